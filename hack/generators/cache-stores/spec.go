@@ -27,6 +27,15 @@ var supportedTypes = []cacheStoreSupportedType{
 		Type:    "EndpointSlice",
 		Package: "discoveryv1",
 	},
+	{
+		Type:    "ConfigMap",
+		Package: "corev1",
+	},
+	{
+		Type:    "Namespace",
+		Package: "corev1",
+		KeyFunc: clusterWideKeyFunc,
+	},
 	// Gateway API types
 	{
 		Type:    "HTTPRoute",
@@ -56,6 +65,19 @@ var supportedTypes = []cacheStoreSupportedType{
 		Type:    "Gateway",
 		Package: "gatewayapi",
 	},
+	{
+		Type:    "BackendTLSPolicy",
+		Package: "gatewayapi",
+	},
+	{
+		Type:    "BackendLBPolicy",
+		Package: "gatewayapi",
+	},
+	// Multi-Cluster Services API types
+	{
+		Type:    "ServiceImport",
+		Package: "mcsv1alpha1",
+	},
 	// Kong types
 	{
 		Type:       "KongPlugin",
@@ -108,4 +130,13 @@ var supportedTypes = []cacheStoreSupportedType{
 		Package: "kongv1alpha1",
 		KeyFunc: clusterWideKeyFunc,
 	},
+	{
+		Type:    "KongCustomEntity",
+		Package: "kongv1alpha1",
+	},
+	{
+		Type:    "KongTenantPolicy",
+		Package: "kongv1alpha1",
+		KeyFunc: clusterWideKeyFunc,
+	},
 }
@@ -18,6 +18,7 @@ import (
 	"sigs.k8s.io/controller-runtime/pkg/client"
 
 	"github.com/kong/kubernetes-ingress-controller/v3/internal/gatewayapi"
+	mcsv1alpha1 "github.com/kong/kubernetes-ingress-controller/v3/internal/mcsapi/v1alpha1"
 	kongv1 "github.com/kong/kubernetes-ingress-controller/v3/pkg/apis/configuration/v1"
 	kongv1alpha1 "github.com/kong/kubernetes-ingress-controller/v3/pkg/apis/configuration/v1alpha1"
 	kongv1beta1 "github.com/kong/kubernetes-ingress-controller/v3/pkg/apis/configuration/v1beta1"
@@ -118,6 +119,7 @@ import (
 	"testing"
 
 	"github.com/kong/kubernetes-ingress-controller/v3/internal/gatewayapi"
+	mcsv1alpha1 "github.com/kong/kubernetes-ingress-controller/v3/internal/mcsapi/v1alpha1"
 	kongv1 "github.com/kong/kubernetes-ingress-controller/v3/pkg/apis/configuration/v1"
 	kongv1alpha1 "github.com/kong/kubernetes-ingress-controller/v3/pkg/apis/configuration/v1alpha1"
 	"github.com/stretchr/testify/require"
@@ -25,6 +25,10 @@ const (
 	kongv1alpha1 = "github.com/kong/kubernetes-ingress-controller/v3/api/configuration/v1alpha1"
 
 	incubatorv1alpha1 = "github.com/kong/kubernetes-ingress-controller/v3/api/incubator/v1alpha1"
+
+	gatewayapi = "github.com/kong/kubernetes-ingress-controller/v3/internal/gatewayapi"
+
+	mcsv1alpha1 = "github.com/kong/kubernetes-ingress-controller/v3/internal/mcsapi/v1alpha1"
 )
 
 // inputControllersNeeded is a list of the supported Types for the
@@ -61,6 +65,20 @@ var inputControllersNeeded = &typesNeeded{
 		AcceptsIngressClassNameSpec:       false,
 		RBACVerbs:                         []string{"list", "watch"},
 	},
+	typeNeeded{
+		Group:                             "\"\"",
+		Version:                           "v1",
+		Kind:                              "Namespace",
+		PackageImportAlias:                "corev1",
+		PackageAlias:                      "CoreV1",
+		Package:                           corev1,
+		Plural:                            "namespaces",
+		CacheType:                         "Namespace",
+		NeedsStatusPermissions:            false,
+		AcceptsIngressClassNameAnnotation: false,
+		AcceptsIngressClassNameSpec:       false,
+		RBACVerbs:                         []string{"get", "list", "watch"},
+	},
 	typeNeeded{
 		Group:                             "networking.k8s.io",
 		Version:                           "v1",
@@ -264,6 +282,67 @@ var inputControllersNeeded = &typesNeeded{
 		AcceptsIngressClassNameAnnotation: true,
 		RBACVerbs:                         []string{"get", "list", "watch"},
 	},
+	typeNeeded{
+		Group:                            "configuration.konghq.com",
+		Version:                          "v1alpha1",
+		Kind:                             "KongCustomEntity",
+		PackageImportAlias:               "kongv1alpha1",
+		PackageAlias:                     "KongV1Alpha1",
+		Package:                          kongv1alpha1,
+		Plural:                           "kongcustomentities",
+		CacheType:                        "KongCustomEntity",
+		NeedsStatusPermissions:           true,
+		ConfigStatusNotificationsEnabled: true,
+		ProgrammedCondition: ProgrammedConditionConfiguration{
+			UpdatesEnabled: true,
+		},
+		// KongCustomEntity can also specify the ingress class in its spec.controllerName which is
+		// handled by the ingress class matching utilities.
+		AcceptsIngressClassNameAnnotation: true,
+		RBACVerbs:                         []string{"get", "list", "watch"},
+	},
+	typeNeeded{
+		Group:                             "configuration.konghq.com",
+		Version:                           "v1alpha1",
+		Kind:                              "KongTenantPolicy",
+		PackageImportAlias:                "kongv1alpha1",
+		PackageAlias:                      "KongV1Alpha1",
+		Package:                           kongv1alpha1,
+		Plural:                            "kongtenantpolicies",
+		CacheType:                         "KongTenantPolicy",
+		NeedsStatusPermissions:            false,
+		AcceptsIngressClassNameAnnotation: false,
+		AcceptsIngressClassNameSpec:       false,
+		RBACVerbs:                         []string{"get", "list", "watch"},
+	},
+	typeNeeded{
+		Group:                             "gateway.networking.k8s.io",
+		Version:                           "v1alpha2",
+		Kind:                              "BackendLBPolicy",
+		PackageImportAlias:                "gatewayapi",
+		PackageAlias:                      "GatewayV1Alpha2",
+		Package:                           gatewayapi,
+		Plural:                            "backendlbpolicies",
+		CacheType:                         "BackendLBPolicy",
+		NeedsStatusPermissions:            false,
+		AcceptsIngressClassNameAnnotation: false,
+		AcceptsIngressClassNameSpec:       false,
+		RBACVerbs:                         []string{"get", "list", "watch"},
+	},
+	typeNeeded{
+		Group:                             "multicluster.x-k8s.io",
+		Version:                           "v1alpha1",
+		Kind:                              "ServiceImport",
+		PackageImportAlias:                "mcsv1alpha1",
+		PackageAlias:                      "MulticlusterV1Alpha1",
+		Package:                           mcsv1alpha1,
+		Plural:                            "serviceimports",
+		CacheType:                         "ServiceImport",
+		NeedsStatusPermissions:            false,
+		AcceptsIngressClassNameAnnotation: false,
+		AcceptsIngressClassNameSpec:       false,
+		RBACVerbs:                         []string{"get", "list", "watch"},
+	},
 }
 
 var inputRBACPermissionsNeeded = &rbacsNeeded{
@@ -466,6 +545,8 @@ import (
 	ctrlref "github.com/kong/kubernetes-ingress-controller/v3/internal/controllers/reference"
 	ctrlutils "github.com/kong/kubernetes-ingress-controller/v3/internal/controllers/utils"
 	"github.com/kong/kubernetes-ingress-controller/v3/internal/dataplane"
+	"github.com/kong/kubernetes-ingress-controller/v3/internal/gatewayapi"
+	mcsv1alpha1 "github.com/kong/kubernetes-ingress-controller/v3/internal/mcsapi/v1alpha1"
 	"github.com/kong/kubernetes-ingress-controller/v3/internal/util"
 	"github.com/kong/kubernetes-ingress-controller/v3/internal/util/kubernetes/object/status"
 	kongv1 "github.com/kong/kubernetes-ingress-controller/v3/pkg/apis/configuration/v1"
@@ -1,12 +1,18 @@
 package adminapi
 
 import (
+	"bytes"
 	"context"
+	"encoding/hex"
 	"errors"
 	"fmt"
 	"net/http"
+	"strings"
+	"sync"
+	"time"
 
 	"github.com/kong/go-kong/kong"
+	"github.com/prometheus/common/expfmt"
 	"github.com/samber/lo"
 	k8stypes "k8s.io/apimachinery/pkg/types"
 
@@ -28,6 +34,25 @@ type Client struct {
 
 	// podRef (optional) describes the Pod that the Client communicates with.
 	podRef *k8stypes.NamespacedName
+
+	// zone (optional) is the zone of the Pod that the Client communicates with.
+	zone string
+
+	// syncStatus is the result of the last configuration sync, nil until the first sync is recorded.
+	syncStatus     *SyncStatus
+	syncStatusLock sync.RWMutex
+}
+
+// SyncStatus is the result of the last configuration sync with an Admin API.
+type SyncStatus struct {
+	// ConfigHash is a hex encoded checksum of the last configuration successfully applied.
+	ConfigHash string
+	// Fallback is true when the last configuration successfully applied was the fallback configuration.
+	Fallback bool
+	// Time is the time of the last sync attempt.
+	Time time.Time
+	// Err is the error returned by the last sync attempt, nil when it succeeded.
+	Err error
 }
 
 // NewClient creates an Admin API client that is to be used with a regular Admin API exposed by Kong Gateways.
@@ -101,6 +126,60 @@ func (c *Client) IsReady(ctx context.Context) error {
 	return err
 }
 
+// ErrHTTPRequestMetricsUnavailable is returned by HTTPRequestCounts when the gateway doesn't expose metrics of
+// HTTP requests it served, which is the case when the Prometheus plugin isn't enabled globally or its
+// status_code_metrics setting is disabled.
+var ErrHTTPRequestMetricsUnavailable = errors.New("HTTP request metrics are not available")
+
+// kongHTTPRequestsTotalMetric is the name of the Prometheus plugin's metric counting HTTP requests per status code.
+const kongHTTPRequestsTotalMetric = "kong_http_requests_total"
+
+// HTTPRequestCounts holds the numbers of HTTP requests served by a gateway since it started.
+type HTTPRequestCounts struct {
+	// Total is the number of all served requests.
+	Total float64
+	// ServerErrors is the number of requests that were responded with a 5xx status code.
+	ServerErrors float64
+}
+
+// HTTPRequestCounts returns the numbers of HTTP requests served by the gateway scraped from the metrics exposed
+// by the Prometheus plugin on the Admin API's /metrics endpoint.
+func (c *Client) HTTPRequestCounts(ctx context.Context) (HTTPRequestCounts, error) {
+	req, err := c.adminAPIClient.NewRequest(http.MethodGet, "/metrics", nil, nil)
+	if err != nil {
+		return HTTPRequestCounts{}, fmt.Errorf("failed creating metrics request: %w", err)
+	}
+	var body bytes.Buffer
+	if _, err := c.adminAPIClient.Do(ctx, req, &body); err != nil {
+		if kong.IsNotFoundErr(err) {
+			return HTTPRequestCounts{}, ErrHTTPRequestMetricsUnavailable
+		}
+		return HTTPRequestCounts{}, fmt.Errorf("failed fetching metrics: %w", err)
+	}
+
+	var parser expfmt.TextParser
+	families, err := parser.TextToMetricFamilies(&body)
+	if err != nil {
+		return HTTPRequestCounts{}, fmt.Errorf("failed parsing metrics: %w", err)
+	}
+	family, ok := families[kongHTTPRequestsTotalMetric]
+	if !ok {
+		return HTTPRequestCounts{}, ErrHTTPRequestMetricsUnavailable
+	}
+
+	var counts HTTPRequestCounts
+	for _, m := range family.GetMetric() {
+		value := m.GetCounter().GetValue()
+		counts.Total += value
+		for _, l := range m.GetLabel() {
+			if l.GetName() == "code" && strings.HasPrefix(l.GetValue(), "5") {
+				counts.ServerErrors += value
+			}
+		}
+	}
+	return counts, nil
+}
+
 // GetKongVersion returns version of the kong gateway.
 func (c *Client) GetKongVersion(ctx context.Context) (string, error) {
 	if c.isKonnect {
@@ -164,6 +243,35 @@ func (c *Client) LastConfigSHA() []byte {
 	return c.lastConfigSHA
 }
 
+// RecordSync records the result of a configuration sync attempted at the given time. The config hash
+// and the fallback flag are updated only when the sync succeeded.
+func (c *Client) RecordSync(t time.Time, fallback bool, err error) {
+	c.syncStatusLock.Lock()
+	defer c.syncStatusLock.Unlock()
+
+	status := SyncStatus{Time: t, Err: err}
+	if c.syncStatus != nil {
+		status.ConfigHash = c.syncStatus.ConfigHash
+		status.Fallback = c.syncStatus.Fallback
+	}
+	if err == nil {
+		status.ConfigHash = hex.EncodeToString(c.lastConfigSHA)
+		status.Fallback = fallback
+	}
+	c.syncStatus = &status
+}
+
+// SyncStatus returns the result of the last configuration sync. It returns false when no sync was recorded yet.
+func (c *Client) SyncStatus() (SyncStatus, bool) {
+	c.syncStatusLock.RLock()
+	defer c.syncStatusLock.RUnlock()
+
+	if c.syncStatus == nil {
+		return SyncStatus{}, false
+	}
+	return *c.syncStatus, true
+}
+
 // AttachPodReference allows attaching a Pod reference to the client. Should be used in case we know what Pod the client
 // will communicate with (e.g. when the gateway service discovery is used).
 func (c *Client) AttachPodReference(podNN k8stypes.NamespacedName) {
@@ -178,6 +286,16 @@ func (c *Client) PodReference() (k8stypes.NamespacedName, bool) {
 	return k8stypes.NamespacedName{}, false
 }
 
+// AttachZone allows attaching the zone of the Pod the client communicates with.
+func (c *Client) AttachZone(zone string) {
+	c.zone = zone
+}
+
+// Zone returns an optional zone of the Pod the client communicates with.
+func (c *Client) Zone() (string, bool) {
+	return c.zone, c.zone != ""
+}
+
 type ClientFactory struct {
 	workspace      string
 	httpClientOpts HTTPClientOpts
@@ -202,5 +320,6 @@ func (cf ClientFactory) CreateAdminAPIClient(ctx context.Context, discoveredAdmi
 		return nil, err
 	}
 	cl.AttachPodReference(discoveredAdminAPI.PodRef)
+	cl.AttachZone(discoveredAdminAPI.Zone)
 	return cl, nil
 }
@@ -2,8 +2,10 @@ package adminapi_test
 
 import (
 	"context"
+	"errors"
 	"net/http/httptest"
 	"testing"
+	"time"
 
 	"github.com/stretchr/testify/require"
 	k8stypes "k8s.io/apimachinery/pkg/types"
@@ -36,3 +38,36 @@ func TestClientFactory_CreateAdminAPIClientAttachesPodReference(t *testing.T) {
 		Name:      "name",
 	}, ref)
 }
+
+func TestClient_RecordSync(t *testing.T) {
+	client, err := adminapi.NewTestClient("http://localhost:8001")
+	require.NoError(t, err)
+
+	_, ok := client.SyncStatus()
+	require.False(t, ok, "expected no sync status before the first sync is recorded")
+
+	t.Log("Recording a successful sync of the fallback configuration")
+	firstSync := time.Now()
+	client.SetLastConfigSHA([]byte{0xab, 0xcd})
+	client.RecordSync(firstSync, true, nil)
+	status, ok := client.SyncStatus()
+	require.True(t, ok)
+	require.Equal(t, adminapi.SyncStatus{
+		ConfigHash: "abcd",
+		Fallback:   true,
+		Time:       firstSync,
+	}, status)
+
+	t.Log("Recording a failed sync keeps the hash of the last applied configuration")
+	secondSync := firstSync.Add(time.Minute)
+	syncErr := errors.New("failed to apply configuration")
+	client.RecordSync(secondSync, false, syncErr)
+	status, ok = client.SyncStatus()
+	require.True(t, ok)
+	require.Equal(t, adminapi.SyncStatus{
+		ConfigHash: "abcd",
+		Fallback:   true,
+		Time:       secondSync,
+		Err:        syncErr,
+	}, status)
+}
@@ -5,6 +5,7 @@ import (
 	"fmt"
 	"strings"
 
+	"github.com/samber/lo"
 	discoveryv1 "k8s.io/api/discovery/v1"
 	"k8s.io/apimachinery/pkg/labels"
 	"k8s.io/apimachinery/pkg/selection"
@@ -19,6 +20,8 @@ import (
 type DiscoveredAdminAPI struct {
 	Address string
 	PodRef  k8stypes.NamespacedName
+	// Zone is the zone of the endpoint, if known.
+	Zone string
 }
 
 type Discoverer struct {
@@ -186,6 +189,7 @@ func adminAPIFromEndpoint(
 		return DiscoveredAdminAPI{
 			Address: fmt.Sprintf("https://%s:%d", address, *port.Port),
 			PodRef:  podNN,
+			Zone:    lo.FromPtr(endpoint.Zone),
 		}, nil
 
 	case cfgtypes.NamespaceScopedPodDNSStrategy:
@@ -195,6 +199,7 @@ func adminAPIFromEndpoint(
 		return DiscoveredAdminAPI{
 			Address: fmt.Sprintf("https://%s:%d", address, *port.Port),
 			PodRef:  podNN,
+			Zone:    lo.FromPtr(endpoint.Zone),
 		}, nil
 
 	case cfgtypes.IPDNSStrategy:
@@ -205,6 +210,7 @@ func adminAPIFromEndpoint(
 		return DiscoveredAdminAPI{
 			Address: fmt.Sprintf("https://%s:%d", bounded, *port.Port),
 			PodRef:  podNN,
+			Zone:    lo.FromPtr(endpoint.Zone),
 		}, nil
 
 	default:
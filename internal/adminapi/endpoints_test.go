@@ -53,6 +53,36 @@ func TestDiscoverer_AddressesFromEndpointSlice(t *testing.T) {
 		dnsStrategy cfgtypes.DNSStrategy
 		expectedErr error
 	}{
+		{
+			name: "endpoint with zone",
+			endpoints: discoveryv1.EndpointSlice{
+				ObjectMeta:  endpointsSliceObjectMeta,
+				AddressType: discoveryv1.AddressTypeIPv4,
+				Endpoints: []discoveryv1.Endpoint{
+					{
+						Addresses: []string{"10.0.0.1"},
+						Conditions: discoveryv1.EndpointConditions{
+							Ready:       lo.ToPtr(true),
+							Terminating: lo.ToPtr(false),
+						},
+						TargetRef: testPodReference(namespaceName, "pod-1"),
+						Zone:      lo.ToPtr("us-east-1a"),
+					},
+				},
+				Ports: builder.NewEndpointPort(8444).WithName("admin").IntoSlice(),
+			},
+			portNames: sets.New("admin"),
+			want: sets.New(
+				DiscoveredAdminAPI{
+					Address: "https://10.0.0.1:8444",
+					PodRef: k8stypes.NamespacedName{
+						Name: "pod-1", Namespace: namespaceName,
+					},
+					Zone: "us-east-1a",
+				},
+			),
+			dnsStrategy: cfgtypes.IPDNSStrategy,
+		},
 		{
 			name: "basic",
 			endpoints: discoveryv1.EndpointSlice{
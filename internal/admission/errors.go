@@ -12,10 +12,14 @@ const (
 	ErrTextCustomEntityFieldsUnmarshalFailed  = "failed to unmarshal fields of custom entity: %v"
 	ErrTextCustomEntityGetSchemaFailed        = "failed to get schema of Kong entity type '%s': %v"
 	ErrTextFailedToRetrieveSecret             = "could not retrieve secrets from the kubernetes API" //nolint:revive,gosec
+	ErrTextHostnameOwnedByAnotherNamespace    = "routes a hostname owned by another namespace"
 	ErrTextPluginConfigInvalid                = "could not parse plugin configuration"
+	ErrTextPluginConfigMapConfigUnretrievable = "could not load ConfigMap plugin configuration"
 	ErrTextPluginConfigValidationFailed       = "unable to validate plugin schema"
 	ErrTextPluginConfigViolatesSchema         = "plugin failed schema validation: %s"
+	ErrTextPluginInstanceNameNotUnique        = "plugin instance_name %q is already used by %s"
 	ErrTextPluginSecretConfigUnretrievable    = "could not load secret plugin configuration"
+	ErrTextTenantPolicyViolated               = "violates KongTenantPolicy"
 	ErrTextVaultConfigUnmarshalFailed         = "failed to unmarshal vault configuration: %v"
 	ErrTextVaultUnableToValidate              = "unable to validate vault on Kong gateway"
 	ErrTextVaultConfigValidationResultInvalid = "vault configuration in invalid: %s"
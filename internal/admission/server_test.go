@@ -74,6 +74,22 @@ func (v KongFakeValidator) ValidateHTTPRoute(_ context.Context, _ gatewayapi.HTT
 	return v.Result, v.Message, v.Error
 }
 
+func (v KongFakeValidator) ValidateGRPCRoute(_ context.Context, _ gatewayapi.GRPCRoute) (bool, string, error) {
+	return v.Result, v.Message, v.Error
+}
+
+func (v KongFakeValidator) ValidateTCPRoute(_ context.Context, _ gatewayapi.TCPRoute) (bool, string, error) {
+	return v.Result, v.Message, v.Error
+}
+
+func (v KongFakeValidator) ValidateUDPRoute(_ context.Context, _ gatewayapi.UDPRoute) (bool, string, error) {
+	return v.Result, v.Message, v.Error
+}
+
+func (v KongFakeValidator) ValidateTLSRoute(_ context.Context, _ gatewayapi.TLSRoute) (bool, string, error) {
+	return v.Result, v.Message, v.Error
+}
+
 func (v KongFakeValidator) ValidateIngress(_ context.Context, _ netv1.Ingress) (bool, string, error) {
 	return v.Result, v.Message, v.Error
 }
@@ -31,9 +31,28 @@ func ValidateCredentials(secret *corev1.Secret) error {
 		return fmt.Errorf("invalid credential type %s", credentialType)
 	}
 
+	// Credentials rotated with versions are validated version by version, each of them has to be a valid credential.
+	versions, err := util.ExtractKongCredentialVersions(secret)
+	if err != nil {
+		return fmt.Errorf("invalid credential versions: %w", err)
+	}
+	for _, version := range versions {
+		if err := validateCredentialFields(credentialType, version.Data); err != nil {
+			if version.Name != "" {
+				return fmt.Errorf("version %s: %w", version.Name, err)
+			}
+			return err
+		}
+	}
+
+	return nil
+}
+
+// validateCredentialFields verifies that all the fields required by the credential type are present in data.
+func validateCredentialFields(credentialType string, data map[string][]byte) error {
 	// Check if we're dealing with a JWT credential with an HMAC algorithm.
 	// In this case, the rsa_public_key field is not required.
-	algo, hasAlgo := secret.Data["algorithm"]
+	algo, hasAlgo := data["algorithm"]
 	ignoreMissingRSAPublicKey := credentialType == "jwt" && hasAlgo && algoIsHMAC(string(algo))
 
 	// verify that all required fields are present
@@ -46,7 +65,7 @@ func ValidateCredentials(secret *corev1.Secret) error {
 		}
 
 		// verify whether the required field is missing
-		requiredData, ok := secret.Data[field]
+		requiredData, ok := data[field]
 		if !ok {
 			missingFields = append(missingFields, field)
 			continue
@@ -131,13 +150,28 @@ func (cs Index) ValidateCredentialsForUniqueKeyConstraints(secret *corev1.Secret
 	// for unique constraint violations. Using an index of credentials
 	// validation will be checked on any Add() to the index, so errors
 	// from this include the unique key constraint errors.
-	for k, v := range secret.Data {
-		if err := cs.add(Credential{
-			Type:  credentialType,
-			Key:   k,
-			Value: string(v),
-		}); err != nil {
-			return err
+	// Versions of a rotated credential may share fields, e.g. the username of a basic-auth credential,
+	// so every distinct value is added only once. The translator provisions only the newest of the valid
+	// versions sharing a unique constrained value, so they never collide in Kong.
+	versions, err := util.ExtractKongCredentialVersions(secret)
+	if err != nil {
+		return fmt.Errorf("invalid credential versions: %w", err)
+	}
+	seen := make(map[Credential]struct{})
+	for _, version := range versions {
+		for k, v := range version.Data {
+			cred := Credential{
+				Type:  credentialType,
+				Key:   k,
+				Value: string(v),
+			}
+			if _, ok := seen[cred]; ok {
+				continue
+			}
+			seen[cred] = struct{}{}
+			if err := cs.add(cred); err != nil {
+				return err
+			}
 		}
 	}
 
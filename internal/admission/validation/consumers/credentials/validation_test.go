@@ -63,6 +63,28 @@ func TestUniqueConstraintsValidation(t *testing.T) {
 
 	t.Log("Verifying that unconstrained keys for types with constraints don't flag as violated")
 	assert.False(t, IsKeyUniqueConstrained("basic-auth", "unconstrained-key"))
+
+	t.Log("Verifying that versions of a credential sharing a unique constrained value don't violate constraints")
+	index = make(Index)
+	rotated := &corev1.Secret{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      "secret",
+			Namespace: "default",
+			Labels: map[string]string{
+				labels.CredentialTypeLabel: "basic-auth",
+			},
+			Annotations: map[string]string{
+				"konghq.com/credential-versions": "v1,v2",
+			},
+		},
+		Data: map[string][]byte{
+			"username":    []byte("batman"),
+			"password.v1": []byte("little-rabbits-be-good"),
+			"password.v2": []byte("little-rabbits-be-better"),
+		},
+	}
+	assert.NoError(t, index.ValidateCredentialsForUniqueKeyConstraints(rotated))
+	assert.Error(t, index.ValidateCredentialsForUniqueKeyConstraints(rotated))
 }
 
 func TestValidateCredentials(t *testing.T) {
@@ -237,6 +259,46 @@ func TestValidateCredentials(t *testing.T) {
 			},
 			wantErr: fmt.Errorf("some fields were invalid due to missing data: key"),
 		},
+		{
+			name: "valid credential versions",
+			secret: &corev1.Secret{
+				ObjectMeta: metav1.ObjectMeta{
+					Name:      "secret",
+					Namespace: "default",
+					Labels: map[string]string{
+						labels.CredentialTypeLabel: "basic-auth",
+					},
+					Annotations: map[string]string{
+						"konghq.com/credential-versions":     "v1,v2",
+						"konghq.com/credential-not-after.v1": "2024-05-01T00:00:00Z",
+					},
+				},
+				Data: map[string][]byte{
+					"username":    []byte("batman"),
+					"password.v1": []byte("little-rabbits-be-good"),
+					"password.v2": []byte("little-rabbits-be-better"),
+				},
+			},
+		},
+		{
+			name: "credential version missing required field",
+			secret: &corev1.Secret{
+				ObjectMeta: metav1.ObjectMeta{
+					Name:      "secret",
+					Namespace: "default",
+					Labels: map[string]string{
+						labels.CredentialTypeLabel: "key-auth",
+					},
+					Annotations: map[string]string{
+						"konghq.com/credential-versions": "v1,v2",
+					},
+				},
+				Data: map[string][]byte{
+					"key.v1": []byte("little-rabbits-be-good"),
+				},
+			},
+			wantErr: fmt.Errorf("version v2: %w", fmt.Errorf("missing required field(s): key")),
+		},
 	}
 	for _, tt := range tests {
 		t.Run(tt.name, func(t *testing.T) {
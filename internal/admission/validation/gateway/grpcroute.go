@@ -0,0 +1,82 @@
+package gateway
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/kong/go-kong/kong"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	"github.com/kong/kubernetes-ingress-controller/v3/internal/admission/validation"
+	"github.com/kong/kubernetes-ingress-controller/v3/internal/admission/validation/kongplugin"
+	gatewaycontroller "github.com/kong/kubernetes-ingress-controller/v3/internal/controllers/gateway"
+	"github.com/kong/kubernetes-ingress-controller/v3/internal/dataplane/kongstate"
+	"github.com/kong/kubernetes-ingress-controller/v3/internal/dataplane/translator"
+	"github.com/kong/kubernetes-ingress-controller/v3/internal/dataplane/translator/subtranslator"
+	"github.com/kong/kubernetes-ingress-controller/v3/internal/gatewayapi"
+)
+
+// -----------------------------------------------------------------------------
+// Validation - GRPCRoute - Public Functions
+// -----------------------------------------------------------------------------
+
+// ValidateGRPCRoute provides a suite of validation for a given GRPCRoute. It checks
+// the matches against the configured router flavor, linked objects, and uses provided
+// routesValidator to validate the route against Kong Gateway validation endpoint.
+func ValidateGRPCRoute(
+	ctx context.Context,
+	routesValidator routeValidator,
+	translatorFeatures translator.FeatureFlags,
+	grpcroute *gatewayapi.GRPCRoute,
+	managerClient client.Client,
+) (bool, string, error) {
+	// Check if route is managed by this controller. If not, we don't need to validate it.
+	routeIsManaged, err := RouteIsManagedByController(ctx, grpcroute.Namespace, grpcroute.Spec.ParentRefs, managerClient)
+	if err != nil {
+		return false, "", fmt.Errorf("failed to determine whether GRPCRoute is managed by %q controller: %w",
+			gatewaycontroller.GetControllerName(), err)
+	}
+	if !routeIsManaged {
+		return true, "", nil
+	}
+
+	if err := kongplugin.ValidatePluginUniquenessPerObject(ctx, managerClient, grpcroute); err != nil {
+		return false, fmt.Sprintf("GRPCRoute has invalid KongPlugin annotation: %s", err), nil
+	}
+
+	// Validate that the matches can be translated with the configured router flavor.
+	if err := subtranslator.ValidateGRPCRoute(grpcroute, translatorFeatures.ExpressionRoutes); err != nil {
+		return false, fmt.Sprintf("GRPCRoute spec did not pass validation: %s", err), nil
+	}
+
+	// Validate that the route uses only supported annotations.
+	if err := validation.ValidateRouteSourceAnnotations(grpcroute); err != nil {
+		return false, fmt.Sprintf("GRPCRoute has invalid Kong annotations: %s", err), nil
+	}
+
+	// Validate that the route is valid against Kong Gateway.
+	ok, msg := validateKongRoutes(ctx, routesValidator, "GRPCRoute", kongRoutesFromGRPCRoute(grpcroute, translatorFeatures))
+	return ok, msg, nil
+}
+
+// -----------------------------------------------------------------------------
+// Validation - GRPCRoute - Private Utility Functions
+// -----------------------------------------------------------------------------
+
+// kongRoutesFromGRPCRoute translates GRPCRoute to Kong Route object(s) that can be sent
+// directly to the Admin API for validation.
+func kongRoutesFromGRPCRoute(grpcroute *gatewayapi.GRPCRoute, translatorFeatures translator.FeatureFlags) []kong.Route {
+	var kongRoutes []kong.Route
+	for ruleNumber := range grpcroute.Spec.Rules {
+		var routes []kongstate.Route
+		if translatorFeatures.ExpressionRoutes {
+			routes = subtranslator.GenerateKongExpressionRoutesFromGRPCRouteRule(grpcroute, ruleNumber)
+		} else {
+			routes = subtranslator.GenerateKongRoutesFromGRPCRouteRule(grpcroute, ruleNumber)
+		}
+		for _, r := range routes {
+			kongRoutes = append(kongRoutes, r.Route)
+		}
+	}
+	return kongRoutes
+}
@@ -0,0 +1,146 @@
+package gateway
+
+import (
+	"context"
+	"testing"
+
+	"github.com/samber/lo"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	fakeclient "sigs.k8s.io/controller-runtime/pkg/client/fake"
+
+	gatewaycontroller "github.com/kong/kubernetes-ingress-controller/v3/internal/controllers/gateway"
+	"github.com/kong/kubernetes-ingress-controller/v3/internal/dataplane/translator"
+	"github.com/kong/kubernetes-ingress-controller/v3/internal/gatewayapi"
+	"github.com/kong/kubernetes-ingress-controller/v3/internal/manager/scheme"
+)
+
+func TestValidateGRPCRoute(t *testing.T) {
+	gatewayClass := &gatewayapi.GatewayClass{
+		ObjectMeta: metav1.ObjectMeta{
+			Name: "kong",
+		},
+		Spec: gatewayapi.GatewayClassSpec{
+			ControllerName: gatewaycontroller.GetControllerName(),
+		},
+	}
+	gateway := &gatewayapi.Gateway{
+		ObjectMeta: metav1.ObjectMeta{
+			Namespace: corev1.NamespaceDefault,
+			Name:      "gateway",
+		},
+		Spec: gatewayapi.GatewaySpec{
+			GatewayClassName: "kong",
+			Listeners: []gatewayapi.Listener{{
+				Name:     "http",
+				Port:     80,
+				Protocol: gatewayapi.HTTPProtocolType,
+			}},
+		},
+	}
+	newGRPCRoute := func(parentRef string, match gatewayapi.GRPCRouteMatch) *gatewayapi.GRPCRoute {
+		return &gatewayapi.GRPCRoute{
+			ObjectMeta: metav1.ObjectMeta{
+				Namespace: corev1.NamespaceDefault,
+				Name:      "grpcroute",
+			},
+			Spec: gatewayapi.GRPCRouteSpec{
+				CommonRouteSpec: gatewayapi.CommonRouteSpec{
+					ParentRefs: []gatewayapi.ParentReference{{
+						Name: gatewayapi.ObjectName(parentRef),
+					}},
+				},
+				Rules: []gatewayapi.GRPCRouteRule{{
+					Matches: []gatewayapi.GRPCRouteMatch{match},
+				}},
+			},
+		}
+	}
+	regexHeaderMatch := gatewayapi.GRPCRouteMatch{
+		Headers: []gatewayapi.GRPCHeaderMatch{{
+			Type:  lo.ToPtr(gatewayapi.HeaderMatchRegularExpression),
+			Name:  "x-foo",
+			Value: "ba.*",
+		}},
+	}
+
+	testCases := []struct {
+		name             string
+		route            *gatewayapi.GRPCRoute
+		expressionRoutes bool
+		routesValidator  routeValidator
+		valid            bool
+		validationMsg    string
+	}{
+		{
+			name: "route not managed by the controller is accepted without validation",
+			route: newGRPCRoute("other-gateway", gatewayapi.GRPCRouteMatch{
+				Method: &gatewayapi.GRPCMethodMatch{},
+			}),
+			valid: true,
+		},
+		{
+			name: "valid route is accepted",
+			route: newGRPCRoute("gateway", gatewayapi.GRPCRouteMatch{
+				Method: &gatewayapi.GRPCMethodMatch{
+					Service: lo.ToPtr("grpcbin.GRPCBin"),
+					Method:  lo.ToPtr("DummyUnary"),
+				},
+			}),
+			valid: true,
+		},
+		{
+			name: "method match without service and method is rejected",
+			route: newGRPCRoute("gateway", gatewayapi.GRPCRouteMatch{
+				Method: &gatewayapi.GRPCMethodMatch{},
+			}),
+			validationMsg: "GRPCRoute spec did not pass validation: rules[0].matches[0]: " +
+				"method match must specify at least one of service or method",
+		},
+		{
+			name:  "regular expression header match is rejected with traditional routes",
+			route: newGRPCRoute("gateway", regexHeaderMatch),
+			validationMsg: "GRPCRoute spec did not pass validation: rules[0].matches[0].headers[0]: " +
+				"regular expression header matches are supported only with expression routes",
+		},
+		{
+			name:             "regular expression header match is accepted with expression routes",
+			route:            newGRPCRoute("gateway", regexHeaderMatch),
+			expressionRoutes: true,
+			valid:            true,
+		},
+		{
+			name: "route rejected by Kong is rejected",
+			route: newGRPCRoute("gateway", gatewayapi.GRPCRouteMatch{
+				Method: &gatewayapi.GRPCMethodMatch{
+					Service: lo.ToPtr("grpcbin.GRPCBin"),
+				},
+			}),
+			routesValidator: rejectingRoutesValidator{msg: "invalid route"},
+			validationMsg:   "GRPCRoute failed schema validation: invalid route",
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			fakeClient := fakeclient.
+				NewClientBuilder().
+				WithScheme(lo.Must(scheme.Get())).
+				WithObjects(gatewayClass, gateway).
+				Build()
+			routesValidator := tc.routesValidator
+			if routesValidator == nil {
+				routesValidator = mockRoutesValidator{}
+			}
+
+			valid, validMsg, err := ValidateGRPCRoute(
+				context.Background(), routesValidator, translator.FeatureFlags{ExpressionRoutes: tc.expressionRoutes}, tc.route, fakeClient,
+			)
+			require.NoError(t, err)
+			assert.Equal(t, tc.valid, valid)
+			assert.Equal(t, tc.validationMsg, validMsg)
+		})
+	}
+}
@@ -15,6 +15,8 @@ import (
 	"github.com/kong/kubernetes-ingress-controller/v3/internal/dataplane/translator"
 	"github.com/kong/kubernetes-ingress-controller/v3/internal/dataplane/translator/subtranslator"
 	"github.com/kong/kubernetes-ingress-controller/v3/internal/gatewayapi"
+	"github.com/kong/kubernetes-ingress-controller/v3/internal/manager/featuregates"
+	"github.com/kong/kubernetes-ingress-controller/v3/internal/util"
 )
 
 type routeValidator interface {
@@ -38,7 +40,7 @@ func ValidateHTTPRoute(
 	managerClient client.Client,
 ) (bool, string, error) {
 	// Check if route is managed by this controller. If not, we don't need to validate it.
-	routeIsManaged, err := ensureHTTPRouteIsManagedByController(ctx, httproute, managerClient)
+	routeIsManaged, err := RouteIsManagedByController(ctx, httproute.Namespace, httproute.Spec.ParentRefs, managerClient)
 	if err != nil {
 		return false, "", fmt.Errorf("failed to determine whether HTTPRoute is managed by %q controller: %w",
 			gatewaycontroller.GetControllerName(), err)
@@ -70,23 +72,14 @@ func ValidateHTTPRoute(
 	return ok, msg, nil
 }
 
-// -----------------------------------------------------------------------------
-// Validation - HTTPRoute - Private Functions
-// -----------------------------------------------------------------------------
-
-// parentRefIsGateway returns true if the group/kind of ParentReference is empty or gateway.networking.k8s.io/Gateway.
-func parentRefIsGateway(parentRef gatewayapi.ParentReference) bool {
-	const KindGateway = gatewayapi.Kind("Gateway")
-
-	return (parentRef.Group == nil || (*parentRef.Group == "" || *parentRef.Group == gatewayapi.V1Group)) &&
-		(parentRef.Kind == nil || (*parentRef.Kind == "" || *parentRef.Kind == KindGateway))
-}
-
-// ensureHTTPRouteIsManagedByController checks whether the provided HTTPRoute is managed by this controller implementation.
-func ensureHTTPRouteIsManagedByController(ctx context.Context, httproute *gatewayapi.HTTPRoute, managerClient client.Client) (bool, error) {
-	// In order to be sure whether an HTTPRoute resource is managed by this
+// RouteIsManagedByController checks whether a route from the provided namespace with the provided
+// parentRefs is managed by this controller implementation.
+func RouteIsManagedByController(
+	ctx context.Context, routeNamespace string, parentRefs []gatewayapi.ParentReference, managerClient client.Client,
+) (bool, error) {
+	// In order to be sure whether a route resource is managed by this
 	// controller we ignore references to Gateway resources that do not exist.
-	for _, parentRef := range httproute.Spec.ParentRefs {
+	for _, parentRef := range parentRefs {
 		// Skip the parentRefs that are not Gateways because they cannot refer to the controller.
 		// https://github.com/Kong/kubernetes-ingress-controller/issues/5912
 		if !parentRefIsGateway(parentRef) {
@@ -95,7 +88,7 @@ func ensureHTTPRouteIsManagedByController(ctx context.Context, httproute *gatewa
 
 		// Determine the namespace of the gateway referenced via parentRef. If no
 		// explicit namespace is provided, assume the namespace of the route.
-		namespace := httproute.Namespace
+		namespace := routeNamespace
 		if parentRef.Namespace != nil {
 			namespace = string(*parentRef.Namespace)
 		}
@@ -128,29 +121,54 @@ func ensureHTTPRouteIsManagedByController(ctx context.Context, httproute *gatewa
 		}
 	}
 
-	// If we get here, the HTTPRoute is not managed by this controller.
+	// If we get here, the route is not managed by this controller.
 	return false, nil
 }
 
+// -----------------------------------------------------------------------------
+// Validation - HTTPRoute - Private Functions
+// -----------------------------------------------------------------------------
+
+// parentRefIsGateway returns true if the group/kind of ParentReference is empty or gateway.networking.k8s.io/Gateway.
+func parentRefIsGateway(parentRef gatewayapi.ParentReference) bool {
+	const KindGateway = gatewayapi.Kind("Gateway")
+
+	return (parentRef.Group == nil || (*parentRef.Group == "" || *parentRef.Group == gatewayapi.V1Group)) &&
+		(parentRef.Kind == nil || (*parentRef.Kind == "" || *parentRef.Kind == KindGateway))
+}
+
 // validateHTTPRouteFeatures checks for features that are not supported by this
 // HTTPRoute implementation and validates that the provided object is not using
 // any of those unsupported features.
 func validateHTTPRouteFeatures(httproute *gatewayapi.HTTPRoute, translatorFeatures translator.FeatureFlags) error {
-	unsupportedFilterMap := map[gatewayapi.HTTPRouteFilterType]struct{}{
-		gatewayapi.HTTPRouteFilterRequestMirror: {},
-	}
 	const (
 		KindService = gatewayapi.Kind("Service")
 	)
 
 	for ruleIndex, rule := range httproute.Spec.Rules {
-		// Filter RequestMirror is not supported.
-
 		for filterIndex, filter := range rule.Filters {
-			if _, unsupported := unsupportedFilterMap[filter.Type]; unsupported {
-				return fmt.Errorf("rules[%d].filters[%d]: filter type %s is unsupported",
+			// RequestMirror filters are supported only with the RequestMirror feature gate enabled and only for
+			// Kubernetes Services with an explicit port.
+			if filter.Type != gatewayapi.HTTPRouteFilterRequestMirror {
+				continue
+			}
+			if !translatorFeatures.RequestMirror {
+				return fmt.Errorf("rules[%d].filters[%d]: filter type %s is unsupported unless the %s feature gate is enabled",
+					ruleIndex, filterIndex, filter.Type, featuregates.RequestMirror)
+			}
+			if filter.RequestMirror == nil {
+				return fmt.Errorf("rules[%d].filters[%d]: requestMirror must be set for filter type %s",
 					ruleIndex, filterIndex, filter.Type)
 			}
+			mirrorRef := filter.RequestMirror.BackendRef
+			if !util.IsBackendRefGroupKindSupported(mirrorRef.Group, mirrorRef.Kind) {
+				return fmt.Errorf("rules[%d].filters[%d]: requestMirror backendRef is not a supported kind, only %s is supported",
+					ruleIndex, filterIndex, KindService)
+			}
+			if mirrorRef.Port == nil {
+				return fmt.Errorf("rules[%d].filters[%d]: requestMirror backendRef must specify a port",
+					ruleIndex, filterIndex)
+			}
 		}
 
 		for refIndex, ref := range rule.BackendRefs {
@@ -171,13 +189,17 @@ func validateHTTPRouteFeatures(httproute *gatewayapi.HTTPRoute, translatorFeatur
 			}
 		}
 
-		for matchIndex, match := range rule.Matches {
-			// We support query parameters matching rules only with expression router.
-			if len(match.QueryParams) != 0 {
-				if !translatorFeatures.ExpressionRoutes {
-					return fmt.Errorf("rules[%d].matches[%d]: queryparam matching is supported with expression router only",
-						ruleIndex, matchIndex)
-				}
+		// Session persistence is translated to Kong's consistent hashing on a session cookie or a header, which
+		// doesn't support expiring sessions.
+		if sp := rule.SessionPersistence; sp != nil {
+			if sp.AbsoluteTimeout != nil || sp.IdleTimeout != nil {
+				return fmt.Errorf("rules[%d].sessionPersistence: absoluteTimeout and idleTimeout are unsupported",
+					ruleIndex)
+			}
+			if sp.CookieConfig != nil && sp.CookieConfig.LifetimeType != nil &&
+				*sp.CookieConfig.LifetimeType == gatewayapi.PermanentCookieLifetimeType {
+				return fmt.Errorf("rules[%d].sessionPersistence: cookieConfig.lifetimeType %s is unsupported",
+					ruleIndex, gatewayapi.PermanentCookieLifetimeType)
 			}
 		}
 	}
@@ -213,27 +235,33 @@ func validateWithKongGateway(
 		}
 	}
 	if len(errMsgs) > 0 {
-		return false, validationMsg(errMsgs)
+		return false, validationMsg("HTTPRoute", errMsgs)
 	}
-	// Validate by using feature of Kong Gateway.
+	return validateKongRoutes(ctx, routesValidator, "HTTPRoute", kongRoutes)
+}
+
+// validateKongRoutes validates the Kong routes generated from a route of the given kind
+// by using the Kong Gateway validation endpoint.
+func validateKongRoutes(ctx context.Context, routesValidator routeValidator, kind string, kongRoutes []kong.Route) (bool, string) {
+	var errMsgs []string
 	for _, kg := range kongRoutes {
 		kg := kg
 		ok, msg, err := routesValidator.Validate(ctx, &kg)
 		if err != nil {
-			return false, fmt.Sprintf("Unable to validate HTTPRoute schema: %s", err.Error())
+			return false, fmt.Sprintf("Unable to validate %s schema: %s", kind, err.Error())
 		}
 		if !ok {
 			errMsgs = append(errMsgs, msg)
 		}
 	}
 	if len(errMsgs) > 0 {
-		return false, validationMsg(errMsgs)
+		return false, validationMsg(kind, errMsgs)
 	}
 	return true, ""
 }
 
-func validationMsg(errMsgs []string) string {
-	return fmt.Sprintf("HTTPRoute failed schema validation: %s", strings.Join(errMsgs, ", "))
+func validationMsg(kind string, errMsgs []string) string {
+	return fmt.Sprintf("%s failed schema validation: %s", kind, strings.Join(errMsgs, ", "))
 }
 
 func validateHTTPRouteTimeoutBackendRequest(httproute *gatewayapi.HTTPRoute) error {
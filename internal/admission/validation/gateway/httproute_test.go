@@ -38,12 +38,13 @@ func TestValidateHTTPRoute(t *testing.T) {
 	)
 
 	for _, tt := range []struct {
-		msg           string
-		route         *gatewayapi.HTTPRoute
-		cachedObjects []client.Object
-		valid         bool
-		validationMsg string
-		err           error
+		msg                string
+		route              *gatewayapi.HTTPRoute
+		cachedObjects      []client.Object
+		translatorFeatures translator.FeatureFlags
+		valid              bool
+		validationMsg      string
+		err                error
 	}{
 		{
 			msg: "route with no parentRef is accepted with no validations",
@@ -285,7 +286,7 @@ func TestValidateHTTPRoute(t *testing.T) {
 			valid: true,
 		},
 		{
-			msg: "if an HTTPRoute is using queryparams matching it fails validation due to only supporting expression router",
+			msg: "if an HTTPRoute is using queryparams matching it passes validation",
 			route: &gatewayapi.HTTPRoute{
 				ObjectMeta: metav1.ObjectMeta{
 					Namespace: corev1.NamespaceDefault,
@@ -337,8 +338,7 @@ func TestValidateHTTPRoute(t *testing.T) {
 					},
 				},
 			},
-			valid:         false,
-			validationMsg: "HTTPRoute spec did not pass validation: rules[0].matches[0]: queryparam matching is supported with expression router only",
+			valid: true,
 		},
 		{
 			msg: "we don't support any group except core kubernetes for backendRefs",
@@ -462,7 +462,7 @@ func TestValidateHTTPRoute(t *testing.T) {
 			validationMsg: "HTTPRoute spec did not pass validation: rules[0].backendRefs[0]: Pod is not a supported kind for httproute backendRefs, only Service is supported",
 		},
 		{
-			msg: "we do not support RequestMirror filter",
+			msg: "RequestMirror filter must have its configuration set",
 			route: &gatewayapi.HTTPRoute{
 				ObjectMeta: metav1.ObjectMeta{
 					Namespace: corev1.NamespaceDefault,
@@ -521,8 +521,78 @@ func TestValidateHTTPRoute(t *testing.T) {
 					},
 				},
 			},
+			translatorFeatures: translator.FeatureFlags{RequestMirror: true},
+			valid:              false,
+			validationMsg:      "HTTPRoute spec did not pass validation: rules[0].filters[0]: requestMirror must be set for filter type RequestMirror",
+		},
+		{
+			msg: "RequestMirror filter is rejected when the RequestMirror feature gate is disabled",
+			route: &gatewayapi.HTTPRoute{
+				ObjectMeta: metav1.ObjectMeta{
+					Namespace: corev1.NamespaceDefault,
+					Name:      "testing-httproute",
+				},
+				Spec: gatewayapi.HTTPRouteSpec{
+					CommonRouteSpec: gatewayapi.CommonRouteSpec{
+						ParentRefs: []gatewayapi.ParentReference{{
+							Name: "testing-gateway",
+						}},
+					},
+					Rules: []gatewayapi.HTTPRouteRule{{
+						Matches: []gatewayapi.HTTPRouteMatch{{
+							Headers: []gatewayapi.HTTPHeaderMatch{{
+								Name:  "Content-Type",
+								Value: "audio/vorbis",
+							}},
+						}},
+						BackendRefs: []gatewayapi.HTTPBackendRef{
+							{
+								BackendRef: gatewayapi.BackendRef{
+									BackendObjectReference: gatewayapi.BackendObjectReference{
+										Name: "service1",
+									},
+								},
+							},
+						},
+						Filters: []gatewayapi.HTTPRouteFilter{
+							{
+								Type: gatewayapi.HTTPRouteFilterRequestMirror,
+								RequestMirror: &gatewayapi.HTTPRequestMirrorFilter{
+									BackendRef: gatewayapi.BackendObjectReference{
+										Name: "mirror",
+										Port: lo.ToPtr(gatewayapi.PortNumber(80)),
+									},
+								},
+							},
+						},
+					}},
+				},
+			},
+			cachedObjects: []client.Object{
+				gatewayClass,
+				&gatewayapi.Gateway{
+					ObjectMeta: metav1.ObjectMeta{
+						Namespace: corev1.NamespaceDefault,
+						Name:      "testing-gateway",
+					},
+					Spec: gatewayapi.GatewaySpec{
+						GatewayClassName: gatewayClassName,
+						Listeners: []gatewayapi.Listener{{
+							Name:     "http",
+							Port:     80,
+							Protocol: (gatewayapi.HTTPProtocolType),
+							AllowedRoutes: &gatewayapi.AllowedRoutes{
+								Kinds: []gatewayapi.RouteGroupKind{{
+									Group: &group,
+									Kind:  "HTTPRoute",
+								}},
+							},
+						}},
+					},
+				},
+			},
 			valid:         false,
-			validationMsg: "HTTPRoute spec did not pass validation: rules[0].filters[0]: filter type RequestMirror is unsupported",
+			validationMsg: "HTTPRoute spec did not pass validation: rules[0].filters[0]: filter type RequestMirror is unsupported unless the RequestMirror feature gate is enabled",
 		},
 		{
 			msg: "we only support setting the timeout to the same value",
@@ -745,6 +815,61 @@ func TestValidateHTTPRoute(t *testing.T) {
 			valid:         false,
 			validationMsg: "HTTPRoute spec did not pass validation: rules[0].backendRefs[0]: filters in backendRef is unsupported",
 		},
+		{
+			msg: "session persistence with timeouts is unsupported",
+			route: &gatewayapi.HTTPRoute{
+				ObjectMeta: metav1.ObjectMeta{
+					Namespace: corev1.NamespaceDefault,
+					Name:      "testing-httproute",
+				},
+				Spec: gatewayapi.HTTPRouteSpec{
+					CommonRouteSpec: gatewayapi.CommonRouteSpec{
+						ParentRefs: []gatewayapi.ParentReference{{
+							Name: "testing-gateway",
+						}},
+					},
+					Rules: []gatewayapi.HTTPRouteRule{{
+						BackendRefs: []gatewayapi.HTTPBackendRef{
+							{
+								BackendRef: gatewayapi.BackendRef{
+									BackendObjectReference: gatewayapi.BackendObjectReference{
+										Name: "service1",
+									},
+								},
+							},
+						},
+						SessionPersistence: &gatewayapi.SessionPersistence{
+							AbsoluteTimeout: lo.ToPtr(gatewayapi.Duration("1h")),
+						},
+					}},
+				},
+			},
+			cachedObjects: []client.Object{
+				gatewayClass,
+				&gatewayapi.Gateway{
+					ObjectMeta: metav1.ObjectMeta{
+						Namespace: corev1.NamespaceDefault,
+						Name:      "testing-gateway",
+					},
+					Spec: gatewayapi.GatewaySpec{
+						GatewayClassName: gatewayClassName,
+						Listeners: []gatewayapi.Listener{{
+							Name:     "http",
+							Port:     80,
+							Protocol: (gatewayapi.HTTPProtocolType),
+							AllowedRoutes: &gatewayapi.AllowedRoutes{
+								Kinds: []gatewayapi.RouteGroupKind{{
+									Group: &group,
+									Kind:  "HTTPRoute",
+								}},
+							},
+						}},
+					},
+				},
+			},
+			valid:         false,
+			validationMsg: "HTTPRoute spec did not pass validation: rules[0].sessionPersistence: absoluteTimeout and idleTimeout are unsupported",
+		},
 		{
 			msg: "invalid protocols",
 			route: &gatewayapi.HTTPRoute{
@@ -962,7 +1087,7 @@ func TestValidateHTTPRoute(t *testing.T) {
 
 			// Passed routesValidator is irrelevant for the above test cases.
 			valid, validMsg, err := ValidateHTTPRoute(
-				context.Background(), mockRoutesValidator{}, translator.FeatureFlags{}, tt.route, fakeClient,
+				context.Background(), mockRoutesValidator{}, tt.translatorFeatures, tt.route, fakeClient,
 			)
 			assert.Equal(t, tt.valid, valid, tt.msg)
 			assert.Equal(t, tt.validationMsg, validMsg, tt.msg)
@@ -976,3 +1101,11 @@ type mockRoutesValidator struct{}
 func (mockRoutesValidator) Validate(_ context.Context, _ *kong.Route) (bool, string, error) {
 	return true, "", nil
 }
+
+type rejectingRoutesValidator struct {
+	msg string
+}
+
+func (v rejectingRoutesValidator) Validate(_ context.Context, _ *kong.Route) (bool, string, error) {
+	return false, v.msg, nil
+}
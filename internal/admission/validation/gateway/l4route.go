@@ -0,0 +1,193 @@
+package gateway
+
+import (
+	"context"
+	"fmt"
+	"slices"
+
+	"github.com/kong/go-kong/kong"
+	"github.com/samber/lo"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	k8stypes "k8s.io/apimachinery/pkg/types"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	"github.com/kong/kubernetes-ingress-controller/v3/internal/admission/validation"
+	"github.com/kong/kubernetes-ingress-controller/v3/internal/admission/validation/kongplugin"
+	gatewaycontroller "github.com/kong/kubernetes-ingress-controller/v3/internal/controllers/gateway"
+	"github.com/kong/kubernetes-ingress-controller/v3/internal/dataplane/kongstate"
+	"github.com/kong/kubernetes-ingress-controller/v3/internal/dataplane/translator"
+	"github.com/kong/kubernetes-ingress-controller/v3/internal/gatewayapi"
+)
+
+// -----------------------------------------------------------------------------
+// Validation - TCPRoute, UDPRoute and TLSRoute - Public Functions
+// -----------------------------------------------------------------------------
+
+// ValidateTCPRoute provides a suite of validation for a given TCPRoute.
+func ValidateTCPRoute(
+	ctx context.Context,
+	routesValidator routeValidator,
+	translatorFeatures translator.FeatureFlags,
+	tcproute *gatewayapi.TCPRoute,
+	managerClient client.Client,
+) (bool, string, error) {
+	return validateL4Route(ctx, routesValidator, managerClient, l4RouteValidation{
+		kind:       "TCPRoute",
+		route:      tcproute,
+		parentRefs: tcproute.Spec.ParentRefs,
+		protocol:   gatewayapi.TCPProtocolType,
+		generateKongRoutes: func(gwPorts []gatewayapi.PortNumber) ([]kongstate.Route, error) {
+			return translator.GenerateKongRoutesFromL4Route(tcproute, gwPorts, translatorFeatures.ExpressionRoutes)
+		},
+	})
+}
+
+// ValidateUDPRoute provides a suite of validation for a given UDPRoute.
+func ValidateUDPRoute(
+	ctx context.Context,
+	routesValidator routeValidator,
+	translatorFeatures translator.FeatureFlags,
+	udproute *gatewayapi.UDPRoute,
+	managerClient client.Client,
+) (bool, string, error) {
+	return validateL4Route(ctx, routesValidator, managerClient, l4RouteValidation{
+		kind:       "UDPRoute",
+		route:      udproute,
+		parentRefs: udproute.Spec.ParentRefs,
+		protocol:   gatewayapi.UDPProtocolType,
+		generateKongRoutes: func(gwPorts []gatewayapi.PortNumber) ([]kongstate.Route, error) {
+			return translator.GenerateKongRoutesFromL4Route(udproute, gwPorts, translatorFeatures.ExpressionRoutes)
+		},
+	})
+}
+
+// ValidateTLSRoute provides a suite of validation for a given TLSRoute.
+func ValidateTLSRoute(
+	ctx context.Context,
+	routesValidator routeValidator,
+	translatorFeatures translator.FeatureFlags,
+	tlsroute *gatewayapi.TLSRoute,
+	managerClient client.Client,
+) (bool, string, error) {
+	return validateL4Route(ctx, routesValidator, managerClient, l4RouteValidation{
+		kind:       "TLSRoute",
+		route:      tlsroute,
+		parentRefs: tlsroute.Spec.ParentRefs,
+		protocol:   gatewayapi.TLSProtocolType,
+		generateKongRoutes: func(gwPorts []gatewayapi.PortNumber) ([]kongstate.Route, error) {
+			return translator.GenerateKongRoutesFromL4Route(tlsroute, gwPorts, translatorFeatures.ExpressionRoutes)
+		},
+	})
+}
+
+// -----------------------------------------------------------------------------
+// Validation - TCPRoute, UDPRoute and TLSRoute - Private Functions
+// -----------------------------------------------------------------------------
+
+// l4RouteValidation describes an L4 route to validate in a way that doesn't depend on its kind.
+type l4RouteValidation struct {
+	kind       string
+	route      client.Object
+	parentRefs []gatewayapi.ParentReference
+	protocol   gatewayapi.ProtocolType
+	// generateKongRoutes translates the route to Kong routes destined to the provided Gateway ports.
+	generateKongRoutes func([]gatewayapi.PortNumber) ([]kongstate.Route, error)
+}
+
+func validateL4Route(
+	ctx context.Context,
+	routesValidator routeValidator,
+	managerClient client.Client,
+	v l4RouteValidation,
+) (bool, string, error) {
+	// Check if route is managed by this controller. If not, we don't need to validate it.
+	routeIsManaged, err := RouteIsManagedByController(ctx, v.route.GetNamespace(), v.parentRefs, managerClient)
+	if err != nil {
+		return false, "", fmt.Errorf("failed to determine whether %s is managed by %q controller: %w",
+			v.kind, gatewaycontroller.GetControllerName(), err)
+	}
+	if !routeIsManaged {
+		return true, "", nil
+	}
+
+	if err := kongplugin.ValidatePluginUniquenessPerObject(ctx, managerClient, v.route); err != nil {
+		return false, fmt.Sprintf("%s has invalid KongPlugin annotation: %s", v.kind, err), nil
+	}
+
+	// Validate that the route uses only supported annotations.
+	if err := validation.ValidateRouteSourceAnnotations(v.route); err != nil {
+		return false, fmt.Sprintf("%s has invalid Kong annotations: %s", v.kind, err), nil
+	}
+
+	gateways := newGatewaysGetter(managerClient)
+	gwPorts, err := gateways.listenerPorts(ctx, v.route.GetNamespace(), v.protocol, v.parentRefs)
+	if err != nil {
+		return false, "", err
+	}
+
+	// Translate the route the same way the translator does.
+	allGWPorts := lo.Flatten(lo.Values(gwPorts))
+	slices.Sort(allGWPorts)
+	routes, err := v.generateKongRoutes(allGWPorts)
+	if err != nil {
+		return false, fmt.Sprintf("%s spec did not pass validation: %s", v.kind, err), nil
+	}
+
+	// Validate that the route is valid against Kong Gateway.
+	kongRoutes := lo.Map(routes, func(r kongstate.Route, _ int) kong.Route { return r.Route })
+	ok, msg := validateKongRoutes(ctx, routesValidator, v.kind, kongRoutes)
+	return ok, msg, nil
+}
+
+// gatewaysGetter gets Gateways referenced by routes, caching them for the duration of a single validation.
+type gatewaysGetter struct {
+	managerClient client.Client
+	gateways      map[k8stypes.NamespacedName]*gatewayapi.Gateway
+}
+
+func newGatewaysGetter(managerClient client.Client) *gatewaysGetter {
+	return &gatewaysGetter{
+		managerClient: managerClient,
+		gateways:      map[k8stypes.NamespacedName]*gatewayapi.Gateway{},
+	}
+}
+
+// listenerPorts returns the ports of the Gateway listeners using the protocol that are referenced
+// by parentRefs of a route from routeNamespace, grouped by the Gateway. Gateways that do not exist are skipped.
+func (g *gatewaysGetter) listenerPorts(
+	ctx context.Context,
+	routeNamespace string,
+	protocol gatewayapi.ProtocolType,
+	parentRefs []gatewayapi.ParentReference,
+) (map[k8stypes.NamespacedName][]gatewayapi.PortNumber, error) {
+	ports := map[k8stypes.NamespacedName][]gatewayapi.PortNumber{}
+	for _, parentRef := range parentRefs {
+		if !parentRefIsGateway(parentRef) {
+			continue
+		}
+		key := k8stypes.NamespacedName{
+			Namespace: routeNamespace,
+			Name:      string(parentRef.Name),
+		}
+		if parentRef.Namespace != nil {
+			key.Namespace = string(*parentRef.Namespace)
+		}
+
+		gateway, ok := g.gateways[key]
+		if !ok {
+			gateway = &gatewayapi.Gateway{}
+			if err := g.managerClient.Get(ctx, key, gateway); err != nil {
+				if !apierrors.IsNotFound(err) {
+					return nil, fmt.Errorf("failed to get Gateway: %w", err)
+				}
+				gateway = nil
+			}
+			g.gateways[key] = gateway
+		}
+		if gateway == nil {
+			continue
+		}
+		ports[key] = lo.Uniq(append(ports[key], translator.GatewayListenerPortsForParentRef(gateway, protocol, parentRef)...))
+	}
+	return ports, nil
+}
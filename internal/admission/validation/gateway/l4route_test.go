@@ -0,0 +1,259 @@
+package gateway
+
+import (
+	"context"
+	"testing"
+
+	"github.com/samber/lo"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	fakeclient "sigs.k8s.io/controller-runtime/pkg/client/fake"
+
+	gatewaycontroller "github.com/kong/kubernetes-ingress-controller/v3/internal/controllers/gateway"
+	"github.com/kong/kubernetes-ingress-controller/v3/internal/dataplane/translator"
+	"github.com/kong/kubernetes-ingress-controller/v3/internal/gatewayapi"
+	"github.com/kong/kubernetes-ingress-controller/v3/internal/manager/scheme"
+)
+
+func l4TestObjects() []client.Object {
+	return []client.Object{
+		&gatewayapi.GatewayClass{
+			ObjectMeta: metav1.ObjectMeta{
+				Name: "kong",
+			},
+			Spec: gatewayapi.GatewayClassSpec{
+				ControllerName: gatewaycontroller.GetControllerName(),
+			},
+		},
+		&gatewayapi.Gateway{
+			ObjectMeta: metav1.ObjectMeta{
+				Namespace: corev1.NamespaceDefault,
+				Name:      "gateway",
+			},
+			Spec: gatewayapi.GatewaySpec{
+				GatewayClassName: "kong",
+				Listeners: []gatewayapi.Listener{
+					{
+						Name:     "tcp",
+						Port:     8888,
+						Protocol: gatewayapi.TCPProtocolType,
+					},
+					{
+						Name:     "udp",
+						Port:     8888,
+						Protocol: gatewayapi.UDPProtocolType,
+					},
+					{
+						Name:     "tls",
+						Port:     8899,
+						Protocol: gatewayapi.TLSProtocolType,
+					},
+				},
+			},
+		},
+	}
+}
+
+func l4TestParentRefs(sectionName string) []gatewayapi.ParentReference {
+	return []gatewayapi.ParentReference{{
+		Name:        "gateway",
+		SectionName: lo.ToPtr(gatewayapi.SectionName(sectionName)),
+	}}
+}
+
+func l4TestBackendRefs() []gatewayapi.BackendRef {
+	return []gatewayapi.BackendRef{{
+		BackendObjectReference: gatewayapi.BackendObjectReference{
+			Name: "backend",
+			Port: lo.ToPtr(gatewayapi.PortNumber(80)),
+		},
+	}}
+}
+
+func TestValidateTCPRoute(t *testing.T) {
+	newTCPRoute := func(name, sectionName string, rules ...gatewayapi.TCPRouteRule) *gatewayapi.TCPRoute {
+		return &gatewayapi.TCPRoute{
+			ObjectMeta: metav1.ObjectMeta{
+				Namespace: corev1.NamespaceDefault,
+				Name:      name,
+			},
+			Spec: gatewayapi.TCPRouteSpec{
+				CommonRouteSpec: gatewayapi.CommonRouteSpec{
+					ParentRefs: l4TestParentRefs(sectionName),
+				},
+				Rules: rules,
+			},
+		}
+	}
+	validRule := gatewayapi.TCPRouteRule{BackendRefs: l4TestBackendRefs()}
+
+	testCases := []struct {
+		name          string
+		route         *gatewayapi.TCPRoute
+		existing      []client.Object
+		valid         bool
+		validationMsg string
+	}{
+		{
+			name:  "valid route is accepted",
+			route: newTCPRoute("tcproute", "tcp", validRule),
+			valid: true,
+		},
+		{
+			name:          "route without rules is rejected",
+			route:         newTCPRoute("tcproute", "tcp"),
+			validationMsg: "TCPRoute spec did not pass validation: no rules provided",
+		},
+		{
+			name:          "route with a rule without backendRefs is rejected",
+			route:         newTCPRoute("tcproute", "tcp", gatewayapi.TCPRouteRule{}),
+			validationMsg: "TCPRoute spec did not pass validation: TCPRoute rules must include at least one backendRef",
+		},
+		{
+			name:     "route bound to a port used by another TCPRoute is accepted",
+			route:    newTCPRoute("tcproute", "tcp", validRule),
+			existing: []client.Object{newTCPRoute("other", "tcp", validRule)},
+			valid:    true,
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			fakeClient := fakeclient.
+				NewClientBuilder().
+				WithScheme(lo.Must(scheme.Get())).
+				WithObjects(append(l4TestObjects(), tc.existing...)...).
+				Build()
+
+			valid, validMsg, err := ValidateTCPRoute(
+				context.Background(), mockRoutesValidator{}, translator.FeatureFlags{}, tc.route, fakeClient,
+			)
+			require.NoError(t, err)
+			assert.Equal(t, tc.valid, valid)
+			assert.Equal(t, tc.validationMsg, validMsg)
+		})
+	}
+}
+
+func TestValidateUDPRoute(t *testing.T) {
+	newUDPRoute := func(name string, rules ...gatewayapi.UDPRouteRule) *gatewayapi.UDPRoute {
+		return &gatewayapi.UDPRoute{
+			ObjectMeta: metav1.ObjectMeta{
+				Namespace: corev1.NamespaceDefault,
+				Name:      name,
+			},
+			Spec: gatewayapi.UDPRouteSpec{
+				CommonRouteSpec: gatewayapi.CommonRouteSpec{
+					ParentRefs: l4TestParentRefs("udp"),
+				},
+				Rules: rules,
+			},
+		}
+	}
+	validRule := gatewayapi.UDPRouteRule{BackendRefs: l4TestBackendRefs()}
+
+	testCases := []struct {
+		name          string
+		route         *gatewayapi.UDPRoute
+		existing      []client.Object
+		valid         bool
+		validationMsg string
+	}{
+		{
+			name:  "valid route is accepted",
+			route: newUDPRoute("udproute", validRule),
+			valid: true,
+		},
+		{
+			name:          "route with a rule without backendRefs is rejected",
+			route:         newUDPRoute("udproute", gatewayapi.UDPRouteRule{}),
+			validationMsg: "UDPRoute spec did not pass validation: no backendRefs in rule",
+		},
+		{
+			name:     "route bound to a port used by another UDPRoute is accepted",
+			route:    newUDPRoute("udproute", validRule),
+			existing: []client.Object{newUDPRoute("other", validRule)},
+			valid:    true,
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			fakeClient := fakeclient.
+				NewClientBuilder().
+				WithScheme(lo.Must(scheme.Get())).
+				WithObjects(append(l4TestObjects(), tc.existing...)...).
+				Build()
+
+			valid, validMsg, err := ValidateUDPRoute(
+				context.Background(), mockRoutesValidator{}, translator.FeatureFlags{}, tc.route, fakeClient,
+			)
+			require.NoError(t, err)
+			assert.Equal(t, tc.valid, valid)
+			assert.Equal(t, tc.validationMsg, validMsg)
+		})
+	}
+}
+
+func TestValidateTLSRoute(t *testing.T) {
+	newTLSRoute := func(name string, hostnames ...gatewayapi.Hostname) *gatewayapi.TLSRoute {
+		return &gatewayapi.TLSRoute{
+			ObjectMeta: metav1.ObjectMeta{
+				Namespace: corev1.NamespaceDefault,
+				Name:      name,
+			},
+			Spec: gatewayapi.TLSRouteSpec{
+				CommonRouteSpec: gatewayapi.CommonRouteSpec{
+					ParentRefs: l4TestParentRefs("tls"),
+				},
+				Hostnames: hostnames,
+				Rules:     []gatewayapi.TLSRouteRule{{BackendRefs: l4TestBackendRefs()}},
+			},
+		}
+	}
+
+	testCases := []struct {
+		name          string
+		route         *gatewayapi.TLSRoute
+		existing      []client.Object
+		valid         bool
+		validationMsg string
+	}{
+		{
+			name:  "valid route is accepted",
+			route: newTLSRoute("tlsroute", "example.com"),
+			valid: true,
+		},
+		{
+			name:          "route without hostnames is rejected",
+			route:         newTLSRoute("tlsroute"),
+			validationMsg: "TLSRoute spec did not pass validation: no hostnames provided",
+		},
+		{
+			name:     "route bound to the same listener as another TLSRoute is accepted",
+			route:    newTLSRoute("tlsroute", "example.com"),
+			existing: []client.Object{newTLSRoute("other", "other.example.com")},
+			valid:    true,
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			fakeClient := fakeclient.
+				NewClientBuilder().
+				WithScheme(lo.Must(scheme.Get())).
+				WithObjects(append(l4TestObjects(), tc.existing...)...).
+				Build()
+
+			valid, validMsg, err := ValidateTLSRoute(
+				context.Background(), mockRoutesValidator{}, translator.FeatureFlags{}, tc.route, fakeClient,
+			)
+			require.NoError(t, err)
+			assert.Equal(t, tc.valid, valid)
+			assert.Equal(t, tc.validationMsg, validMsg)
+		})
+	}
+}
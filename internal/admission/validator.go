@@ -2,6 +2,7 @@ package admission
 
 import (
 	"context"
+	"errors"
 	"fmt"
 	"strings"
 
@@ -24,7 +25,9 @@ import (
 	"github.com/kong/kubernetes-ingress-controller/v3/internal/dataplane/kongstate"
 	"github.com/kong/kubernetes-ingress-controller/v3/internal/dataplane/translator"
 	"github.com/kong/kubernetes-ingress-controller/v3/internal/gatewayapi"
+	"github.com/kong/kubernetes-ingress-controller/v3/internal/hostownership"
 	"github.com/kong/kubernetes-ingress-controller/v3/internal/store"
+	"github.com/kong/kubernetes-ingress-controller/v3/internal/tenantpolicy"
 	"github.com/kong/kubernetes-ingress-controller/v3/internal/util"
 	kongv1 "github.com/kong/kubernetes-ingress-controller/v3/pkg/apis/configuration/v1"
 	kongv1alpha1 "github.com/kong/kubernetes-ingress-controller/v3/pkg/apis/configuration/v1alpha1"
@@ -42,6 +45,10 @@ type KongValidator interface {
 	ValidateCredential(ctx context.Context, secret corev1.Secret) (bool, string)
 	ValidateGateway(ctx context.Context, gateway gatewayapi.Gateway) (bool, string, error)
 	ValidateHTTPRoute(ctx context.Context, httproute gatewayapi.HTTPRoute) (bool, string, error)
+	ValidateGRPCRoute(ctx context.Context, grpcroute gatewayapi.GRPCRoute) (bool, string, error)
+	ValidateTCPRoute(ctx context.Context, tcproute gatewayapi.TCPRoute) (bool, string, error)
+	ValidateUDPRoute(ctx context.Context, udproute gatewayapi.UDPRoute) (bool, string, error)
+	ValidateTLSRoute(ctx context.Context, tlsroute gatewayapi.TLSRoute) (bool, string, error)
 	ValidateIngress(ctx context.Context, ingress netv1.Ingress) (bool, string, error)
 	ValidateService(ctx context.Context, ingress corev1.Service) (bool, string, error)
 }
@@ -100,11 +107,18 @@ func NewSecretGetterWithOverride(s kongstate.SecretGetter, overrideSecrets []*co
 	}
 }
 
+// configSourceGetter gets Secrets and ConfigMaps that plugin configurations are sourced from.
+type configSourceGetter struct {
+	kongstate.SecretGetter
+	kongstate.ConfigMapGetter
+}
+
 // KongHTTPValidator implements KongValidator interface to validate Kong
 // entities using the Admin API of Kong.
 type KongHTTPValidator struct {
 	Logger                   logr.Logger
 	SecretGetter             kongstate.SecretGetter
+	ConfigMapGetter          kongstate.ConfigMapGetter
 	ConsumerGetter           ConsumerGetter
 	Storer                   store.Storer
 	ManagerClient            client.Client
@@ -130,6 +144,7 @@ func NewKongHTTPValidator(
 	return KongHTTPValidator{
 		Logger:                   logger,
 		SecretGetter:             &managerClientSecretGetter{managerClient: managerClient},
+		ConfigMapGetter:          &managerClientConfigMapGetter{managerClient: managerClient},
 		ConsumerGetter:           &managerClientConsumerGetter{managerClient: managerClient},
 		Storer:                   storer,
 		ManagerClient:            managerClient,
@@ -157,6 +172,9 @@ func (validator KongHTTPValidator) ValidateConsumer(
 	if err := kongplugin.ValidatePluginUniquenessPerObject(ctx, validator.ManagerClient, &consumer); err != nil {
 		return false, fmt.Sprintf("KongConsumer has invalid KongPlugin annotation: %s", err), nil
 	}
+	if msg := validator.validateTenantPolicies("KongConsumer", &consumer); msg != "" {
+		return false, msg, nil
+	}
 
 	errText, err := validator.ensureConsumerDoesNotExistInGateway(ctx, consumer.Username)
 	if err != nil || errText != "" {
@@ -239,6 +257,9 @@ func (validator KongHTTPValidator) ValidateConsumerGroup(
 	if err := kongplugin.ValidatePluginUniquenessPerObject(ctx, validator.ManagerClient, &consumerGroup); err != nil {
 		return false, fmt.Sprintf("KongConsumerGroup has invalid KongPlugin annotation: %s", err), nil
 	}
+	if msg := validator.validateTenantPolicies("KongConsumerGroup", &consumerGroup); msg != "" {
+		return false, msg, nil
+	}
 
 	infoSvc, ok := validator.AdminAPIServicesProvider.GetInfoService()
 	if !ok {
@@ -340,14 +361,23 @@ func (validator KongHTTPValidator) ValidatePlugin(
 	k8sPlugin kongv1.KongPlugin,
 	overrideSecrets []*corev1.Secret,
 ) (bool, string, error) {
+	if validator.Storer != nil {
+		if err := tenantpolicy.NewEvaluator(validator.Storer).ValidatePlugin(k8sPlugin.Namespace, k8sPlugin.PluginName); err != nil {
+			return false, fmt.Sprintf("KongPlugin %s: %s", ErrTextTenantPolicyViolated, err), nil
+		}
+	}
+
 	var plugin kong.Plugin
 	plugin.Name = kong.String(k8sPlugin.PluginName)
 	var err error
 
-	secretGetter := NewSecretGetterWithOverride(validator.SecretGetter, overrideSecrets)
+	sourceGetter := configSourceGetter{
+		SecretGetter:    NewSecretGetterWithOverride(validator.SecretGetter, overrideSecrets),
+		ConfigMapGetter: validator.ConfigMapGetter,
+	}
 
 	plugin.Config, err = kongstate.RawConfigurationWithPatchesToConfiguration(
-		secretGetter,
+		sourceGetter,
 		k8sPlugin.Namespace,
 		k8sPlugin.Config,
 		k8sPlugin.ConfigPatches,
@@ -356,18 +386,25 @@ func (validator KongHTTPValidator) ValidatePlugin(
 		return false, fmt.Sprintf("%s: %s", ErrTextPluginConfigInvalid, err), nil
 	}
 	if k8sPlugin.ConfigFrom != nil {
-		config, err := kongstate.SecretToConfiguration(secretGetter, k8sPlugin.ConfigFrom.SecretValue, k8sPlugin.Namespace)
+		config, err := kongstate.ConfigSourceToConfiguration(sourceGetter, *k8sPlugin.ConfigFrom, k8sPlugin.Namespace)
 		if err != nil {
-			return false, fmt.Sprintf("%s: %s", ErrTextPluginSecretConfigUnretrievable, err), nil
+			return false, fmt.Sprintf("%s: %s", configSourceUnretrievableErrText(k8sPlugin.ConfigFrom.ConfigMapValue != nil), err), nil
 		}
 		plugin.Config = config
 	}
 	if k8sPlugin.RunOn != "" {
 		plugin.RunOn = kong.String(k8sPlugin.RunOn)
 	}
+	if k8sPlugin.InstanceName != "" {
+		plugin.InstanceName = kong.String(k8sPlugin.InstanceName)
+	}
 	plugin.Ordering = k8sPlugin.Ordering
 	plugin.Protocols = kong.StringSlice(kongv1.KongProtocolsToStrings(k8sPlugin.Protocols)...)
 
+	if errText := validator.validatePluginInstanceName(KindKongPlugin, &k8sPlugin, k8sPlugin.InstanceName); errText != "" {
+		return false, errText, nil
+	}
+
 	errText, err := validator.validatePluginAgainstGatewaySchema(ctx, plugin)
 	if err != nil || errText != "" {
 		validator.Logger.Info("validate KongPlugin on Kong gateway failed",
@@ -391,9 +428,12 @@ func (validator KongHTTPValidator) ValidateClusterPlugin(
 	plugin.Name = kong.String(k8sPlugin.PluginName)
 	var err error
 
-	secretGetter := NewSecretGetterWithOverride(validator.SecretGetter, overrideSecrets)
+	sourceGetter := configSourceGetter{
+		SecretGetter:    NewSecretGetterWithOverride(validator.SecretGetter, overrideSecrets),
+		ConfigMapGetter: validator.ConfigMapGetter,
+	}
 	plugin.Config, err = kongstate.RawConfigurationWithNamespacedPatchesToConfiguration(
-		secretGetter,
+		sourceGetter,
 		k8sPlugin.Config,
 		k8sPlugin.ConfigPatches,
 	)
@@ -402,19 +442,26 @@ func (validator KongHTTPValidator) ValidateClusterPlugin(
 	}
 
 	if k8sPlugin.ConfigFrom != nil {
-		config, err := kongstate.NamespacedSecretToConfiguration(secretGetter, k8sPlugin.ConfigFrom.SecretValue)
+		config, err := kongstate.NamespacedConfigSourceToConfiguration(sourceGetter, *k8sPlugin.ConfigFrom)
 		if err != nil {
-			return false, fmt.Sprintf("%s: %s", ErrTextPluginSecretConfigUnretrievable, err), nil
+			return false, fmt.Sprintf("%s: %s", configSourceUnretrievableErrText(k8sPlugin.ConfigFrom.ConfigMapValue != nil), err), nil
 		}
 		plugin.Config = config
 	}
 	if k8sPlugin.RunOn != "" {
 		plugin.RunOn = kong.String(k8sPlugin.RunOn)
 	}
+	if k8sPlugin.InstanceName != "" {
+		plugin.InstanceName = kong.String(k8sPlugin.InstanceName)
+	}
 
 	plugin.Ordering = k8sPlugin.Ordering
 	plugin.Protocols = kong.StringSlice(kongv1.KongProtocolsToStrings(k8sPlugin.Protocols)...)
 
+	if errText := validator.validatePluginInstanceName(KindKongClusterPlugin, &k8sPlugin, k8sPlugin.InstanceName); errText != "" {
+		return false, errText, nil
+	}
+
 	errText, err := validator.validatePluginAgainstGatewaySchema(ctx, plugin)
 	if err != nil || errText != "" {
 		validator.Logger.Info("validate KongClusterPlugin on Kong gateway failed",
@@ -455,9 +502,77 @@ func (validator KongHTTPValidator) ValidateHTTPRoute(
 	if routesSvc, ok := validator.AdminAPIServicesProvider.GetRoutesService(); ok {
 		routeValidator = routesSvc
 	}
-	return gatewayvalidation.ValidateHTTPRoute(
+	ok, msg, err := gatewayvalidation.ValidateHTTPRoute(
 		ctx, routeValidator, validator.TranslatorFeatures, &httproute, validator.ManagerClient,
 	)
+	if err != nil || !ok {
+		return ok, msg, err
+	}
+	return validator.validateRoutePolicies(ctx, "HTTPRoute", &httproute, httproute.Spec.ParentRefs)
+}
+
+func (validator KongHTTPValidator) ValidateGRPCRoute(
+	ctx context.Context, grpcroute gatewayapi.GRPCRoute,
+) (bool, string, error) {
+	var routeValidator routeValidator = noOpRoutesValidator{}
+	if routesSvc, ok := validator.AdminAPIServicesProvider.GetRoutesService(); ok {
+		routeValidator = routesSvc
+	}
+	ok, msg, err := gatewayvalidation.ValidateGRPCRoute(
+		ctx, routeValidator, validator.TranslatorFeatures, &grpcroute, validator.ManagerClient,
+	)
+	if err != nil || !ok {
+		return ok, msg, err
+	}
+	return validator.validateRoutePolicies(ctx, "GRPCRoute", &grpcroute, grpcroute.Spec.ParentRefs)
+}
+
+func (validator KongHTTPValidator) ValidateTCPRoute(
+	ctx context.Context, tcproute gatewayapi.TCPRoute,
+) (bool, string, error) {
+	var routeValidator routeValidator = noOpRoutesValidator{}
+	if routesSvc, ok := validator.AdminAPIServicesProvider.GetRoutesService(); ok {
+		routeValidator = routesSvc
+	}
+	ok, msg, err := gatewayvalidation.ValidateTCPRoute(
+		ctx, routeValidator, validator.TranslatorFeatures, &tcproute, validator.ManagerClient,
+	)
+	if err != nil || !ok {
+		return ok, msg, err
+	}
+	return validator.validateRoutePolicies(ctx, "TCPRoute", &tcproute, tcproute.Spec.ParentRefs)
+}
+
+func (validator KongHTTPValidator) ValidateUDPRoute(
+	ctx context.Context, udproute gatewayapi.UDPRoute,
+) (bool, string, error) {
+	var routeValidator routeValidator = noOpRoutesValidator{}
+	if routesSvc, ok := validator.AdminAPIServicesProvider.GetRoutesService(); ok {
+		routeValidator = routesSvc
+	}
+	ok, msg, err := gatewayvalidation.ValidateUDPRoute(
+		ctx, routeValidator, validator.TranslatorFeatures, &udproute, validator.ManagerClient,
+	)
+	if err != nil || !ok {
+		return ok, msg, err
+	}
+	return validator.validateRoutePolicies(ctx, "UDPRoute", &udproute, udproute.Spec.ParentRefs)
+}
+
+func (validator KongHTTPValidator) ValidateTLSRoute(
+	ctx context.Context, tlsroute gatewayapi.TLSRoute,
+) (bool, string, error) {
+	var routeValidator routeValidator = noOpRoutesValidator{}
+	if routesSvc, ok := validator.AdminAPIServicesProvider.GetRoutesService(); ok {
+		routeValidator = routesSvc
+	}
+	ok, msg, err := gatewayvalidation.ValidateTLSRoute(
+		ctx, routeValidator, validator.TranslatorFeatures, &tlsroute, validator.ManagerClient,
+	)
+	if err != nil || !ok {
+		return ok, msg, err
+	}
+	return validator.validateRoutePolicies(ctx, "TLSRoute", &tlsroute, tlsroute.Spec.ParentRefs)
 }
 
 func (validator KongHTTPValidator) ValidateIngress(
@@ -469,6 +584,13 @@ func (validator KongHTTPValidator) ValidateIngress(
 		return true, "", nil
 	}
 
+	if msg := validator.validateTenantPolicies("Ingress", &ingress); msg != "" {
+		return false, msg, nil
+	}
+	if msg, err := validator.validateHostnameOwnership("Ingress", &ingress); err != nil || msg != "" {
+		return false, msg, err
+	}
+
 	var routeValidator routeValidator = noOpRoutesValidator{}
 	if routesSvc, ok := validator.AdminAPIServicesProvider.GetRoutesService(); ok {
 		routeValidator = routesSvc
@@ -482,6 +604,9 @@ func (validator KongHTTPValidator) ValidateService(
 	if err := kongplugin.ValidatePluginUniquenessPerObject(ctx, validator.ManagerClient, &service); err != nil {
 		return false, fmt.Sprintf("Service has invalid KongPlugin annotation: %s", err), nil
 	}
+	if msg := validator.validateTenantPolicies("Service", &service); msg != "" {
+		return false, msg, nil
+	}
 	return true, "", nil
 }
 
@@ -592,6 +717,130 @@ func (validator KongHTTPValidator) validatePluginAgainstGatewaySchema(ctx contex
 	return "", nil
 }
 
+// validatePluginInstanceName checks that no other KongPlugin or KongClusterPlugin configured for the same Kong entity
+// uses the instance_name of the plugin, which Kong rejects. instance_names of plugins attached to entities are
+// suffixed with a hash of the entity, so they only conflict when the plugins are attached to the same object with
+// the konghq.com/plugins annotation, or when both plugins are global. It returns a message describing the conflict
+// or an empty string when there's none.
+func (validator KongHTTPValidator) validatePluginInstanceName(kind string, obj client.Object, instanceName string) string {
+	if validator.Storer == nil || instanceName == "" {
+		return ""
+	}
+	isSameObject := func(otherKind string, other client.Object) bool {
+		return kind == otherKind && obj.GetNamespace() == other.GetNamespace() && obj.GetName() == other.GetName()
+	}
+	attachingObjects := validator.listObjectsAttachingPlugins()
+	// conflictingAttachment returns a description of the object both plugins are attached to, if any.
+	conflictingAttachment := func(otherKind string, other client.Object) (string, bool) {
+		if isGlobalPlugin(kind, obj) && isGlobalPlugin(otherKind, other) {
+			return "", true
+		}
+		for _, o := range attachingObjects {
+			if validator.isPluginAttachedTo(kind, obj, o.obj) && validator.isPluginAttachedTo(otherKind, other, o.obj) {
+				return fmt.Sprintf(" attached to %s %s/%s", o.kind, o.obj.GetNamespace(), o.obj.GetName()), true
+			}
+		}
+		return "", false
+	}
+
+	for _, other := range validator.Storer.ListKongClusterPlugins() {
+		if other.InstanceName != instanceName || isSameObject(KindKongClusterPlugin, other) {
+			continue
+		}
+		if attachment, ok := conflictingAttachment(KindKongClusterPlugin, other); ok {
+			return fmt.Sprintf(ErrTextPluginInstanceNameNotUnique, instanceName,
+				fmt.Sprintf("%s %s%s", KindKongClusterPlugin, other.Name, attachment))
+		}
+	}
+	for _, other := range validator.Storer.ListKongPlugins() {
+		if other.InstanceName != instanceName || isSameObject(KindKongPlugin, other) {
+			continue
+		}
+		if attachment, ok := conflictingAttachment(KindKongPlugin, other); ok {
+			return fmt.Sprintf(ErrTextPluginInstanceNameNotUnique, instanceName,
+				fmt.Sprintf("%s %s/%s%s", KindKongPlugin, other.Namespace, other.Name, attachment))
+		}
+	}
+	return ""
+}
+
+// pluginAttachingObject is an object that plugins can be attached to with the konghq.com/plugins annotation.
+type pluginAttachingObject struct {
+	kind string
+	obj  client.Object
+}
+
+// listObjectsAttachingPlugins lists the objects attaching plugins with the konghq.com/plugins annotation.
+func (validator KongHTTPValidator) listObjectsAttachingPlugins() []pluginAttachingObject {
+	var objects []pluginAttachingObject
+	add := func(kind string, objs []client.Object) {
+		for _, obj := range objs {
+			if len(annotations.ExtractNamespacedKongPluginsFromAnnotations(obj.GetAnnotations())) > 0 {
+				objects = append(objects, pluginAttachingObject{kind: kind, obj: obj})
+			}
+		}
+	}
+	addListed := func(kind string, objs []client.Object, err error) {
+		if err != nil {
+			validator.Logger.Error(err, "Failed to list objects attaching plugins", "kind", kind)
+			return
+		}
+		add(kind, objs)
+	}
+
+	add("Service", toClientObjects(validator.Storer.ListServices()))
+	add("Ingress", toClientObjects(validator.Storer.ListIngressesV1()))
+	add("KongConsumer", toClientObjects(validator.Storer.ListKongConsumers()))
+	add("KongConsumerGroup", toClientObjects(validator.Storer.ListKongConsumerGroups()))
+	httpRoutes, err := validator.Storer.ListHTTPRoutes()
+	addListed("HTTPRoute", toClientObjects(httpRoutes), err)
+	grpcRoutes, err := validator.Storer.ListGRPCRoutes()
+	addListed("GRPCRoute", toClientObjects(grpcRoutes), err)
+	tcpRoutes, err := validator.Storer.ListTCPRoutes()
+	addListed("TCPRoute", toClientObjects(tcpRoutes), err)
+	udpRoutes, err := validator.Storer.ListUDPRoutes()
+	addListed("UDPRoute", toClientObjects(udpRoutes), err)
+	tlsRoutes, err := validator.Storer.ListTLSRoutes()
+	addListed("TLSRoute", toClientObjects(tlsRoutes), err)
+	tcpIngresses, err := validator.Storer.ListTCPIngresses()
+	addListed("TCPIngress", toClientObjects(tcpIngresses), err)
+	udpIngresses, err := validator.Storer.ListUDPIngresses()
+	addListed("UDPIngress", toClientObjects(udpIngresses), err)
+	return objects
+}
+
+func toClientObjects[T client.Object](objs []T) []client.Object {
+	return lo.Map(objs, func(o T, _ int) client.Object { return o })
+}
+
+// isPluginAttachedTo returns true if the konghq.com/plugins annotation of the object references the plugin.
+// References are resolved the same way as in the translation: a KongPlugin in the referenced namespace takes
+// precedence over a KongClusterPlugin with the same name.
+func (validator KongHTTPValidator) isPluginAttachedTo(kind string, plugin client.Object, obj client.Object) bool {
+	for _, ref := range annotations.ExtractNamespacedKongPluginsFromAnnotations(obj.GetAnnotations()) {
+		if ref.Name != plugin.GetName() {
+			continue
+		}
+		namespace := lo.Ternary(ref.Namespace != "", ref.Namespace, obj.GetNamespace())
+		switch kind {
+		case KindKongPlugin:
+			if namespace == plugin.GetNamespace() {
+				return true
+			}
+		case KindKongClusterPlugin:
+			if _, err := validator.Storer.GetKongPlugin(namespace, ref.Name); err != nil {
+				return true
+			}
+		}
+	}
+	return false
+}
+
+// isGlobalPlugin returns true if the plugin is a KongClusterPlugin with the global label.
+func isGlobalPlugin(kind string, plugin client.Object) bool {
+	return kind == KindKongClusterPlugin && plugin.GetLabels()["global"] == "true"
+}
+
 func (validator KongHTTPValidator) validateVaultAgainstGatewaySchema(ctx context.Context, vault kong.Vault) (string, error) {
 	vaultService, hasClient := validator.AdminAPIServicesProvider.GetVaultsService()
 	if !hasClient {
@@ -607,6 +856,83 @@ func (validator KongHTTPValidator) validateVaultAgainstGatewaySchema(ctx context
 	return "", nil
 }
 
+// validateTenantPolicies checks the object and the plugins attached to it with the konghq.com/plugins annotation
+// against the KongTenantPolicies selecting its namespace. It returns a message describing the violations
+// or an empty string when the object complies with the policies.
+func (validator KongHTTPValidator) validateTenantPolicies(kind string, obj client.Object) string {
+	if validator.Storer == nil {
+		return ""
+	}
+	tenantPolicies := tenantpolicy.NewEvaluator(validator.Storer)
+
+	var errs []error
+	if err := tenantPolicies.ValidateObject(obj); err != nil {
+		errs = append(errs, err)
+	}
+	for _, p := range annotations.ExtractNamespacedKongPluginsFromAnnotations(obj.GetAnnotations()) {
+		namespace := lo.Ternary(p.Namespace != "", p.Namespace, obj.GetNamespace())
+		var pluginName string
+		if plugin, err := validator.Storer.GetKongPlugin(namespace, p.Name); err == nil {
+			pluginName = plugin.PluginName
+		} else if clusterPlugin, err := validator.Storer.GetKongClusterPlugin(p.Name); err == nil {
+			pluginName = clusterPlugin.PluginName
+		} else {
+			// Plugins that don't exist yet are checked when they are created or during the translation.
+			continue
+		}
+		if err := tenantPolicies.ValidatePlugin(obj.GetNamespace(), pluginName); err != nil {
+			errs = append(errs, err)
+		}
+	}
+	if len(errs) == 0 {
+		return ""
+	}
+	return fmt.Sprintf("%s %s: %s", kind, ErrTextTenantPolicyViolated, errors.Join(errs...))
+}
+
+// validateHostnameOwnership checks that the object does not route hostnames owned by other namespaces when
+// the HostnameOwnership feature is enabled. It returns a message describing the conflicts or an empty string
+// when there are none.
+func (validator KongHTTPValidator) validateHostnameOwnership(kind string, obj client.Object) (string, error) {
+	if !validator.TranslatorFeatures.HostnameOwnership || validator.Storer == nil {
+		return "", nil
+	}
+	owners, err := hostownership.NewOwnersFromStore(validator.Storer)
+	if err != nil {
+		return "", err
+	}
+	if err := owners.Validate(obj); err != nil {
+		return fmt.Sprintf("%s %s: %s", kind, ErrTextHostnameOwnedByAnotherNamespace, err), nil
+	}
+	return "", nil
+}
+
+// validateRoutePolicies checks a Gateway API route against the KongTenantPolicies selecting its namespace and
+// the hostnames owned by other namespaces, unless the route is not managed by this controller.
+func (validator KongHTTPValidator) validateRoutePolicies(
+	ctx context.Context, kind string, route client.Object, parentRefs []gatewayapi.ParentReference,
+) (bool, string, error) {
+	msg := validator.validateTenantPolicies(kind, route)
+	if msg == "" {
+		var err error
+		if msg, err = validator.validateHostnameOwnership(kind, route); err != nil {
+			return false, "", err
+		}
+	}
+	if msg == "" {
+		return true, "", nil
+	}
+	routeIsManaged, err := gatewayvalidation.RouteIsManagedByController(ctx, route.GetNamespace(), parentRefs, validator.ManagerClient)
+	if err != nil {
+		return false, "", fmt.Errorf("failed to determine whether %s is managed by %q controller: %w",
+			kind, gatewaycontroller.GetControllerName(), err)
+	}
+	if !routeIsManaged {
+		return true, "", nil
+	}
+	return false, msg, nil
+}
+
 type managerClientSecretGetter struct {
 	managerClient client.Client
 }
@@ -619,6 +945,27 @@ func (m *managerClientSecretGetter) GetSecret(namespace, name string) (*corev1.S
 	}, secret)
 }
 
+type managerClientConfigMapGetter struct {
+	managerClient client.Client
+}
+
+func (m *managerClientConfigMapGetter) GetConfigMap(namespace, name string) (*corev1.ConfigMap, error) {
+	configMap := &corev1.ConfigMap{}
+	return configMap, m.managerClient.Get(context.Background(), client.ObjectKey{
+		Namespace: namespace,
+		Name:      name,
+	}, configMap)
+}
+
+// configSourceUnretrievableErrText returns the error text for a plugin configuration that could not be loaded
+// from a ConfigMap or a Secret.
+func configSourceUnretrievableErrText(fromConfigMap bool) string {
+	if fromConfigMap {
+		return ErrTextPluginConfigMapConfigUnretrievable
+	}
+	return ErrTextPluginSecretConfigUnretrievable
+}
+
 type managerClientConsumerGetter struct {
 	managerClient client.Client
 }
@@ -26,6 +26,7 @@ import (
 	"github.com/kong/kubernetes-ingress-controller/v3/internal/annotations"
 	"github.com/kong/kubernetes-ingress-controller/v3/internal/dataplane/kongstate"
 	"github.com/kong/kubernetes-ingress-controller/v3/internal/dataplane/translator"
+	"github.com/kong/kubernetes-ingress-controller/v3/internal/gatewayapi"
 	managerscheme "github.com/kong/kubernetes-ingress-controller/v3/internal/manager/scheme"
 	"github.com/kong/kubernetes-ingress-controller/v3/internal/store"
 	"github.com/kong/kubernetes-ingress-controller/v3/internal/util/builder"
@@ -41,9 +42,13 @@ type fakePluginSvc struct {
 	err   error
 	msg   string
 	valid bool
+
+	// validatedPlugin is the last plugin passed to Validate.
+	validatedPlugin *kong.Plugin
 }
 
-func (f *fakePluginSvc) Validate(context.Context, *kong.Plugin) (bool, string, error) {
+func (f *fakePluginSvc) Validate(_ context.Context, plugin *kong.Plugin) (bool, string, error) {
+	f.validatedPlugin = plugin
 	return f.valid, f.msg, f.err
 }
 
@@ -132,6 +137,17 @@ func TestKongHTTPValidator_ValidatePlugin(t *testing.T) {
 				},
 			},
 		},
+		ConfigMaps: []*corev1.ConfigMap{
+			{
+				ObjectMeta: metav1.ObjectMeta{
+					Namespace: "",
+					Name:      "conf-configmap",
+				},
+				Data: map[string]string{
+					"valid-conf": `{"foo":"bar"}`,
+				},
+			},
+		},
 	})
 	type args struct {
 		plugin          kongv1.KongPlugin
@@ -250,6 +266,39 @@ func TestKongHTTPValidator_ValidatePlugin(t *testing.T) {
 			wantMessage: ErrTextPluginSecretConfigUnretrievable,
 			wantErr:     false,
 		},
+		{
+			name:      "plugin ConfigFrom references ConfigMap",
+			PluginSvc: &fakePluginSvc{valid: true},
+			args: args{
+				plugin: kongv1.KongPlugin{
+					PluginName: "key-auth",
+					ConfigFrom: &kongv1.ConfigSource{
+						ConfigMapValue: &kongv1.ConfigMapValueFromSource{
+							Key:       "valid-conf",
+							ConfigMap: "conf-configmap",
+						},
+					},
+				},
+			},
+			wantOK: true,
+		},
+		{
+			name:      "plugin ConfigFrom references non-existent ConfigMap",
+			PluginSvc: &fakePluginSvc{},
+			args: args{
+				plugin: kongv1.KongPlugin{
+					PluginName: "key-auth",
+					ConfigFrom: &kongv1.ConfigSource{
+						ConfigMapValue: &kongv1.ConfigMapValueFromSource{
+							Key:       "valid-conf",
+							ConfigMap: "missing-configmap",
+						},
+					},
+				},
+			},
+			wantOK:      false,
+			wantMessage: ErrTextPluginConfigMapConfigUnretrievable,
+		},
 		{
 			name:      "failed to retrieve validation info",
 			PluginSvc: &fakePluginSvc{valid: false, err: fmt.Errorf("everything broke")},
@@ -292,7 +341,8 @@ func TestKongHTTPValidator_ValidatePlugin(t *testing.T) {
 	for _, tt := range tests {
 		t.Run(tt.name, func(t *testing.T) {
 			validator := KongHTTPValidator{
-				SecretGetter: store,
+				SecretGetter:    store,
+				ConfigMapGetter: store,
 				AdminAPIServicesProvider: fakeServicesProvider{
 					pluginSvc: tt.PluginSvc,
 				},
@@ -531,6 +581,301 @@ func TestKongHTTPValidator_ValidateClusterPlugin(t *testing.T) {
 	}
 }
 
+func TestKongHTTPValidator_ValidateClusterPluginValidatesResolvedPlugin(t *testing.T) {
+	storer := lo.Must(store.NewFakeStore(store.FakeObjects{
+		Secrets: []*corev1.Secret{
+			{
+				ObjectMeta: metav1.ObjectMeta{Namespace: "other-namespace", Name: "conf-secret"},
+				Data:       map[string][]byte{"key": []byte(`"from-store"`)},
+			},
+		},
+	}))
+	plugin := kongv1.KongClusterPlugin{
+		ObjectMeta: metav1.ObjectMeta{Name: "rate-limiting"},
+		PluginName: "rate-limiting",
+		Config:     apiextensionsv1.JSON{Raw: []byte(`{"minute":5}`)},
+		ConfigPatches: []kongv1.NamespacedConfigPatch{
+			{
+				Path: "/redis_password",
+				ValueFrom: kongv1.NamespacedConfigSource{
+					SecretValue: kongv1.NamespacedSecretValueFromSource{
+						Namespace: "other-namespace",
+						Secret:    "conf-secret",
+						Key:       "key",
+					},
+				},
+			},
+		},
+		InstanceName: "rate-limiting-global",
+		Protocols:    []kongv1.KongProtocol{"http", "https"},
+		Ordering: &kong.PluginOrdering{
+			Before: kong.PluginOrderingPhase{"access": []string{"key-auth"}},
+		},
+	}
+	expectedPlugin := func(redisPassword string) *kong.Plugin {
+		return &kong.Plugin{
+			Name:         kong.String("rate-limiting"),
+			Config:       kong.Configuration{"minute": float64(5), "redis_password": redisPassword},
+			InstanceName: kong.String("rate-limiting-global"),
+			Protocols:    kong.StringSlice("http", "https"),
+			Ordering: &kong.PluginOrdering{
+				Before: kong.PluginOrderingPhase{"access": []string{"key-auth"}},
+			},
+		}
+	}
+
+	testCases := []struct {
+		name            string
+		overrideSecrets []*corev1.Secret
+		expectedPlugin  *kong.Plugin
+	}{
+		{
+			name:           "patches from Secrets in other namespaces are applied",
+			expectedPlugin: expectedPlugin("from-store"),
+		},
+		{
+			name: "patches from the Secret being updated are applied",
+			overrideSecrets: []*corev1.Secret{
+				{
+					ObjectMeta: metav1.ObjectMeta{Namespace: "other-namespace", Name: "conf-secret"},
+					Data:       map[string][]byte{"key": []byte(`"from-update"`)},
+				},
+			},
+			expectedPlugin: expectedPlugin("from-update"),
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			pluginSvc := &fakePluginSvc{valid: true}
+			validator := KongHTTPValidator{
+				SecretGetter:             storer,
+				AdminAPIServicesProvider: fakeServicesProvider{pluginSvc: pluginSvc},
+				ingressClassMatcher:      fakeClassMatcher,
+			}
+
+			ok, msg, err := validator.ValidateClusterPlugin(context.Background(), plugin, tc.overrideSecrets)
+			require.NoError(t, err)
+			require.True(t, ok, msg)
+			require.Equal(t, tc.expectedPlugin, pluginSvc.validatedPlugin)
+		})
+	}
+}
+
+func TestKongHTTPValidator_ValidatePluginInstanceName(t *testing.T) {
+	clusterPluginAnnotations := map[string]string{annotations.IngressClassKey: annotations.DefaultIngressClass}
+	pluginsAnnotation := func(plugins string) map[string]string {
+		return map[string]string{annotations.AnnotationPrefix + annotations.PluginsKey: plugins}
+	}
+	storer := lo.Must(store.NewFakeStore(store.FakeObjects{
+		KongPlugins: []*kongv1.KongPlugin{
+			{
+				ObjectMeta:   metav1.ObjectMeta{Namespace: "default", Name: "rate-limiting"},
+				PluginName:   "rate-limiting",
+				InstanceName: "rate-limiting-default",
+			},
+		},
+		KongClusterPlugins: []*kongv1.KongClusterPlugin{
+			{
+				ObjectMeta: metav1.ObjectMeta{
+					Name:        "global-rate-limiting",
+					Annotations: clusterPluginAnnotations,
+					Labels:      map[string]string{"global": "true"},
+				},
+				PluginName:   "rate-limiting",
+				InstanceName: "rate-limiting-global",
+			},
+			{
+				ObjectMeta:   metav1.ObjectMeta{Name: "shared-rate-limiting", Annotations: clusterPluginAnnotations},
+				PluginName:   "rate-limiting",
+				InstanceName: "rate-limiting-shared",
+			},
+		},
+		HTTPRoutes: []*gatewayapi.HTTPRoute{
+			{
+				ObjectMeta: metav1.ObjectMeta{
+					Namespace:   "default",
+					Name:        "route",
+					Annotations: pluginsAnnotation("rate-limiting,other-rate-limiting"),
+				},
+			},
+		},
+		Services: []*corev1.Service{
+			{
+				ObjectMeta: metav1.ObjectMeta{
+					Namespace:   "default",
+					Name:        "svc",
+					Annotations: pluginsAnnotation("shared-rate-limiting,svc-rate-limiting"),
+				},
+			},
+		},
+	}))
+
+	t.Run("KongClusterPlugin", func(t *testing.T) {
+		testCases := []struct {
+			name        string
+			plugin      kongv1.KongClusterPlugin
+			wantOK      bool
+			wantMessage string
+		}{
+			{
+				name: "unique instance_name is passed to the gateway schema validation",
+				plugin: kongv1.KongClusterPlugin{
+					ObjectMeta:   metav1.ObjectMeta{Name: "other-rate-limiting"},
+					PluginName:   "rate-limiting",
+					InstanceName: "rate-limiting-other",
+				},
+				wantOK: true,
+			},
+			{
+				name: "updating the KongClusterPlugin using the instance_name is allowed",
+				plugin: kongv1.KongClusterPlugin{
+					ObjectMeta:   metav1.ObjectMeta{Name: "global-rate-limiting", Labels: map[string]string{"global": "true"}},
+					PluginName:   "rate-limiting",
+					InstanceName: "rate-limiting-global",
+				},
+				wantOK: true,
+			},
+			{
+				name: "instance_name used by another global KongClusterPlugin is rejected",
+				plugin: kongv1.KongClusterPlugin{
+					ObjectMeta:   metav1.ObjectMeta{Name: "other-global-rate-limiting", Labels: map[string]string{"global": "true"}},
+					PluginName:   "rate-limiting",
+					InstanceName: "rate-limiting-global",
+				},
+				wantMessage: fmt.Sprintf(ErrTextPluginInstanceNameNotUnique, "rate-limiting-global", "KongClusterPlugin global-rate-limiting"),
+			},
+			{
+				name: "instance_name used by a global KongClusterPlugin is allowed for a plugin that is not global",
+				plugin: kongv1.KongClusterPlugin{
+					ObjectMeta:   metav1.ObjectMeta{Name: "other-rate-limiting"},
+					PluginName:   "rate-limiting",
+					InstanceName: "rate-limiting-global",
+				},
+				wantOK: true,
+			},
+			{
+				name: "instance_name used by a KongPlugin attached to the same object is rejected",
+				plugin: kongv1.KongClusterPlugin{
+					ObjectMeta:   metav1.ObjectMeta{Name: "other-rate-limiting"},
+					PluginName:   "rate-limiting",
+					InstanceName: "rate-limiting-default",
+				},
+				wantMessage: fmt.Sprintf(ErrTextPluginInstanceNameNotUnique, "rate-limiting-default",
+					"KongPlugin default/rate-limiting attached to HTTPRoute default/route"),
+			},
+			{
+				name: "instance_name used by a KongClusterPlugin attached to another object is allowed",
+				plugin: kongv1.KongClusterPlugin{
+					ObjectMeta:   metav1.ObjectMeta{Name: "other-rate-limiting"},
+					PluginName:   "rate-limiting",
+					InstanceName: "rate-limiting-shared",
+				},
+				wantOK: true,
+			},
+		}
+		for _, tc := range testCases {
+			t.Run(tc.name, func(t *testing.T) {
+				pluginSvc := &fakePluginSvc{valid: true}
+				validator := KongHTTPValidator{
+					Storer:                   storer,
+					AdminAPIServicesProvider: fakeServicesProvider{pluginSvc: pluginSvc},
+					ingressClassMatcher:      fakeClassMatcher,
+				}
+
+				ok, message, err := validator.ValidateClusterPlugin(context.Background(), tc.plugin, nil)
+				require.NoError(t, err)
+				require.Equal(t, tc.wantOK, ok)
+				require.Equal(t, tc.wantMessage, message)
+				if tc.wantOK {
+					require.NotNil(t, pluginSvc.validatedPlugin)
+					require.Equal(t, tc.plugin.InstanceName, lo.FromPtr(pluginSvc.validatedPlugin.InstanceName))
+				}
+			})
+		}
+	})
+
+	t.Run("KongPlugin", func(t *testing.T) {
+		testCases := []struct {
+			name        string
+			plugin      kongv1.KongPlugin
+			wantOK      bool
+			wantMessage string
+		}{
+			{
+				name: "KongPlugin with the same name in another namespace using the instance_name is allowed",
+				plugin: kongv1.KongPlugin{
+					ObjectMeta:   metav1.ObjectMeta{Namespace: "other", Name: "rate-limiting"},
+					PluginName:   "rate-limiting",
+					InstanceName: "rate-limiting-default",
+				},
+				wantOK: true,
+			},
+			{
+				name: "instance_name used by a KongPlugin attached to the same object is rejected",
+				plugin: kongv1.KongPlugin{
+					ObjectMeta:   metav1.ObjectMeta{Namespace: "default", Name: "other-rate-limiting"},
+					PluginName:   "rate-limiting",
+					InstanceName: "rate-limiting-default",
+				},
+				wantMessage: fmt.Sprintf(ErrTextPluginInstanceNameNotUnique, "rate-limiting-default",
+					"KongPlugin default/rate-limiting attached to HTTPRoute default/route"),
+			},
+			{
+				name: "instance_name used by a KongClusterPlugin attached to the same object is rejected",
+				plugin: kongv1.KongPlugin{
+					ObjectMeta:   metav1.ObjectMeta{Namespace: "default", Name: "svc-rate-limiting"},
+					PluginName:   "rate-limiting",
+					InstanceName: "rate-limiting-shared",
+				},
+				wantMessage: fmt.Sprintf(ErrTextPluginInstanceNameNotUnique, "rate-limiting-shared",
+					"KongClusterPlugin shared-rate-limiting attached to Service default/svc"),
+			},
+			{
+				name: "instance_name used by a KongClusterPlugin attached to another object is allowed",
+				plugin: kongv1.KongPlugin{
+					ObjectMeta:   metav1.ObjectMeta{Namespace: "default", Name: "other-rate-limiting"},
+					PluginName:   "rate-limiting",
+					InstanceName: "rate-limiting-shared",
+				},
+				wantOK: true,
+			},
+			{
+				name: "instance_name used by a global KongClusterPlugin is allowed",
+				plugin: kongv1.KongPlugin{
+					ObjectMeta:   metav1.ObjectMeta{Namespace: "default", Name: "other-rate-limiting"},
+					PluginName:   "rate-limiting",
+					InstanceName: "rate-limiting-global",
+				},
+				wantOK: true,
+			},
+			{
+				name: "updating the KongPlugin using the instance_name is allowed",
+				plugin: kongv1.KongPlugin{
+					ObjectMeta:   metav1.ObjectMeta{Namespace: "default", Name: "rate-limiting"},
+					PluginName:   "rate-limiting",
+					InstanceName: "rate-limiting-default",
+				},
+				wantOK: true,
+			},
+		}
+		for _, tc := range testCases {
+			t.Run(tc.name, func(t *testing.T) {
+				validator := KongHTTPValidator{
+					Storer:                   storer,
+					AdminAPIServicesProvider: fakeServicesProvider{pluginSvc: &fakePluginSvc{valid: true}},
+					ingressClassMatcher:      fakeClassMatcher,
+				}
+
+				ok, message, err := validator.ValidatePlugin(context.Background(), tc.plugin, nil)
+				require.NoError(t, err)
+				require.Equal(t, tc.wantOK, ok)
+				require.Equal(t, tc.wantMessage, message)
+			})
+		}
+	})
+}
+
 func TestKongHTTPValidator_ValidateConsumer(t *testing.T) {
 	t.Run("passes with and without consumers service available", func(t *testing.T) {
 		s, _ := store.NewFakeStore(store.FakeObjects{})
@@ -1251,6 +1596,115 @@ func TestValidator_ValidateIngress(t *testing.T) {
 			storerObjects: store.FakeObjects{}, // No KongServiceFacade found would result in an error, but the feature flag is off.
 			wantOK:        true,
 		},
+		{
+			name: "Ingress routing a hostname owned by another namespace fails when HostnameOwnership is enabled",
+			ingress: builder.NewIngress("ingress", "kong").
+				WithNamespace("team-b").
+				WithRules(newHTTPIngressRuleWithHost("api.example.com", netv1.IngressBackend{
+					Service: &netv1.IngressServiceBackend{
+						Name: "svc",
+						Port: netv1.ServiceBackendPort{
+							Number: 8080,
+						},
+					},
+				})).
+				Build(),
+			translatorFeatures: translator.FeatureFlags{
+				HostnameOwnership: true,
+			},
+			storerObjects: store.FakeObjects{
+				IngressesV1: []*netv1.Ingress{
+					builder.NewIngress("api", "kong").
+						WithNamespace("team-a").
+						WithRules(newHTTPIngressRuleWithHost("api.example.com", netv1.IngressBackend{})).
+						Build(),
+				},
+			},
+			wantOK:      false,
+			wantMessage: `Ingress routes a hostname owned by another namespace: host "api.example.com" is owned by namespace "team-a" where it was first claimed by Ingress api`,
+		},
+		{
+			name: "Ingress routing a hostname owned by another namespace passes when HostnameOwnership is disabled",
+			ingress: builder.NewIngress("ingress", "kong").
+				WithNamespace("team-b").
+				WithRules(newHTTPIngressRuleWithHost("api.example.com", netv1.IngressBackend{
+					Service: &netv1.IngressServiceBackend{
+						Name: "svc",
+						Port: netv1.ServiceBackendPort{
+							Number: 8080,
+						},
+					},
+				})).
+				Build(),
+			storerObjects: store.FakeObjects{
+				IngressesV1: []*netv1.Ingress{
+					builder.NewIngress("api", "kong").
+						WithNamespace("team-a").
+						WithRules(newHTTPIngressRuleWithHost("api.example.com", netv1.IngressBackend{})).
+						Build(),
+				},
+			},
+			wantOK: true,
+		},
+		{
+			name: "Ingress with a plugin denied by KongTenantPolicy fails",
+			ingress: builder.NewIngress("ingress", "kong").
+				WithNamespace("team-a").
+				WithKongPlugins("pre-function").
+				WithRules(
+					newHTTPIngressRule(netv1.IngressBackend{
+						Service: &netv1.IngressServiceBackend{
+							Name: "svc",
+							Port: netv1.ServiceBackendPort{
+								Number: 8080,
+							},
+						},
+					}),
+				).
+				Build(),
+			storerObjects: store.FakeObjects{
+				KongPlugins: []*kongv1.KongPlugin{
+					{
+						ObjectMeta: metav1.ObjectMeta{
+							Name:      "pre-function",
+							Namespace: "team-a",
+						},
+						PluginName: "pre-function",
+					},
+				},
+				KongTenantPolicies: []*kongv1alpha1.KongTenantPolicy{
+					newTestKongTenantPolicy(kongv1alpha1.KongTenantPolicySpec{
+						Plugins: &kongv1alpha1.KongTenantPolicyRule{Deny: []string{"pre-function"}},
+					}),
+				},
+			},
+			wantOK:      false,
+			wantMessage: `Ingress violates KongTenantPolicy: plugin "pre-function" is not allowed in namespace "team-a" by KongTenantPolicy tenants`,
+		},
+		{
+			name: "Ingress with a path allowed by KongTenantPolicy passes",
+			ingress: builder.NewIngress("ingress", "kong").
+				WithNamespace("team-a").
+				WithRules(
+					newHTTPIngressRule(netv1.IngressBackend{
+						Service: &netv1.IngressServiceBackend{
+							Name: "svc",
+							Port: netv1.ServiceBackendPort{
+								Number: 8080,
+							},
+						},
+					}),
+				).
+				Build(),
+			storerObjects: store.FakeObjects{
+				KongTenantPolicies: []*kongv1alpha1.KongTenantPolicy{
+					newTestKongTenantPolicy(kongv1alpha1.KongTenantPolicySpec{
+						PathPrefixes: &kongv1alpha1.KongTenantPolicyRule{Allow: []string{"/"}},
+					}),
+				},
+			},
+			wantOK: true,
+		},
 	}
 
 	for _, tc := range testCases {
@@ -1281,6 +1735,19 @@ func TestValidator_ValidateIngress(t *testing.T) {
 	}
 }
 
+// newTestKongTenantPolicy returns a KongTenantPolicy selecting the team-a namespace.
+func newTestKongTenantPolicy(spec kongv1alpha1.KongTenantPolicySpec) *kongv1alpha1.KongTenantPolicy {
+	spec.NamespaceSelector = metav1.LabelSelector{
+		MatchLabels: map[string]string{corev1.LabelMetadataName: "team-a"},
+	}
+	return &kongv1alpha1.KongTenantPolicy{
+		ObjectMeta: metav1.ObjectMeta{
+			Name: "tenants",
+		},
+		Spec: spec,
+	}
+}
+
 func newHTTPIngressRule(backend netv1.IngressBackend) netv1.IngressRule {
 	return netv1.IngressRule{
 		IngressRuleValue: netv1.IngressRuleValue{
@@ -1297,6 +1764,12 @@ func newHTTPIngressRule(backend netv1.IngressBackend) netv1.IngressRule {
 	}
 }
 
+func newHTTPIngressRuleWithHost(host string, backend netv1.IngressBackend) netv1.IngressRule {
+	rule := newHTTPIngressRule(backend)
+	rule.Host = host
+	return rule
+}
+
 type fakeRouteSvc struct {
 	kong.AbstractRouteService
 	shouldFail bool
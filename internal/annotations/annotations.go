@@ -51,6 +51,7 @@ const (
 	HTTPSRedirectCodeKey = "/https-redirect-status-code"
 	PreserveHostKey      = "/preserve-host"
 	RegexPriorityKey     = "/regex-priority"
+	RoutePriorityKey     = "/route-priority"
 	HostHeaderKey        = "/host-header"
 	MethodsKey           = "/methods"
 	SNIsKey              = "/snis"
@@ -68,6 +69,14 @@ const (
 	UserTagKey           = "/tags"
 	RewriteURIKey        = "/rewrite"
 
+	// CredentialVersionsKey is an annotation used on a credential Secret to list the versions of the credential
+	// it holds, so that credentials can be rotated.
+	CredentialVersionsKey = "/credential-versions"
+	// CredentialNotBeforeKey and CredentialNotAfterKey are annotation prefixes, followed by .<version>, used on
+	// a credential Secret to bound the time a version of the credential is valid in.
+	CredentialNotBeforeKey = "/credential-not-before"
+	CredentialNotAfterKey  = "/credential-not-after"
+
 	// GatewayClassUnmanagedKey is an annotation used on a Gateway resource to
 	// indicate that the GatewayClass should be reconciled according to unmanaged
 	// mode.
@@ -254,6 +263,11 @@ func ExtractRegexPriority(anns map[string]string) string {
 	return anns[AnnotationPrefix+RegexPriorityKey]
 }
 
+// ExtractRoutePriority extracts the route-priority annotation value.
+func ExtractRoutePriority(anns map[string]string) string {
+	return anns[AnnotationPrefix+RoutePriorityKey]
+}
+
 // ExtractHostHeader extracts the host-header annotation value.
 func ExtractHostHeader(anns map[string]string) string {
 	return anns[AnnotationPrefix+HostHeaderKey]
@@ -517,6 +517,38 @@ func TestExtractRegexPriority(t *testing.T) {
 	}
 }
 
+func TestExtractRoutePriority(t *testing.T) {
+	type args struct {
+		anns map[string]string
+	}
+	tests := []struct {
+		name string
+		args args
+		want string
+	}{
+		{
+			name: "empty",
+			want: "",
+		},
+		{
+			name: "non-empty",
+			args: args{
+				anns: map[string]string{
+					"konghq.com/route-priority": "10",
+				},
+			},
+			want: "10",
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := ExtractRoutePriority(tt.args.anns); got != tt.want {
+				t.Errorf("ExtractRoutePriority() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
 func TestExtractHostHeader(t *testing.T) {
 	type args struct {
 		anns map[string]string
@@ -0,0 +1,251 @@
+package rootcmd
+
+import (
+	"context"
+	"fmt"
+	"io"
+
+	"github.com/go-logr/logr"
+	"github.com/go-logr/zapr"
+	"github.com/samber/lo"
+	"github.com/spf13/cobra"
+	corev1 "k8s.io/api/core/v1"
+	netv1 "k8s.io/api/networking/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/client-go/kubernetes"
+	"k8s.io/client-go/tools/clientcmd"
+	cliflag "k8s.io/component-base/cli/flag"
+	"sigs.k8s.io/yaml"
+
+	"github.com/kong/kubernetes-ingress-controller/v3/internal/annotations"
+	dpconf "github.com/kong/kubernetes-ingress-controller/v3/internal/dataplane/config"
+	"github.com/kong/kubernetes-ingress-controller/v3/internal/dataplane/offline"
+	"github.com/kong/kubernetes-ingress-controller/v3/internal/gatewayapi"
+	"github.com/kong/kubernetes-ingress-controller/v3/internal/ingressmigration"
+	"github.com/kong/kubernetes-ingress-controller/v3/internal/util"
+)
+
+// migrateIngressConfig contains the settings of the migrate-ingress subcommand.
+type migrateIngressConfig struct {
+	ingressmigration.Options
+
+	IngressClassName string
+	FeatureGates     map[string]bool
+	Verify           bool
+	LogLevel         string
+	ManifestsPaths   []string
+	KubeconfigPath   string
+	Namespace        string
+}
+
+// migrationInput contains the objects read from manifests or from a cluster.
+type migrationInput struct {
+	// Ingresses are the Ingresses to convert.
+	Ingresses []*netv1.Ingress
+	// Services are the Services the Ingresses route to.
+	Services []*corev1.Service
+	// Objects are all the other objects, translated along with the Ingresses and the HTTPRoutes when verifying them.
+	Objects [][]byte
+}
+
+// GetMigrateIngressCmd returns a command converting Ingresses into equivalent Gateway API HTTPRoutes.
+func GetMigrateIngressCmd() *cobra.Command {
+	var cfg migrateIngressConfig
+	cmd := &cobra.Command{
+		Use:   "migrate-ingress",
+		Short: "Convert Ingresses into equivalent Gateway API HTTPRoutes",
+		Long: "Convert Ingresses read from files or directories, or from a Kubernetes cluster when no files are given, " +
+			"into equivalent Gateway API HTTPRoutes, taking the konghq.com annotations into account. KongPlugins are " +
+			"referenced with ExtensionRef filters. The HTTPRoutes are printed to stdout and the parts of the Ingresses " +
+			"that couldn't be converted are printed to stderr. Unless disabled, both the Ingresses and the HTTPRoutes " +
+			"are translated and the generated Kong routes are compared to verify they are equivalent.",
+		Args: cobra.NoArgs,
+		RunE: func(cmd *cobra.Command, _ []string) error {
+			return runMigrateIngress(cmd, cfg)
+		},
+		SilenceUsage: true,
+	}
+
+	flagSet := cmd.Flags()
+	flagSet.StringSliceVarP(&cfg.ManifestsPaths, "filename", "f", nil, `File(s) or directory(ies) containing Kubernetes manifests to convert. Directories are read recursively. When not set, objects are read from the cluster.`)
+	flagSet.StringVar(&cfg.KubeconfigPath, "kubeconfig", "", `Path to the kubeconfig file used to read objects from the cluster.`)
+	flagSet.StringVarP(&cfg.Namespace, "namespace", "n", "", `Namespace to read objects from when reading them from the cluster. All namespaces are read when not set.`)
+	flagSet.StringVar(&cfg.IngressClassName, "ingress-class", annotations.DefaultIngressClass, `Name of the ingress class of the Ingresses to convert.`)
+	flagSet.StringVar(&cfg.GatewayName, "gateway-name", "kong", `Name of the Gateway the HTTPRoutes are attached to.`)
+	flagSet.StringVar(&cfg.GatewayNamespace, "gateway-namespace", "", `Namespace of the Gateway the HTTPRoutes are attached to. When not set, HTTPRoutes are attached to a Gateway in their own namespace.`)
+	flagSet.Var(cliflag.NewMapStringBool(&cfg.FeatureGates), "feature-gates", "A set of comma separated key=value pairs that describe feature gates for alpha/beta/experimental features, as passed to the controller.")
+	flagSet.BoolVar(&cfg.Verify, "verify", true, "Verify that the Kong routes generated from the HTTPRoutes are equivalent to the ones generated from the Ingresses.")
+	flagSet.StringVar(&cfg.LogLevel, "log-level", "error", `Level of logging. Allowed values are trace, debug, info, and error.`)
+
+	return cmd
+}
+
+func runMigrateIngress(cmd *cobra.Command, cfg migrateIngressConfig) error {
+	logger, err := util.MakeLogger(cfg.LogLevel, "text", cmd.ErrOrStderr())
+	if err != nil {
+		return fmt.Errorf("failed to make logger: %w", err)
+	}
+
+	var input migrationInput
+	if len(cfg.ManifestsPaths) > 0 {
+		input, err = readMigrationInputFromManifests(zapr.NewLogger(logger), cfg.ManifestsPaths)
+	} else {
+		input, err = readMigrationInputFromCluster(cmd.Context(), cfg.KubeconfigPath, cfg.Namespace)
+	}
+	if err != nil {
+		return err
+	}
+
+	isValidClass := annotations.IngressClassValidatorFuncFromV1Ingress(cfg.IngressClassName)
+	isValidClassAnnotation := annotations.IngressClassValidatorFuncFromObjectMeta(cfg.IngressClassName)
+	ingresses := lo.Filter(input.Ingresses, func(ingress *netv1.Ingress, _ int) bool {
+		return isValidClass(ingress, annotations.ExactClassMatch) ||
+			isValidClassAnnotation(&ingress.ObjectMeta, annotations.IngressClassKey, annotations.ExactClassMatch)
+	})
+
+	cfg.Services = input.Services
+	result := ingressmigration.Convert(ingresses, cfg.Options)
+	if err := printHTTPRoutes(cmd.OutOrStdout(), result); err != nil {
+		return err
+	}
+	for _, w := range result.Warnings {
+		fmt.Fprintf(cmd.ErrOrStderr(), "warning: %s\n", w)
+	}
+
+	if !cfg.Verify {
+		return nil
+	}
+	differences, err := ingressmigration.Verify(zapr.NewLogger(logger), input.Objects, ingresses, result.HTTPRoutes, input.Services, offline.Config{
+		IngressClassName: cfg.IngressClassName,
+		FeatureGates:     cfg.FeatureGates,
+		RouterFlavor:     dpconf.RouterFlavorTraditionalCompatible,
+	})
+	if err != nil {
+		return fmt.Errorf("failed to verify HTTPRoutes: %w", err)
+	}
+	for _, d := range differences {
+		fmt.Fprintf(cmd.ErrOrStderr(), "verification: %s\n", d)
+	}
+	if len(differences) > 0 {
+		return fmt.Errorf("%d difference(s) found between the Kong routes generated from the Ingresses and the HTTPRoutes", len(differences))
+	}
+	return nil
+}
+
+func printHTTPRoutes(w io.Writer, result ingressmigration.Result) error {
+	for _, httpRoute := range result.HTTPRoutes {
+		b, err := marshalHTTPRouteManifest(httpRoute)
+		if err != nil {
+			return fmt.Errorf("failed to marshal HTTPRoute %s/%s: %w", httpRoute.Namespace, httpRoute.Name, err)
+		}
+		if _, err := fmt.Fprintf(w, "---\n%s", b); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// marshalHTTPRouteManifest marshals the HTTPRoute into a YAML manifest without the creationTimestamp and status
+// fields that are set by the API server and would otherwise be printed as empty.
+func marshalHTTPRouteManifest(httpRoute *gatewayapi.HTTPRoute) ([]byte, error) {
+	obj, err := runtime.DefaultUnstructuredConverter.ToUnstructured(httpRoute)
+	if err != nil {
+		return nil, err
+	}
+	unstructured.RemoveNestedField(obj, "metadata", "creationTimestamp")
+	unstructured.RemoveNestedField(obj, "status")
+	return yaml.Marshal(obj)
+}
+
+func readMigrationInputFromManifests(logger logr.Logger, paths []string) (migrationInput, error) {
+	var input migrationInput
+	for _, path := range paths {
+		objects, err := offline.ReadManifests(path)
+		if err != nil {
+			return migrationInput{}, fmt.Errorf("failed to read manifests from %s: %w", path, err)
+		}
+		// Only objects of kinds supported by the translator are relevant to the migration and its verification.
+		objects, _, err = offline.FilterSupportedObjects(logger, objects)
+		if err != nil {
+			return migrationInput{}, fmt.Errorf("failed to read manifests from %s: %w", path, err)
+		}
+		for _, b := range objects {
+			var typeMeta metav1.TypeMeta
+			if err := yaml.Unmarshal(b, &typeMeta); err != nil {
+				return migrationInput{}, fmt.Errorf("failed to read the kind of an object from %s: %w", path, err)
+			}
+			switch typeMeta.GroupVersionKind() {
+			case netv1.SchemeGroupVersion.WithKind("Ingress"):
+				ingress := &netv1.Ingress{}
+				if err := yaml.Unmarshal(b, ingress); err != nil {
+					return migrationInput{}, fmt.Errorf("failed to read Ingress from %s: %w", path, err)
+				}
+				input.Ingresses = append(input.Ingresses, ingress)
+				continue
+			case corev1.SchemeGroupVersion.WithKind("Service"):
+				service := &corev1.Service{}
+				if err := yaml.Unmarshal(b, service); err != nil {
+					return migrationInput{}, fmt.Errorf("failed to read Service from %s: %w", path, err)
+				}
+				input.Services = append(input.Services, service)
+			}
+			input.Objects = append(input.Objects, b)
+		}
+	}
+	return input, nil
+}
+
+func readMigrationInputFromCluster(ctx context.Context, kubeconfigPath, namespace string) (migrationInput, error) {
+	loadingRules := clientcmd.NewDefaultClientConfigLoadingRules()
+	loadingRules.ExplicitPath = kubeconfigPath
+	restConfig, err := clientcmd.NewNonInteractiveDeferredLoadingClientConfig(loadingRules, &clientcmd.ConfigOverrides{}).ClientConfig()
+	if err != nil {
+		return migrationInput{}, fmt.Errorf("failed to load kubeconfig: %w", err)
+	}
+	clientset, err := kubernetes.NewForConfig(restConfig)
+	if err != nil {
+		return migrationInput{}, fmt.Errorf("failed to create Kubernetes client: %w", err)
+	}
+
+	ingresses, err := clientset.NetworkingV1().Ingresses(namespace).List(ctx, metav1.ListOptions{})
+	if err != nil {
+		return migrationInput{}, fmt.Errorf("failed to list Ingresses: %w", err)
+	}
+	ingressClasses, err := clientset.NetworkingV1().IngressClasses().List(ctx, metav1.ListOptions{})
+	if err != nil {
+		return migrationInput{}, fmt.Errorf("failed to list IngressClasses: %w", err)
+	}
+	services, err := clientset.CoreV1().Services(namespace).List(ctx, metav1.ListOptions{})
+	if err != nil {
+		return migrationInput{}, fmt.Errorf("failed to list Services: %w", err)
+	}
+
+	var input migrationInput
+	for i := range ingresses.Items {
+		input.Ingresses = append(input.Ingresses, &ingresses.Items[i])
+	}
+	// Objects listed with a typed client don't have their TypeMeta set, it's needed to load them
+	// into the translator's cache stores.
+	for i := range ingressClasses.Items {
+		ingressClass := &ingressClasses.Items[i]
+		ingressClass.TypeMeta = metav1.TypeMeta{Kind: "IngressClass", APIVersion: netv1.SchemeGroupVersion.String()}
+		b, err := yaml.Marshal(ingressClass)
+		if err != nil {
+			return migrationInput{}, fmt.Errorf("failed to marshal IngressClass %s: %w", ingressClass.Name, err)
+		}
+		input.Objects = append(input.Objects, b)
+	}
+	for i := range services.Items {
+		service := &services.Items[i]
+		service.TypeMeta = metav1.TypeMeta{Kind: "Service", APIVersion: corev1.SchemeGroupVersion.String()}
+		b, err := yaml.Marshal(service)
+		if err != nil {
+			return migrationInput{}, fmt.Errorf("failed to marshal Service %s/%s: %w", service.Namespace, service.Name, err)
+		}
+		input.Services = append(input.Services, service)
+		input.Objects = append(input.Objects, b)
+	}
+	return input, nil
+}
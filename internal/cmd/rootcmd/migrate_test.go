@@ -0,0 +1,95 @@
+package rootcmd
+
+import (
+	"bytes"
+	"context"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+const testMigrationManifests = `
+apiVersion: networking.k8s.io/v1
+kind: Ingress
+metadata:
+  name: foo
+  namespace: default
+spec:
+  ingressClassName: kong
+  rules:
+  - host: example.com
+    http:
+      paths:
+      - path: /foo
+        pathType: Prefix
+        backend:
+          service:
+            name: foo
+            port:
+              number: 80
+---
+apiVersion: v1
+kind: Service
+metadata:
+  name: foo
+  namespace: default
+spec:
+  ports:
+  - port: 80
+    protocol: TCP
+---
+apiVersion: apps/v1
+kind: Deployment
+metadata:
+  name: foo
+  namespace: default
+spec:
+  selector:
+    matchLabels:
+      app: foo
+  template:
+    metadata:
+      labels:
+        app: foo
+    spec:
+      containers:
+      - name: foo
+        image: kong/httpbin
+`
+
+func TestMigrateIngressCmd(t *testing.T) {
+	dir := t.TempDir()
+	require.NoError(t, os.WriteFile(filepath.Join(dir, "manifests.yaml"), []byte(testMigrationManifests), 0o600))
+
+	var stdout, stderr bytes.Buffer
+	cmd := GetMigrateIngressCmd()
+	cmd.SetArgs([]string{"-f", dir})
+	cmd.SetOut(&stdout)
+	cmd.SetErr(&stderr)
+	require.NoError(t, cmd.ExecuteContext(context.Background()), "objects of unsupported kinds should not fail the verification")
+	require.NotContains(t, stderr.String(), "warning:")
+
+	require.Equal(t, `---
+apiVersion: gateway.networking.k8s.io/v1
+kind: HTTPRoute
+metadata:
+  name: foo
+  namespace: default
+spec:
+  hostnames:
+  - example.com
+  parentRefs:
+  - name: kong
+  rules:
+  - backendRefs:
+    - kind: Service
+      name: foo
+      port: 80
+    matches:
+    - path:
+        type: PathPrefix
+        value: /foo
+`, stdout.String())
+}
@@ -0,0 +1,161 @@
+package rootcmd
+
+import (
+	"encoding/json"
+	"fmt"
+	"io"
+	"strings"
+
+	"github.com/go-logr/zapr"
+	"github.com/spf13/cobra"
+	cliflag "k8s.io/component-base/cli/flag"
+	"sigs.k8s.io/yaml"
+
+	"github.com/kong/kubernetes-ingress-controller/v3/internal/annotations"
+	dpconf "github.com/kong/kubernetes-ingress-controller/v3/internal/dataplane/config"
+	"github.com/kong/kubernetes-ingress-controller/v3/internal/dataplane/failures"
+	"github.com/kong/kubernetes-ingress-controller/v3/internal/dataplane/offline"
+	"github.com/kong/kubernetes-ingress-controller/v3/internal/dataplane/sendconfig"
+	"github.com/kong/kubernetes-ingress-controller/v3/internal/util"
+)
+
+const (
+	translateFormatDeck   = "deck"
+	translateFormatDBLess = "dbless"
+
+	translateOutputYAML = "yaml"
+	translateOutputJSON = "json"
+)
+
+// translateConfig contains the settings of the translate subcommand.
+type translateConfig struct {
+	offline.Config
+
+	RouterFlavorName string
+	Format           string
+	Output           string
+	FailOnFailures   bool
+	LogLevel         string
+	ManifestsPaths   []string
+}
+
+// GetTranslateCmd returns a command translating Kubernetes manifests into Kong declarative configuration
+// without connecting to a Kubernetes cluster or a Kong Gateway.
+func GetTranslateCmd() *cobra.Command {
+	var cfg translateConfig
+	cmd := &cobra.Command{
+		Use:   "translate",
+		Short: "Translate Kubernetes manifests into Kong declarative configuration",
+		Long: "Translate Kubernetes manifests (Ingresses, Services, EndpointSlices, Gateway API routes, Kong custom resources, " +
+			"Secrets, etc.) read from files or directories into Kong declarative configuration, using the same translator " +
+			"as the controller. The configuration is printed to stdout and translation failures are printed to stderr.",
+		Args: cobra.NoArgs,
+		RunE: func(cmd *cobra.Command, _ []string) error {
+			return runTranslate(cmd, cfg)
+		},
+		SilenceUsage: true,
+	}
+
+	flagSet := cmd.Flags()
+	flagSet.StringSliceVarP(&cfg.ManifestsPaths, "filename", "f", nil, `File(s) or directory(ies) containing Kubernetes manifests to translate. Directories are read recursively.`)
+	flagSet.StringVar(&cfg.IngressClassName, "ingress-class", annotations.DefaultIngressClass, `Name of the ingress class to route through this controller.`)
+	flagSet.StringVar(&cfg.KongWorkspace, "kong-workspace", "", "Kong Enterprise workspace to generate the configuration for.")
+	flagSet.Var(cliflag.NewMapStringBool(&cfg.FeatureGates), "feature-gates", "A set of comma separated key=value pairs that describe feature gates for alpha/beta/experimental features, as passed to the controller.")
+	flagSet.StringVar(&cfg.RouterFlavorName, "router-flavor", string(dpconf.RouterFlavorTraditionalCompatible),
+		fmt.Sprintf("Router flavor of the Kong Gateway to generate the configuration for. Allowed values are %s, %s and %s.",
+			dpconf.RouterFlavorTraditional, dpconf.RouterFlavorTraditionalCompatible, dpconf.RouterFlavorExpressions))
+	flagSet.BoolVar(&cfg.EnterpriseEdition, "enterprise", false, "Generate the configuration for Kong Enterprise.")
+	flagSet.StringSliceVar(&cfg.FilterTags, "kong-filter-tags", nil, "Tag(s) in comma-separated format (or specify this flag multiple times) to set as decK's select tags.")
+	flagSet.StringVar(&cfg.Format, "format", translateFormatDeck, fmt.Sprintf("Format of the generated configuration. Allowed values are %s and %s.", translateFormatDeck, translateFormatDBLess))
+	flagSet.StringVarP(&cfg.Output, "output", "o", translateOutputYAML, fmt.Sprintf("Output encoding. Allowed values are %s and %s.", translateOutputYAML, translateOutputJSON))
+	flagSet.BoolVar(&cfg.FailOnFailures, "fail-on-translation-failures", false, "Exit with a non-zero code when any of the objects failed to translate.")
+	flagSet.StringVar(&cfg.LogLevel, "log-level", "error", `Level of logging. Allowed values are trace, debug, info, and error.`)
+	_ = cmd.MarkFlagRequired("filename")
+
+	return cmd
+}
+
+func runTranslate(cmd *cobra.Command, cfg translateConfig) error {
+	switch rf := dpconf.RouterFlavor(cfg.RouterFlavorName); rf {
+	case dpconf.RouterFlavorTraditional, dpconf.RouterFlavorTraditionalCompatible, dpconf.RouterFlavorExpressions:
+		cfg.RouterFlavor = rf
+	default:
+		return fmt.Errorf("invalid router flavor %q", cfg.RouterFlavorName)
+	}
+	if cfg.Format != translateFormatDeck && cfg.Format != translateFormatDBLess {
+		return fmt.Errorf("invalid format %q", cfg.Format)
+	}
+	if cfg.Output != translateOutputYAML && cfg.Output != translateOutputJSON {
+		return fmt.Errorf("invalid output %q", cfg.Output)
+	}
+
+	logger, err := util.MakeLogger(cfg.LogLevel, "text", cmd.ErrOrStderr())
+	if err != nil {
+		return fmt.Errorf("failed to make logger: %w", err)
+	}
+
+	var objects [][]byte
+	for _, path := range cfg.ManifestsPaths {
+		o, err := offline.ReadManifests(path)
+		if err != nil {
+			return fmt.Errorf("failed to read manifests from %s: %w", path, err)
+		}
+		objects = append(objects, o...)
+	}
+
+	result, err := offline.Translate(cmd.Context(), zapr.NewLogger(logger), objects, cfg.Config)
+	if err != nil {
+		return err
+	}
+
+	var content any = result.Content
+	if cfg.Format == translateFormatDBLess {
+		content = sendconfig.DefaultContentToDBLessConfigConverter{}.Convert(result.Content)
+	}
+	if err := printTranslatedContent(cmd.OutOrStdout(), content, cfg.Output); err != nil {
+		return err
+	}
+
+	for _, w := range result.Warnings {
+		fmt.Fprintf(cmd.ErrOrStderr(), "warning: %s\n", w)
+	}
+	printTranslationFailures(cmd.ErrOrStderr(), result.TranslationFailures)
+	if cfg.FailOnFailures && len(result.TranslationFailures) > 0 {
+		return fmt.Errorf("%d translation failure(s) occurred", len(result.TranslationFailures))
+	}
+	return nil
+}
+
+func printTranslatedContent(w io.Writer, content any, output string) error {
+	var (
+		b   []byte
+		err error
+	)
+	switch output {
+	case translateOutputJSON:
+		b, err = json.MarshalIndent(content, "", "  ")
+		b = append(b, '\n')
+	default:
+		b, err = yaml.Marshal(content)
+	}
+	if err != nil {
+		return fmt.Errorf("failed to marshal configuration: %w", err)
+	}
+	_, err = w.Write(b)
+	return err
+}
+
+func printTranslationFailures(w io.Writer, translationFailures []failures.ResourceFailure) {
+	for _, f := range translationFailures {
+		objects := make([]string, 0, len(f.CausingObjects()))
+		for _, obj := range f.CausingObjects() {
+			kind := obj.GetObjectKind().GroupVersionKind().Kind
+			if ns := obj.GetNamespace(); ns != "" {
+				objects = append(objects, fmt.Sprintf("%s %s/%s", kind, ns, obj.GetName()))
+			} else {
+				objects = append(objects, fmt.Sprintf("%s %s", kind, obj.GetName()))
+			}
+		}
+		fmt.Fprintf(w, "translation failure: %s: %s\n", strings.Join(objects, ", "), f.Message())
+	}
+}
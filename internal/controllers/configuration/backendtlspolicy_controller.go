@@ -0,0 +1,281 @@
+package configuration
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/go-logr/logr"
+	corev1 "k8s.io/api/core/v1"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	k8stypes "k8s.io/apimachinery/pkg/types"
+	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/controller"
+	"sigs.k8s.io/controller-runtime/pkg/handler"
+	"sigs.k8s.io/controller-runtime/pkg/reconcile"
+	"sigs.k8s.io/controller-runtime/pkg/source"
+
+	"github.com/kong/kubernetes-ingress-controller/v3/internal/controllers"
+	ctrlref "github.com/kong/kubernetes-ingress-controller/v3/internal/controllers/reference"
+	"github.com/kong/kubernetes-ingress-controller/v3/internal/gatewayapi"
+	"github.com/kong/kubernetes-ingress-controller/v3/internal/util"
+	"github.com/kong/kubernetes-ingress-controller/v3/internal/util/kubernetes/object/status"
+)
+
+// -----------------------------------------------------------------------------
+// BackendTLSPolicy Controller - Reconciler
+// -----------------------------------------------------------------------------
+
+// BackendTLSPolicyReconciler reconciles Gateway API BackendTLSPolicy resources.
+type BackendTLSPolicyReconciler struct {
+	client.Client
+
+	Log               logr.Logger
+	Scheme            *runtime.Scheme
+	DataplaneClient   controllers.DataPlane
+	CacheSyncTimeout  time.Duration
+	StatusQueue       *status.Queue
+	ReferenceIndexers ctrlref.CacheIndexers
+}
+
+// SetupWithManager sets up the controller with the Manager.
+func (r *BackendTLSPolicyReconciler) SetupWithManager(mgr ctrl.Manager) error {
+	if err := r.setupIndices(mgr); err != nil {
+		return err
+	}
+
+	blder := ctrl.NewControllerManagedBy(mgr).
+		Named("BackendTLSPolicy").
+		WithOptions(controller.Options{
+			LogConstructor: func(_ *reconcile.Request) logr.Logger {
+				return r.Log
+			},
+			CacheSyncTimeout: r.CacheSyncTimeout,
+		}).
+		Watches(&corev1.Service{},
+			handler.EnqueueRequestsFromMapFunc(r.getBackendTLSPoliciesForService),
+		).
+		Watches(&corev1.ConfigMap{},
+			handler.EnqueueRequestsFromMapFunc(r.getBackendTLSPoliciesForCACertificate),
+		).
+		Watches(&corev1.Secret{},
+			handler.EnqueueRequestsFromMapFunc(r.getBackendTLSPoliciesForCACertificate),
+		)
+
+	if r.StatusQueue != nil {
+		// Watch for notifications on the status queue from Services as their status change needs to be propagated
+		// to the BackendTLSPolicy's ancestor Programmed status.
+		blder.WatchesRawSource(
+			source.Channel(
+				r.StatusQueue.Subscribe(schema.GroupVersionKind{
+					Version: "v1",
+					Kind:    "Service",
+				}),
+				handler.EnqueueRequestsFromMapFunc(r.getBackendTLSPoliciesForService),
+			),
+		)
+	}
+
+	return blder.For(&gatewayapi.BackendTLSPolicy{}).
+		Complete(r)
+}
+
+func (r *BackendTLSPolicyReconciler) setupIndices(mgr ctrl.Manager) error {
+	if err := mgr.GetCache().IndexField(
+		context.Background(),
+		&gatewayapi.BackendTLSPolicy{},
+		backendTLSPolicyTargetServiceIndexKey,
+		indexBackendTLSPoliciesOnTargetServices,
+	); err != nil {
+		return fmt.Errorf("failed to index BackendTLSPolicies on targetRefs: %w", err)
+	}
+
+	if err := mgr.GetCache().IndexField(
+		context.Background(),
+		&gatewayapi.BackendTLSPolicy{},
+		backendTLSPolicyCACertificateIndexKey,
+		indexBackendTLSPoliciesOnCACertificateRefs,
+	); err != nil {
+		return fmt.Errorf("failed to index BackendTLSPolicies on caCertificateRefs: %w", err)
+	}
+
+	return nil
+}
+
+// -----------------------------------------------------------------------------
+// BackendTLSPolicy Controller - Indexers
+// -----------------------------------------------------------------------------
+
+const (
+	backendTLSPolicyTargetServiceIndexKey = "backendTLSPolicyTargetService"
+	backendTLSPolicyCACertificateIndexKey = "backendTLSPolicyCACertificate"
+)
+
+// indexBackendTLSPoliciesOnTargetServices indexes the BackendTLSPolicies on the Services they target.
+func indexBackendTLSPoliciesOnTargetServices(o client.Object) []string {
+	policy, ok := o.(*gatewayapi.BackendTLSPolicy)
+	if !ok {
+		return []string{}
+	}
+
+	var indexes []string
+	for _, targetRef := range policy.Spec.TargetRefs {
+		if !isBackendTLSPolicyTargetRefService(targetRef) {
+			continue
+		}
+		indexes = append(indexes, string(buildServiceReference(policy.Namespace, string(targetRef.Name))))
+	}
+	return indexes
+}
+
+// indexBackendTLSPoliciesOnCACertificateRefs indexes the BackendTLSPolicies on the ConfigMaps and Secrets they
+// reference as CA certificates.
+func indexBackendTLSPoliciesOnCACertificateRefs(o client.Object) []string {
+	policy, ok := o.(*gatewayapi.BackendTLSPolicy)
+	if !ok {
+		return []string{}
+	}
+
+	var indexes []string
+	for _, ref := range policy.Spec.Validation.CACertificateRefs {
+		if !isCoreGroup(ref.Group) {
+			continue
+		}
+		indexes = append(indexes, buildCACertificateReference(string(ref.Kind), policy.Namespace, string(ref.Name)))
+	}
+	return indexes
+}
+
+// -----------------------------------------------------------------------------
+// BackendTLSPolicy Controller - Watch Predicates
+// -----------------------------------------------------------------------------
+
+// getBackendTLSPoliciesForService enqueues a new reconcile request for the BackendTLSPolicies targeting a Service.
+func (r *BackendTLSPolicyReconciler) getBackendTLSPoliciesForService(ctx context.Context, obj client.Object) []reconcile.Request {
+	return r.listBackendTLSPoliciesMatchingIndex(ctx, backendTLSPolicyTargetServiceIndexKey,
+		string(buildServiceReference(obj.GetNamespace(), obj.GetName())))
+}
+
+// getBackendTLSPoliciesForCACertificate enqueues a new reconcile request for the BackendTLSPolicies referencing
+// a ConfigMap or a Secret as a CA certificate.
+func (r *BackendTLSPolicyReconciler) getBackendTLSPoliciesForCACertificate(ctx context.Context, obj client.Object) []reconcile.Request {
+	var kind string
+	switch obj.(type) {
+	case *corev1.ConfigMap:
+		kind = "ConfigMap"
+	case *corev1.Secret:
+		kind = "Secret"
+	default:
+		return nil
+	}
+	return r.listBackendTLSPoliciesMatchingIndex(ctx, backendTLSPolicyCACertificateIndexKey,
+		buildCACertificateReference(kind, obj.GetNamespace(), obj.GetName()))
+}
+
+func (r *BackendTLSPolicyReconciler) listBackendTLSPoliciesMatchingIndex(ctx context.Context, indexKey, value string) []reconcile.Request {
+	policies := &gatewayapi.BackendTLSPolicyList{}
+	if err := r.List(ctx, policies, client.MatchingFields{indexKey: value}); err != nil {
+		r.Log.Error(err, "Failed to list BackendTLSPolicies in watch predicates", "index", indexKey, "value", value)
+		return nil
+	}
+
+	requests := make([]reconcile.Request, 0, len(policies.Items))
+	for _, policy := range policies.Items {
+		requests = append(requests, reconcile.Request{
+			NamespacedName: k8stypes.NamespacedName{
+				Namespace: policy.Namespace,
+				Name:      policy.Name,
+			},
+		})
+	}
+	return requests
+}
+
+// -----------------------------------------------------------------------------
+// BackendTLSPolicy Controller - Reconciliation
+// -----------------------------------------------------------------------------
+
+// +kubebuilder:rbac:groups=gateway.networking.k8s.io,resources=backendtlspolicies,verbs=get;list;watch
+// +kubebuilder:rbac:groups=gateway.networking.k8s.io,resources=backendtlspolicies/status,verbs=get;update;patch
+// +kubebuilder:rbac:groups="",resources=services,verbs=get;list;watch
+// +kubebuilder:rbac:groups="",resources=configmaps,verbs=get;list;watch
+
+// Reconcile processes the watched objects.
+func (r *BackendTLSPolicyReconciler) Reconcile(ctx context.Context, req ctrl.Request) (ctrl.Result, error) {
+	log := r.Log.WithValues("GatewayV1alpha3BackendTLSPolicy", req.NamespacedName)
+
+	// get the relevant object
+	policy := new(gatewayapi.BackendTLSPolicy)
+	if err := r.Get(ctx, req.NamespacedName, policy); err != nil {
+		if apierrors.IsNotFound(err) {
+			policy.Namespace = req.Namespace
+			policy.Name = req.Name
+
+			// remove reference record where the BackendTLSPolicy is the referrer
+			if err := ctrlref.DeleteReferencesByReferrer(r.ReferenceIndexers, r.DataplaneClient, policy); err != nil {
+				return ctrl.Result{}, err
+			}
+
+			return ctrl.Result{}, r.DataplaneClient.DeleteObject(policy)
+		}
+		return ctrl.Result{}, err
+	}
+	log.V(util.DebugLevel).Info("Reconciling resource", "namespace", req.Namespace, "name", req.Name)
+
+	// clean the object up if it's being deleted
+	if !policy.DeletionTimestamp.IsZero() && time.Now().After(policy.DeletionTimestamp.Time) {
+		log.V(util.DebugLevel).Info("Resource is being deleted, its configuration will be removed", "type", "BackendTLSPolicy", "namespace", req.Namespace, "name", req.Name)
+
+		// remove reference record where the BackendTLSPolicy is the referrer
+		if err := ctrlref.DeleteReferencesByReferrer(r.ReferenceIndexers, r.DataplaneClient, policy); err != nil {
+			return ctrl.Result{}, err
+		}
+
+		objectExistsInCache, err := r.DataplaneClient.ObjectExists(policy)
+		if err != nil {
+			return ctrl.Result{}, err
+		}
+		if objectExistsInCache {
+			if err := r.DataplaneClient.DeleteObject(policy); err != nil {
+				return ctrl.Result{}, err
+			}
+			return ctrl.Result{Requeue: true}, nil // wait until the object is no longer present in the cache
+		}
+		return ctrl.Result{}, nil
+	}
+
+	// enforce the desired BackendTLSPolicy status
+	updated, err := r.enforceBackendTLSPolicyStatus(ctx, policy)
+	if err != nil {
+		return ctrl.Result{}, err
+	}
+	if updated {
+		// status update will re-trigger reconciliation
+		return ctrl.Result{}, nil
+	}
+
+	// update the kong Admin API with the changes
+	if err := r.DataplaneClient.UpdateObject(policy); err != nil {
+		return ctrl.Result{}, err
+	}
+	// update reference relationship from the BackendTLSPolicy to the ConfigMaps and Secrets it references.
+	if err := updateReferredObjects(ctx, r.Client, r.ReferenceIndexers, r.DataplaneClient, policy); err != nil {
+		if apierrors.IsNotFound(err) {
+			// reconcile again if the referenced ConfigMap or Secret does not exist yet
+			return ctrl.Result{
+				Requeue: true,
+			}, nil
+		}
+		return ctrl.Result{}, err
+	}
+
+	return ctrl.Result{}, nil
+}
+
+// SetLogger sets the logger.
+func (r *BackendTLSPolicyReconciler) SetLogger(l logr.Logger) {
+	r.Log = l
+}
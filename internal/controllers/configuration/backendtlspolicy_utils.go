@@ -0,0 +1,291 @@
+package configuration
+
+import (
+	"context"
+	"fmt"
+	"sort"
+
+	corev1 "k8s.io/api/core/v1"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	k8stypes "k8s.io/apimachinery/pkg/types"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	gatewaycontroller "github.com/kong/kubernetes-ingress-controller/v3/internal/controllers/gateway"
+	"github.com/kong/kubernetes-ingress-controller/v3/internal/gatewayapi"
+)
+
+// backendTLSPolicyCACertKey is the key of a ConfigMap or a Secret holding the CA certificate bundle referenced
+// by a BackendTLSPolicy.
+const backendTLSPolicyCACertKey = "ca.crt"
+
+// enforceBackendTLSPolicyStatus gets a list of Services (ancestors) targeted by the BackendTLSPolicy along with their
+// desired status and enforces them in the BackendTLSPolicy status.
+func (r *BackendTLSPolicyReconciler) enforceBackendTLSPolicyStatus(
+	ctx context.Context,
+	oldPolicy *gatewayapi.BackendTLSPolicy,
+) (bool, error) {
+	services, err := r.getServicesTargetedByBackendTLSPolicy(ctx, oldPolicy)
+	if err != nil {
+		return false, err
+	}
+
+	ancestorsStatus, err := r.buildBackendTLSPolicyAncestorsStatus(ctx, oldPolicy, services)
+	if err != nil {
+		return false, err
+	}
+
+	newPolicyStatus, err := r.buildBackendTLSPolicyStatus(oldPolicy, ancestorsStatus)
+	if err != nil {
+		return false, err
+	}
+
+	// If the status is not updated, we don't need to patch the BackendTLSPolicy.
+	if isStatusUpdated := isPolicyStatusUpdated(oldPolicy.Status, newPolicyStatus); !isStatusUpdated {
+		newPolicy := oldPolicy.DeepCopy()
+		newPolicy.Status = newPolicyStatus
+		return true, r.Client.Status().Patch(ctx, newPolicy, client.MergeFrom(oldPolicy))
+	}
+	return false, nil
+}
+
+// getServicesTargetedByBackendTLSPolicy returns the existing Services targeted by the BackendTLSPolicy.
+func (r *BackendTLSPolicyReconciler) getServicesTargetedByBackendTLSPolicy(
+	ctx context.Context,
+	policy *gatewayapi.BackendTLSPolicy,
+) ([]corev1.Service, error) {
+	var services []corev1.Service
+	seen := make(map[string]struct{}, len(policy.Spec.TargetRefs))
+	for _, targetRef := range policy.Spec.TargetRefs {
+		if !isBackendTLSPolicyTargetRefService(targetRef) {
+			continue
+		}
+		if _, ok := seen[string(targetRef.Name)]; ok {
+			continue
+		}
+		seen[string(targetRef.Name)] = struct{}{}
+
+		service := corev1.Service{}
+		if err := r.Get(ctx, k8stypes.NamespacedName{
+			Namespace: policy.Namespace,
+			Name:      string(targetRef.Name),
+		}, &service); err != nil {
+			if apierrors.IsNotFound(err) {
+				continue
+			}
+			return nil, fmt.Errorf("failed getting Service %s/%s: %w", policy.Namespace, targetRef.Name, err)
+		}
+		services = append(services, service)
+	}
+	return services, nil
+}
+
+// buildBackendTLSPolicyAncestorsStatus creates a list of Services with their conditions associated.
+func (r *BackendTLSPolicyReconciler) buildBackendTLSPolicyAncestorsStatus(
+	ctx context.Context,
+	policy *gatewayapi.BackendTLSPolicy,
+	services []corev1.Service,
+) ([]ancestorStatus, error) {
+	acceptedCondition := metav1.Condition{
+		Type:               string(gatewayapi.PolicyConditionAccepted),
+		Status:             metav1.ConditionTrue,
+		Reason:             string(gatewayapi.PolicyReasonAccepted),
+		LastTransitionTime: metav1.Now(),
+	}
+	programmedCondition := metav1.Condition{
+		Type:               string(gatewayapi.GatewayConditionProgrammed),
+		Status:             metav1.ConditionTrue,
+		Reason:             string(gatewayapi.GatewayReasonProgrammed),
+		LastTransitionTime: metav1.Now(),
+	}
+
+	invalidMessage, err := r.validateBackendTLSPolicy(ctx, policy)
+	if err != nil {
+		return nil, err
+	}
+	if invalidMessage != "" {
+		acceptedCondition.Status = metav1.ConditionFalse
+		acceptedCondition.Reason = string(gatewayapi.PolicyReasonInvalid)
+		acceptedCondition.Message = invalidMessage
+	}
+
+	// List all policies in the namespace to detect the ones targeting the same Services.
+	policies := &gatewayapi.BackendTLSPolicyList{}
+	if err := r.List(ctx, policies, client.InNamespace(policy.Namespace)); err != nil {
+		return nil, fmt.Errorf("failed listing BackendTLSPolicies: %w", err)
+	}
+
+	ancestorsStatus := make([]ancestorStatus, 0, len(services))
+	for _, service := range services {
+		service := service
+		acceptedCondition := acceptedCondition
+		programmedCondition := programmedCondition
+
+		if acceptedCondition.Status == metav1.ConditionTrue && isBackendTLSPolicyConflicted(policy, policies.Items, service.Name) {
+			acceptedCondition.Status = metav1.ConditionFalse
+			acceptedCondition.Reason = string(gatewayapi.PolicyReasonConflicted)
+			acceptedCondition.Message = "Service is targeted by another BackendTLSPolicy that takes precedence"
+		}
+
+		if acceptedCondition.Status == metav1.ConditionFalse || !r.DataplaneClient.KubernetesObjectIsConfigured(&service) {
+			// If the policy is not accepted or the Service is not configured, we change it to False.
+			programmedCondition.Status = metav1.ConditionFalse
+			programmedCondition.Reason = string(gatewayapi.GatewayReasonPending)
+		}
+
+		ancestorsStatus = append(ancestorsStatus, ancestorStatus{
+			namespacedName: k8stypes.NamespacedName{
+				Namespace: service.Namespace,
+				Name:      service.Name,
+			},
+			ancestorKind:        upstreamPolicyAncestorKindService,
+			acceptedCondition:   acceptedCondition,
+			programmedCondition: programmedCondition,
+			creationTimestamp:   service.CreationTimestamp,
+		})
+	}
+
+	return ancestorsStatus, nil
+}
+
+// validateBackendTLSPolicy verifies that the CA certificates referenced by the BackendTLSPolicy are supported
+// and exist. It returns a message describing the problem if the policy is invalid, or an empty string otherwise.
+func (r *BackendTLSPolicyReconciler) validateBackendTLSPolicy(ctx context.Context, policy *gatewayapi.BackendTLSPolicy) (string, error) {
+	validation := policy.Spec.Validation
+	if validation.WellKnownCACertificates != nil && *validation.WellKnownCACertificates != gatewayapi.WellKnownCACertificatesSystem {
+		return fmt.Sprintf("unsupported wellKnownCACertificates %q", *validation.WellKnownCACertificates), nil
+	}
+
+	for _, ref := range validation.CACertificateRefs {
+		if !isCoreGroup(ref.Group) {
+			return fmt.Sprintf("unsupported group %q of CA certificate reference %s", ref.Group, ref.Name), nil
+		}
+
+		nn := k8stypes.NamespacedName{Namespace: policy.Namespace, Name: string(ref.Name)}
+		var hasCACert bool
+		switch ref.Kind {
+		case "ConfigMap":
+			configMap := &corev1.ConfigMap{}
+			if err := r.Get(ctx, nn, configMap); err != nil {
+				if apierrors.IsNotFound(err) {
+					return fmt.Sprintf("ConfigMap %s not found", ref.Name), nil
+				}
+				return "", err
+			}
+			_, hasCACert = configMap.Data[backendTLSPolicyCACertKey]
+		case "Secret":
+			secret := &corev1.Secret{}
+			if err := r.Get(ctx, nn, secret); err != nil {
+				if apierrors.IsNotFound(err) {
+					return fmt.Sprintf("Secret %s not found", ref.Name), nil
+				}
+				return "", err
+			}
+			_, hasCACert = secret.Data[backendTLSPolicyCACertKey]
+		default:
+			return fmt.Sprintf("unsupported kind %q of CA certificate reference %s, only ConfigMap and Secret are supported", ref.Kind, ref.Name), nil
+		}
+		if !hasCACert {
+			return fmt.Sprintf("%s %s is missing '%s' field in data", ref.Kind, ref.Name, backendTLSPolicyCACertKey), nil
+		}
+	}
+
+	return "", nil
+}
+
+// isBackendTLSPolicyConflicted returns true if another BackendTLSPolicy targets the same Service with the same
+// sectionName and takes precedence over the given one, as it's older (or has a lexicographically lower name).
+func isBackendTLSPolicyConflicted(policy *gatewayapi.BackendTLSPolicy, policies []gatewayapi.BackendTLSPolicy, serviceName string) bool {
+	sectionNames := backendTLSPolicySectionNamesForService(policy, serviceName)
+	for i := range policies {
+		other := &policies[i]
+		if other.Name == policy.Name {
+			continue
+		}
+		otherTakesPrecedence := other.CreationTimestamp.Before(&policy.CreationTimestamp) ||
+			(other.CreationTimestamp.Equal(&policy.CreationTimestamp) && other.Name < policy.Name)
+		if !otherTakesPrecedence {
+			continue
+		}
+		for sectionName := range backendTLSPolicySectionNamesForService(other, serviceName) {
+			if _, ok := sectionNames[sectionName]; ok {
+				return true
+			}
+		}
+	}
+	return false
+}
+
+// backendTLSPolicySectionNamesForService returns the set of sectionNames the BackendTLSPolicy targets the Service
+// with. An empty sectionName represents the whole Service.
+func backendTLSPolicySectionNamesForService(policy *gatewayapi.BackendTLSPolicy, serviceName string) map[string]struct{} {
+	sectionNames := make(map[string]struct{})
+	for _, targetRef := range policy.Spec.TargetRefs {
+		if !isBackendTLSPolicyTargetRefService(targetRef) || string(targetRef.Name) != serviceName {
+			continue
+		}
+		sectionName := ""
+		if targetRef.SectionName != nil {
+			sectionName = string(*targetRef.SectionName)
+		}
+		sectionNames[sectionName] = struct{}{}
+	}
+	return sectionNames
+}
+
+// buildBackendTLSPolicyStatus builds the BackendTLSPolicy status from the ancestors' statuses.
+// It ensures that the number of ancestors is not greater than the maximum allowed by the Gateway API
+// and that the oldest ancestors are kept.
+func (r *BackendTLSPolicyReconciler) buildBackendTLSPolicyStatus(
+	policy *gatewayapi.BackendTLSPolicy,
+	ancestorsStatus []ancestorStatus,
+) (gatewayapi.PolicyStatus, error) {
+	sort.SliceStable(ancestorsStatus, func(i, j int) bool {
+		return ancestorsStatus[i].creationTimestamp.Before(&ancestorsStatus[j].creationTimestamp)
+	})
+	if len(ancestorsStatus) > maxNAncestors {
+		r.Log.Info("status has more ancestors than the Gateway API permits, the newest ones will be ignored",
+			"BackendTLSPolicy", client.ObjectKeyFromObject(policy).String(),
+			"ancestorsCount", len(ancestorsStatus),
+			"maxAllowedAncestors", maxNAncestors,
+		)
+		ancestorsStatus = ancestorsStatus[:maxNAncestors]
+	}
+
+	policyStatus := gatewayapi.PolicyStatus{}
+	if len(ancestorsStatus) > 0 {
+		policyStatus.Ancestors = make([]gatewayapi.PolicyAncestorStatus, 0, len(ancestorsStatus))
+	}
+	for _, ss := range ancestorsStatus {
+		ancestorRef, err := ancestorRef(ss.namespacedName, ss.ancestorKind)
+		if err != nil {
+			return gatewayapi.PolicyStatus{}, fmt.Errorf("failed to build ancestor reference: %w", err)
+		}
+		policyStatus.Ancestors = append(policyStatus.Ancestors,
+			gatewayapi.PolicyAncestorStatus{
+				AncestorRef:    ancestorRef,
+				ControllerName: gatewaycontroller.GetControllerName(),
+				Conditions: []metav1.Condition{
+					ss.acceptedCondition,
+					ss.programmedCondition,
+				},
+			},
+		)
+	}
+
+	return policyStatus, nil
+}
+
+// isBackendTLSPolicyTargetRefService returns true if the targetRef points to a core Service.
+func isBackendTLSPolicyTargetRefService(targetRef gatewayapi.LocalPolicyTargetReferenceWithSectionName) bool {
+	return isCoreGroup(targetRef.Group) && targetRef.Kind == "Service"
+}
+
+// isCoreGroup returns true if the group is the core API group.
+func isCoreGroup(group gatewayapi.Group) bool {
+	return group == "" || group == "core"
+}
+
+func buildCACertificateReference(kind, namespace, name string) string {
+	return fmt.Sprintf("%s/%s/%s", kind, namespace, name)
+}
@@ -0,0 +1,236 @@
+package configuration
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/go-logr/logr"
+	"github.com/google/go-cmp/cmp"
+	"github.com/google/go-cmp/cmp/cmpopts"
+	"github.com/samber/lo"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	k8stypes "k8s.io/apimachinery/pkg/types"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	fakectrlruntimeclient "sigs.k8s.io/controller-runtime/pkg/client/fake"
+
+	gatewaycontroller "github.com/kong/kubernetes-ingress-controller/v3/internal/controllers/gateway"
+	"github.com/kong/kubernetes-ingress-controller/v3/internal/gatewayapi"
+	"github.com/kong/kubernetes-ingress-controller/v3/internal/manager/scheme"
+)
+
+func TestEnforceBackendTLSPolicyStatus(t *testing.T) {
+	const (
+		policyName    = "test-policy"
+		testNamespace = "test"
+	)
+
+	newPolicy := func(name string, created time.Time, caCertRefs ...gatewayapi.LocalObjectReference) gatewayapi.BackendTLSPolicy {
+		return gatewayapi.BackendTLSPolicy{
+			ObjectMeta: metav1.ObjectMeta{
+				Name:              name,
+				Namespace:         testNamespace,
+				CreationTimestamp: metav1.NewTime(created),
+			},
+			Spec: gatewayapi.BackendTLSPolicySpec{
+				TargetRefs: []gatewayapi.LocalPolicyTargetReferenceWithSectionName{
+					{
+						LocalPolicyTargetReference: gatewayapi.LocalPolicyTargetReference{
+							Group: "core",
+							Kind:  "Service",
+							Name:  "svc-1",
+						},
+					},
+					{
+						LocalPolicyTargetReference: gatewayapi.LocalPolicyTargetReference{
+							Group: "core",
+							Kind:  "Service",
+							Name:  "svc-not-existing",
+						},
+					},
+				},
+				Validation: gatewayapi.BackendTLSPolicyValidation{
+					CACertificateRefs: caCertRefs,
+					Hostname:          "example.com",
+				},
+			},
+		}
+	}
+	service := &corev1.Service{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:              "svc-1",
+			Namespace:         testNamespace,
+			CreationTimestamp: metav1.Now(),
+		},
+	}
+	configMapRef := gatewayapi.LocalObjectReference{
+		Kind: "ConfigMap",
+		Name: "ca",
+	}
+	configMap := &corev1.ConfigMap{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      "ca",
+			Namespace: testNamespace,
+		},
+		Data: map[string]string{
+			backendTLSPolicyCACertKey: "certificate",
+		},
+	}
+	expectedStatus := func(accepted, programmed metav1.Condition) gatewayapi.PolicyStatus {
+		return gatewayapi.PolicyStatus{
+			Ancestors: []gatewayapi.PolicyAncestorStatus{
+				{
+					AncestorRef: gatewayapi.ParentReference{
+						Group:     lo.ToPtr(gatewayapi.Group("core")),
+						Kind:      lo.ToPtr(gatewayapi.Kind("Service")),
+						Namespace: lo.ToPtr(gatewayapi.Namespace(testNamespace)),
+						Name:      gatewayapi.ObjectName("svc-1"),
+					},
+					ControllerName: gatewaycontroller.GetControllerName(),
+					Conditions:     []metav1.Condition{accepted, programmed},
+				},
+			},
+		}
+	}
+	acceptedCondition := metav1.Condition{
+		Type:   string(gatewayapi.PolicyConditionAccepted),
+		Status: metav1.ConditionTrue,
+		Reason: string(gatewayapi.PolicyReasonAccepted),
+	}
+	programmedCondition := metav1.Condition{
+		Type:   string(gatewayapi.GatewayConditionProgrammed),
+		Status: metav1.ConditionTrue,
+		Reason: string(gatewayapi.GatewayReasonProgrammed),
+	}
+	pendingCondition := metav1.Condition{
+		Type:   string(gatewayapi.GatewayConditionProgrammed),
+		Status: metav1.ConditionFalse,
+		Reason: string(gatewayapi.GatewayReasonPending),
+	}
+
+	testCases := []struct {
+		name                           string
+		backendTLSPolicy               gatewayapi.BackendTLSPolicy
+		inputObjects                   []client.Object
+		objectsConfiguredInDataPlane   bool
+		expectedBackendTLSPolicyStatus gatewayapi.PolicyStatus
+		updated                        bool
+	}{
+		{
+			name:                           "policy referencing existing ConfigMap, service configured in data plane. Status update.",
+			backendTLSPolicy:               newPolicy(policyName, time.Now(), configMapRef),
+			inputObjects:                   []client.Object{service, configMap},
+			objectsConfiguredInDataPlane:   true,
+			expectedBackendTLSPolicyStatus: expectedStatus(acceptedCondition, programmedCondition),
+			updated:                        true,
+		},
+		{
+			name:                           "policy referencing existing ConfigMap, service not configured in data plane. Status update.",
+			backendTLSPolicy:               newPolicy(policyName, time.Now(), configMapRef),
+			inputObjects:                   []client.Object{service, configMap},
+			objectsConfiguredInDataPlane:   false,
+			expectedBackendTLSPolicyStatus: expectedStatus(acceptedCondition, pendingCondition),
+			updated:                        true,
+		},
+		{
+			name:                         "policy referencing not existing ConfigMap. Status update.",
+			backendTLSPolicy:             newPolicy(policyName, time.Now(), configMapRef),
+			inputObjects:                 []client.Object{service},
+			objectsConfiguredInDataPlane: true,
+			expectedBackendTLSPolicyStatus: expectedStatus(
+				metav1.Condition{
+					Type:    string(gatewayapi.PolicyConditionAccepted),
+					Status:  metav1.ConditionFalse,
+					Reason:  string(gatewayapi.PolicyReasonInvalid),
+					Message: "ConfigMap ca not found",
+				},
+				pendingCondition,
+			),
+			updated: true,
+		},
+		{
+			name: "policy referencing unsupported kind. Status update.",
+			backendTLSPolicy: newPolicy(policyName, time.Now(), gatewayapi.LocalObjectReference{
+				Kind: "Certificate",
+				Name: "ca",
+			}),
+			inputObjects:                 []client.Object{service},
+			objectsConfiguredInDataPlane: true,
+			expectedBackendTLSPolicyStatus: expectedStatus(
+				metav1.Condition{
+					Type:    string(gatewayapi.PolicyConditionAccepted),
+					Status:  metav1.ConditionFalse,
+					Reason:  string(gatewayapi.PolicyReasonInvalid),
+					Message: `unsupported kind "Certificate" of CA certificate reference ca, only ConfigMap and Secret are supported`,
+				},
+				pendingCondition,
+			),
+			updated: true,
+		},
+		{
+			name:             "older policy targeting the same service. Status update.",
+			backendTLSPolicy: newPolicy(policyName, time.Now(), configMapRef),
+			inputObjects: []client.Object{
+				service,
+				configMap,
+				lo.ToPtr(newPolicy("older-policy", time.Now().Add(-time.Hour), configMapRef)),
+			},
+			objectsConfiguredInDataPlane: true,
+			expectedBackendTLSPolicyStatus: expectedStatus(
+				metav1.Condition{
+					Type:    string(gatewayapi.PolicyConditionAccepted),
+					Status:  metav1.ConditionFalse,
+					Reason:  string(gatewayapi.PolicyReasonConflicted),
+					Message: "Service is targeted by another BackendTLSPolicy that takes precedence",
+				},
+				pendingCondition,
+			),
+			updated: true,
+		},
+		{
+			name: "policy status already up to date. No status update.",
+			backendTLSPolicy: func() gatewayapi.BackendTLSPolicy {
+				p := newPolicy(policyName, time.Now(), configMapRef)
+				p.Status = expectedStatus(acceptedCondition, programmedCondition)
+				return p
+			}(),
+			inputObjects:                   []client.Object{service, configMap},
+			objectsConfiguredInDataPlane:   true,
+			expectedBackendTLSPolicyStatus: expectedStatus(acceptedCondition, programmedCondition),
+			updated:                        false,
+		},
+	}
+
+	for _, tc := range testCases {
+		tc := tc
+		t.Run(tc.name, func(t *testing.T) {
+			tc.inputObjects = append(tc.inputObjects, &tc.backendTLSPolicy)
+			fakeClient := fakectrlruntimeclient.
+				NewClientBuilder().
+				WithScheme(lo.Must(scheme.Get())).
+				WithObjects(tc.inputObjects...).
+				WithStatusSubresource(tc.inputObjects...).
+				Build()
+
+			reconciler := BackendTLSPolicyReconciler{
+				Client:          fakeClient,
+				Log:             logr.Discard(),
+				DataplaneClient: DataPlaneStatusClientMock{ObjectsConfigured: tc.objectsConfiguredInDataPlane},
+			}
+
+			updated, err := reconciler.enforceBackendTLSPolicyStatus(context.TODO(), &tc.backendTLSPolicy)
+			require.NoError(t, err)
+			assert.Equal(t, tc.updated, updated)
+			newPolicy := &gatewayapi.BackendTLSPolicy{}
+			require.NoError(t, fakeClient.Get(context.TODO(), k8stypes.NamespacedName{
+				Namespace: tc.backendTLSPolicy.Namespace,
+				Name:      tc.backendTLSPolicy.Name,
+			}, newPolicy))
+			ignoreLastTransitionTime := cmpopts.IgnoreFields(metav1.Condition{}, "LastTransitionTime")
+			assert.Empty(t, cmp.Diff(tc.expectedBackendTLSPolicyStatus, newPolicy.Status, ignoreLastTransitionTime))
+		})
+	}
+}
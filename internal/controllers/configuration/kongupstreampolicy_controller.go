@@ -48,6 +48,9 @@ type KongUpstreamPolicyReconciler struct {
 	// HTTPRouteEnabled determines whether the controller should populate the KongUpstreamPolicy's
 	// ancestor status for Services used in HTTPRoutes.
 	HTTPRouteEnabled bool
+	// BackendLBPolicyEnabled determines whether the controller should populate the KongUpstreamPolicy's
+	// ancestor status for Services targeted by BackendLBPolicies.
+	BackendLBPolicyEnabled bool
 }
 
 // SetupWithManager sets up the controller with the Manager.
@@ -77,6 +80,14 @@ func (r *KongUpstreamPolicyReconciler) SetupWithManager(mgr ctrl.Manager) error
 		)
 	}
 
+	if r.BackendLBPolicyEnabled {
+		// Watch for BackendLBPolicy changes to trigger reconciliation for the KongUpstreamPolicies referenced by
+		// the Services targeted by the BackendLBPolicy, as its session persistence may conflict with them.
+		blder.Watches(&gatewayapi.BackendLBPolicy{},
+			handler.EnqueueRequestsFromMapFunc(r.getUpstreamPoliciesForBackendLBPolicyServices),
+		)
+	}
+
 	if r.KongServiceFacadeEnabled {
 		blder.Watches(&incubatorv1alpha1.KongServiceFacade{},
 			handler.EnqueueRequestsFromMapFunc(r.getUpstreamPolicyForObject),
@@ -134,6 +145,17 @@ func (r *KongUpstreamPolicyReconciler) setupIndices(mgr ctrl.Manager) error {
 		}
 	}
 
+	if r.BackendLBPolicyEnabled {
+		if err := mgr.GetCache().IndexField(
+			context.Background(),
+			&gatewayapi.BackendLBPolicy{},
+			backendLBPolicyTargetServiceIndexKey,
+			indexBackendLBPoliciesOnTargetServices,
+		); err != nil {
+			return fmt.Errorf("failed to index BackendLBPolicies on targetRefs: %w", err)
+		}
+	}
+
 	if r.KongServiceFacadeEnabled {
 		if err := mgr.GetCache().IndexField(
 			context.Background(),
@@ -153,8 +175,9 @@ func (r *KongUpstreamPolicyReconciler) setupIndices(mgr ctrl.Manager) error {
 // -----------------------------------------------------------------------------
 
 const (
-	upstreamPolicyIndexKey             = "upstreamPolicy"
-	routeBackendRefServiceNameIndexKey = "serviceRef"
+	upstreamPolicyIndexKey               = "upstreamPolicy"
+	routeBackendRefServiceNameIndexKey   = "serviceRef"
+	backendLBPolicyTargetServiceIndexKey = "backendLBPolicyTargetService"
 )
 
 // indexServicesOnUpstreamPolicyAnnotation indexes the services on the annotation konghq.com/upstream-policy.
@@ -191,6 +214,23 @@ func indexRoutesOnBackendRefServiceName(o client.Object) []string {
 	return indexes
 }
 
+// indexBackendLBPoliciesOnTargetServices indexes the BackendLBPolicies on the Services they target.
+func indexBackendLBPoliciesOnTargetServices(o client.Object) []string {
+	policy, ok := o.(*gatewayapi.BackendLBPolicy)
+	if !ok {
+		return []string{}
+	}
+
+	var indexes []string
+	for _, targetRef := range policy.Spec.TargetRefs {
+		if !isBackendLBPolicyTargetRefService(targetRef) {
+			continue
+		}
+		indexes = append(indexes, string(buildServiceReference(policy.Namespace, string(targetRef.Name))))
+	}
+	return indexes
+}
+
 // indexServiceFacadesOnUpstreamPolicyAnnotation indexes the KongServiceFacades on the annotation konghq.com/upstream-policy.
 func indexServiceFacadesOnUpstreamPolicyAnnotation(o client.Object) []string {
 	service, ok := o.(*incubatorv1alpha1.KongServiceFacade)
@@ -292,6 +332,35 @@ func (r *KongUpstreamPolicyReconciler) getUpstreamPoliciesForHTTPRouteServices(c
 	return requests
 }
 
+// getUpstreamPoliciesForBackendLBPolicyServices enqueues a new reconcile request for the KongUpstreamPolicies
+// referenced by the Services targeted by a BackendLBPolicy.
+func (r *KongUpstreamPolicyReconciler) getUpstreamPoliciesForBackendLBPolicyServices(ctx context.Context, obj client.Object) []reconcile.Request {
+	policy, ok := obj.(*gatewayapi.BackendLBPolicy)
+	if !ok {
+		return nil
+	}
+	var requests []reconcile.Request
+	for _, targetRef := range policy.Spec.TargetRefs {
+		if !isBackendLBPolicyTargetRefService(targetRef) {
+			continue
+		}
+		service := &corev1.Service{}
+		if err := r.Client.Get(ctx, k8stypes.NamespacedName{
+			Namespace: policy.Namespace,
+			Name:      string(targetRef.Name),
+		}, service); err != nil {
+			if !apierrors.IsNotFound(err) {
+				r.Log.Error(err, "Failed to retrieve Service in watch predicates",
+					"Service", fmt.Sprintf("%s/%s", policy.Namespace, string(targetRef.Name)),
+				)
+			}
+			continue
+		}
+		requests = append(requests, r.getUpstreamPolicyForObject(ctx, service)...)
+	}
+	return requests
+}
+
 // doesObjectReferUpstreamPolicy filters out all the objects not referencing KongUpstreamPolicies.
 func doesObjectReferUpstreamPolicy(obj client.Object) bool {
 	annotations := obj.GetAnnotations()
@@ -309,6 +378,7 @@ func doesObjectReferUpstreamPolicy(obj client.Object) bool {
 // +kubebuilder:rbac:groups=configuration.konghq.com,resources=kongupstreampolicies,verbs=get;list;watch
 // +kubebuilder:rbac:groups=configuration.konghq.com,resources=kongupstreampolicies/status,verbs=get;update;patch
 // +kubebuilder:rbac:groups=gateway.networking.k8s.io,resources=httproutes,verbs=get;list;watch
+// +kubebuilder:rbac:groups=gateway.networking.k8s.io,resources=backendlbpolicies,verbs=get;list;watch
 // +kubebuilder:rbac:groups="",resources=services,verbs=get;list;watch
 // +kubebuilder:rbac:groups=incubator.ingress-controller.konghq.com,resources=kongservicefacades,verbs=get;list;watch
 
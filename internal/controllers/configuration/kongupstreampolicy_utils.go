@@ -14,6 +14,7 @@ import (
 	"sigs.k8s.io/controller-runtime/pkg/client"
 
 	gatewaycontroller "github.com/kong/kubernetes-ingress-controller/v3/internal/controllers/gateway"
+	"github.com/kong/kubernetes-ingress-controller/v3/internal/dataplane/kongstate"
 	"github.com/kong/kubernetes-ingress-controller/v3/internal/gatewayapi"
 	kongv1beta1 "github.com/kong/kubernetes-ingress-controller/v3/pkg/apis/configuration/v1beta1"
 	incubatorv1alpha1 "github.com/kong/kubernetes-ingress-controller/v3/pkg/apis/incubator/v1alpha1"
@@ -69,7 +70,7 @@ func (r *KongUpstreamPolicyReconciler) enforceKongUpstreamPolicyStatus(
 	}
 
 	// Build the status for each ancestor.
-	ancestorsStatus, err := r.buildAncestorsStatus(ctx, services, serviceFacades)
+	ancestorsStatus, err := r.buildAncestorsStatus(ctx, oldPolicy, services, serviceFacades)
 	if err != nil {
 		return false, err
 	}
@@ -132,12 +133,13 @@ func (r *KongUpstreamPolicyReconciler) maybeGetServiceFacadesReferencingUpstream
 // buildAncestorsStatus creates a list of services with their conditions associated.
 func (r *KongUpstreamPolicyReconciler) buildAncestorsStatus(
 	ctx context.Context,
+	policy *kongv1beta1.KongUpstreamPolicy,
 	services []corev1.Service,
 	serviceFacades []incubatorv1alpha1.KongServiceFacade,
 ) ([]ancestorStatus, error) {
 	// Check if any Services have conflicts. We do not verify conflicts for KongServiceFacades as there's
 	// no scenario in which they would have one.
-	conflictedServices, err := r.getConflictedServices(ctx, services)
+	conflictedServices, err := r.getConflictedServices(ctx, policy, services)
 	if err != nil {
 		return nil, err
 	}
@@ -211,32 +213,30 @@ func (r *KongUpstreamPolicyReconciler) buildAncestorsStatus(
 }
 
 // getConflictedServices returns a set of services that have conflicts.
-func (r *KongUpstreamPolicyReconciler) getConflictedServices(ctx context.Context, services []corev1.Service) (servicesSet, error) {
-	// return directly when HTTPRoute is not enabled, as it only check conflicted services in HTTPRoute backends only.
-	if !r.HTTPRouteEnabled {
-		return make(servicesSet), nil
-	}
+func (r *KongUpstreamPolicyReconciler) getConflictedServices(
+	ctx context.Context,
+	policy *kongv1beta1.KongUpstreamPolicy,
+	services []corev1.Service,
+) (servicesSet, error) {
 	// Prepare a mapping for efficient lookups if a Service uses this KongUpstreamPolicy.
 	upstreamPolicyServices := make(servicesSet)
 	for _, service := range services {
 		upstreamPolicyServices[buildServiceReference(service.Namespace, service.Name)] = struct{}{}
 	}
+	conflictsWithSessionPersistence := kongstate.KongUpstreamPolicyConflictsWithSessionPersistence(policy.Spec)
 
 	conflictedServices := make(servicesSet)
 	for serviceKey := range upstreamPolicyServices {
-		// We fetch all the HTTPRoutes that reference this service.
-		httpRoutes := &gatewayapi.HTTPRouteList{}
-		err := r.List(ctx, httpRoutes,
-			client.MatchingFields{
-				routeBackendRefServiceNameIndexKey: string(serviceKey),
-			},
-		)
+		hasConflict, err := r.serviceHasConflictingHTTPRoutes(ctx, upstreamPolicyServices, serviceKey, conflictsWithSessionPersistence)
 		if err != nil {
 			return nil, err
 		}
-		hasConflict := lo.ContainsBy(httpRoutes.Items, func(httpRoute gatewayapi.HTTPRoute) bool {
-			return httpRouteHasUpstreamPolicyConflictedBackendRefsWithService(httpRoute, upstreamPolicyServices, serviceKey)
-		})
+		if !hasConflict && conflictsWithSessionPersistence {
+			hasConflict, err = r.serviceHasBackendLBPolicyWithSessionPersistence(ctx, serviceKey)
+			if err != nil {
+				return nil, err
+			}
+		}
 		if hasConflict {
 			conflictedServices[serviceKey] = struct{}{}
 		}
@@ -244,6 +244,57 @@ func (r *KongUpstreamPolicyReconciler) getConflictedServices(ctx context.Context
 	return conflictedServices, nil
 }
 
+// serviceHasConflictingHTTPRoutes checks whether any HTTPRoute using the service conflicts with the KongUpstreamPolicy,
+// either by mixing it with services not using the same policy or by configuring session persistence. It checks
+// only the backends of HTTPRoutes, so it returns false when HTTPRoute is not enabled.
+func (r *KongUpstreamPolicyReconciler) serviceHasConflictingHTTPRoutes(
+	ctx context.Context,
+	upstreamPolicyServices servicesSet,
+	serviceKey serviceKey,
+	conflictsWithSessionPersistence bool,
+) (bool, error) {
+	if !r.HTTPRouteEnabled {
+		return false, nil
+	}
+	// We fetch all the HTTPRoutes that reference this service.
+	httpRoutes := &gatewayapi.HTTPRouteList{}
+	err := r.List(ctx, httpRoutes,
+		client.MatchingFields{
+			routeBackendRefServiceNameIndexKey: string(serviceKey),
+		},
+	)
+	if err != nil {
+		return false, err
+	}
+	return lo.ContainsBy(httpRoutes.Items, func(httpRoute gatewayapi.HTTPRoute) bool {
+		return httpRouteHasUpstreamPolicyConflictedBackendRefsWithService(httpRoute, upstreamPolicyServices, serviceKey) ||
+			(conflictsWithSessionPersistence && httpRouteHasSessionPersistenceWithService(httpRoute, serviceKey))
+	}), nil
+}
+
+// serviceHasBackendLBPolicyWithSessionPersistence checks whether the service is targeted by a BackendLBPolicy
+// configuring session persistence. It returns false when BackendLBPolicy is not enabled.
+func (r *KongUpstreamPolicyReconciler) serviceHasBackendLBPolicyWithSessionPersistence(
+	ctx context.Context,
+	serviceKey serviceKey,
+) (bool, error) {
+	if !r.BackendLBPolicyEnabled {
+		return false, nil
+	}
+	backendLBPolicies := &gatewayapi.BackendLBPolicyList{}
+	err := r.List(ctx, backendLBPolicies,
+		client.MatchingFields{
+			backendLBPolicyTargetServiceIndexKey: string(serviceKey),
+		},
+	)
+	if err != nil {
+		return false, err
+	}
+	return lo.ContainsBy(backendLBPolicies.Items, func(policy gatewayapi.BackendLBPolicy) bool {
+		return policy.Spec.SessionPersistence != nil
+	}), nil
+}
+
 // httpRouteHasUpstreamPolicyConflictedBackendRefsWithService checks if there's any HTTPRoute's rule that uses multiple backendRefs
 // AND they're not all using the same KongUpstreamPolicy.
 // If so, that means that we have a conflict because we cannot apply multiple KongUpstreamPolicy to the same Kong Service.
@@ -265,6 +316,20 @@ func httpRouteHasUpstreamPolicyConflictedBackendRefsWithService(
 	return hasAnyBackendRefNotUsingSameUpstreamPolicy
 }
 
+// httpRouteHasSessionPersistenceWithService checks if there's any HTTPRoute's rule that uses the given service
+// and configures session persistence. Session persistence is translated to the Kong Upstream's load balancing
+// settings, therefore it conflicts with a KongUpstreamPolicy configuring them too.
+func httpRouteHasSessionPersistenceWithService(httpRoute gatewayapi.HTTPRoute, serviceKey serviceKey) bool {
+	return lo.ContainsBy(httpRoute.Spec.Rules, func(rule gatewayapi.HTTPRouteRule) bool {
+		if rule.SessionPersistence == nil {
+			return false
+		}
+		return lo.ContainsBy(rule.BackendRefs, func(br gatewayapi.HTTPBackendRef) bool {
+			return backendRefToServiceRef(httpRoute.Namespace, br.BackendRef) == serviceKey
+		})
+	})
+}
+
 // getAllBackendRefsUsedWithService returns HTTPRoute's backendRefs that use the given service (excluding the given service).
 func getAllBackendRefsUsedWithService(httpRoute gatewayapi.HTTPRoute, serviceKey serviceKey) []gatewayapi.HTTPBackendRef {
 	var backendRefs []gatewayapi.HTTPBackendRef
@@ -401,6 +466,11 @@ func buildServiceReference(namespace, name string) serviceKey {
 	return serviceKey(fmt.Sprintf("%s/%s", namespace, name))
 }
 
+// isBackendLBPolicyTargetRefService returns true if the BackendLBPolicy's targetRef is a core Service.
+func isBackendLBPolicyTargetRefService(targetRef gatewayapi.LocalPolicyTargetReference) bool {
+	return isCoreGroup(targetRef.Group) && targetRef.Kind == "Service"
+}
+
 func isSupportedHTTPRouteBackendRef(br gatewayapi.BackendRef) bool {
 	groupIsCoreOrNilOrEmpty := br.Group == nil || *br.Group == "core" || *br.Group == ""
 	kindIsServiceOrNil := br.Kind == nil || *br.Kind == "Service"
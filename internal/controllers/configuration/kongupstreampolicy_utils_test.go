@@ -394,6 +394,73 @@ func TestEnforceKongUpstreamPolicyStatus(t *testing.T) {
 			},
 			updated: true,
 		},
+		{
+			name: "service targeted by a BackendLBPolicy with session persistence and a policy configuring its own hashing, conflict",
+			kongUpstreamPolicy: kongv1beta1.KongUpstreamPolicy{
+				ObjectMeta: metav1.ObjectMeta{
+					Name:      policyName,
+					Namespace: testNamespace,
+				},
+				Spec: kongv1beta1.KongUpstreamPolicySpec{
+					Algorithm: lo.ToPtr("least-connections"),
+				},
+			},
+			inputObjects: []client.Object{
+				&corev1.Service{
+					ObjectMeta: metav1.ObjectMeta{
+						Name:      "svc-1",
+						Namespace: testNamespace,
+						Annotations: map[string]string{
+							kongv1beta1.KongUpstreamPolicyAnnotationKey: policyName,
+						},
+					},
+				},
+				&gatewayapi.BackendLBPolicy{
+					ObjectMeta: metav1.ObjectMeta{
+						Name:      "backend-lb-policy",
+						Namespace: testNamespace,
+					},
+					Spec: gatewayapi.BackendLBPolicySpec{
+						TargetRefs: []gatewayapi.LocalPolicyTargetReference{
+							{
+								Kind: "Service",
+								Name: "svc-1",
+							},
+						},
+						SessionPersistence: &gatewayapi.SessionPersistence{
+							SessionName: lo.ToPtr("session"),
+						},
+					},
+				},
+			},
+			objectsConfiguredInDataPlane: true,
+			expectedKongUpstreamPolicyStatus: gatewayapi.PolicyStatus{
+				Ancestors: []gatewayapi.PolicyAncestorStatus{
+					{
+						AncestorRef: gatewayapi.ParentReference{
+							Group:     lo.ToPtr(gatewayapi.Group("core")),
+							Kind:      lo.ToPtr(gatewayapi.Kind("Service")),
+							Namespace: lo.ToPtr(gatewayapi.Namespace(testNamespace)),
+							Name:      gatewayapi.ObjectName("svc-1"),
+						},
+						ControllerName: gatewaycontroller.GetControllerName(),
+						Conditions: []metav1.Condition{
+							{
+								Type:   string(gatewayapi.PolicyConditionAccepted),
+								Status: metav1.ConditionFalse,
+								Reason: string(gatewayapi.PolicyReasonConflicted),
+							},
+							{
+								Type:   string(gatewayapi.GatewayConditionProgrammed),
+								Status: metav1.ConditionFalse,
+								Reason: string(gatewayapi.GatewayReasonPending),
+							},
+						},
+					},
+				},
+			},
+			updated: true,
+		},
 		{
 			name: "2 services referencing different policies in different http route rules, accepted",
 			kongUpstreamPolicy: kongv1beta1.KongUpstreamPolicy{
@@ -691,6 +758,7 @@ func TestEnforceKongUpstreamPolicyStatus(t *testing.T) {
 				WithStatusSubresource(tc.inputObjects...).
 				WithIndex(&corev1.Service{}, upstreamPolicyIndexKey, indexServicesOnUpstreamPolicyAnnotation).
 				WithIndex(&gatewayapi.HTTPRoute{}, routeBackendRefServiceNameIndexKey, indexRoutesOnBackendRefServiceName).
+				WithIndex(&gatewayapi.BackendLBPolicy{}, backendLBPolicyTargetServiceIndexKey, indexBackendLBPoliciesOnTargetServices).
 				WithIndex(&incubatorv1alpha1.KongServiceFacade{}, upstreamPolicyIndexKey, indexServiceFacadesOnUpstreamPolicyAnnotation).
 				Build()
 
@@ -699,6 +767,7 @@ func TestEnforceKongUpstreamPolicyStatus(t *testing.T) {
 				DataplaneClient:          DataPlaneStatusClientMock{ObjectsConfigured: tc.objectsConfiguredInDataPlane},
 				KongServiceFacadeEnabled: true,
 				HTTPRouteEnabled:         true,
+				BackendLBPolicyEnabled:   true,
 			}
 
 			updated, err := reconciler.enforceKongUpstreamPolicyStatus(context.TODO(), &tc.kongUpstreamPolicy)
@@ -867,6 +936,83 @@ func TestHttpRouteHasUpstreamPolicyConflictedBackendRefsWithService(t *testing.T
 	}
 }
 
+func TestHTTPRouteHasSessionPersistenceWithService(t *testing.T) {
+	newHTTPRoute := func(rules ...gatewayapi.HTTPRouteRule) gatewayapi.HTTPRoute {
+		return gatewayapi.HTTPRoute{
+			ObjectMeta: metav1.ObjectMeta{
+				Name:      "httpRoute",
+				Namespace: "default",
+			},
+			Spec: gatewayapi.HTTPRouteSpec{
+				Rules: rules,
+			},
+		}
+	}
+	sessionPersistence := &gatewayapi.SessionPersistence{
+		SessionName: lo.ToPtr("session"),
+	}
+
+	testCases := []struct {
+		name       string
+		httpRoute  gatewayapi.HTTPRoute
+		serviceRef serviceKey
+		expected   bool
+	}{
+		{
+			name:       "service referenced by a rule without session persistence",
+			serviceRef: "default/svc-1",
+			httpRoute: newHTTPRoute(gatewayapi.HTTPRouteRule{
+				BackendRefs: []gatewayapi.HTTPBackendRef{
+					builder.NewHTTPBackendRef("svc-1").Build(),
+				},
+			}),
+			expected: false,
+		},
+		{
+			name:       "service referenced by a rule with session persistence",
+			serviceRef: "default/svc-1",
+			httpRoute: newHTTPRoute(
+				gatewayapi.HTTPRouteRule{
+					BackendRefs: []gatewayapi.HTTPBackendRef{
+						builder.NewHTTPBackendRef("svc-2").Build(),
+					},
+				},
+				gatewayapi.HTTPRouteRule{
+					BackendRefs: []gatewayapi.HTTPBackendRef{
+						builder.NewHTTPBackendRef("svc-1").Build(),
+					},
+					SessionPersistence: sessionPersistence,
+				},
+			),
+			expected: true,
+		},
+		{
+			name:       "service not referenced by a rule with session persistence",
+			serviceRef: "default/svc-1",
+			httpRoute: newHTTPRoute(
+				gatewayapi.HTTPRouteRule{
+					BackendRefs: []gatewayapi.HTTPBackendRef{
+						builder.NewHTTPBackendRef("svc-1").Build(),
+					},
+				},
+				gatewayapi.HTTPRouteRule{
+					BackendRefs: []gatewayapi.HTTPBackendRef{
+						builder.NewHTTPBackendRef("svc-2").Build(),
+					},
+					SessionPersistence: sessionPersistence,
+				},
+			),
+			expected: false,
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			require.Equal(t, tc.expected, httpRouteHasSessionPersistenceWithService(tc.httpRoute, tc.serviceRef))
+		})
+	}
+}
+
 func TestBuildPolicyStatus(t *testing.T) {
 	acceptedCondition := metav1.Condition{
 		Type:   string(gatewayapi.PolicyConditionAccepted),
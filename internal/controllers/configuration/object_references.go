@@ -2,6 +2,7 @@ package configuration
 
 import (
 	"context"
+	"errors"
 
 	"github.com/samber/lo"
 	corev1 "k8s.io/api/core/v1"
@@ -12,18 +13,20 @@ import (
 	"github.com/kong/kubernetes-ingress-controller/v3/internal/annotations"
 	"github.com/kong/kubernetes-ingress-controller/v3/internal/controllers"
 	ctrlref "github.com/kong/kubernetes-ingress-controller/v3/internal/controllers/reference"
+	"github.com/kong/kubernetes-ingress-controller/v3/internal/gatewayapi"
 	kongv1 "github.com/kong/kubernetes-ingress-controller/v3/pkg/apis/configuration/v1"
 	kongv1beta1 "github.com/kong/kubernetes-ingress-controller/v3/pkg/apis/configuration/v1beta1"
 )
 
 // updateReferredObjects updates reference records where the referrer is the object in parameter obj.
-// currently it only updates reference records to secrets, since we wanted to limit cache size of secrets:
+// currently it only updates reference records to secrets and ConfigMaps, since we wanted to limit cache size of them:
 // https://github.com/Kong/kubernetes-ingress-controller/issues/2868
 func updateReferredObjects(
 	ctx context.Context, client client.Client, refIndexers ctrlref.CacheIndexers, dataplaneClient controllers.DataPlane, obj client.Object,
 ) error {
 	referredSecretNameMap := make(map[k8stypes.NamespacedName]struct{})
-	var referredSecretList []k8stypes.NamespacedName
+	referredConfigMapNameMap := make(map[k8stypes.NamespacedName]struct{})
+	var referredSecretList, referredConfigMapList []k8stypes.NamespacedName
 	switch obj := obj.(type) {
 	// functions update***ReferredSecrets first list the secrets referred by object,
 	// then call UpdateReferencesToSecret to store reference records between the object and referred secrets,
@@ -34,18 +37,31 @@ func updateReferredObjects(
 		referredSecretList = listNetV1IngressReferredSecrets(obj)
 	case *kongv1.KongPlugin:
 		referredSecretList = listKongPluginReferredSecrets(obj)
+		referredConfigMapList = listKongPluginReferredConfigMaps(obj)
 	case *kongv1.KongClusterPlugin:
 		referredSecretList = listKongClusterPluginReferredSecrets(obj)
+		referredConfigMapList = listKongClusterPluginReferredConfigMaps(obj)
 	case *kongv1.KongConsumer:
 		referredSecretList = listKongConsumerReferredSecrets(obj)
 	case *kongv1beta1.TCPIngress:
 		referredSecretList = listTCPIngressReferredSecrets(obj)
+	case *gatewayapi.BackendTLSPolicy:
+		referredSecretList = listBackendTLSPolicyReferredSecrets(obj)
+		referredConfigMapList = listBackendTLSPolicyReferredConfigMaps(obj)
 	}
 
 	for _, nsName := range referredSecretList {
 		referredSecretNameMap[nsName] = struct{}{}
 	}
-	return ctrlref.UpdateReferencesToSecret(ctx, client, refIndexers, dataplaneClient, obj, referredSecretNameMap)
+	for _, nsName := range referredConfigMapList {
+		referredConfigMapNameMap[nsName] = struct{}{}
+	}
+	// ConfigMap references are updated even if some of the referred secrets do not exist yet,
+	// so that the ConfigMaps get reconciled as soon as they are created.
+	return errors.Join(
+		ctrlref.UpdateReferencesToSecret(ctx, client, refIndexers, dataplaneClient, obj, referredSecretNameMap),
+		ctrlref.UpdateReferencesToConfigMap(ctx, client, refIndexers, dataplaneClient, obj, referredConfigMapNameMap),
+	)
 }
 
 func listCoreV1ServiceReferredSecrets(service *corev1.Service) []k8stypes.NamespacedName {
@@ -83,7 +99,7 @@ func listNetV1IngressReferredSecrets(ingress *netv1.Ingress) []k8stypes.Namespac
 
 func listKongPluginReferredSecrets(plugin *kongv1.KongPlugin) []k8stypes.NamespacedName {
 	referredSecretNames := make([]k8stypes.NamespacedName, 0, len(plugin.ConfigPatches)+1)
-	if plugin.ConfigFrom != nil {
+	if plugin.ConfigFrom != nil && plugin.ConfigFrom.ConfigMapValue == nil {
 		nsName := k8stypes.NamespacedName{
 			Namespace: plugin.Namespace,
 			Name:      plugin.ConfigFrom.SecretValue.Secret,
@@ -92,6 +108,9 @@ func listKongPluginReferredSecrets(plugin *kongv1.KongPlugin) []k8stypes.Namespa
 	}
 
 	for _, patch := range plugin.ConfigPatches {
+		if patch.ValueFrom.ConfigMapValue != nil {
+			continue
+		}
 		nsName := k8stypes.NamespacedName{
 			Namespace: plugin.Namespace,
 			Name:      patch.ValueFrom.SecretValue.Secret,
@@ -104,7 +123,7 @@ func listKongPluginReferredSecrets(plugin *kongv1.KongPlugin) []k8stypes.Namespa
 
 func listKongClusterPluginReferredSecrets(plugin *kongv1.KongClusterPlugin) []k8stypes.NamespacedName {
 	referredSecretNames := make([]k8stypes.NamespacedName, 0, len(plugin.ConfigPatches)+1)
-	if plugin.ConfigFrom != nil {
+	if plugin.ConfigFrom != nil && plugin.ConfigFrom.ConfigMapValue == nil {
 		nsName := k8stypes.NamespacedName{
 			Namespace: plugin.ConfigFrom.SecretValue.Namespace,
 			Name:      plugin.ConfigFrom.SecretValue.Secret,
@@ -113,6 +132,9 @@ func listKongClusterPluginReferredSecrets(plugin *kongv1.KongClusterPlugin) []k8
 	}
 
 	for _, patch := range plugin.ConfigPatches {
+		if patch.ValueFrom.ConfigMapValue != nil {
+			continue
+		}
 		nsName := k8stypes.NamespacedName{
 			Namespace: patch.ValueFrom.SecretValue.Namespace,
 			Name:      patch.ValueFrom.SecretValue.Secret,
@@ -123,6 +145,54 @@ func listKongClusterPluginReferredSecrets(plugin *kongv1.KongClusterPlugin) []k8
 	return lo.Uniq(referredSecretNames)
 }
 
+func listKongPluginReferredConfigMaps(plugin *kongv1.KongPlugin) []k8stypes.NamespacedName {
+	referredConfigMapNames := make([]k8stypes.NamespacedName, 0, len(plugin.ConfigPatches)+1)
+	if plugin.ConfigFrom != nil && plugin.ConfigFrom.ConfigMapValue != nil {
+		nsName := k8stypes.NamespacedName{
+			Namespace: plugin.Namespace,
+			Name:      plugin.ConfigFrom.ConfigMapValue.ConfigMap,
+		}
+		referredConfigMapNames = append(referredConfigMapNames, nsName)
+	}
+
+	for _, patch := range plugin.ConfigPatches {
+		if patch.ValueFrom.ConfigMapValue == nil {
+			continue
+		}
+		nsName := k8stypes.NamespacedName{
+			Namespace: plugin.Namespace,
+			Name:      patch.ValueFrom.ConfigMapValue.ConfigMap,
+		}
+		referredConfigMapNames = append(referredConfigMapNames, nsName)
+	}
+
+	return lo.Uniq(referredConfigMapNames)
+}
+
+func listKongClusterPluginReferredConfigMaps(plugin *kongv1.KongClusterPlugin) []k8stypes.NamespacedName {
+	referredConfigMapNames := make([]k8stypes.NamespacedName, 0, len(plugin.ConfigPatches)+1)
+	if plugin.ConfigFrom != nil && plugin.ConfigFrom.ConfigMapValue != nil {
+		nsName := k8stypes.NamespacedName{
+			Namespace: plugin.ConfigFrom.ConfigMapValue.Namespace,
+			Name:      plugin.ConfigFrom.ConfigMapValue.ConfigMap,
+		}
+		referredConfigMapNames = append(referredConfigMapNames, nsName)
+	}
+
+	for _, patch := range plugin.ConfigPatches {
+		if patch.ValueFrom.ConfigMapValue == nil {
+			continue
+		}
+		nsName := k8stypes.NamespacedName{
+			Namespace: patch.ValueFrom.ConfigMapValue.Namespace,
+			Name:      patch.ValueFrom.ConfigMapValue.ConfigMap,
+		}
+		referredConfigMapNames = append(referredConfigMapNames, nsName)
+	}
+
+	return lo.Uniq(referredConfigMapNames)
+}
+
 func listKongConsumerReferredSecrets(consumer *kongv1.KongConsumer) []k8stypes.NamespacedName {
 	referredSecretNames := make([]k8stypes.NamespacedName, 0, len(consumer.Credentials))
 	for _, secretName := range consumer.Credentials {
@@ -149,3 +219,33 @@ func listTCPIngressReferredSecrets(tcpIngress *kongv1beta1.TCPIngress) []k8stype
 	}
 	return referredSecretNames
 }
+
+func listBackendTLSPolicyReferredSecrets(policy *gatewayapi.BackendTLSPolicy) []k8stypes.NamespacedName {
+	referredSecretNames := make([]k8stypes.NamespacedName, 0, len(policy.Spec.Validation.CACertificateRefs))
+	for _, ref := range policy.Spec.Validation.CACertificateRefs {
+		if !isCoreGroup(ref.Group) || ref.Kind != "Secret" {
+			continue
+		}
+		nsName := k8stypes.NamespacedName{
+			Namespace: policy.Namespace,
+			Name:      string(ref.Name),
+		}
+		referredSecretNames = append(referredSecretNames, nsName)
+	}
+	return referredSecretNames
+}
+
+func listBackendTLSPolicyReferredConfigMaps(policy *gatewayapi.BackendTLSPolicy) []k8stypes.NamespacedName {
+	referredConfigMapNames := make([]k8stypes.NamespacedName, 0, len(policy.Spec.Validation.CACertificateRefs))
+	for _, ref := range policy.Spec.Validation.CACertificateRefs {
+		if !isCoreGroup(ref.Group) || ref.Kind != "ConfigMap" {
+			continue
+		}
+		nsName := k8stypes.NamespacedName{
+			Namespace: policy.Namespace,
+			Name:      string(ref.Name),
+		}
+		referredConfigMapNames = append(referredConfigMapNames, nsName)
+	}
+	return referredConfigMapNames
+}
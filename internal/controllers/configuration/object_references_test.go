@@ -1,14 +1,21 @@
 package configuration
 
 import (
+	"context"
 	"testing"
 
+	"github.com/go-logr/logr"
 	"github.com/stretchr/testify/require"
 	corev1 "k8s.io/api/core/v1"
 	netv1 "k8s.io/api/networking/v1"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	k8stypes "k8s.io/apimachinery/pkg/types"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	fakectrlruntimeclient "sigs.k8s.io/controller-runtime/pkg/client/fake"
 
+	"github.com/kong/kubernetes-ingress-controller/v3/internal/controllers"
+	ctrlref "github.com/kong/kubernetes-ingress-controller/v3/internal/controllers/reference"
+	"github.com/kong/kubernetes-ingress-controller/v3/internal/gatewayapi"
 	kongv1 "github.com/kong/kubernetes-ingress-controller/v3/pkg/apis/configuration/v1"
 	kongv1beta1 "github.com/kong/kubernetes-ingress-controller/v3/pkg/apis/configuration/v1beta1"
 )
@@ -229,6 +236,138 @@ func TestListKongClusterPluginReferredSecrets(t *testing.T) {
 	}
 }
 
+func TestListKongPluginReferredConfigMaps(t *testing.T) {
+	testCases := []struct {
+		name           string
+		plugin         *kongv1.KongPlugin
+		secretNames    []k8stypes.NamespacedName
+		configMapNames []k8stypes.NamespacedName
+	}{
+		{
+			name: "kong_plugin_refer_config_map_in_config_from",
+			plugin: &kongv1.KongPlugin{
+				ObjectMeta: metav1.ObjectMeta{
+					Namespace: "ns",
+					Name:      "plugin1",
+				},
+				ConfigFrom: &kongv1.ConfigSource{
+					ConfigMapValue: &kongv1.ConfigMapValueFromSource{
+						ConfigMap: "cm1",
+						Key:       "k",
+					},
+				},
+			},
+			secretNames:    []k8stypes.NamespacedName{},
+			configMapNames: []k8stypes.NamespacedName{{Namespace: "ns", Name: "cm1"}},
+		},
+		{
+			name: "kong_plugin_refer_config_maps_and_secrets_in_config_patches",
+			plugin: &kongv1.KongPlugin{
+				ObjectMeta: metav1.ObjectMeta{
+					Namespace: "ns",
+					Name:      "plugin1",
+				},
+				ConfigPatches: []kongv1.ConfigPatch{
+					{
+						Path: "/a",
+						ValueFrom: kongv1.ConfigSource{
+							ConfigMapValue: &kongv1.ConfigMapValueFromSource{ConfigMap: "cm1", Key: "a"},
+						},
+					},
+					{
+						Path: "/b",
+						ValueFrom: kongv1.ConfigSource{
+							ConfigMapValue: &kongv1.ConfigMapValueFromSource{ConfigMap: "cm1", Key: "b"},
+						},
+					},
+					{
+						Path: "/c",
+						ValueFrom: kongv1.ConfigSource{
+							SecretValue: kongv1.SecretValueFromSource{Secret: "secret1", Key: "c"},
+						},
+					},
+				},
+			},
+			secretNames:    []k8stypes.NamespacedName{{Namespace: "ns", Name: "secret1"}},
+			configMapNames: []k8stypes.NamespacedName{{Namespace: "ns", Name: "cm1"}},
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			require.ElementsMatch(t, tc.secretNames, listKongPluginReferredSecrets(tc.plugin))
+			require.ElementsMatch(t, tc.configMapNames, listKongPluginReferredConfigMaps(tc.plugin))
+		})
+	}
+}
+
+func TestListKongClusterPluginReferredConfigMaps(t *testing.T) {
+	testCases := []struct {
+		name           string
+		plugin         *kongv1.KongClusterPlugin
+		secretNames    []k8stypes.NamespacedName
+		configMapNames []k8stypes.NamespacedName
+	}{
+		{
+			name: "kong_cluster_plugin_refer_config_map_in_config_from",
+			plugin: &kongv1.KongClusterPlugin{
+				ObjectMeta: metav1.ObjectMeta{
+					Name: "plugin1",
+				},
+				ConfigFrom: &kongv1.NamespacedConfigSource{
+					ConfigMapValue: &kongv1.NamespacedConfigMapValueFromSource{
+						Namespace: "ns",
+						ConfigMap: "cm1",
+						Key:       "k",
+					},
+				},
+			},
+			secretNames:    []k8stypes.NamespacedName{},
+			configMapNames: []k8stypes.NamespacedName{{Namespace: "ns", Name: "cm1"}},
+		},
+		{
+			name: "kong_cluster_plugin_refer_config_maps_and_secrets_in_config_patches",
+			plugin: &kongv1.KongClusterPlugin{
+				ObjectMeta: metav1.ObjectMeta{
+					Name: "plugin1",
+				},
+				ConfigPatches: []kongv1.NamespacedConfigPatch{
+					{
+						Path: "/a",
+						ValueFrom: kongv1.NamespacedConfigSource{
+							ConfigMapValue: &kongv1.NamespacedConfigMapValueFromSource{Namespace: "ns1", ConfigMap: "cm1", Key: "a"},
+						},
+					},
+					{
+						Path: "/b",
+						ValueFrom: kongv1.NamespacedConfigSource{
+							ConfigMapValue: &kongv1.NamespacedConfigMapValueFromSource{Namespace: "ns2", ConfigMap: "cm1", Key: "b"},
+						},
+					},
+					{
+						Path: "/c",
+						ValueFrom: kongv1.NamespacedConfigSource{
+							SecretValue: kongv1.NamespacedSecretValueFromSource{Namespace: "ns1", Secret: "secret1", Key: "c"},
+						},
+					},
+				},
+			},
+			secretNames: []k8stypes.NamespacedName{{Namespace: "ns1", Name: "secret1"}},
+			configMapNames: []k8stypes.NamespacedName{
+				{Namespace: "ns1", Name: "cm1"},
+				{Namespace: "ns2", Name: "cm1"},
+			},
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			require.ElementsMatch(t, tc.secretNames, listKongClusterPluginReferredSecrets(tc.plugin))
+			require.ElementsMatch(t, tc.configMapNames, listKongClusterPluginReferredConfigMaps(tc.plugin))
+		})
+	}
+}
+
 func TestListKongConsumerReferredSecrets(t *testing.T) {
 	testCases := []struct {
 		name          string
@@ -323,3 +462,62 @@ func TestListTCPIngressReferredSecrets(t *testing.T) {
 		})
 	}
 }
+
+type referredObjectsDataPlaneMock struct {
+	controllers.DataPlane
+	objects map[k8stypes.NamespacedName]struct{}
+}
+
+func (d referredObjectsDataPlaneMock) UpdateObject(obj client.Object) error {
+	d.objects[client.ObjectKeyFromObject(obj)] = struct{}{}
+	return nil
+}
+
+func (d referredObjectsDataPlaneMock) DeleteObject(obj client.Object) error {
+	delete(d.objects, client.ObjectKeyFromObject(obj))
+	return nil
+}
+
+func TestUpdateReferredObjectsBackendTLSPolicyConfigMaps(t *testing.T) {
+	ctx := context.Background()
+	configMaps := []client.Object{
+		&corev1.ConfigMap{ObjectMeta: metav1.ObjectMeta{Namespace: "default", Name: "ca-1"}},
+		&corev1.ConfigMap{ObjectMeta: metav1.ObjectMeta{Namespace: "default", Name: "ca-2"}},
+	}
+	policy := &gatewayapi.BackendTLSPolicy{
+		TypeMeta: metav1.TypeMeta{
+			APIVersion: "gateway.networking.k8s.io/v1alpha3",
+			Kind:       "BackendTLSPolicy",
+		},
+		ObjectMeta: metav1.ObjectMeta{Namespace: "default", Name: "policy"},
+		Spec: gatewayapi.BackendTLSPolicySpec{
+			Validation: gatewayapi.BackendTLSPolicyValidation{
+				CACertificateRefs: []gatewayapi.LocalObjectReference{
+					{Kind: "ConfigMap", Name: "ca-1"},
+					{Kind: "ConfigMap", Name: "ca-2"},
+				},
+			},
+		},
+	}
+
+	fakeClient := fakectrlruntimeclient.NewClientBuilder().WithObjects(configMaps...).Build()
+	indexers := ctrlref.NewCacheIndexers(logr.Discard())
+	dataplaneClient := referredObjectsDataPlaneMock{objects: map[k8stypes.NamespacedName]struct{}{}}
+
+	require.NoError(t, updateReferredObjects(ctx, fakeClient, indexers, dataplaneClient, policy))
+	for _, cm := range configMaps {
+		referred, err := indexers.ObjectReferred(cm)
+		require.NoError(t, err)
+		require.True(t, referred)
+		require.Contains(t, dataplaneClient.objects, client.ObjectKeyFromObject(cm))
+	}
+
+	t.Log("removing the reference to the second ConfigMap from the policy")
+	policy.Spec.Validation.CACertificateRefs = policy.Spec.Validation.CACertificateRefs[:1]
+	require.NoError(t, updateReferredObjects(ctx, fakeClient, indexers, dataplaneClient, policy))
+	referred, err := indexers.ObjectReferred(configMaps[1])
+	require.NoError(t, err)
+	require.False(t, referred, "ConfigMap removed from the policy should not be referred anymore")
+	require.NotContains(t, dataplaneClient.objects, client.ObjectKeyFromObject(configMaps[1]))
+	require.Contains(t, dataplaneClient.objects, client.ObjectKeyFromObject(configMaps[0]))
+}
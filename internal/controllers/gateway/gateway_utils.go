@@ -2,6 +2,7 @@ package gateway
 
 import (
 	"context"
+	"crypto/x509"
 	"encoding/pem"
 	"fmt"
 	"reflect"
@@ -31,6 +32,10 @@ import (
 const (
 	// maxConds is the maximum number of status conditions a Gateway can have at one time.
 	maxConds = 8
+
+	// maxListenerCertificateRefs is the maximum number of certificateRefs of a Gateway listener.
+	// Kong certificates can hold an alternate certificate and key next to the main ones.
+	maxListenerCertificateRefs = 2
 )
 
 // setGatewayCondition sets the condition with specified type in gateway status
@@ -253,7 +258,16 @@ func getListenerStatus(
 		// all the secrets it references
 		if listener.TLS != nil {
 			tlsResolvedRefReason := string(gatewayapi.ListenerReasonResolvedRefs)
+			// Kong certificates hold up to two certificates which must have keys of different types
+			// (e.g. RSA and ECDSA), so that the one supported by the client can be served.
+			if len(listener.TLS.CertificateRefs) > maxListenerCertificateRefs {
+				tlsResolvedRefReason = string(gatewayapi.ListenerReasonInvalidCertificateRef)
+			}
+			keyAlgorithms := make(map[x509.PublicKeyAlgorithm]struct{}, len(listener.TLS.CertificateRefs))
 			for _, certRef := range listener.TLS.CertificateRefs {
+				if tlsResolvedRefReason != string(gatewayapi.ListenerReasonResolvedRefs) {
+					break
+				}
 				// if the certificate is in the same namespace of the gateway, no ReferenceGrant is needed
 				if certRef.Namespace != nil && *certRef.Namespace != (gatewayapi.Namespace)(gateway.Namespace) {
 					// get the result of the certificate reference. If the returned reason is not successful, the loop
@@ -284,7 +298,14 @@ func getListenerStatus(
 				}
 				if !isTLSSecretValid(secret) {
 					tlsResolvedRefReason = string(gatewayapi.ListenerReasonInvalidCertificateRef)
+					break
+				}
+				keyAlgorithm, ok := tlsSecretPublicKeyAlgorithm(secret)
+				if _, seen := keyAlgorithms[keyAlgorithm]; !ok || seen {
+					tlsResolvedRefReason = string(gatewayapi.ListenerReasonInvalidCertificateRef)
+					break
 				}
+				keyAlgorithms[keyAlgorithm] = struct{}{}
 			}
 			if gatewayapi.ListenerConditionReason(tlsResolvedRefReason) != gatewayapi.ListenerReasonResolvedRefs {
 				ResolvedRefsReason = gatewayapi.ListenerConditionReason(tlsResolvedRefReason)
@@ -662,6 +683,20 @@ func isTLSSecretValid(secret *corev1.Secret) bool {
 	return true
 }
 
+// tlsSecretPublicKeyAlgorithm returns the public key algorithm of the certificate stored in a TLS secret.
+// It returns false if the certificate can't be parsed.
+func tlsSecretPublicKeyAlgorithm(secret *corev1.Secret) (x509.PublicKeyAlgorithm, bool) {
+	p, _ := pem.Decode(secret.Data["tls.crt"])
+	if p == nil {
+		return x509.UnknownPublicKeyAlgorithm, false
+	}
+	cert, err := x509.ParseCertificate(p.Bytes)
+	if err != nil {
+		return x509.UnknownPublicKeyAlgorithm, false
+	}
+	return cert.PublicKeyAlgorithm, true
+}
+
 // routeAcceptedByGateways finds all the Gateways the route has been accepted by
 // and returns them in the form of a NamespacedName slice.
 func routeAcceptedByGateways(route *gatewayapi.HTTPRoute,
@@ -7,6 +7,7 @@ import (
 	"github.com/samber/lo"
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
+	corev1 "k8s.io/api/core/v1"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/runtime"
 	k8stypes "k8s.io/apimachinery/pkg/types"
@@ -15,6 +16,7 @@ import (
 
 	"github.com/kong/kubernetes-ingress-controller/v3/internal/gatewayapi"
 	"github.com/kong/kubernetes-ingress-controller/v3/internal/util/builder"
+	"github.com/kong/kubernetes-ingress-controller/v3/test/helpers/certificate"
 )
 
 func TestGetListenerSupportedRouteKinds(t *testing.T) {
@@ -281,6 +283,109 @@ func TestGetListenerStatus(t *testing.T) {
 	}
 }
 
+func TestGetListenerStatus_CertificateRefs(t *testing.T) {
+	ctx := context.Background()
+	scheme := runtime.NewScheme()
+	require.NoError(t, gatewayapi.InstallV1(scheme))
+	require.NoError(t, corev1.AddToScheme(scheme))
+
+	tlsSecret := func(name string, opts ...certificate.SelfSignedCertificateOption) *corev1.Secret {
+		cert, key := certificate.MustGenerateSelfSignedCertPEMFormat(opts...)
+		return &corev1.Secret{
+			ObjectMeta: metav1.ObjectMeta{
+				Namespace: "default",
+				Name:      name,
+			},
+			Data: map[string][]byte{
+				"tls.crt": cert,
+				"tls.key": key,
+			},
+		}
+	}
+	client := fake.NewClientBuilder().WithScheme(scheme).WithObjects(
+		tlsSecret("rsa-1"),
+		tlsSecret("rsa-2"),
+		tlsSecret("ecdsa", certificate.WithECDSAKey()),
+	).Build()
+
+	testCases := []struct {
+		name                   string
+		certificateRefs        []string
+		expectedResolvedStatus metav1.ConditionStatus
+	}{
+		{
+			name:                   "single certificate",
+			certificateRefs:        []string{"rsa-1"},
+			expectedResolvedStatus: metav1.ConditionTrue,
+		},
+		{
+			name:                   "RSA and ECDSA certificates",
+			certificateRefs:        []string{"rsa-1", "ecdsa"},
+			expectedResolvedStatus: metav1.ConditionTrue,
+		},
+		{
+			name:                   "two RSA certificates",
+			certificateRefs:        []string{"rsa-1", "rsa-2"},
+			expectedResolvedStatus: metav1.ConditionFalse,
+		},
+		{
+			name:                   "more than two certificates",
+			certificateRefs:        []string{"rsa-1", "ecdsa", "rsa-2"},
+			expectedResolvedStatus: metav1.ConditionFalse,
+		},
+		{
+			name:                   "one of certificates does not exist",
+			certificateRefs:        []string{"rsa-1", "missing"},
+			expectedResolvedStatus: metav1.ConditionFalse,
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			gateway := &gatewayapi.Gateway{
+				TypeMeta: gatewayapi.V1GatewayTypeMeta,
+				ObjectMeta: metav1.ObjectMeta{
+					Namespace: "default",
+					Name:      "gateway",
+				},
+				Spec: gatewayapi.GatewaySpec{
+					GatewayClassName: "kong",
+					Listeners: []gatewayapi.Listener{
+						{
+							Name:     "https-443",
+							Port:     443,
+							Protocol: gatewayapi.HTTPSProtocolType,
+							TLS: &gatewayapi.GatewayTLSConfig{
+								CertificateRefs: lo.Map(tc.certificateRefs, func(name string, _ int) gatewayapi.SecretObjectReference {
+									return gatewayapi.SecretObjectReference{Name: gatewayapi.ObjectName(name)}
+								}),
+							},
+						},
+					},
+				},
+			}
+			kongListens := []gatewayapi.Listener{
+				{
+					Port:     443,
+					Protocol: gatewayapi.HTTPSProtocolType,
+				},
+			}
+
+			statuses, err := getListenerStatus(ctx, gateway, kongListens, nil, client)
+			require.NoError(t, err)
+			require.Len(t, statuses, 1)
+			resolvedRefs, ok := lo.Find(statuses[0].Conditions, func(c metav1.Condition) bool {
+				return c.Type == string(gatewayapi.ListenerConditionResolvedRefs)
+			})
+			require.True(t, ok, "ResolvedRefs condition should be set")
+			assert.Equal(t, tc.expectedResolvedStatus, resolvedRefs.Status)
+			if tc.expectedResolvedStatus == metav1.ConditionFalse {
+				assert.Equal(t, string(gatewayapi.ListenerReasonInvalidCertificateRef), resolvedRefs.Reason)
+			}
+		})
+	}
+}
+
 func assertOnlyOneConditionForType(t *testing.T, conditions []metav1.Condition) {
 	conditionsNum := lo.CountValuesBy(conditions, func(c metav1.Condition) string {
 		return c.Type
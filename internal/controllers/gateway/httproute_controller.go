@@ -13,6 +13,7 @@ import (
 	"github.com/samber/lo"
 	corev1 "k8s.io/api/core/v1"
 	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/apimachinery/pkg/api/meta"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/runtime"
 	"k8s.io/apimachinery/pkg/runtime/schema"
@@ -32,9 +33,12 @@ import (
 	"github.com/kong/kubernetes-ingress-controller/v3/internal/controllers"
 	ctrlutils "github.com/kong/kubernetes-ingress-controller/v3/internal/controllers/utils"
 	"github.com/kong/kubernetes-ingress-controller/v3/internal/gatewayapi"
+	"github.com/kong/kubernetes-ingress-controller/v3/internal/hostownership"
+	mcsv1alpha1 "github.com/kong/kubernetes-ingress-controller/v3/internal/mcsapi/v1alpha1"
 	"github.com/kong/kubernetes-ingress-controller/v3/internal/util"
 	k8sobj "github.com/kong/kubernetes-ingress-controller/v3/internal/util/kubernetes/object"
 	"github.com/kong/kubernetes-ingress-controller/v3/internal/util/kubernetes/object/status"
+	incubatorv1alpha1 "github.com/kong/kubernetes-ingress-controller/v3/pkg/apis/incubator/v1alpha1"
 )
 
 // -----------------------------------------------------------------------------
@@ -57,9 +61,19 @@ type HTTPRouteReconciler struct {
 	// It's resolved on SetupWithManager call.
 	enableReferenceGrant bool
 
+	// KongServiceFacadeEnabled determines whether KongServiceFacades are supported as backendRefs.
+	KongServiceFacadeEnabled bool
+
+	// ServiceImportEnabled determines whether ServiceImports are supported as backendRefs.
+	ServiceImportEnabled bool
+
 	// If GatewayNN is set,
 	// only resources managed by the specified Gateway are reconciled.
 	GatewayNN controllers.OptionalNamespacedName
+
+	// HostnameOwnershipStorer is set when the HostnameOwnership feature is enabled. It's used to report HTTPRoutes
+	// routing hostnames owned by other namespaces in their Programmed condition.
+	HostnameOwnershipStorer hostownership.Storer
 }
 
 // SetupWithManager sets up the controller with the Manager.
@@ -486,10 +500,17 @@ func (r *HTTPRouteReconciler) Reconcile(ctx context.Context, req ctrl.Request) (
 
 		if configurationStatus == k8sobj.ConfigurationStatusFailed {
 			debug(log, httproute, "HTTPRoute configuration failed")
-			statusUpdated, err := ensureParentsProgrammedCondition(ctx, r.Status(), httproute, httproute.Status.Parents, gateways, metav1.Condition{
+			programmedCondition := metav1.Condition{
 				Status: metav1.ConditionFalse,
 				Reason: string(ConditionReasonTranslationError),
-			})
+			}
+			if filteredHTTPRoute != nil {
+				if err := r.validateHostnameOwnership(filteredHTTPRoute); err != nil {
+					programmedCondition.Reason = string(ConditionReasonHostnameOwnedByAnotherNamespace)
+					programmedCondition.Message = err.Error()
+				}
+			}
+			statusUpdated, err := ensureParentsProgrammedCondition(ctx, r.Status(), httproute, httproute.Status.Parents, gateways, programmedCondition)
 			if err != nil {
 				// don't proceed until the statuses can be updated appropriately
 				debug(log, httproute, "Failed to update programmed condition")
@@ -672,28 +693,47 @@ func (r *HTTPRouteReconciler) setRouteConditionResolvedRefsCondition(
 
 func (r *HTTPRouteReconciler) getHTTPRouteRuleReason(ctx context.Context, httpRoute gatewayapi.HTTPRoute) (gatewayapi.RouteConditionReason, error) {
 	for _, rule := range httpRoute.Spec.Rules {
-		for _, backendRef := range rule.BackendRefs {
+		for i, backendRef := range slices.Concat(rule.BackendRefs, requestMirrorBackendRefs(rule.Filters)) {
 			backendNamespace := httpRoute.Namespace
 			if backendRef.Namespace != nil && *backendRef.Namespace != "" {
 				backendNamespace = string(*backendRef.Namespace)
 			}
 
-			// Check if the BackendRef GroupKind is supported
-			if !util.IsBackendRefGroupKindSupported(backendRef.Group, backendRef.Kind) {
+			// Check if the BackendRef GroupKind is supported. Only the rule's backendRefs can be KongServiceFacades
+			// or ServiceImports, requests can be mirrored only to Services.
+			isRuleBackendRef := i < len(rule.BackendRefs)
+			isServiceFacade := r.KongServiceFacadeEnabled && isRuleBackendRef &&
+				util.IsBackendRefKongServiceFacade(backendRef.Group, backendRef.Kind)
+			isServiceImport := r.ServiceImportEnabled && isRuleBackendRef &&
+				util.IsBackendRefServiceImport(backendRef.Group, backendRef.Kind)
+			if !isServiceFacade && !isServiceImport && !util.IsBackendRefGroupKindSupported(backendRef.Group, backendRef.Kind) {
 				return gatewayapi.RouteReasonInvalidKind, nil
 			}
 
 			// Check if all the objects referenced actually exist
-			// Only services are currently supported as BackendRef objects
-			service := &corev1.Service{}
-			err := r.Client.Get(ctx, k8stypes.NamespacedName{Namespace: backendNamespace, Name: string(backendRef.Name)}, service)
+			var backend client.Object = &corev1.Service{}
+			switch {
+			case isServiceFacade:
+				backend = &incubatorv1alpha1.KongServiceFacade{}
+			case isServiceImport:
+				backend = &mcsv1alpha1.ServiceImport{}
+			}
+			err := r.Client.Get(ctx, k8stypes.NamespacedName{Namespace: backendNamespace, Name: string(backendRef.Name)}, backend)
 			if err != nil {
-				if !apierrors.IsNotFound(err) {
+				// ServiceImports can't be found either when the Multi-Cluster Services API CRDs are not installed.
+				if !apierrors.IsNotFound(err) && !meta.IsNoMatchError(err) {
 					return "", err
 				}
 				return gatewayapi.RouteReasonBackendNotFound, nil
 			}
 
+			// Requests are mirrored over plain HTTP only.
+			if !isRuleBackendRef {
+				if err := util.ValidateServicePortServesHTTP(backend.(*corev1.Service), backendRef.Port); err != nil {
+					return gatewayapi.RouteReasonUnsupportedProtocol, nil
+				}
+			}
+
 			// Check if the object referenced is in another namespace,
 			// and if there is grant for that reference
 			if httpRoute.Namespace != backendNamespace {
@@ -724,6 +764,37 @@ func (r *HTTPRouteReconciler) getHTTPRouteRuleReason(ctx context.Context, httpRo
 	return gatewayapi.RouteReasonResolvedRefs, nil
 }
 
+// requestMirrorBackendRefs returns the backends of the RequestMirror filters as HTTPBackendRefs, so they can be
+// resolved the same way as the rule's backendRefs.
+func requestMirrorBackendRefs(filters []gatewayapi.HTTPRouteFilter) []gatewayapi.HTTPBackendRef {
+	var backendRefs []gatewayapi.HTTPBackendRef
+	for _, filter := range filters {
+		if filter.Type != gatewayapi.HTTPRouteFilterRequestMirror || filter.RequestMirror == nil {
+			continue
+		}
+		backendRefs = append(backendRefs, gatewayapi.HTTPBackendRef{
+			BackendRef: gatewayapi.BackendRef{
+				BackendObjectReference: filter.RequestMirror.BackendRef,
+			},
+		})
+	}
+	return backendRefs
+}
+
+// validateHostnameOwnership returns an error when the HostnameOwnership feature is enabled and the HTTPRoute routes
+// hostnames owned by other namespaces. Hostname owners are determined the same way as in translation.
+func (r *HTTPRouteReconciler) validateHostnameOwnership(httproute *gatewayapi.HTTPRoute) error {
+	if r.HostnameOwnershipStorer == nil {
+		return nil
+	}
+	owners, err := hostownership.NewOwnersFromStore(r.HostnameOwnershipStorer)
+	if err != nil {
+		r.Log.Error(err, "Failed to determine hostname owners")
+		return nil
+	}
+	return owners.Validate(httproute)
+}
+
 // SetLogger sets the logger.
 func (r *HTTPRouteReconciler) SetLogger(l logr.Logger) {
 	r.Log = l
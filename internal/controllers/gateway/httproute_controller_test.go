@@ -1,12 +1,25 @@
 package gateway
 
 import (
+	"context"
 	"testing"
+	"time"
 
+	"github.com/go-logr/logr"
 	"github.com/samber/lo"
 	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	fakeclient "sigs.k8s.io/controller-runtime/pkg/client/fake"
 
+	"github.com/kong/kubernetes-ingress-controller/v3/internal/annotations"
 	"github.com/kong/kubernetes-ingress-controller/v3/internal/gatewayapi"
+	"github.com/kong/kubernetes-ingress-controller/v3/internal/manager/scheme"
+	mcsv1alpha1 "github.com/kong/kubernetes-ingress-controller/v3/internal/mcsapi/v1alpha1"
+	"github.com/kong/kubernetes-ingress-controller/v3/internal/store"
+	"github.com/kong/kubernetes-ingress-controller/v3/internal/util/builder"
 )
 
 func TestEnsureNoStaleParentStatus(t *testing.T) {
@@ -225,3 +238,189 @@ func TestParentReferenceKey(t *testing.T) {
 		})
 	}
 }
+
+func TestHTTPRouteReconciler_ValidateHostnameOwnership(t *testing.T) {
+	now := time.Now()
+	newHTTPRoute := func(namespace string, created time.Time) *gatewayapi.HTTPRoute {
+		return &gatewayapi.HTTPRoute{
+			ObjectMeta: metav1.ObjectMeta{
+				Namespace:         namespace,
+				Name:              "api",
+				CreationTimestamp: metav1.NewTime(created),
+			},
+			Spec: gatewayapi.HTTPRouteSpec{
+				Hostnames: []gatewayapi.Hostname{"api.example.com"},
+			},
+		}
+	}
+	owner := newHTTPRoute("team-a", now)
+	other := newHTTPRoute("team-b", now.Add(time.Minute))
+	s, err := store.NewFakeStore(store.FakeObjects{
+		HTTPRoutes: []*gatewayapi.HTTPRoute{owner, other},
+	})
+	require.NoError(t, err)
+
+	t.Run("feature disabled", func(t *testing.T) {
+		r := &HTTPRouteReconciler{Log: logr.Discard()}
+		require.NoError(t, r.validateHostnameOwnership(other))
+	})
+
+	t.Run("feature enabled", func(t *testing.T) {
+		r := &HTTPRouteReconciler{Log: logr.Discard(), HostnameOwnershipStorer: s}
+		require.NoError(t, r.validateHostnameOwnership(owner))
+		require.Error(t, r.validateHostnameOwnership(other))
+	})
+}
+
+func TestHTTPRouteReconciler_GetHTTPRouteRuleReason_ServiceImport(t *testing.T) {
+	httpRoute := gatewayapi.HTTPRoute{
+		ObjectMeta: metav1.ObjectMeta{
+			Namespace: "default",
+			Name:      "httproute",
+		},
+		Spec: gatewayapi.HTTPRouteSpec{
+			Rules: []gatewayapi.HTTPRouteRule{
+				{
+					BackendRefs: []gatewayapi.HTTPBackendRef{
+						{
+							BackendRef: builder.NewBackendRef("svc").
+								WithGroup(mcsv1alpha1.GroupVersion.Group).
+								WithKind(mcsv1alpha1.ServiceImportKind).
+								WithPort(80).
+								Build(),
+						},
+					},
+				},
+			},
+		},
+	}
+	serviceImport := &mcsv1alpha1.ServiceImport{
+		ObjectMeta: metav1.ObjectMeta{
+			Namespace: "default",
+			Name:      "svc",
+		},
+	}
+	// A Service with the same name as the ServiceImport must not make the ServiceImport backendRef resolved.
+	service := &corev1.Service{
+		ObjectMeta: metav1.ObjectMeta{
+			Namespace: "default",
+			Name:      "svc",
+		},
+	}
+
+	testCases := []struct {
+		name                 string
+		serviceImportEnabled bool
+		objects              []client.Object
+		expectedReason       gatewayapi.RouteConditionReason
+	}{
+		{
+			name:                 "existing ServiceImport",
+			serviceImportEnabled: true,
+			objects:              []client.Object{serviceImport},
+			expectedReason:       gatewayapi.RouteReasonResolvedRefs,
+		},
+		{
+			name:                 "missing ServiceImport",
+			serviceImportEnabled: true,
+			objects:              []client.Object{service},
+			expectedReason:       gatewayapi.RouteReasonBackendNotFound,
+		},
+		{
+			name:                 "ServiceImport backendRefs disabled",
+			serviceImportEnabled: false,
+			objects:              []client.Object{serviceImport},
+			expectedReason:       gatewayapi.RouteReasonInvalidKind,
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			s, err := scheme.Get()
+			require.NoError(t, err)
+			r := &HTTPRouteReconciler{
+				Client:               fakeclient.NewClientBuilder().WithScheme(s).WithObjects(tc.objects...).Build(),
+				ServiceImportEnabled: tc.serviceImportEnabled,
+			}
+
+			reason, err := r.getHTTPRouteRuleReason(context.Background(), httpRoute)
+			require.NoError(t, err)
+			require.Equal(t, tc.expectedReason, reason)
+		})
+	}
+}
+
+func TestHTTPRouteReconciler_GetHTTPRouteRuleReason_RequestMirror(t *testing.T) {
+	httpRoute := gatewayapi.HTTPRoute{
+		ObjectMeta: metav1.ObjectMeta{
+			Namespace: "default",
+			Name:      "httproute",
+		},
+		Spec: gatewayapi.HTTPRouteSpec{
+			Rules: []gatewayapi.HTTPRouteRule{
+				{
+					Filters: []gatewayapi.HTTPRouteFilter{
+						{
+							Type: gatewayapi.HTTPRouteFilterRequestMirror,
+							RequestMirror: &gatewayapi.HTTPRequestMirrorFilter{
+								BackendRef: builder.NewBackendRef("mirror").WithPort(80).Build().BackendObjectReference,
+							},
+						},
+					},
+				},
+			},
+		},
+	}
+	mirrorService := func(anns map[string]string, port corev1.ServicePort) *corev1.Service {
+		return &corev1.Service{
+			ObjectMeta: metav1.ObjectMeta{
+				Namespace:   "default",
+				Name:        "mirror",
+				Annotations: anns,
+			},
+			Spec: corev1.ServiceSpec{Ports: []corev1.ServicePort{port}},
+		}
+	}
+
+	testCases := []struct {
+		name           string
+		objects        []client.Object
+		expectedReason gatewayapi.RouteConditionReason
+	}{
+		{
+			name:           "HTTP Service",
+			objects:        []client.Object{mirrorService(nil, corev1.ServicePort{Port: 80})},
+			expectedReason: gatewayapi.RouteReasonResolvedRefs,
+		},
+		{
+			name:           "missing Service",
+			expectedReason: gatewayapi.RouteReasonBackendNotFound,
+		},
+		{
+			name: "HTTPS Service",
+			objects: []client.Object{mirrorService(map[string]string{
+				annotations.AnnotationPrefix + annotations.ProtocolKey: "https",
+			}, corev1.ServicePort{Port: 80})},
+			expectedReason: gatewayapi.RouteReasonUnsupportedProtocol,
+		},
+		{
+			name:           "h2c Service port",
+			objects:        []client.Object{mirrorService(nil, corev1.ServicePort{Port: 80, AppProtocol: lo.ToPtr("kubernetes.io/h2c")})},
+			expectedReason: gatewayapi.RouteReasonUnsupportedProtocol,
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			s, err := scheme.Get()
+			require.NoError(t, err)
+			r := &HTTPRouteReconciler{
+				Client: fakeclient.NewClientBuilder().WithScheme(s).WithObjects(tc.objects...).Build(),
+			}
+
+			reason, err := r.getHTTPRouteRuleReason(context.Background(), httpRoute)
+			require.NoError(t, err)
+			require.Equal(t, tc.expectedReason, reason)
+		})
+	}
+}
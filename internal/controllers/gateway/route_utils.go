@@ -31,6 +31,9 @@ const (
 	ConditionReasonProgrammedUnknown   gatewayapi.RouteConditionReason = "Unknown"
 	ConditionReasonConfiguredInGateway gatewayapi.RouteConditionReason = "ConfiguredInGateway"
 	ConditionReasonTranslationError    gatewayapi.RouteConditionReason = "TranslationError"
+	// ConditionReasonHostnameOwnedByAnotherNamespace is the reason of the Programmed condition of routes that were not
+	// translated because they route hostnames owned by other namespaces (see the HostnameOwnership feature gate).
+	ConditionReasonHostnameOwnedByAnotherNamespace gatewayapi.RouteConditionReason = "HostnameOwnedByAnotherNamespace"
 )
 
 var (
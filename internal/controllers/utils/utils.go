@@ -9,6 +9,7 @@ import (
 	"sigs.k8s.io/controller-runtime/pkg/predicate"
 
 	"github.com/kong/kubernetes-ingress-controller/v3/internal/annotations"
+	kongv1alpha1 "github.com/kong/kubernetes-ingress-controller/v3/pkg/apis/configuration/v1alpha1"
 )
 
 const defaultIngressClassAnnotation = "ingressclass.kubernetes.io/is-default-class"
@@ -32,6 +33,11 @@ func MatchesIngressClass(obj client.Object, controllerIngressClass string, isDef
 			return true
 		}
 	}
+	if entity, isCustomEntity := obj.(*kongv1alpha1.KongCustomEntity); isCustomEntity {
+		if entity.Spec.ControllerName != "" && entity.Spec.ControllerName == controllerIngressClass {
+			return true
+		}
+	}
 	return objectIngressClass == controllerIngressClass
 }
 
@@ -60,6 +66,12 @@ func IsIngressClassEmpty(obj client.Object) bool {
 			return obj.Spec.IngressClassName == nil
 		}
 		return false
+	case *kongv1alpha1.KongCustomEntity:
+		// KongCustomEntity specifies the ingress class in its spec.controllerName in addition to the annotation.
+		if _, ok := obj.GetAnnotations()[annotations.IngressClassKey]; !ok {
+			return obj.Spec.ControllerName == ""
+		}
+		return false
 	default:
 		if _, ok := obj.GetAnnotations()[annotations.IngressClassKey]; ok {
 			return false
@@ -0,0 +1,185 @@
+package dataplane
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"slices"
+	"strings"
+	"time"
+
+	"github.com/go-logr/logr"
+	"github.com/samber/lo"
+	"github.com/samber/mo"
+
+	"github.com/kong/kubernetes-ingress-controller/v3/internal/adminapi"
+	"github.com/kong/kubernetes-ingress-controller/v3/internal/dataplane/kongstate"
+	"github.com/kong/kubernetes-ingress-controller/v3/internal/dataplane/sendconfig"
+)
+
+// CanaryHealthChecker checks health of canary gateways in a staged configuration rollout.
+type CanaryHealthChecker interface {
+	// Baseline collects the state of a canary gateway before it receives the new configuration.
+	Baseline(ctx context.Context, client *adminapi.Client) CanaryBaseline
+
+	// Check returns an error if a canary gateway is considered unhealthy after it received the new configuration.
+	Check(ctx context.Context, client *adminapi.Client, baseline CanaryBaseline) error
+}
+
+// CanaryBaseline is the state of a canary gateway before it receives the new configuration.
+type CanaryBaseline struct {
+	// RequestCounts are the numbers of HTTP requests served by the gateway. It's empty when the gateway
+	// doesn't expose HTTP request metrics.
+	RequestCounts mo.Option[adminapi.HTTPRequestCounts]
+}
+
+// DefaultCanaryHealthChecker considers a canary gateway healthy if its status endpoint responds and, in case it
+// exposes HTTP request metrics, the ratio of requests responded with 5xx status codes to all requests it served
+// since the baseline was collected doesn't exceed the maximum error rate.
+type DefaultCanaryHealthChecker struct {
+	logger       logr.Logger
+	maxErrorRate float64
+}
+
+// NewDefaultCanaryHealthChecker creates a new DefaultCanaryHealthChecker.
+func NewDefaultCanaryHealthChecker(logger logr.Logger, maxErrorRate float64) *DefaultCanaryHealthChecker {
+	return &DefaultCanaryHealthChecker{
+		logger:       logger,
+		maxErrorRate: maxErrorRate,
+	}
+}
+
+func (h *DefaultCanaryHealthChecker) Baseline(ctx context.Context, client *adminapi.Client) CanaryBaseline {
+	counts, err := client.HTTPRequestCounts(ctx)
+	if err != nil {
+		if !errors.Is(err, adminapi.ErrHTTPRequestMetricsUnavailable) {
+			h.logger.Error(err, "Failed to collect HTTP request metrics of canary gateway, its error rate won't be checked",
+				"url", client.BaseRootURL())
+		}
+		return CanaryBaseline{}
+	}
+	return CanaryBaseline{RequestCounts: mo.Some(counts)}
+}
+
+func (h *DefaultCanaryHealthChecker) Check(ctx context.Context, client *adminapi.Client, baseline CanaryBaseline) error {
+	if err := client.IsReady(ctx); err != nil {
+		return fmt.Errorf("canary gateway %s is not ready: %w", client.BaseRootURL(), err)
+	}
+
+	before, ok := baseline.RequestCounts.Get()
+	if !ok {
+		return nil
+	}
+	after, err := client.HTTPRequestCounts(ctx)
+	if err != nil {
+		return fmt.Errorf("failed to collect HTTP request metrics of canary gateway %s: %w", client.BaseRootURL(), err)
+	}
+	total := after.Total - before.Total
+	// No requests were served in the meantime or the counters were reset by a restart of the gateway,
+	// either way there's nothing to judge the error rate by.
+	if total <= 0 {
+		return nil
+	}
+	if errorRate := (after.ServerErrors - before.ServerErrors) / total; errorRate > h.maxErrorRate {
+		return fmt.Errorf("canary gateway %s error rate %.3f exceeds the maximum of %.3f", client.BaseRootURL(), errorRate, h.maxErrorRate)
+	}
+	return nil
+}
+
+// sendToGatewayClients sends the configuration to the given gateway clients. If staged rollout is enabled,
+// the configuration is pushed to the canary gateways first and to the rest only if the canaries are healthy.
+// Otherwise, it's pushed to all the gateways concurrently.
+func (c *KongClient) sendToGatewayClients(
+	ctx context.Context,
+	gatewayClients []*adminapi.Client,
+	s *kongstate.KongState,
+	config sendconfig.Config,
+	isFallback bool,
+) ([]string, error) {
+	canaries, rest := c.splitCanaryGatewayClients(gatewayClients, config, isFallback)
+	if len(canaries) == 0 {
+		return c.sendToGatewayClientsConcurrently(ctx, gatewayClients, s, config, isFallback)
+	}
+
+	logger := c.logger.WithValues("canary_urls", lo.Map(canaries, func(cl *adminapi.Client, _ int) string { return cl.BaseRootURL() }))
+	baselines := lo.Map(canaries, func(cl *adminapi.Client, _ int) CanaryBaseline {
+		return c.canaryHealthChecker.Baseline(ctx, cl)
+	})
+	previousSHAs := lo.Map(canaries, func(cl *adminapi.Client, _ int) string { return string(cl.LastConfigSHA()) })
+	canarySHAs, err := c.sendToGatewayClientsConcurrently(ctx, canaries, s, config, isFallback)
+	if err != nil {
+		return nil, err
+	}
+
+	// Canaries are only checked when they actually received a new configuration.
+	if !slices.Equal(previousSHAs, canarySHAs) {
+		logger.Info("Configuration pushed to canary gateways, waiting before checking their health", "delay", config.CanaryRollout.HealthCheckDelay)
+		select {
+		case <-ctx.Done():
+			return nil, ctx.Err()
+		case <-time.After(config.CanaryRollout.HealthCheckDelay):
+		}
+		var errs error
+		for i, cl := range canaries {
+			errs = errors.Join(errs, c.canaryHealthChecker.Check(ctx, cl, baselines[i]))
+		}
+		if errs != nil {
+			err := fmt.Errorf("canary gateways are unhealthy, configuration was not pushed to the remaining gateways: %w", errs)
+			if rollbackErr := c.rollBackCanaryGatewayClients(ctx, logger, canaries, config); rollbackErr != nil {
+				return nil, errors.Join(err, rollbackErr)
+			}
+			return nil, err
+		}
+		logger.Info("Canary gateways are healthy, pushing configuration to the remaining gateways")
+	}
+
+	restSHAs, err := c.sendToGatewayClientsConcurrently(ctx, rest, s, config, isFallback)
+	if err != nil {
+		return nil, err
+	}
+	return append(canarySHAs, restSHAs...), nil
+}
+
+// rollBackCanaryGatewayClients pushes the last valid configuration to the canary gateways found unhealthy, so that
+// they don't keep running the configuration that broke them. It's a noop if there's no last valid configuration.
+func (c *KongClient) rollBackCanaryGatewayClients(
+	ctx context.Context,
+	logger logr.Logger,
+	canaries []*adminapi.Client,
+	config sendconfig.Config,
+) error {
+	lastValidConfig, found := c.kongConfigFetcher.LastValidConfig()
+	if !found {
+		logger.Info("No last valid configuration to roll unhealthy canary gateways back to")
+		return nil
+	}
+
+	const isFallback = true
+	if _, err := c.sendToGatewayClientsConcurrently(ctx, canaries, lastValidConfig, config, isFallback); err != nil {
+		return fmt.Errorf("failed to roll canary gateways back to the last valid configuration: %w", err)
+	}
+	logger.Info("Unhealthy canary gateways were rolled back to the last valid configuration")
+	return nil
+}
+
+// splitCanaryGatewayClients splits the gateway clients into canaries and the rest. Canaries are empty when staged
+// rollout is not applicable: it's disabled, there are not more gateways than canaries, or the configuration is a
+// fallback or recovery one that should reach all the gateways as soon as possible. It's also not applicable in
+// DB mode in which configuration is pushed to a single gateway, and in dry-run mode in which nothing is applied.
+func (c *KongClient) splitCanaryGatewayClients(
+	gatewayClients []*adminapi.Client,
+	config sendconfig.Config,
+	isFallback bool,
+) (canaries []*adminapi.Client, rest []*adminapi.Client) {
+	if !config.CanaryRollout.Enabled() || isFallback || config.DryRun || !c.dbmode.IsDBLessMode() ||
+		len(gatewayClients) <= config.CanaryRollout.Gateways {
+		return nil, gatewayClients
+	}
+
+	// Sort the clients so that the same gateways are picked as canaries in subsequent rollouts.
+	sorted := slices.Clone(gatewayClients)
+	slices.SortFunc(sorted, func(a, b *adminapi.Client) int {
+		return strings.Compare(a.BaseRootURL(), b.BaseRootURL())
+	})
+	return sorted[:config.CanaryRollout.Gateways], sorted[config.CanaryRollout.Gateways:]
+}
@@ -0,0 +1,251 @@
+package dataplane
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/go-logr/zapr"
+	"github.com/kong/go-kong/kong"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/zap"
+
+	"github.com/kong/kubernetes-ingress-controller/v3/internal/adminapi"
+	dpconf "github.com/kong/kubernetes-ingress-controller/v3/internal/dataplane/config"
+	"github.com/kong/kubernetes-ingress-controller/v3/internal/dataplane/kongstate"
+	"github.com/kong/kubernetes-ingress-controller/v3/internal/dataplane/sendconfig"
+)
+
+// mockCanaryHealthChecker is a mock implementation of CanaryHealthChecker interface.
+type mockCanaryHealthChecker struct {
+	unhealthy   bool
+	checkedURLs []string
+}
+
+func (m *mockCanaryHealthChecker) Baseline(context.Context, *adminapi.Client) CanaryBaseline {
+	return CanaryBaseline{}
+}
+
+func (m *mockCanaryHealthChecker) Check(_ context.Context, client *adminapi.Client, _ CanaryBaseline) error {
+	m.checkedURLs = append(m.checkedURLs, client.BaseRootURL())
+	if m.unhealthy {
+		return errors.New("unhealthy")
+	}
+	return nil
+}
+
+func TestKongClientUpdate_CanaryRollout(t *testing.T) {
+	testCases := []struct {
+		name              string
+		canaryGateways    int
+		unhealthyCanaries bool
+		expectError       bool
+		expectUpdatedURLs func(canaryURL string, allURLs []string) []string
+		expectCheckedURLs func(canaryURL string) []string
+	}{
+		{
+			name:              "healthy canary, configuration is pushed to all gateways",
+			canaryGateways:    1,
+			expectUpdatedURLs: func(_ string, allURLs []string) []string { return allURLs },
+			expectCheckedURLs: func(canaryURL string) []string { return []string{canaryURL} },
+		},
+		{
+			name:              "unhealthy canary, configuration is pushed only to the canary",
+			canaryGateways:    1,
+			unhealthyCanaries: true,
+			expectError:       true,
+			expectUpdatedURLs: func(canaryURL string, _ []string) []string { return []string{canaryURL} },
+			expectCheckedURLs: func(canaryURL string) []string { return []string{canaryURL} },
+		},
+		{
+			name:              "not more gateways than canaries, configuration is pushed to all gateways without checks",
+			canaryGateways:    3,
+			unhealthyCanaries: true,
+			expectUpdatedURLs: func(_ string, allURLs []string) []string { return allURLs },
+			expectCheckedURLs: func(string) []string { return nil },
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			clientsProvider := mockGatewayClientsProvider{
+				gatewayClients: []*adminapi.Client{
+					mustSampleGatewayClient(t),
+					mustSampleGatewayClient(t),
+					mustSampleGatewayClient(t),
+				},
+			}
+			allURLs := mapClientsToUrls(clientsProvider)
+			canaryURL := allURLs[0]
+			for _, url := range allURLs[1:] {
+				if url < canaryURL {
+					canaryURL = url
+				}
+			}
+
+			updateStrategyResolver := newMockUpdateStrategyResolver(t)
+			configChangeDetector := mockConfigurationChangeDetector{hasConfigurationChanged: true}
+			kongClient := setupTestKongClient(
+				t,
+				updateStrategyResolver,
+				clientsProvider,
+				configChangeDetector,
+				newMockKongConfigBuilder(),
+				nil,
+				&mockKongLastValidConfigFetcher{},
+			)
+			kongClient.kongConfig.CanaryRollout = sendconfig.CanaryRolloutConfig{Gateways: tc.canaryGateways}
+			healthChecker := &mockCanaryHealthChecker{unhealthy: tc.unhealthyCanaries}
+			kongClient.canaryHealthChecker = healthChecker
+
+			err := kongClient.Update(context.Background())
+			if tc.expectError {
+				require.ErrorContains(t, err, "canary gateways are unhealthy")
+			} else {
+				require.NoError(t, err)
+			}
+			updateStrategyResolver.assertUpdateCalledForURLs(tc.expectUpdatedURLs(canaryURL, allURLs))
+			assert.Equal(t, tc.expectCheckedURLs(canaryURL), healthChecker.checkedURLs)
+		})
+	}
+}
+
+func TestKongClient_UnhealthyCanariesAreRolledBackToLastValidConfig(t *testing.T) {
+	clientsProvider := mockGatewayClientsProvider{
+		gatewayClients: []*adminapi.Client{
+			mustSampleGatewayClient(t),
+			mustSampleGatewayClient(t),
+		},
+	}
+	canaries, _ := (&KongClient{dbmode: dpconf.DBModeOff}).splitCanaryGatewayClients(
+		clientsProvider.gatewayClients,
+		sendconfig.Config{CanaryRollout: sendconfig.CanaryRolloutConfig{Gateways: 1}},
+		false,
+	)
+	require.Len(t, canaries, 1)
+	canaryURL := canaries[0].BaseRootURL()
+
+	lastValidConfig := &kongstate.KongState{
+		Services: []kongstate.Service{{Service: kong.Service{Name: kong.String("last-valid")}}},
+	}
+	updateStrategyResolver := newMockUpdateStrategyResolver(t)
+	kongClient := setupTestKongClient(
+		t,
+		updateStrategyResolver,
+		clientsProvider,
+		mockConfigurationChangeDetector{hasConfigurationChanged: true},
+		newMockKongConfigBuilder(),
+		nil,
+		&mockKongLastValidConfigFetcher{lastKongState: lastValidConfig},
+	)
+	kongClient.kongConfig.CanaryRollout = sendconfig.CanaryRolloutConfig{Gateways: 1}
+	kongClient.canaryHealthChecker = &mockCanaryHealthChecker{unhealthy: true}
+
+	newConfig := &kongstate.KongState{
+		Services: []kongstate.Service{{Service: kong.Service{Name: kong.String("new")}}},
+	}
+	_, err := kongClient.sendToGatewayClients(context.Background(), clientsProvider.gatewayClients, newConfig, kongClient.kongConfig, false)
+	require.ErrorContains(t, err, "canary gateways are unhealthy")
+
+	updateStrategyResolver.assertUpdateCalledForURLs([]string{canaryURL, canaryURL},
+		"canary should receive the new configuration and then be rolled back, the rest should not be updated")
+	content, ok := updateStrategyResolver.lastUpdatedContentForURL(canaryURL)
+	require.True(t, ok)
+	require.Len(t, content.Content.Services, 1)
+	assert.Equal(t, "last-valid", *content.Content.Services[0].Name)
+}
+
+func TestDefaultCanaryHealthChecker(t *testing.T) {
+	const metricsTemplate = `# HELP kong_http_requests_total HTTP status codes per consumer/service/route in Kong
+# TYPE kong_http_requests_total counter
+kong_http_requests_total{service="svc",route="route",code="200",source="service",workspace="default",consumer=""} %d
+kong_http_requests_total{service="svc",route="route",code="502",source="kong",workspace="default",consumer=""} %d
+`
+	type requestCounts struct {
+		ok, serverErrors int
+	}
+
+	testCases := []struct {
+		name          string
+		ready         bool
+		exposeMetrics bool
+		before, after requestCounts
+		expectError   string
+	}{
+		{
+			name:          "ready gateway with low error rate is healthy",
+			ready:         true,
+			exposeMetrics: true,
+			before:        requestCounts{ok: 100, serverErrors: 1},
+			after:         requestCounts{ok: 199, serverErrors: 2},
+		},
+		{
+			name:          "ready gateway with high error rate is unhealthy",
+			ready:         true,
+			exposeMetrics: true,
+			before:        requestCounts{ok: 100, serverErrors: 1},
+			after:         requestCounts{ok: 150, serverErrors: 51},
+			expectError:   "error rate 0.500 exceeds the maximum of 0.100",
+		},
+		{
+			name:          "ready gateway that served no requests is healthy",
+			ready:         true,
+			exposeMetrics: true,
+			before:        requestCounts{ok: 100, serverErrors: 1},
+			after:         requestCounts{ok: 100, serverErrors: 1},
+		},
+		{
+			name:  "ready gateway without metrics is healthy",
+			ready: true,
+		},
+		{
+			name:        "not ready gateway is unhealthy",
+			expectError: "is not ready",
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			counts := tc.before
+			server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				switch r.URL.Path {
+				case "/status":
+					if !tc.ready {
+						w.WriteHeader(http.StatusServiceUnavailable)
+						return
+					}
+					_, _ = w.Write([]byte(`{}`))
+				case "/metrics":
+					if !tc.exposeMetrics {
+						w.WriteHeader(http.StatusNotFound)
+						_, _ = w.Write([]byte(`{"message":"Not found"}`))
+						return
+					}
+					_, _ = fmt.Fprintf(w, metricsTemplate, counts.ok, counts.serverErrors)
+				default:
+					w.WriteHeader(http.StatusNotFound)
+				}
+			}))
+			t.Cleanup(server.Close)
+			client, err := adminapi.NewTestClient(server.URL)
+			require.NoError(t, err)
+
+			ctx := context.Background()
+			checker := NewDefaultCanaryHealthChecker(zapr.NewLogger(zap.NewNop()), 0.1)
+			baseline := checker.Baseline(ctx, client)
+			assert.Equal(t, tc.exposeMetrics, baseline.RequestCounts.IsPresent())
+
+			counts = tc.after
+			err = checker.Check(ctx, client, baseline)
+			if tc.expectError != "" {
+				require.ErrorContains(t, err, tc.expectError)
+				return
+			}
+			require.NoError(t, err)
+		})
+	}
+}
@@ -19,6 +19,7 @@ import (
 
 type LastValidConfigFetcher interface {
 	// TryFetchingValidConfigFromGateways tries to fetch a valid configuration from all gateways and persists it if found.
+	// If none of the gateways has a valid configuration loaded, it tries to load the configuration from the storage.
 	TryFetchingValidConfigFromGateways(ctx context.Context, logger logr.Logger, gatewayClients []*adminapi.Client) error
 
 	// LastValidConfig returns the last valid config and true if there's one available. Otherwise, second return value is false.
@@ -26,6 +27,10 @@ type LastValidConfigFetcher interface {
 
 	// StoreLastValidConfig stores a given configuration as the last valid config. Should be used when the configuration was successfully accepted by a gateway.
 	StoreLastValidConfig(s *kongstate.KongState)
+
+	// PersistLastValidConfig persists the last valid config in the storage so that it survives restarts. It's a noop
+	// when there's no storage configured or no last valid config available.
+	PersistLastValidConfig(ctx context.Context) error
 }
 
 type DefaultKongLastGoodConfigFetcher struct {
@@ -39,6 +44,8 @@ type DefaultKongLastGoodConfigFetcher struct {
 	workspace string
 	// licenseGetter is an optional license provider.
 	licenseGetter license.Getter
+	// storage is an optional storage the last valid config is persisted in.
+	storage LastValidConfigStorage
 }
 
 func NewDefaultKongLastGoodConfigFetcher(fillIDs bool, workspace string) *DefaultKongLastGoodConfigFetcher {
@@ -54,6 +61,11 @@ func (cf *DefaultKongLastGoodConfigFetcher) InjectLicenseGetter(licenseGetter li
 	cf.licenseGetter = licenseGetter
 }
 
+// InjectStorage adds a storage the last valid config is persisted in to the config fetcher.
+func (cf *DefaultKongLastGoodConfigFetcher) InjectStorage(storage LastValidConfigStorage) {
+	cf.storage = storage
+}
+
 func (cf *DefaultKongLastGoodConfigFetcher) LastValidConfig() (*kongstate.KongState, bool) {
 	if cf.lastValidState != nil {
 		// TODO the translator version of this also has a condition on
@@ -104,6 +116,16 @@ func (cf *DefaultKongLastGoodConfigFetcher) StoreLastValidConfig(s *kongstate.Ko
 	cf.lastValidState = s
 }
 
+func (cf *DefaultKongLastGoodConfigFetcher) PersistLastValidConfig(ctx context.Context) error {
+	if cf.storage == nil || cf.lastValidState == nil {
+		return nil
+	}
+	if err := cf.storage.Persist(ctx, cf.lastValidState); err != nil {
+		return fmt.Errorf("failed to persist last valid configuration: %w", err)
+	}
+	return nil
+}
+
 func (cf *DefaultKongLastGoodConfigFetcher) TryFetchingValidConfigFromGateways(
 	ctx context.Context,
 	logger logr.Logger,
@@ -142,12 +164,26 @@ func (cf *DefaultKongLastGoodConfigFetcher) TryFetchingValidConfigFromGateways(
 			break
 		}
 	}
+	if goodKongState == nil && cf.storage != nil {
+		// None of the gateways has a valid configuration loaded, which is the case when they were restarted
+		// along with the controller. Try the configuration persisted before the restart then.
+		ks, found, err := cf.storage.Load(ctx)
+		if err != nil {
+			errs = errors.Join(errs, fmt.Errorf("failed to load last good configuration from storage: %w", err))
+		}
+		if found {
+			goodKongState = ks
+			logger.V(util.DebugLevel).Info("Last good configuration loaded from storage")
+		}
+	}
 	if goodKongState != nil {
 		if cf.fillIDs {
 			goodKongState.FillIDs(logger, cf.workspace)
 		}
 		cf.lastValidState = goodKongState
-		logger.V(util.DebugLevel).Info("Last good configuration fetched from Kong node", "url", clientUsed.BaseRootURL())
+		if clientUsed != nil {
+			logger.V(util.DebugLevel).Info("Last good configuration fetched from Kong node", "url", clientUsed.BaseRootURL())
+		}
 	}
 	return errs
 }
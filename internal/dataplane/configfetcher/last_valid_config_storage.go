@@ -0,0 +1,359 @@
+package configfetcher
+
+import (
+	"bytes"
+	"compress/gzip"
+	"context"
+	"crypto/sha256"
+	"encoding/json"
+	"fmt"
+	"io"
+
+	"github.com/kong/go-database-reconciler/pkg/utils"
+	"github.com/kong/go-kong/kong"
+	"github.com/samber/lo"
+	corev1 "k8s.io/api/core/v1"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	k8stypes "k8s.io/apimachinery/pkg/types"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	"github.com/kong/kubernetes-ingress-controller/v3/internal/dataplane/deckgen"
+	"github.com/kong/kubernetes-ingress-controller/v3/internal/dataplane/kongstate"
+)
+
+const (
+	// LastValidConfigSecretKey is the key of the Secret data under which the last valid configuration is stored.
+	LastValidConfigSecretKey = "config.json.gz"
+
+	// LastValidConfigSecretLabel is the label set on the Secret storing the last valid configuration.
+	LastValidConfigSecretLabel = "konghq.com/last-valid-config"
+)
+
+// LastValidConfigStorage persists the last valid configuration outside of the controller's memory, so it
+// survives restarts of both the controller and the gateways.
+type LastValidConfigStorage interface {
+	// Persist stores the given configuration.
+	Persist(ctx context.Context, s *kongstate.KongState) error
+
+	// Load returns the stored configuration and true if there's one available. Otherwise, second return value is false.
+	Load(ctx context.Context) (*kongstate.KongState, bool, error)
+}
+
+// SecretLastValidConfigStorage is a LastValidConfigStorage that stores the configuration in a Kubernetes Secret.
+// The configuration is stored as gzip-compressed JSON of the Kong entities only, including credentials and
+// custom entities, without any references to the Kubernetes objects they were translated from.
+type SecretLastValidConfigStorage struct {
+	// reader is used to read the Secret. It should not be backed by the manager's cache as the configuration
+	// is loaded before the caches are populated and the controller doesn't watch Secrets it doesn't reference.
+	reader client.Reader
+	writer client.Writer
+	secret k8stypes.NamespacedName
+
+	// lastPersistedHash is the hash of the last persisted configuration. It's used to avoid updating the Secret
+	// when the configuration hasn't changed since the last successful sync.
+	lastPersistedHash [sha256.Size]byte
+}
+
+// NewSecretLastValidConfigStorage creates a new SecretLastValidConfigStorage storing the configuration in the
+// given Secret. The Secret is created on the first Persist call if it doesn't exist.
+func NewSecretLastValidConfigStorage(
+	reader client.Reader,
+	writer client.Writer,
+	secret k8stypes.NamespacedName,
+) *SecretLastValidConfigStorage {
+	return &SecretLastValidConfigStorage{
+		reader: reader,
+		writer: writer,
+		secret: secret,
+	}
+}
+
+// lastValidConfig is the format the last valid configuration is persisted in.
+type lastValidConfig struct {
+	// Entities are the Kong entities of the configuration in decK's raw state format. Besides the entities
+	// KongRawStateToKongState handles, it holds plugins of consumers and members of consumer groups.
+	Entities *utils.KongRawState `json:"entities"`
+	// Plugins are the plugins translated from KongPlugins and KongClusterPlugins. They're stored separately
+	// as they reference the entities they're attached to by their names (or IDs) rather than by synthetic IDs.
+	Plugins []kong.Plugin `json:"plugins,omitempty"`
+	// CustomEntities are the custom Kong entities indexed by their entity type.
+	CustomEntities deckgen.CustomEntitiesByType `json:"customEntities,omitempty"`
+}
+
+func (s *SecretLastValidConfigStorage) Persist(ctx context.Context, ks *kongstate.KongState) error {
+	raw, err := json.Marshal(kongStateToLastValidConfig(ks))
+	if err != nil {
+		return fmt.Errorf("failed to marshal configuration: %w", err)
+	}
+	hash := sha256.Sum256(raw)
+	if hash == s.lastPersistedHash {
+		return nil
+	}
+
+	var compressed bytes.Buffer
+	w := gzip.NewWriter(&compressed)
+	if _, err := w.Write(raw); err != nil {
+		return fmt.Errorf("failed to compress configuration: %w", err)
+	}
+	if err := w.Close(); err != nil {
+		return fmt.Errorf("failed to compress configuration: %w", err)
+	}
+	if compressed.Len() > corev1.MaxSecretSize {
+		return fmt.Errorf("compressed configuration size %d exceeds the maximum Secret size %d", compressed.Len(), corev1.MaxSecretSize)
+	}
+
+	secret := &corev1.Secret{}
+	err = s.reader.Get(ctx, s.secret, secret)
+	switch {
+	case apierrors.IsNotFound(err):
+		secret = &corev1.Secret{
+			ObjectMeta: metav1.ObjectMeta{
+				Name:      s.secret.Name,
+				Namespace: s.secret.Namespace,
+				Labels: map[string]string{
+					LastValidConfigSecretLabel: "true",
+				},
+			},
+			Data: map[string][]byte{
+				LastValidConfigSecretKey: compressed.Bytes(),
+			},
+		}
+		if err := s.writer.Create(ctx, secret); err != nil {
+			return fmt.Errorf("failed to create Secret %s: %w", s.secret, err)
+		}
+	case err != nil:
+		return fmt.Errorf("failed to get Secret %s: %w", s.secret, err)
+	default:
+		if secret.Data == nil {
+			secret.Data = map[string][]byte{}
+		}
+		secret.Data[LastValidConfigSecretKey] = compressed.Bytes()
+		if err := s.writer.Update(ctx, secret); err != nil {
+			return fmt.Errorf("failed to update Secret %s: %w", s.secret, err)
+		}
+	}
+
+	s.lastPersistedHash = hash
+	return nil
+}
+
+func (s *SecretLastValidConfigStorage) Load(ctx context.Context) (*kongstate.KongState, bool, error) {
+	secret := &corev1.Secret{}
+	if err := s.reader.Get(ctx, s.secret, secret); err != nil {
+		if apierrors.IsNotFound(err) {
+			return nil, false, nil
+		}
+		return nil, false, fmt.Errorf("failed to get Secret %s: %w", s.secret, err)
+	}
+	compressed, ok := secret.Data[LastValidConfigSecretKey]
+	if !ok {
+		return nil, false, nil
+	}
+
+	r, err := gzip.NewReader(bytes.NewReader(compressed))
+	if err != nil {
+		return nil, false, fmt.Errorf("failed to decompress configuration from Secret %s: %w", s.secret, err)
+	}
+	defer r.Close()
+	raw, err := io.ReadAll(r)
+	if err != nil {
+		return nil, false, fmt.Errorf("failed to decompress configuration from Secret %s: %w", s.secret, err)
+	}
+	var config lastValidConfig
+	if err := json.Unmarshal(raw, &config); err != nil {
+		return nil, false, fmt.Errorf("failed to unmarshal configuration from Secret %s: %w", s.secret, err)
+	}
+
+	// The configuration is in the Secret already, there's no need to update it unless it changes.
+	s.lastPersistedHash = sha256.Sum256(raw)
+	return lastValidConfigToKongState(&config), true, nil
+}
+
+// kongStateToLastValidConfig converts a KIC KongState to the format it's persisted in. Kubernetes objects
+// the entities were translated from are not persisted.
+func kongStateToLastValidConfig(ks *kongstate.KongState) *lastValidConfig {
+	config := &lastValidConfig{
+		Entities: kongStateToKongRawState(ks),
+	}
+	if ks == nil {
+		return config
+	}
+
+	for i, c := range ks.Consumers {
+		consumerRef := &kong.Consumer{ID: kong.String(consumerID(i))}
+		for _, p := range c.Plugins {
+			plugin := p
+			plugin.Consumer = consumerRef
+			config.Entities.Plugins = append(config.Entities.Plugins, &plugin)
+		}
+		for _, cg := range c.ConsumerGroups {
+			for _, group := range config.Entities.ConsumerGroups {
+				if lo.FromPtr(group.ConsumerGroup.Name) == lo.FromPtr(cg.Name) {
+					group.Consumers = append(group.Consumers, consumerRef)
+				}
+			}
+		}
+	}
+	for _, p := range ks.Plugins {
+		config.Plugins = append(config.Plugins, p.Plugin)
+	}
+	config.CustomEntities = deckgen.ToCustomEntities(ks)
+
+	return config
+}
+
+// lastValidConfigToKongState converts a persisted configuration back to a KIC KongState.
+func lastValidConfigToKongState(config *lastValidConfig) *kongstate.KongState {
+	ks := KongRawStateToKongState(config.Entities)
+	if config.Entities != nil {
+		consumerIndexes := make(map[string]int, len(config.Entities.Consumers))
+		for i, c := range config.Entities.Consumers {
+			consumerIndexes[lo.FromPtr(c.ID)] = i
+		}
+		for _, p := range config.Entities.Plugins {
+			if p.Consumer == nil || p.Service != nil || p.Route != nil {
+				continue
+			}
+			if i, ok := consumerIndexes[lo.FromPtr(p.Consumer.ID)]; ok {
+				plugin := sanitizePlugin(*p)
+				plugin.Consumer = nil
+				ks.Consumers[i].Plugins = append(ks.Consumers[i].Plugins, plugin)
+			}
+		}
+		for _, group := range config.Entities.ConsumerGroups {
+			for _, c := range group.Consumers {
+				if i, ok := consumerIndexes[lo.FromPtr(c.ID)]; ok {
+					ks.Consumers[i].ConsumerGroups = append(ks.Consumers[i].ConsumerGroups, kong.ConsumerGroup{
+						Name: group.ConsumerGroup.Name,
+					})
+				}
+			}
+		}
+	}
+
+	for _, p := range config.Plugins {
+		ks.Plugins = append(ks.Plugins, kongstate.Plugin{Plugin: p})
+	}
+	if len(config.CustomEntities) > 0 {
+		ks.CustomEntities = make(map[string]*kongstate.KongCustomEntityCollection, len(config.CustomEntities))
+		for entityType, objects := range config.CustomEntities {
+			ks.CustomEntities[entityType] = &kongstate.KongCustomEntityCollection{
+				Entities: lo.Map(objects, func(o map[string]interface{}, _ int) kongstate.CustomEntity {
+					return kongstate.CustomEntity{Object: o}
+				}),
+			}
+		}
+	}
+
+	return ks
+}
+
+func consumerID(i int) string {
+	return fmt.Sprintf("consumer-%d", i)
+}
+
+// kongStateToKongRawState converts a KIC KongState to a Deck kongRawState. It's the reverse of KongRawStateToKongState
+// and as such it only covers the entities KongRawStateToKongState handles. References between entities are
+// expressed with synthetic IDs that are dropped when converting the state back.
+// Licenses are omitted as the latest available license is always used with the last valid configuration.
+func kongStateToKongRawState(ks *kongstate.KongState) *utils.KongRawState {
+	rawState := &utils.KongRawState{}
+	if ks == nil {
+		return rawState
+	}
+
+	for i, s := range ks.Services {
+		service := s.Service
+		service.ID = kong.String(fmt.Sprintf("service-%d", i))
+		rawState.Services = append(rawState.Services, &service)
+		for _, p := range s.Plugins {
+			plugin := p
+			plugin.Service = &kong.Service{ID: service.ID}
+			rawState.Plugins = append(rawState.Plugins, &plugin)
+		}
+		for j, r := range s.Routes {
+			route := r.Route
+			route.ID = kong.String(fmt.Sprintf("service-%d-route-%d", i, j))
+			route.Service = &kong.Service{ID: service.ID}
+			rawState.Routes = append(rawState.Routes, &route)
+			for _, p := range r.Plugins {
+				plugin := p
+				plugin.Route = &kong.Route{ID: route.ID}
+				rawState.Plugins = append(rawState.Plugins, &plugin)
+			}
+		}
+	}
+
+	for i, u := range ks.Upstreams {
+		upstream := u.Upstream
+		upstream.ID = kong.String(fmt.Sprintf("upstream-%d", i))
+		rawState.Upstreams = append(rawState.Upstreams, &upstream)
+		for _, t := range u.Targets {
+			target := t.Target
+			target.Upstream = &kong.Upstream{ID: upstream.ID}
+			rawState.Targets = append(rawState.Targets, &target)
+		}
+	}
+
+	for _, c := range ks.Certificates {
+		certificate := c.Certificate
+		rawState.Certificates = append(rawState.Certificates, &certificate)
+	}
+	for _, c := range ks.CACertificates {
+		caCertificate := c
+		rawState.CACertificates = append(rawState.CACertificates, &caCertificate)
+	}
+	for _, v := range ks.Vaults {
+		vault := v.Vault
+		rawState.Vaults = append(rawState.Vaults, &vault)
+	}
+	for _, cg := range ks.ConsumerGroups {
+		consumerGroup := cg.ConsumerGroup
+		rawState.ConsumerGroups = append(rawState.ConsumerGroups, &kong.ConsumerGroupObject{ConsumerGroup: &consumerGroup})
+	}
+
+	for i, c := range ks.Consumers {
+		consumer := c.Consumer
+		consumer.ID = kong.String(consumerID(i))
+		rawState.Consumers = append(rawState.Consumers, &consumer)
+		consumerRef := &kong.Consumer{ID: consumer.ID}
+		for _, a := range c.KeyAuths {
+			keyAuth := a.KeyAuth
+			keyAuth.Consumer = consumerRef
+			rawState.KeyAuths = append(rawState.KeyAuths, &keyAuth)
+		}
+		for _, a := range c.HMACAuths {
+			hmacAuth := a.HMACAuth
+			hmacAuth.Consumer = consumerRef
+			rawState.HMACAuths = append(rawState.HMACAuths, &hmacAuth)
+		}
+		for _, a := range c.JWTAuths {
+			jwtAuth := a.JWTAuth
+			jwtAuth.Consumer = consumerRef
+			rawState.JWTAuths = append(rawState.JWTAuths, &jwtAuth)
+		}
+		for _, a := range c.BasicAuths {
+			basicAuth := a.BasicAuth
+			basicAuth.Consumer = consumerRef
+			rawState.BasicAuths = append(rawState.BasicAuths, &basicAuth)
+		}
+		for _, a := range c.ACLGroups {
+			aclGroup := a.ACLGroup
+			aclGroup.Consumer = consumerRef
+			rawState.ACLGroups = append(rawState.ACLGroups, &aclGroup)
+		}
+		for _, a := range c.Oauth2Creds {
+			oauth2Cred := a.Oauth2Credential
+			oauth2Cred.Consumer = consumerRef
+			rawState.Oauth2Creds = append(rawState.Oauth2Creds, &oauth2Cred)
+		}
+		for _, a := range c.MTLSAuths {
+			mTLSAuth := a.MTLSAuth
+			mTLSAuth.Consumer = consumerRef
+			rawState.MTLSAuths = append(rawState.MTLSAuths, &mTLSAuth)
+		}
+	}
+
+	return rawState
+}
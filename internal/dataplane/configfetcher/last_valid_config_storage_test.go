@@ -0,0 +1,231 @@
+package configfetcher
+
+import (
+	"context"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/go-logr/zapr"
+	"github.com/kong/go-kong/kong"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/zap"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	k8stypes "k8s.io/apimachinery/pkg/types"
+	fakectrlruntimeclient "sigs.k8s.io/controller-runtime/pkg/client/fake"
+
+	"github.com/kong/kubernetes-ingress-controller/v3/internal/adminapi"
+	"github.com/kong/kubernetes-ingress-controller/v3/internal/dataplane/kongstate"
+	kongv1 "github.com/kong/kubernetes-ingress-controller/v3/pkg/apis/configuration/v1"
+	kongv1alpha1 "github.com/kong/kubernetes-ingress-controller/v3/pkg/apis/configuration/v1alpha1"
+	kongv1beta1 "github.com/kong/kubernetes-ingress-controller/v3/pkg/apis/configuration/v1beta1"
+	"github.com/kong/kubernetes-ingress-controller/v3/test/mocks"
+)
+
+func TestSecretLastValidConfigStorage(t *testing.T) {
+	secretNN := k8stypes.NamespacedName{Namespace: "kong", Name: "last-valid-config"}
+	kongState := &kongstate.KongState{
+		Services: []kongstate.Service{
+			{
+				Service: kong.Service{
+					Name: kong.String("service"),
+					Host: kong.String("example.com"),
+				},
+				Namespace: "default",
+				Routes: []kongstate.Route{
+					{
+						Route: kong.Route{
+							Name:  kong.String("route"),
+							Paths: kong.StringSlice("/foo"),
+						},
+						Plugins: []kong.Plugin{{Name: kong.String("key-auth")}},
+					},
+				},
+				Plugins: []kong.Plugin{{Name: kong.String("cors")}},
+			},
+		},
+		Upstreams: []kongstate.Upstream{
+			{
+				Upstream: kong.Upstream{Name: kong.String("example.com")},
+				Targets:  []kongstate.Target{{Target: kong.Target{Target: kong.String("10.0.0.1:80")}}},
+			},
+		},
+		Consumers: []kongstate.Consumer{
+			{
+				Consumer:       kong.Consumer{Username: kong.String("consumer")},
+				Plugins:        []kong.Plugin{{Name: kong.String("rate-limiting"), Config: kong.Configuration{"minute": float64(5)}}},
+				ConsumerGroups: []kong.ConsumerGroup{{Name: kong.String("group")}},
+				KeyAuths:       []*kongstate.KeyAuth{{KeyAuth: kong.KeyAuth{Key: kong.String("secret-key")}}},
+				K8sKongConsumer: kongv1.KongConsumer{
+					ObjectMeta: metav1.ObjectMeta{Namespace: "default", Name: "consumer"},
+				},
+			},
+		},
+		ConsumerGroups: []kongstate.ConsumerGroup{
+			{
+				ConsumerGroup: kong.ConsumerGroup{Name: kong.String("group")},
+				K8sKongConsumerGroup: kongv1beta1.KongConsumerGroup{
+					ObjectMeta: metav1.ObjectMeta{Namespace: "default", Name: "group"},
+				},
+			},
+		},
+		Plugins: []kongstate.Plugin{
+			{
+				Plugin: kong.Plugin{Name: kong.String("prometheus")},
+				K8sParent: &kongv1.KongClusterPlugin{
+					ObjectMeta: metav1.ObjectMeta{Name: "prometheus"},
+				},
+			},
+			{
+				Plugin: kong.Plugin{
+					Name:          kong.String("rate-limiting"),
+					ConsumerGroup: &kong.ConsumerGroup{ID: kong.String("group")},
+				},
+				K8sParent: &kongv1.KongPlugin{
+					ObjectMeta: metav1.ObjectMeta{Namespace: "default", Name: "rate-limiting"},
+				},
+			},
+		},
+		CustomEntities: map[string]*kongstate.KongCustomEntityCollection{
+			"degraphql_routes": {
+				Schema: kongstate.EntitySchema{Fields: map[string]kongstate.EntityField{}},
+				Entities: []kongstate.CustomEntity{
+					{
+						Object: map[string]interface{}{"uri": "/contacts", "query": "query{ contacts { name } }"},
+						K8sKongCustomEntity: &kongv1alpha1.KongCustomEntity{
+							ObjectMeta: metav1.ObjectMeta{Namespace: "default", Name: "degraphql-route"},
+						},
+					},
+				},
+			},
+		},
+		Licenses: []kongstate.License{{License: kong.License{Payload: kong.String("license")}}},
+	}
+	expectedKongState := &kongstate.KongState{
+		Services: []kongstate.Service{
+			{
+				Service: kong.Service{
+					Name: kong.String("service"),
+					Host: kong.String("example.com"),
+				},
+				Routes: []kongstate.Route{
+					{
+						Route: kong.Route{
+							Name:  kong.String("route"),
+							Paths: kong.StringSlice("/foo"),
+						},
+						Plugins: []kong.Plugin{{Name: kong.String("key-auth")}},
+					},
+				},
+				Plugins: []kong.Plugin{{Name: kong.String("cors")}},
+			},
+		},
+		Upstreams: []kongstate.Upstream{
+			{
+				Upstream: kong.Upstream{Name: kong.String("example.com")},
+				Targets:  []kongstate.Target{{Target: kong.Target{Target: kong.String("10.0.0.1:80")}}},
+			},
+		},
+		Consumers: []kongstate.Consumer{
+			{
+				Consumer:       kong.Consumer{Username: kong.String("consumer")},
+				Plugins:        []kong.Plugin{{Name: kong.String("rate-limiting"), Config: kong.Configuration{"minute": float64(5)}}},
+				ConsumerGroups: []kong.ConsumerGroup{{Name: kong.String("group")}},
+				KeyAuths:       []*kongstate.KeyAuth{{KeyAuth: kong.KeyAuth{Key: kong.String("secret-key")}}},
+			},
+		},
+		ConsumerGroups: []kongstate.ConsumerGroup{
+			{ConsumerGroup: kong.ConsumerGroup{Name: kong.String("group")}},
+		},
+		Plugins: []kongstate.Plugin{
+			{Plugin: kong.Plugin{Name: kong.String("prometheus")}},
+			{
+				Plugin: kong.Plugin{
+					Name:          kong.String("rate-limiting"),
+					ConsumerGroup: &kong.ConsumerGroup{ID: kong.String("group")},
+				},
+			},
+		},
+		CustomEntities: map[string]*kongstate.KongCustomEntityCollection{
+			"degraphql_routes": {
+				Entities: []kongstate.CustomEntity{
+					{Object: map[string]interface{}{"uri": "/contacts", "query": "query{ contacts { name } }"}},
+				},
+			},
+		},
+	}
+
+	t.Run("nothing is loaded when Secret doesn't exist", func(t *testing.T) {
+		fakeClient := fakectrlruntimeclient.NewClientBuilder().Build()
+		storage := NewSecretLastValidConfigStorage(fakeClient, fakeClient, secretNN)
+
+		ks, found, err := storage.Load(context.Background())
+		require.NoError(t, err)
+		assert.False(t, found)
+		assert.Nil(t, ks)
+	})
+
+	t.Run("persisted configuration is loaded back without Kubernetes objects and licenses", func(t *testing.T) {
+		ctx := context.Background()
+		fakeClient := fakectrlruntimeclient.NewClientBuilder().Build()
+		storage := NewSecretLastValidConfigStorage(fakeClient, fakeClient, secretNN)
+		require.NoError(t, storage.Persist(ctx, kongState))
+
+		secret := &corev1.Secret{}
+		require.NoError(t, fakeClient.Get(ctx, secretNN, secret))
+		assert.NotEmpty(t, secret.Data[LastValidConfigSecretKey])
+		assert.Equal(t, "true", secret.Labels[LastValidConfigSecretLabel])
+
+		// Use a new storage to simulate a controller restart.
+		ks, found, err := NewSecretLastValidConfigStorage(fakeClient, fakeClient, secretNN).Load(ctx)
+		require.NoError(t, err)
+		require.True(t, found)
+		assert.Equal(t, expectedKongState, ks)
+	})
+
+	t.Run("Secret is not updated when configuration doesn't change", func(t *testing.T) {
+		ctx := context.Background()
+		fakeClient := fakectrlruntimeclient.NewClientBuilder().Build()
+		storage := NewSecretLastValidConfigStorage(fakeClient, fakeClient, secretNN)
+		require.NoError(t, storage.Persist(ctx, kongState))
+		secret := &corev1.Secret{}
+		require.NoError(t, fakeClient.Get(ctx, secretNN, secret))
+		resourceVersion := secret.ResourceVersion
+
+		require.NoError(t, storage.Persist(ctx, kongState))
+		require.NoError(t, fakeClient.Get(ctx, secretNN, secret))
+		assert.Equal(t, resourceVersion, secret.ResourceVersion)
+
+		require.NoError(t, storage.Persist(ctx, &kongstate.KongState{}))
+		require.NoError(t, fakeClient.Get(ctx, secretNN, secret))
+		assert.NotEqual(t, resourceVersion, secret.ResourceVersion)
+	})
+}
+
+func TestTryFetchingValidConfigFromGateways_FallsBackToStorage(t *testing.T) {
+	const zeroConfigHash = "00000000000000000000000000000000"
+
+	ctx := context.Background()
+	adminAPIHandler := mocks.NewAdminAPIHandler(t, mocks.WithReady(true), mocks.WithConfigurationHash(zeroConfigHash))
+	adminAPIServer := httptest.NewServer(adminAPIHandler)
+	t.Cleanup(func() { adminAPIServer.Close() })
+	client, err := adminapi.NewKongClientForWorkspace(ctx, adminAPIServer.URL, "", adminAPIServer.Client())
+	require.NoError(t, err)
+
+	secretNN := k8stypes.NamespacedName{Namespace: "kong", Name: "last-valid-config"}
+	fakeClient := fakectrlruntimeclient.NewClientBuilder().Build()
+	persisted := &kongstate.KongState{
+		Services: []kongstate.Service{{Service: kong.Service{Name: kong.String("service")}}},
+	}
+	require.NoError(t, NewSecretLastValidConfigStorage(fakeClient, fakeClient, secretNN).Persist(ctx, persisted))
+
+	fetcher := NewDefaultKongLastGoodConfigFetcher(false, "")
+	fetcher.InjectStorage(NewSecretLastValidConfigStorage(fakeClient, fakeClient, secretNN))
+	require.NoError(t, fetcher.TryFetchingValidConfigFromGateways(ctx, zapr.NewLogger(zap.NewNop()), []*adminapi.Client{client}))
+
+	state, ok := fetcher.LastValidConfig()
+	require.True(t, ok)
+	require.Len(t, state.Services, 1)
+	assert.Equal(t, "service", *state.Services[0].Name)
+}
@@ -0,0 +1,23 @@
+package deckgen
+
+import (
+	"github.com/kong/kubernetes-ingress-controller/v3/internal/dataplane/kongstate"
+)
+
+// CustomEntitiesByType are custom Kong entities indexed by their entity type. They are not part of decK's
+// schema and are sent alongside the decK content only in DB-less mode.
+type CustomEntitiesByType map[string][]map[string]interface{}
+
+// ToCustomEntities generates custom Kong entities from `k8sState`. It returns nil if there are none.
+func ToCustomEntities(k8sState *kongstate.KongState) CustomEntitiesByType {
+	if len(k8sState.CustomEntities) == 0 {
+		return nil
+	}
+	entities := make(CustomEntitiesByType, len(k8sState.CustomEntities))
+	for entityType, collection := range k8sState.CustomEntities {
+		for _, e := range collection.Entities {
+			entities[entityType] = append(entities[entityType], e.Object)
+		}
+	}
+	return entities
+}
@@ -9,6 +9,7 @@ import (
 	"sigs.k8s.io/controller-runtime/pkg/client"
 
 	"github.com/kong/kubernetes-ingress-controller/v3/internal/gatewayapi"
+	mcsv1alpha1 "github.com/kong/kubernetes-ingress-controller/v3/internal/mcsapi/v1alpha1"
 	"github.com/kong/kubernetes-ingress-controller/v3/internal/store"
 	kongv1 "github.com/kong/kubernetes-ingress-controller/v3/pkg/apis/configuration/v1"
 	kongv1alpha1 "github.com/kong/kubernetes-ingress-controller/v3/pkg/apis/configuration/v1alpha1"
@@ -26,6 +27,9 @@ func ResolveDependencies(cache store.CacheStores, obj client.Object) ([]client.O
 		return resolveServiceDependencies(cache, obj), nil
 	case *netv1.Ingress:
 		return resolveIngressDependencies(cache, obj), nil
+	// Multi-Cluster Services API objects.
+	case *mcsv1alpha1.ServiceImport:
+		return resolveServiceImportDependencies(cache, obj), nil
 	// Gateway API objects.
 	case *gatewayapi.HTTPRoute:
 		return resolveHTTPRouteDependencies(cache, obj), nil
@@ -37,6 +41,8 @@ func ResolveDependencies(cache store.CacheStores, obj client.Object) ([]client.O
 		return resolveUDPRouteDependencies(cache, obj), nil
 	case *gatewayapi.GRPCRoute:
 		return resolveGRPCRouteDependencies(cache, obj), nil
+	case *gatewayapi.BackendTLSPolicy:
+		return resolveBackendTLSPolicyDependencies(cache, obj), nil
 	// Kong specific objects.
 	case *kongv1.KongPlugin:
 		return resolveKongPluginDependencies(cache, obj), nil
@@ -52,16 +58,22 @@ func ResolveDependencies(cache store.CacheStores, obj client.Object) ([]client.O
 		return resolveTCPIngressDependencies(cache, obj), nil
 	case *incubatorv1alpha1.KongServiceFacade:
 		return resolveKongServiceFacadeDependencies(cache, obj), nil
+	case *kongv1alpha1.KongCustomEntity:
+		return resolveKongCustomEntityDependencies(cache, obj), nil
 	// Object types that have no dependencies.
 	case *netv1.IngressClass,
 		*corev1.Secret,
+		*corev1.ConfigMap,
+		*corev1.Namespace,
 		*discoveryv1.EndpointSlice,
 		*gatewayapi.ReferenceGrant,
 		*gatewayapi.Gateway,
+		*gatewayapi.BackendLBPolicy,
 		*kongv1.KongIngress,
 		*kongv1beta1.KongUpstreamPolicy,
 		*kongv1alpha1.IngressClassParameters,
-		*kongv1alpha1.KongVault:
+		*kongv1alpha1.KongVault,
+		*kongv1alpha1.KongTenantPolicy:
 		return nil, nil
 	default:
 		return nil, fmt.Errorf("unsupported object type: %T", obj)
@@ -14,6 +14,7 @@ import (
 
 // resolveHTTPRouteDependencies resolves potential dependencies for a given HTTPRoute object:
 // - Service
+// - KongServiceFacade
 // - KongPlugin
 // - KongClusterPlugin.
 func resolveHTTPRouteDependencies(cache store.CacheStores, route *gatewayapi.HTTPRoute) []client.Object {
@@ -58,6 +59,7 @@ func resolveTLSRouteDependencies(cache store.CacheStores, route *gatewayapi.TLSR
 
 // resolveGRPCRouteDependencies resolves potential dependencies for a given GRPCRoute object:
 // - Service
+// - KongServiceFacade
 // - KongPlugin
 // - KongClusterPlugin.
 func resolveGRPCRouteDependencies(cache store.CacheStores, route *gatewayapi.GRPCRoute) []client.Object {
@@ -77,14 +79,29 @@ type gatewayAPIRoute interface {
 func resolveGatewayAPIRouteDependenciesBackendRefs[T gatewayAPIRoute](cache store.CacheStores, route T, backendRefs []gatewayapi.BackendRef) []client.Object {
 	var dependencies []client.Object
 	for _, backendRef := range backendRefs {
-		if !util.IsBackendRefGroupKindSupported(backendRef.Group, backendRef.Kind) {
-			continue
-		}
 		ns := route.GetNamespace()
 		if backendRef.Namespace != nil {
 			ns = string(*backendRef.Namespace)
 		}
-		ingressClass, exists, err := cache.Service.GetByKey(fmt.Sprintf("%s/%s", ns, backendRef.Name))
+		key := fmt.Sprintf("%s/%s", ns, backendRef.Name)
+		if util.IsBackendRefKongServiceFacade(backendRef.Group, backendRef.Kind) {
+			kongServiceFacade, exists, err := cache.KongServiceFacade.GetByKey(key)
+			if err == nil && exists {
+				dependencies = append(dependencies, kongServiceFacade.(client.Object))
+			}
+			continue
+		}
+		if util.IsBackendRefServiceImport(backendRef.Group, backendRef.Kind) {
+			serviceImport, exists, err := cache.ServiceImport.GetByKey(key)
+			if err == nil && exists {
+				dependencies = append(dependencies, serviceImport.(client.Object))
+			}
+			continue
+		}
+		if !util.IsBackendRefGroupKindSupported(backendRef.Group, backendRef.Kind) {
+			continue
+		}
+		ingressClass, exists, err := cache.Service.GetByKey(key)
 		if err == nil && exists {
 			dependencies = append(dependencies, ingressClass.(client.Object))
 		}
@@ -135,3 +152,69 @@ func getGRPCRouteBackendRefs(route *gatewayapi.GRPCRoute) []gatewayapi.BackendRe
 	}
 	return backendRefs
 }
+
+// resolveBackendTLSPolicyDependencies resolves potential dependencies for a given BackendTLSPolicy object:
+// - ConfigMap
+// - Secret.
+func resolveBackendTLSPolicyDependencies(cache store.CacheStores, policy *gatewayapi.BackendTLSPolicy) []client.Object {
+	var dependencies []client.Object
+	for _, ref := range policy.Spec.Validation.CACertificateRefs {
+		if ref.Group != "" && ref.Group != "core" {
+			continue
+		}
+		key := fmt.Sprintf("%s/%s", policy.Namespace, ref.Name)
+		var (
+			obj    any
+			exists bool
+			err    error
+		)
+		switch ref.Kind {
+		case "ConfigMap":
+			obj, exists, err = cache.ConfigMap.GetByKey(key)
+		case "Secret":
+			obj, exists, err = cache.Secret.GetByKey(key)
+		default:
+			continue
+		}
+		if err == nil && exists {
+			dependencies = append(dependencies, obj.(client.Object))
+		}
+	}
+	return dependencies
+}
+
+// resolveServiceDependenciesBackendTLSPolicy resolves BackendTLSPolicies targeting the given Service. A Service
+// depends on them as it mustn't be configured without TLS verification if a policy targeting it is broken.
+func resolveServiceDependenciesBackendTLSPolicy(cache store.CacheStores, service client.Object) []client.Object {
+	var dependencies []client.Object
+	for _, obj := range cache.BackendTLSPolicy.List() {
+		policy, ok := obj.(*gatewayapi.BackendTLSPolicy)
+		if !ok || policy.Namespace != service.GetNamespace() {
+			continue
+		}
+		if lo.ContainsBy(policy.Spec.TargetRefs, func(ref gatewayapi.LocalPolicyTargetReferenceWithSectionName) bool {
+			return (ref.Group == "" || ref.Group == "core") && ref.Kind == "Service" && string(ref.Name) == service.GetName()
+		}) {
+			dependencies = append(dependencies, policy)
+		}
+	}
+	return dependencies
+}
+
+// resolveServiceDependenciesBackendLBPolicy resolves BackendLBPolicies targeting the given Service. A Service
+// depends on them as they configure the load balancing of its Kong Upstream.
+func resolveServiceDependenciesBackendLBPolicy(cache store.CacheStores, service client.Object) []client.Object {
+	var dependencies []client.Object
+	for _, obj := range cache.BackendLBPolicy.List() {
+		policy, ok := obj.(*gatewayapi.BackendLBPolicy)
+		if !ok || policy.Namespace != service.GetNamespace() {
+			continue
+		}
+		if lo.ContainsBy(policy.Spec.TargetRefs, func(ref gatewayapi.LocalPolicyTargetReference) bool {
+			return (ref.Group == "" || ref.Group == "core") && ref.Kind == "Service" && string(ref.Name) == service.GetName()
+		}) {
+			dependencies = append(dependencies, policy)
+		}
+	}
+	return dependencies
+}
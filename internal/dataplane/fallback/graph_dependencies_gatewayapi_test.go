@@ -4,11 +4,15 @@ import (
 	"testing"
 
 	"github.com/samber/lo"
+	corev1 "k8s.io/api/core/v1"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"sigs.k8s.io/controller-runtime/pkg/client"
 
 	"github.com/kong/kubernetes-ingress-controller/v3/internal/annotations"
 	"github.com/kong/kubernetes-ingress-controller/v3/internal/gatewayapi"
+	mcsv1alpha1 "github.com/kong/kubernetes-ingress-controller/v3/internal/mcsapi/v1alpha1"
+	incubatorv1alpha1 "github.com/kong/kubernetes-ingress-controller/v3/pkg/apis/incubator/v1alpha1"
+	"github.com/kong/kubernetes-ingress-controller/v3/test/helpers"
 )
 
 func TestResolveDependencies_HTTPRoute(t *testing.T) {
@@ -72,6 +76,72 @@ func TestResolveDependencies_HTTPRoute(t *testing.T) {
 				testService(t, "2"),
 			},
 		},
+		{
+			name: "HTTPRoute -> KongServiceFacade",
+			object: &gatewayapi.HTTPRoute{
+				ObjectMeta: metav1.ObjectMeta{
+					Name:      "test-route",
+					Namespace: "test-namespace",
+				},
+				Spec: gatewayapi.HTTPRouteSpec{
+					Rules: []gatewayapi.HTTPRouteRule{
+						{
+							BackendRefs: []gatewayapi.HTTPBackendRef{
+								{
+									BackendRef: gatewayapi.BackendRef{
+										BackendObjectReference: gatewayapi.BackendObjectReference{
+											Name:  "1",
+											Group: lo.ToPtr(gatewayapi.Group(incubatorv1alpha1.GroupVersion.Group)),
+											Kind:  lo.ToPtr(gatewayapi.Kind(incubatorv1alpha1.KongServiceFacadeKind)),
+										},
+									},
+								},
+							},
+						},
+					},
+				},
+			},
+			cache: cacheStoresFromObjs(t,
+				testService(t, "1"),
+				testKongServiceFacade(t, "1"),
+			),
+			expected: []client.Object{
+				testKongServiceFacade(t, "1"),
+			},
+		},
+		{
+			name: "HTTPRoute -> ServiceImport",
+			object: &gatewayapi.HTTPRoute{
+				ObjectMeta: metav1.ObjectMeta{
+					Name:      "test-route",
+					Namespace: "test-namespace",
+				},
+				Spec: gatewayapi.HTTPRouteSpec{
+					Rules: []gatewayapi.HTTPRouteRule{
+						{
+							BackendRefs: []gatewayapi.HTTPBackendRef{
+								{
+									BackendRef: gatewayapi.BackendRef{
+										BackendObjectReference: gatewayapi.BackendObjectReference{
+											Name:  "1",
+											Group: lo.ToPtr(gatewayapi.Group(mcsv1alpha1.GroupVersion.Group)),
+											Kind:  lo.ToPtr(gatewayapi.Kind(mcsv1alpha1.ServiceImportKind)),
+										},
+									},
+								},
+							},
+						},
+					},
+				},
+			},
+			cache: cacheStoresFromObjs(t,
+				testService(t, "1"),
+				testServiceImport(t, "1"),
+			),
+			expected: []client.Object{
+				testServiceImport(t, "1"),
+			},
+		},
 		{
 			name: "HTTPRoute -> KongPlugin, KongClusterPlugin",
 			object: &gatewayapi.HTTPRoute{
@@ -458,3 +528,122 @@ func TestResolveDependencies_GRPCRoute(t *testing.T) {
 		runResolveDependenciesTest(t, tc)
 	}
 }
+
+func TestResolveDependencies_BackendTLSPolicy(t *testing.T) {
+	caConfigMap := helpers.WithTypeMeta(t, &corev1.ConfigMap{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      "ca",
+			Namespace: testNamespace,
+		},
+	})
+	policy := helpers.WithTypeMeta(t, &gatewayapi.BackendTLSPolicy{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      "policy",
+			Namespace: testNamespace,
+		},
+		Spec: gatewayapi.BackendTLSPolicySpec{
+			TargetRefs: []gatewayapi.LocalPolicyTargetReferenceWithSectionName{
+				{
+					LocalPolicyTargetReference: gatewayapi.LocalPolicyTargetReference{
+						Kind: "Service",
+						Name: "1",
+					},
+				},
+			},
+			Validation: gatewayapi.BackendTLSPolicyValidation{
+				CACertificateRefs: []gatewayapi.LocalObjectReference{
+					{Kind: "ConfigMap", Name: "ca"},
+					{Kind: "Secret", Name: "1"},
+				},
+				Hostname: "example.com",
+			},
+		},
+	})
+
+	testCases := []resolveDependenciesTestCase{
+		{
+			name:   "BackendTLSPolicy -> ConfigMap, Secret",
+			object: policy,
+			cache: cacheStoresFromObjs(t,
+				caConfigMap,
+				testSecret(t, "1"),
+				testSecret(t, "2"),
+			),
+			expected: []client.Object{
+				caConfigMap,
+				testSecret(t, "1"),
+			},
+		},
+		{
+			name:   "Service -> BackendTLSPolicy",
+			object: testService(t, "1"),
+			cache: cacheStoresFromObjs(t,
+				policy,
+			),
+			expected: []client.Object{
+				policy,
+			},
+		},
+		{
+			name:   "Service not targeted by BackendTLSPolicy",
+			object: testService(t, "2"),
+			cache: cacheStoresFromObjs(t,
+				policy,
+			),
+			expected: []client.Object{},
+		},
+	}
+
+	for _, tc := range testCases {
+		runResolveDependenciesTest(t, tc)
+	}
+}
+
+func TestResolveDependencies_BackendLBPolicy(t *testing.T) {
+	policy := helpers.WithTypeMeta(t, &gatewayapi.BackendLBPolicy{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      "policy",
+			Namespace: testNamespace,
+		},
+		Spec: gatewayapi.BackendLBPolicySpec{
+			TargetRefs: []gatewayapi.LocalPolicyTargetReference{
+				{
+					Kind: "Service",
+					Name: "1",
+				},
+			},
+			SessionPersistence: &gatewayapi.SessionPersistence{},
+		},
+	})
+
+	testCases := []resolveDependenciesTestCase{
+		{
+			name:     "BackendLBPolicy has no dependencies",
+			object:   policy,
+			cache:    cacheStoresFromObjs(t, testService(t, "1")),
+			expected: []client.Object{},
+		},
+		{
+			name:   "Service -> BackendLBPolicy",
+			object: testService(t, "1"),
+			cache: cacheStoresFromObjs(t,
+				policy,
+			),
+			expected: []client.Object{
+				policy,
+			},
+		},
+		{
+			name:   "Service not targeted by BackendLBPolicy",
+			object: testService(t, "2"),
+			cache: cacheStoresFromObjs(t,
+				policy,
+			),
+			expected: []client.Object{},
+		},
+	}
+
+	for _, tc := range testCases {
+		runResolveDependenciesTest(t, tc)
+	}
+}
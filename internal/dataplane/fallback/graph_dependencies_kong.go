@@ -3,73 +3,69 @@ package fallback
 import (
 	"fmt"
 
+	"github.com/samber/lo"
 	k8stypes "k8s.io/apimachinery/pkg/types"
 	"sigs.k8s.io/controller-runtime/pkg/client"
 
 	"github.com/kong/kubernetes-ingress-controller/v3/internal/store"
 	kongv1 "github.com/kong/kubernetes-ingress-controller/v3/pkg/apis/configuration/v1"
+	kongv1alpha1 "github.com/kong/kubernetes-ingress-controller/v3/pkg/apis/configuration/v1alpha1"
 	kongv1beta1 "github.com/kong/kubernetes-ingress-controller/v3/pkg/apis/configuration/v1beta1"
 	incubatorv1alpha1 "github.com/kong/kubernetes-ingress-controller/v3/pkg/apis/incubator/v1alpha1"
 )
 
 // resolveKongPluginDependencies resolves potential dependencies for a KongPlugin object:
-// - Secret.
+// - Secret
+// - ConfigMap.
 func resolveKongPluginDependencies(cache store.CacheStores, kongPlugin *kongv1.KongPlugin) []client.Object {
 	var dependencies []client.Object
 	if cf := kongPlugin.ConfigFrom; cf != nil {
-		if s, ok := fetchSecret(
-			cache,
-			k8stypes.NamespacedName{
-				Namespace: kongPlugin.Namespace,
-				Name:      cf.SecretValue.Secret,
-			},
-		); ok {
-			dependencies = append(dependencies, s)
+		if obj, ok := fetchConfigSource(cache, kongPlugin.Namespace, *cf); ok {
+			dependencies = append(dependencies, obj)
 		}
 	}
 	for _, cp := range kongPlugin.ConfigPatches {
-		if s, ok := fetchSecret(
-			cache,
-			k8stypes.NamespacedName{
-				Namespace: kongPlugin.Namespace,
-				Name:      cp.ValueFrom.SecretValue.Secret,
-			},
-		); ok {
-			dependencies = append(dependencies, s)
+		if obj, ok := fetchConfigSource(cache, kongPlugin.Namespace, cp.ValueFrom); ok {
+			dependencies = append(dependencies, obj)
 		}
 	}
 	return dependencies
 }
 
 // resolveKongClusterPluginDependencies resolves potential dependencies for a KongClusterPlugin object:
-// - Secret.
+// - Secret
+// - ConfigMap.
 func resolveKongClusterPluginDependencies(cache store.CacheStores, kongClusterPlugin *kongv1.KongClusterPlugin) []client.Object {
 	var dependencies []client.Object
 	if cf := kongClusterPlugin.ConfigFrom; cf != nil {
-		if s, ok := fetchSecret(
-			cache,
-			k8stypes.NamespacedName{
-				Namespace: cf.SecretValue.Namespace,
-				Name:      cf.SecretValue.Secret,
-			},
-		); ok {
-			dependencies = append(dependencies, s)
+		if obj, ok := fetchNamespacedConfigSource(cache, *cf); ok {
+			dependencies = append(dependencies, obj)
 		}
 	}
 	for _, cp := range kongClusterPlugin.ConfigPatches {
-		if s, ok := fetchSecret(
-			cache,
-			k8stypes.NamespacedName{
-				Namespace: cp.ValueFrom.SecretValue.Namespace,
-				Name:      cp.ValueFrom.SecretValue.Secret,
-			},
-		); ok {
-			dependencies = append(dependencies, s)
+		if obj, ok := fetchNamespacedConfigSource(cache, cp.ValueFrom); ok {
+			dependencies = append(dependencies, obj)
 		}
 	}
 	return dependencies
 }
 
+// fetchConfigSource fetches the Secret or the ConfigMap a KongPlugin configuration is sourced from.
+func fetchConfigSource(cache store.CacheStores, namespace string, source kongv1.ConfigSource) (client.Object, bool) {
+	if cm := source.ConfigMapValue; cm != nil {
+		return fetchConfigMap(cache, k8stypes.NamespacedName{Namespace: namespace, Name: cm.ConfigMap})
+	}
+	return fetchSecret(cache, k8stypes.NamespacedName{Namespace: namespace, Name: source.SecretValue.Secret})
+}
+
+// fetchNamespacedConfigSource fetches the Secret or the ConfigMap a KongClusterPlugin configuration is sourced from.
+func fetchNamespacedConfigSource(cache store.CacheStores, source kongv1.NamespacedConfigSource) (client.Object, bool) {
+	if cm := source.ConfigMapValue; cm != nil {
+		return fetchConfigMap(cache, k8stypes.NamespacedName{Namespace: cm.Namespace, Name: cm.ConfigMap})
+	}
+	return fetchSecret(cache, k8stypes.NamespacedName{Namespace: source.SecretValue.Namespace, Name: source.SecretValue.Secret})
+}
+
 // resolveKongConsumerDependencies resolves potential dependencies for a KongConsumer object:
 // - KongPlugin
 // - KongClusterPlugin.
@@ -123,3 +119,28 @@ func resolveTCPIngressDependencies(cache store.CacheStores, tcpIngress *kongv1be
 func resolveKongServiceFacadeDependencies(cache store.CacheStores, kongServiceFacade *incubatorv1alpha1.KongServiceFacade) []client.Object {
 	return resolveDependenciesForServiceLikeObj(cache, kongServiceFacade)
 }
+
+// resolveKongCustomEntityDependencies resolves potential dependencies for a KongCustomEntity object:
+// - KongPlugin
+// - KongClusterPlugin.
+func resolveKongCustomEntityDependencies(cache store.CacheStores, kongCustomEntity *kongv1alpha1.KongCustomEntity) []client.Object {
+	ref := kongCustomEntity.Spec.ParentRef
+	if ref == nil {
+		return nil
+	}
+	var (
+		obj    interface{}
+		exists bool
+		err    error
+	)
+	switch lo.FromPtr(ref.Kind) {
+	case "", "KongPlugin":
+		obj, exists, err = cache.Plugin.GetByKey(fmt.Sprintf("%s/%s", kongCustomEntity.Namespace, ref.Name))
+	case "KongClusterPlugin":
+		obj, exists, err = cache.ClusterPlugin.GetByKey(ref.Name)
+	}
+	if err != nil || !exists {
+		return nil
+	}
+	return []client.Object{obj.(client.Object)}
+}
@@ -3,12 +3,14 @@ package fallback_test
 import (
 	"testing"
 
+	"github.com/samber/lo"
 	corev1 "k8s.io/api/core/v1"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"sigs.k8s.io/controller-runtime/pkg/client"
 
 	"github.com/kong/kubernetes-ingress-controller/v3/internal/annotations"
 	kongv1 "github.com/kong/kubernetes-ingress-controller/v3/pkg/apis/configuration/v1"
+	kongv1alpha1 "github.com/kong/kubernetes-ingress-controller/v3/pkg/apis/configuration/v1alpha1"
 	kongv1beta1 "github.com/kong/kubernetes-ingress-controller/v3/pkg/apis/configuration/v1beta1"
 	incubatorv1alpha1 "github.com/kong/kubernetes-ingress-controller/v3/pkg/apis/incubator/v1alpha1"
 )
@@ -104,6 +106,43 @@ func TestResolveDependencies_KongPlugin(t *testing.T) {
 			),
 			expected: []client.Object{testSecret(t, "1"), testSecret(t, "2")},
 		},
+		{
+			name: "KongPlugin -> ConfigMap referenced by ConfigFrom and Secret and ConfigMap referenced by ConfigPatches",
+			object: &kongv1.KongPlugin{
+				ObjectMeta: metav1.ObjectMeta{
+					Name:      "test-KongPlugin",
+					Namespace: testNamespace,
+				},
+				ConfigFrom: &kongv1.ConfigSource{
+					ConfigMapValue: &kongv1.ConfigMapValueFromSource{
+						ConfigMap: "1",
+					},
+				},
+				ConfigPatches: []kongv1.ConfigPatch{
+					{
+						ValueFrom: kongv1.ConfigSource{
+							SecretValue: kongv1.SecretValueFromSource{
+								Secret: "1",
+							},
+						},
+					},
+					{
+						ValueFrom: kongv1.ConfigSource{
+							ConfigMapValue: &kongv1.ConfigMapValueFromSource{
+								ConfigMap: "2",
+							},
+						},
+					},
+				},
+			},
+			cache: cacheStoresFromObjs(t,
+				testSecret(t, "1"),
+				testSecret(t, "2"),
+				testConfigMap(t, "1"),
+				testConfigMap(t, "2"),
+			),
+			expected: []client.Object{testConfigMap(t, "1"), testSecret(t, "1"), testConfigMap(t, "2")},
+		},
 	}
 
 	for _, tc := range testCases {
@@ -207,6 +246,35 @@ func TestResolveDependencies_KongClusterPlugin(t *testing.T) {
 				testSecret(t, "2"),
 			},
 		},
+		{
+			name: "KongClusterPlugin -> ConfigMaps referenced by ConfigFrom and ConfigPatches",
+			object: &kongv1.KongClusterPlugin{
+				ObjectMeta: metav1.ObjectMeta{
+					Name: "test-KongClusterPlugin",
+				},
+				ConfigFrom: &kongv1.NamespacedConfigSource{
+					ConfigMapValue: &kongv1.NamespacedConfigMapValueFromSource{
+						Namespace: testNamespace,
+						ConfigMap: "1",
+					},
+				},
+				ConfigPatches: []kongv1.NamespacedConfigPatch{
+					{
+						ValueFrom: kongv1.NamespacedConfigSource{
+							ConfigMapValue: &kongv1.NamespacedConfigMapValueFromSource{
+								Namespace: "another-namespace",
+								ConfigMap: "2",
+							},
+						},
+					},
+				},
+			},
+			cache: cacheStoresFromObjs(t,
+				testConfigMap(t, "1"),
+				testConfigMap(t, "2"),
+			),
+			expected: []client.Object{testConfigMap(t, "1")},
+		},
 	}
 
 	for _, tc := range testCases {
@@ -706,3 +774,84 @@ func TestResolveDependencies_TCPIngress(t *testing.T) {
 		runResolveDependenciesTest(t, tc)
 	}
 }
+
+func TestResolveDependencies_KongCustomEntity(t *testing.T) {
+	testCases := []resolveDependenciesTestCase{
+		{
+			name: "no dependencies",
+			object: &kongv1alpha1.KongCustomEntity{
+				ObjectMeta: metav1.ObjectMeta{
+					Name:      "test-KongCustomEntity",
+					Namespace: testNamespace,
+				},
+			},
+			cache: cacheStoresFromObjs(t,
+				testKongPlugin(t, "1"),
+				testKongClusterPlugin(t, "1"),
+			),
+			expected: []client.Object{},
+		},
+		{
+			name: "KongCustomEntity -> KongPlugin (KongPlugin and KongClusterPlugin with the same name)",
+			object: &kongv1alpha1.KongCustomEntity{
+				ObjectMeta: metav1.ObjectMeta{
+					Name:      "test-KongCustomEntity",
+					Namespace: testNamespace,
+				},
+				Spec: kongv1alpha1.KongCustomEntitySpec{
+					ParentRef: &kongv1alpha1.ObjectReference{
+						Name: "1",
+					},
+				},
+			},
+			cache: cacheStoresFromObjs(t,
+				testKongPlugin(t, "1"),
+				testKongClusterPlugin(t, "1"),
+			),
+			expected: []client.Object{testKongPlugin(t, "1")},
+		},
+		{
+			name: "KongCustomEntity -> KongClusterPlugin",
+			object: &kongv1alpha1.KongCustomEntity{
+				ObjectMeta: metav1.ObjectMeta{
+					Name:      "test-KongCustomEntity",
+					Namespace: testNamespace,
+				},
+				Spec: kongv1alpha1.KongCustomEntitySpec{
+					ParentRef: &kongv1alpha1.ObjectReference{
+						Kind: lo.ToPtr("KongClusterPlugin"),
+						Name: "1",
+					},
+				},
+			},
+			cache: cacheStoresFromObjs(t,
+				testKongPlugin(t, "1"),
+				testKongClusterPlugin(t, "1"),
+			),
+			expected: []client.Object{testKongClusterPlugin(t, "1")},
+		},
+		{
+			name: "KongCustomEntity -> KongPlugin that does not exist",
+			object: &kongv1alpha1.KongCustomEntity{
+				ObjectMeta: metav1.ObjectMeta{
+					Name:      "test-KongCustomEntity",
+					Namespace: testNamespace,
+				},
+				Spec: kongv1alpha1.KongCustomEntitySpec{
+					ParentRef: &kongv1alpha1.ObjectReference{
+						Kind: lo.ToPtr("KongPlugin"),
+						Name: "2",
+					},
+				},
+			},
+			cache: cacheStoresFromObjs(t,
+				testKongPlugin(t, "1"),
+			),
+			expected: []client.Object{},
+		},
+	}
+
+	for _, tc := range testCases {
+		runResolveDependenciesTest(t, tc)
+	}
+}
@@ -1,16 +1,33 @@
 package fallback
 
 import (
+	"slices"
+
 	corev1 "k8s.io/api/core/v1"
 	"sigs.k8s.io/controller-runtime/pkg/client"
 
+	mcsv1alpha1 "github.com/kong/kubernetes-ingress-controller/v3/internal/mcsapi/v1alpha1"
 	"github.com/kong/kubernetes-ingress-controller/v3/internal/store"
 )
 
 // resolveServiceDependencies resolves potential dependencies for a Service object:
 // - KongPlugin
 // - KongClusterPlugin
-// - KongUpstreamPolicy.
+// - KongUpstreamPolicy
+// - BackendTLSPolicy
+// - BackendLBPolicy.
 func resolveServiceDependencies(cache store.CacheStores, service *corev1.Service) []client.Object {
-	return resolveDependenciesForServiceLikeObj(cache, service)
+	return slices.Concat(
+		resolveDependenciesForServiceLikeObj(cache, service),
+		resolveServiceDependenciesBackendTLSPolicy(cache, service),
+		resolveServiceDependenciesBackendLBPolicy(cache, service),
+	)
+}
+
+// resolveServiceImportDependencies resolves potential dependencies for a ServiceImport object:
+// - KongPlugin
+// - KongClusterPlugin
+// - KongUpstreamPolicy.
+func resolveServiceImportDependencies(cache store.CacheStores, serviceImport *mcsv1alpha1.ServiceImport) []client.Object {
+	return resolveDependenciesForServiceLikeObj(cache, serviceImport)
 }
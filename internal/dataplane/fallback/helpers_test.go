@@ -13,6 +13,7 @@ import (
 	"sigs.k8s.io/controller-runtime/pkg/client"
 
 	"github.com/kong/kubernetes-ingress-controller/v3/internal/dataplane/fallback"
+	mcsv1alpha1 "github.com/kong/kubernetes-ingress-controller/v3/internal/mcsapi/v1alpha1"
 	kongv1 "github.com/kong/kubernetes-ingress-controller/v3/pkg/apis/configuration/v1"
 	kongv1beta1 "github.com/kong/kubernetes-ingress-controller/v3/pkg/apis/configuration/v1beta1"
 	incubatorv1alpha1 "github.com/kong/kubernetes-ingress-controller/v3/pkg/apis/incubator/v1alpha1"
@@ -57,6 +58,15 @@ func testSecret(t *testing.T, name string, modifiers ...func(s *corev1.Secret))
 	return s
 }
 
+func testConfigMap(t *testing.T, name string) *corev1.ConfigMap {
+	return helpers.WithTypeMeta(t, &corev1.ConfigMap{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      name,
+			Namespace: testNamespace,
+		},
+	})
+}
+
 func testKongServiceFacade(t *testing.T, name string) *incubatorv1alpha1.KongServiceFacade {
 	return helpers.WithTypeMeta(t, &incubatorv1alpha1.KongServiceFacade{
 		ObjectMeta: metav1.ObjectMeta{
@@ -66,6 +76,15 @@ func testKongServiceFacade(t *testing.T, name string) *incubatorv1alpha1.KongSer
 	})
 }
 
+func testServiceImport(t *testing.T, name string) *mcsv1alpha1.ServiceImport {
+	return helpers.WithTypeMeta(t, &mcsv1alpha1.ServiceImport{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      name,
+			Namespace: testNamespace,
+		},
+	})
+}
+
 func testKongPlugin(t *testing.T, name string) *kongv1.KongPlugin {
 	return helpers.WithTypeMeta(t, &kongv1.KongPlugin{
 		ObjectMeta: metav1.ObjectMeta{
@@ -2,6 +2,7 @@ package dataplane
 
 import (
 	"context"
+	"encoding/hex"
 	"errors"
 	"fmt"
 	"reflect"
@@ -55,6 +56,11 @@ const (
 	FallbackKongConfigurationTranslationFailedEventReason = "FallbackKongConfigurationTranslationFailed"
 	// FallbackKongConfigurationApplyFailedEventReason defines an event reason used for creating fallback config apply resource failure events.
 	FallbackKongConfigurationApplyFailedEventReason = "FallbackKongConfigurationApplyFailed"
+
+	// KongConfigurationDryRunSucceededEventReason defines an event reason to tell the validation of Kong configuration in dry-run mode succeeded.
+	KongConfigurationDryRunSucceededEventReason = "KongConfigurationDryRunSucceeded"
+	// KongConfigurationDryRunFailedEventReason defines an event reason used for creating all dry-run validation resource failure events.
+	KongConfigurationDryRunFailedEventReason = "KongConfigurationDryRunFailed"
 )
 
 // -----------------------------------------------------------------------------
@@ -164,6 +170,9 @@ type KongClient struct {
 	// fallbackConfigGenerator is used to generate a fallback configuration in case of sync failures.
 	fallbackConfigGenerator FallbackConfigGenerator
 
+	// canaryHealthChecker is used to check health of canary gateways when staged rollout is enabled.
+	canaryHealthChecker CanaryHealthChecker
+
 	// lastProcessedSnapshotHash stores the hash of the last processed Kubernetes objects cache snapshot. It's used to determine configuration
 	// changes. Please note it is always empty when the `FallbackConfiguration` feature gate is turned off.
 	lastProcessedSnapshotHash store.SnapshotHash
@@ -210,6 +219,7 @@ func NewKongClient(
 		kongConfigBuilder:       kongConfigBuilder,
 		kongConfigFetcher:       kongConfigFetcher,
 		fallbackConfigGenerator: fallbackConfigGenerator,
+		canaryHealthChecker:     NewDefaultCanaryHealthChecker(logger, kongConfig.CanaryRollout.MaxErrorRate),
 	}
 	c.initializeControllerPodReference()
 
@@ -455,14 +465,15 @@ func (c *KongClient) Update(ctx context.Context) error {
 
 	c.logger.V(util.DebugLevel).Info("Parsing kubernetes objects into data-plane configuration")
 	parsingResult := c.kongConfigBuilder.BuildKongConfig()
+	c.prometheusMetrics.RecordTranslationDurations(parsingResult.TranslationDurations)
 	if failuresCount := len(parsingResult.TranslationFailures); failuresCount > 0 {
 		c.prometheusMetrics.RecordTranslationFailure()
-		c.prometheusMetrics.RecordTranslationBrokenResources(failuresCount)
+		c.prometheusMetrics.RecordTranslationBrokenResources(parsingResult.TranslationFailures)
 		c.recordResourceFailureEvents(parsingResult.TranslationFailures, KongConfigurationTranslationFailedEventReason)
 		c.logger.V(util.DebugLevel).Info("Translation failures occurred when building data-plane configuration", "count", failuresCount)
 	} else {
 		c.prometheusMetrics.RecordTranslationSuccess()
-		c.prometheusMetrics.RecordTranslationBrokenResources(0)
+		c.prometheusMetrics.RecordTranslationBrokenResources(nil)
 		c.logger.V(util.DebugLevel).Info("Successfully built data-plane configuration")
 	}
 
@@ -470,6 +481,11 @@ func (c *KongClient) Update(ctx context.Context) error {
 	shas, gatewaysSyncErr := c.sendOutToGatewayClients(ctx, parsingResult.KongState, c.kongConfig, isFallback)
 	konnectSyncErr := c.maybeSendOutToKonnectClient(ctx, parsingResult.KongState, c.kongConfig, isFallback)
 
+	// In dry-run mode nothing was applied, so there's no config status to report and nothing to recover from.
+	if c.kongConfig.DryRun {
+		return gatewaysSyncErr
+	}
+
 	// Taking into account the results of syncing configuration with Gateways and Konnect, and potential translation
 	// failures, calculate the config status and update it.
 	c.updateConfigStatus(ctx, clients.CalculateConfigStatus(
@@ -492,6 +508,7 @@ func (c *KongClient) Update(ctx context.Context) error {
 
 	// Gateways were successfully synced with the current configuration, so we can update the last valid cache snapshot.
 	c.maybePreserveTheLastValidConfigCache(cacheSnapshot)
+	c.recordConfigEntities(parsingResult.KongState)
 
 	// report on configured Kubernetes objects if enabled
 	if c.AreKubernetesObjectReportsEnabled() {
@@ -517,6 +534,24 @@ func (c *KongClient) maybePreserveTheLastValidConfigCache(lastValidCache store.C
 	}
 }
 
+// recordConfigEntities records the number of Kong entities in the configuration successfully pushed to the gateways.
+func (c *KongClient) recordConfigEntities(s *kongstate.KongState) {
+	c.prometheusMetrics.RecordConfigEntities(s.EntityCounts())
+}
+
+// maybePersistLastValidConfig persists the last valid configuration so that it can be used for recovery after
+// the controller and the gateways are restarted. It's only done in DB-less mode as in DB mode the configuration
+// is persisted in the database anyway, and never in dry-run mode in which nothing is applied.
+func (c *KongClient) maybePersistLastValidConfig(ctx context.Context) {
+	if !c.dbmode.IsDBLessMode() || c.kongConfig.DryRun {
+		return
+	}
+	if err := c.kongConfigFetcher.PersistLastValidConfig(ctx); err != nil {
+		// Failing to persist the configuration doesn't affect the gateways, so we only log it and carry on.
+		c.logger.Error(err, "Failed to persist last valid configuration")
+	}
+}
+
 // tryRecoveringFromGatewaysSyncError tries to recover from a configuration rejection by:
 // 1. Generating a fallback configuration and pushing it to the gateways if FallbackConfiguration feature is enabled.
 // 2. Applying the last valid configuration to the gateways if FallbackConfiguration is disabled or fallback
@@ -594,6 +629,7 @@ func (c *KongClient) tryRecoveringWithFallbackConfiguration(
 
 	// Configuration was successfully recovered with the fallback configuration. Store the last valid configuration.
 	c.maybePreserveTheLastValidConfigCache(fallbackCache)
+	c.recordConfigEntities(fallbackParsingResult.KongState)
 	return nil
 }
 
@@ -659,13 +695,17 @@ func (c *KongClient) sendOutToGatewayClients(
 	configureGatewayClientURLs := lo.Map(gatewayClientsToConfigure, func(cl *adminapi.Client, _ int) string { return cl.BaseRootURL() })
 	c.logger.V(util.DebugLevel).Info("Sending configuration to gateway clients", "urls", configureGatewayClientURLs)
 
-	shas, err := iter.MapErr(gatewayClientsToConfigure, func(client **adminapi.Client) (string, error) {
-		return c.sendToClient(ctx, *client, s, config, isFallback)
-	})
+	shas, err := c.sendToGatewayClients(ctx, gatewayClientsToConfigure, s, config, isFallback)
 	if err != nil {
 		return nil, err
 	}
 
+	// In dry-run mode the configuration was only validated, the gateways still run the previous one,
+	// so neither the SHAs nor the last valid configuration can be updated.
+	if config.DryRun {
+		return c.SHAs, nil
+	}
+
 	// After a successful configuration update in DB mode,
 	// since only ONE gateway client is chosen to send requests and store SHA of latest configurations,
 	// we should propagate the SHA from the chosen client to other clients
@@ -682,10 +722,43 @@ func (c *KongClient) sendOutToGatewayClients(
 	c.SHAs = shas
 
 	c.kongConfigFetcher.StoreLastValidConfig(s)
+	c.maybePersistLastValidConfig(ctx)
 
 	return previousSHAs, nil
 }
 
+// sendToGatewayClientsConcurrently sends the configuration to all the given gateway clients concurrently.
+func (c *KongClient) sendToGatewayClientsConcurrently(
+	ctx context.Context,
+	gatewayClients []*adminapi.Client,
+	s *kongstate.KongState,
+	config sendconfig.Config,
+	isFallback bool,
+) ([]string, error) {
+	return iter.MapErr(gatewayClients, func(client **adminapi.Client) (string, error) {
+		sha, err := c.sendToClient(ctx, *client, c.kongStateForGatewayClient(s, *client), config, isFallback)
+		// Dry runs don't change the configuration of gateways, so they're not recorded as syncs.
+		if !config.DryRun {
+			(*client).RecordSync(time.Now(), isFallback, err)
+		}
+		return sha, err
+	})
+}
+
+// kongStateForGatewayClient returns the KongState to send to the given gateway client. In DB-less mode, every
+// gateway has its own configuration, so targets in the zone of the gateway are preferred when the zone is known.
+// Targets get zones only when topology aware routing is enabled, otherwise the KongState is returned unchanged.
+func (c *KongClient) kongStateForGatewayClient(s *kongstate.KongState, client *adminapi.Client) *kongstate.KongState {
+	if !c.dbmode.IsDBLessMode() {
+		return s
+	}
+	zone, ok := client.Zone()
+	if !ok {
+		return s
+	}
+	return s.WithTargetsPreferringZone(zone)
+}
+
 // maybeSendOutToKonnectClient sends out the configuration to Konnect when KonnectClient is provided.
 // It's a noop when Konnect integration is not enabled.
 func (c *KongClient) maybeSendOutToKonnectClient(
@@ -759,7 +832,8 @@ func (c *KongClient) sendToClient(
 		AppendStubEntityWhenConfigEmpty: !client.IsKonnect() && config.InMemory,
 	}
 	targetContent := deckgen.ToDeckContent(ctx, logger, s, deckGenParams)
-	sendDiagnostic := prepareSendDiagnosticFn(ctx, logger, c.diagnostic, s, targetContent, deckGenParams)
+	customEntities := deckgen.ToCustomEntities(s)
+	sendDiagnostic := prepareSendDiagnosticFn(ctx, logger, c.diagnostic, s, targetContent, customEntities, deckGenParams, config.DryRun)
 
 	// apply the configuration update in Kong
 	timedCtx, cancel := context.WithTimeout(ctx, c.requestTimeout)
@@ -770,6 +844,7 @@ func (c *KongClient) sendToClient(
 		client,
 		config,
 		targetContent,
+		customEntities,
 		c.prometheusMetrics,
 		c.updateStrategyResolver,
 		c.configChangeDetector,
@@ -790,6 +865,9 @@ func (c *KongClient) sendToClient(
 			if isFallback {
 				reason = FallbackKongConfigurationApplyFailedEventReason
 			}
+			if config.DryRun {
+				reason = KongConfigurationDryRunFailedEventReason
+			}
 			c.recordResourceFailureEvents(updateErr.ResourceFailures(), reason)
 		}
 		if errors.As(err, &responseParsingErr) {
@@ -803,6 +881,10 @@ func (c *KongClient) sendToClient(
 		return "", fmt.Errorf("performing update for %s failed: %w", client.BaseRootURL(), err)
 	}
 	sendDiagnostic(false, nil) // No error occurred.
+	// Dry runs don't change the configuration of gateways, so their lastConfigSHA is left as is.
+	if config.DryRun {
+		return string(newConfigSHA), nil
+	}
 	// update the lastConfigSHA with the new updated checksum
 	client.SetLastConfigSHA(newConfigSHA)
 
@@ -832,7 +914,9 @@ func prepareSendDiagnosticFn(
 	diagnosticConfig util.ConfigDumpDiagnostic,
 	targetState *kongstate.KongState,
 	targetContent *file.Content,
+	customEntities deckgen.CustomEntitiesByType,
 	deckGenParams deckgen.GenerateDeckContentParams,
+	dryRun bool,
 ) sendDiagnosticFn {
 	if diagnosticConfig == (util.ConfigDumpDiagnostic{}) {
 		// noop, diagnostics won't be sent
@@ -851,6 +935,13 @@ func prepareSendDiagnosticFn(
 		config = redactedConfig
 	}
 
+	var hash string
+	if sha, err := deckgen.GenerateSHA(targetContent, customEntities); err != nil {
+		logger.Error(err, "Failed to generate diagnostic config hash")
+	} else {
+		hash = hex.EncodeToString(sha)
+	}
+
 	return func(failed bool, rawResponseBody []byte) {
 		// Given that we can send multiple configs to this channel and
 		// the fact that the API that exposes that can only expose 1 config
@@ -859,7 +950,7 @@ func prepareSendDiagnosticFn(
 		// or successfully send configs might be covered by those send
 		// later on but we're OK with this limitation of said API.
 		select {
-		case diagnosticConfig.Configs <- util.ConfigDump{Failed: failed, Config: *config, RawResponseBody: rawResponseBody}:
+		case diagnosticConfig.Configs <- util.ConfigDump{Failed: failed, Hash: hash, DryRun: dryRun, Config: *config, RawResponseBody: rawResponseBody}:
 			logger.V(util.DebugLevel).Info("Shipping config to diagnostic server")
 		default:
 			logger.Error(nil, "Config diagnostic buffer full, dropping diagnostic config")
@@ -967,6 +1058,15 @@ func (c *KongClient) recordApplyConfigurationEvents(err error, rootURL string, i
 		}
 	}
 
+	if c.kongConfig.DryRun {
+		reason = KongConfigurationDryRunSucceededEventReason
+		message = fmt.Sprintf("successfully validated Kong configuration against %s without applying it", rootURL)
+		if err != nil {
+			reason = KongConfigurationDryRunFailedEventReason
+			message = fmt.Sprintf("failed to validate Kong configuration against %s: %v", rootURL, err)
+		}
+	}
+
 	pod := &corev1.Pod{
 		ObjectMeta: metav1.ObjectMeta{
 			Name:      podNN.Name,
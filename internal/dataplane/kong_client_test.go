@@ -613,6 +613,37 @@ func TestKongClientUpdate_ConfigStatusIsNotified(t *testing.T) {
 	}
 }
 
+func TestKongClientUpdate_DryRunDoesNotChangeState(t *testing.T) {
+	var (
+		ctx                    = context.Background()
+		testGatewayClient      = mustSampleGatewayClient(t)
+		clientsProvider        = mockGatewayClientsProvider{gatewayClients: []*adminapi.Client{testGatewayClient}}
+		configChangeDetector   = mockConfigurationChangeDetector{hasConfigurationChanged: true}
+		configBuilder          = newMockKongConfigBuilder()
+		lastValidConfigFetcher = &mockKongLastValidConfigFetcher{}
+		updateStrategyResolver = newMockUpdateStrategyResolver(t)
+		statusQueue            = newMockConfigStatusQueue()
+		kongClient             = setupTestKongClient(t, updateStrategyResolver, clientsProvider, configChangeDetector, configBuilder, nil, lastValidConfigFetcher)
+	)
+	kongClient.kongConfig.DryRun = true
+	kongClient.SetConfigStatusNotifier(statusQueue)
+	configBuilder.kongState = &kongstate.KongState{
+		Services: []kongstate.Service{{Service: kong.Service{Name: kong.String("service")}}},
+	}
+
+	require.NoError(t, kongClient.Update(ctx))
+	updateStrategyResolver.assertUpdateCalledForURLs([]string{testGatewayClient.BaseRootURL()})
+	require.Empty(t, kongClient.SHAs, "SHAs should not be updated in dry-run mode")
+	require.Empty(t, testGatewayClient.LastConfigSHA(), "gateway client SHA should not be updated in dry-run mode")
+	_, hasLastValidConfig := lastValidConfigFetcher.LastValidConfig()
+	require.False(t, hasLastValidConfig, "last valid config should not be stored in dry-run mode")
+	require.Empty(t, statusQueue.Notifications(), "config status should not be notified in dry-run mode")
+
+	updateStrategyResolver.returnErrorOnUpdate(testGatewayClient.BaseRootURL())
+	require.Error(t, kongClient.Update(ctx))
+	require.Empty(t, statusQueue.Notifications(), "config status should not be notified in dry-run mode")
+}
+
 func TestKongClient_ApplyConfigurationEvents(t *testing.T) {
 	testGatewayClient := mustSampleGatewayClient(t)
 	clientsProvider := mockGatewayClientsProvider{
@@ -972,8 +1003,9 @@ func mapClientsToUrls(clients mockGatewayClientsProvider) []string {
 }
 
 type mockKongLastValidConfigFetcher struct {
-	kongRawState  *utils.KongRawState
-	lastKongState *kongstate.KongState
+	kongRawState   *utils.KongRawState
+	lastKongState  *kongstate.KongState
+	persistedState *kongstate.KongState
 }
 
 func (cf *mockKongLastValidConfigFetcher) LastValidConfig() (*kongstate.KongState, bool) {
@@ -987,6 +1019,11 @@ func (cf *mockKongLastValidConfigFetcher) StoreLastValidConfig(s *kongstate.Kong
 	cf.lastKongState = s
 }
 
+func (cf *mockKongLastValidConfigFetcher) PersistLastValidConfig(context.Context) error {
+	cf.persistedState = cf.lastKongState
+	return nil
+}
+
 func (cf *mockKongLastValidConfigFetcher) TryFetchingValidConfigFromGateways(context.Context, logr.Logger, []*adminapi.Client) error {
 	if cf.kongRawState != nil {
 		cf.lastKongState = configfetcher.KongRawStateToKongState(cf.kongRawState)
@@ -1045,42 +1082,47 @@ func TestKongClientUpdate_FetchStoreAndPushLastValidConfig(t *testing.T) {
 	configBuilder.kongState = newKongState
 
 	testCases := []struct {
-		name                  string
-		translationFailures   bool
-		gatewayFailuresCount  int
-		lastValidKongRawState *utils.KongRawState
-		lastKongStatusHash    string
-		expectedLastKongState *kongstate.KongState
-		errorsSize            int
+		name                       string
+		translationFailures        bool
+		gatewayFailuresCount       int
+		lastValidKongRawState      *utils.KongRawState
+		lastKongStatusHash         string
+		expectedLastKongState      *kongstate.KongState
+		expectedPersistedKongState *kongstate.KongState
+		errorsSize                 int
 	}{
 		{
-			name:                  "success, new fallback set",
-			lastValidKongRawState: lastKongRawState,
-			expectedLastKongState: newKongState,
-			lastKongStatusHash:    "xyz",
+			name:                       "success, new fallback set",
+			lastValidKongRawState:      lastKongRawState,
+			expectedLastKongState:      newKongState,
+			expectedPersistedKongState: newKongState,
+			lastKongStatusHash:         "xyz",
 		},
 		{
-			name:                  "no previous state, failure",
-			gatewayFailuresCount:  1,
-			expectedLastKongState: nil,
-			errorsSize:            1,
-			lastKongStatusHash:    sendconfig.WellKnownInitialHash,
+			name:                       "no previous state, failure",
+			gatewayFailuresCount:       1,
+			expectedLastKongState:      nil,
+			expectedPersistedKongState: nil,
+			errorsSize:                 1,
+			lastKongStatusHash:         sendconfig.WellKnownInitialHash,
 		},
 		{
-			name:                  "previous state, failure, fallback pushed with success",
-			gatewayFailuresCount:  1,
-			lastValidKongRawState: lastKongRawState,
-			expectedLastKongState: lastKongState,
-			errorsSize:            1,
-			lastKongStatusHash:    "xyz",
+			name:                       "previous state, failure, fallback pushed with success",
+			gatewayFailuresCount:       1,
+			lastValidKongRawState:      lastKongRawState,
+			expectedLastKongState:      lastKongState,
+			expectedPersistedKongState: lastKongState,
+			errorsSize:                 1,
+			lastKongStatusHash:         "xyz",
 		},
 		{
-			name:                  "previous state, failure, fallback pushed with failure",
-			gatewayFailuresCount:  2,
-			lastValidKongRawState: lastKongRawState,
-			expectedLastKongState: lastKongState,
-			errorsSize:            3,
-			lastKongStatusHash:    "xyz",
+			name:                       "previous state, failure, fallback pushed with failure",
+			gatewayFailuresCount:       2,
+			lastValidKongRawState:      lastKongRawState,
+			expectedLastKongState:      lastKongState,
+			expectedPersistedKongState: nil,
+			errorsSize:                 3,
+			lastKongStatusHash:         "xyz",
 		},
 	}
 
@@ -1116,6 +1158,7 @@ func TestKongClientUpdate_FetchStoreAndPushLastValidConfig(t *testing.T) {
 			}
 			s, _ := kongClient.kongConfigFetcher.LastValidConfig()
 			assert.Equal(t, tc.expectedLastKongState, s)
+			assert.Equal(t, tc.expectedPersistedKongState, kongRawStateGetter.persistedState)
 		})
 	}
 }
@@ -0,0 +1,78 @@
+package kongstate
+
+import (
+	"fmt"
+	"sort"
+
+	"github.com/samber/lo"
+	"github.com/samber/mo"
+	corev1 "k8s.io/api/core/v1"
+	k8stypes "k8s.io/apimachinery/pkg/types"
+
+	"github.com/kong/kubernetes-ingress-controller/v3/internal/gatewayapi"
+)
+
+// GetBackendLBPolicyForServices scans all Services in the group to see if the BackendLBPolicy targeting them is
+// consistent and returns a non-nil BackendLBPolicy if it is.
+//
+// We require either:
+// - all the Services to be targeted by the same BackendLBPolicy.
+// - none of the Services to be targeted by a BackendLBPolicy.
+//
+// If the BackendLBPolicy configuration is inconsistent, an error is returned.
+func GetBackendLBPolicyForServices(
+	policies []*gatewayapi.BackendLBPolicy,
+	servicesGroup []*corev1.Service,
+) (*gatewayapi.BackendLBPolicy, error) {
+	if len(servicesGroup) == 0 || len(policies) == 0 {
+		return nil, nil
+	}
+
+	policiesByService := make(map[*corev1.Service]*gatewayapi.BackendLBPolicy, len(servicesGroup))
+	servicesGroupedByPolicy := lo.GroupBy(servicesGroup, func(svc *corev1.Service) mo.Option[k8stypes.NamespacedName] {
+		policy := getBackendLBPolicyForService(policies, svc)
+		if policy == nil {
+			return mo.None[k8stypes.NamespacedName]()
+		}
+		policiesByService[svc] = policy
+		return mo.Some(k8stypes.NamespacedName{Namespace: policy.Namespace, Name: policy.Name})
+	})
+
+	// If there's more than one group, then there are services targeted by different BackendLBPolicies.
+	if len(servicesGroupedByPolicy) > 1 {
+		return nil, fmt.Errorf("inconsistent BackendLBPolicy configuration for services %s",
+			prettyPrintServiceList(servicesGroup))
+	}
+
+	// There's one group, so either all services are targeted by the same BackendLBPolicy, or none of them is.
+	return policiesByService[servicesGroup[0]], nil
+}
+
+// getBackendLBPolicyForService returns the BackendLBPolicy targeting the given Service. Conflicts between multiple
+// policies targeting the same Service are resolved in favor of the oldest policy, as prescribed by the Gateway API
+// policy attachment specification.
+func getBackendLBPolicyForService(policies []*gatewayapi.BackendLBPolicy, svc *corev1.Service) *gatewayapi.BackendLBPolicy {
+	candidates := lo.Filter(policies, func(policy *gatewayapi.BackendLBPolicy, _ int) bool {
+		return policy.Namespace == svc.Namespace &&
+			lo.ContainsBy(policy.Spec.TargetRefs, func(targetRef gatewayapi.LocalPolicyTargetReference) bool {
+				return isBackendLBPolicyTargetRefService(targetRef) && string(targetRef.Name) == svc.Name
+			})
+	})
+	if len(candidates) == 0 {
+		return nil
+	}
+
+	sort.SliceStable(candidates, func(i, j int) bool {
+		ti, tj := candidates[i].CreationTimestamp, candidates[j].CreationTimestamp
+		if !ti.Equal(&tj) {
+			return ti.Before(&tj)
+		}
+		return candidates[i].Name < candidates[j].Name
+	})
+	return candidates[0]
+}
+
+// isBackendLBPolicyTargetRefService returns true if the targetRef points to a core Service.
+func isBackendLBPolicyTargetRefService(targetRef gatewayapi.LocalPolicyTargetReference) bool {
+	return (targetRef.Group == "" || targetRef.Group == "core") && targetRef.Kind == "Service"
+}
@@ -0,0 +1,109 @@
+package kongstate
+
+import (
+	"fmt"
+	"sort"
+
+	"github.com/samber/lo"
+	"github.com/samber/mo"
+	corev1 "k8s.io/api/core/v1"
+	k8stypes "k8s.io/apimachinery/pkg/types"
+
+	"github.com/kong/kubernetes-ingress-controller/v3/internal/gatewayapi"
+)
+
+// GetBackendTLSPolicyForServices scans all Services in the group to see if the BackendTLSPolicy targeting them is
+// consistent and returns a non-nil BackendTLSPolicy if it is. The port is the Services' port the Kong Service
+// sends traffic to, it's used to match policies targeting a specific port with a sectionName.
+//
+// We require either:
+// - all the Services to be targeted by the same BackendTLSPolicy.
+// - none of the Services to be targeted by a BackendTLSPolicy.
+//
+// If the BackendTLSPolicy configuration is inconsistent, an error is returned.
+func GetBackendTLSPolicyForServices(
+	policies []*gatewayapi.BackendTLSPolicy,
+	servicesGroup []*corev1.Service,
+	port int,
+) (*gatewayapi.BackendTLSPolicy, error) {
+	if len(servicesGroup) == 0 || len(policies) == 0 {
+		return nil, nil
+	}
+
+	policiesByService := make(map[*corev1.Service]*gatewayapi.BackendTLSPolicy, len(servicesGroup))
+	servicesGroupedByPolicy := lo.GroupBy(servicesGroup, func(svc *corev1.Service) mo.Option[k8stypes.NamespacedName] {
+		policy := getBackendTLSPolicyForService(policies, svc, port)
+		if policy == nil {
+			return mo.None[k8stypes.NamespacedName]()
+		}
+		policiesByService[svc] = policy
+		return mo.Some(k8stypes.NamespacedName{Namespace: policy.Namespace, Name: policy.Name})
+	})
+
+	// If there's more than one group, then there are services targeted by different BackendTLSPolicies.
+	if len(servicesGroupedByPolicy) > 1 {
+		return nil, fmt.Errorf("inconsistent BackendTLSPolicy configuration for services %s",
+			prettyPrintServiceList(servicesGroup))
+	}
+
+	// There's one group, so either all services are targeted by the same BackendTLSPolicy, or none of them is.
+	return policiesByService[servicesGroup[0]], nil
+}
+
+// getBackendTLSPolicyForService returns the BackendTLSPolicy targeting the given Service's port. In case of
+// multiple policies targeting the same Service, the one targeting the port by its name takes precedence over
+// the ones targeting the whole Service. Remaining conflicts are resolved in favor of the oldest policy, as
+// prescribed by the Gateway API policy attachment specification.
+func getBackendTLSPolicyForService(policies []*gatewayapi.BackendTLSPolicy, svc *corev1.Service, port int) *gatewayapi.BackendTLSPolicy {
+	portName := ""
+	for _, p := range svc.Spec.Ports {
+		if int(p.Port) == port {
+			portName = p.Name
+			break
+		}
+	}
+
+	type candidate struct {
+		policy          *gatewayapi.BackendTLSPolicy
+		targetsPortName bool
+	}
+	var candidates []candidate
+	for _, policy := range policies {
+		if policy.Namespace != svc.Namespace {
+			continue
+		}
+		for _, targetRef := range policy.Spec.TargetRefs {
+			if !isBackendTLSPolicyTargetRefService(targetRef) || string(targetRef.Name) != svc.Name {
+				continue
+			}
+			if targetRef.SectionName == nil {
+				candidates = append(candidates, candidate{policy: policy})
+				break
+			}
+			if portName != "" && string(*targetRef.SectionName) == portName {
+				candidates = append(candidates, candidate{policy: policy, targetsPortName: true})
+				break
+			}
+		}
+	}
+	if len(candidates) == 0 {
+		return nil
+	}
+
+	sort.SliceStable(candidates, func(i, j int) bool {
+		if candidates[i].targetsPortName != candidates[j].targetsPortName {
+			return candidates[i].targetsPortName
+		}
+		ti, tj := candidates[i].policy.CreationTimestamp, candidates[j].policy.CreationTimestamp
+		if !ti.Equal(&tj) {
+			return ti.Before(&tj)
+		}
+		return candidates[i].policy.Name < candidates[j].policy.Name
+	})
+	return candidates[0].policy
+}
+
+// isBackendTLSPolicyTargetRefService returns true if the targetRef points to a core Service.
+func isBackendTLSPolicyTargetRefService(targetRef gatewayapi.LocalPolicyTargetReferenceWithSectionName) bool {
+	return (targetRef.Group == "" || targetRef.Group == "core") && targetRef.Kind == "Service"
+}
@@ -0,0 +1,164 @@
+package kongstate_test
+
+import (
+	"testing"
+	"time"
+
+	"github.com/samber/lo"
+	"github.com/stretchr/testify/require"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	"github.com/kong/kubernetes-ingress-controller/v3/internal/dataplane/kongstate"
+	"github.com/kong/kubernetes-ingress-controller/v3/internal/gatewayapi"
+)
+
+func TestGetBackendTLSPolicyForServices(t *testing.T) {
+	now := time.Now()
+	newService := func(name string) *corev1.Service {
+		return &corev1.Service{
+			ObjectMeta: metav1.ObjectMeta{
+				Name:      name,
+				Namespace: "default",
+			},
+			Spec: corev1.ServiceSpec{
+				Ports: []corev1.ServicePort{
+					{Name: "http", Port: 80},
+					{Name: "https", Port: 443},
+				},
+			},
+		}
+	}
+	newPolicy := func(name string, created time.Time, targetRefs ...gatewayapi.LocalPolicyTargetReferenceWithSectionName) *gatewayapi.BackendTLSPolicy {
+		return &gatewayapi.BackendTLSPolicy{
+			ObjectMeta: metav1.ObjectMeta{
+				Name:              name,
+				Namespace:         "default",
+				CreationTimestamp: metav1.NewTime(created),
+			},
+			Spec: gatewayapi.BackendTLSPolicySpec{
+				TargetRefs: targetRefs,
+			},
+		}
+	}
+	targetService := func(name string, sectionName *gatewayapi.SectionName) gatewayapi.LocalPolicyTargetReferenceWithSectionName {
+		return gatewayapi.LocalPolicyTargetReferenceWithSectionName{
+			LocalPolicyTargetReference: gatewayapi.LocalPolicyTargetReference{
+				Group: "core",
+				Kind:  "Service",
+				Name:  gatewayapi.ObjectName(name),
+			},
+			SectionName: sectionName,
+		}
+	}
+
+	testCases := []struct {
+		name          string
+		servicesGroup []*corev1.Service
+		policies      []*gatewayapi.BackendTLSPolicy
+		port          int
+		expectPolicy  string
+		expectError   string
+	}{
+		{
+			name: "no services in group gives no policy",
+			policies: []*gatewayapi.BackendTLSPolicy{
+				newPolicy("policy", now, targetService("svc", nil)),
+			},
+			port: 443,
+		},
+		{
+			name:          "services not targeted by any policy give no policy",
+			servicesGroup: []*corev1.Service{newService("svc-1"), newService("svc-2")},
+			policies: []*gatewayapi.BackendTLSPolicy{
+				newPolicy("policy", now, targetService("other-svc", nil)),
+			},
+			port: 443,
+		},
+		{
+			name:          "all services targeted by the same policy give the policy",
+			servicesGroup: []*corev1.Service{newService("svc-1"), newService("svc-2")},
+			policies: []*gatewayapi.BackendTLSPolicy{
+				newPolicy("policy", now, targetService("svc-1", nil), targetService("svc-2", nil)),
+			},
+			port:         443,
+			expectPolicy: "policy",
+		},
+		{
+			name:          "services targeted by different policies give error",
+			servicesGroup: []*corev1.Service{newService("svc-1"), newService("svc-2")},
+			policies: []*gatewayapi.BackendTLSPolicy{
+				newPolicy("policy-1", now, targetService("svc-1", nil)),
+				newPolicy("policy-2", now, targetService("svc-2", nil)),
+			},
+			port:        443,
+			expectError: "inconsistent BackendTLSPolicy configuration for services",
+		},
+		{
+			name:          "one service targeted and one not targeted by a policy gives error",
+			servicesGroup: []*corev1.Service{newService("svc-1"), newService("svc-2")},
+			policies: []*gatewayapi.BackendTLSPolicy{
+				newPolicy("policy", now, targetService("svc-1", nil)),
+			},
+			port:        443,
+			expectError: "inconsistent BackendTLSPolicy configuration for services",
+		},
+		{
+			name:          "policy targeting other port by sectionName gives no policy",
+			servicesGroup: []*corev1.Service{newService("svc")},
+			policies: []*gatewayapi.BackendTLSPolicy{
+				newPolicy("policy", now, targetService("svc", lo.ToPtr(gatewayapi.SectionName("http")))),
+			},
+			port: 443,
+		},
+		{
+			name:          "policy targeting port by sectionName takes precedence over older policy targeting whole service",
+			servicesGroup: []*corev1.Service{newService("svc")},
+			policies: []*gatewayapi.BackendTLSPolicy{
+				newPolicy("whole-service", now.Add(-time.Hour), targetService("svc", nil)),
+				newPolicy("https-port", now, targetService("svc", lo.ToPtr(gatewayapi.SectionName("https")))),
+			},
+			port:         443,
+			expectPolicy: "https-port",
+		},
+		{
+			name:          "oldest policy wins among conflicting policies",
+			servicesGroup: []*corev1.Service{newService("svc")},
+			policies: []*gatewayapi.BackendTLSPolicy{
+				newPolicy("newer", now, targetService("svc", nil)),
+				newPolicy("older", now.Add(-time.Hour), targetService("svc", nil)),
+			},
+			port:         443,
+			expectPolicy: "older",
+		},
+		{
+			name:          "policy in other namespace is ignored",
+			servicesGroup: []*corev1.Service{newService("svc")},
+			policies: []*gatewayapi.BackendTLSPolicy{
+				func() *gatewayapi.BackendTLSPolicy {
+					p := newPolicy("policy", now, targetService("svc", nil))
+					p.Namespace = "other"
+					return p
+				}(),
+			},
+			port: 443,
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			policy, err := kongstate.GetBackendTLSPolicyForServices(tc.policies, tc.servicesGroup, tc.port)
+			if tc.expectError != "" {
+				require.ErrorContains(t, err, tc.expectError)
+				return
+			}
+			require.NoError(t, err)
+			if tc.expectPolicy == "" {
+				require.Nil(t, policy)
+				return
+			}
+			require.NotNil(t, policy)
+			require.Equal(t, tc.expectPolicy, policy.Name)
+		})
+	}
+}
@@ -1,6 +1,23 @@
 package kongstate
 
-import "github.com/kong/go-kong/kong"
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"sort"
+	"time"
+
+	"github.com/go-logr/logr"
+	"github.com/kong/go-kong/kong"
+	"github.com/samber/lo"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	"github.com/kong/kubernetes-ingress-controller/v3/internal/dataplane/failures"
+	"github.com/kong/kubernetes-ingress-controller/v3/internal/store"
+	"github.com/kong/kubernetes-ingress-controller/v3/internal/util"
+	kongv1 "github.com/kong/kubernetes-ingress-controller/v3/pkg/apis/configuration/v1"
+	kongv1alpha1 "github.com/kong/kubernetes-ingress-controller/v3/pkg/apis/configuration/v1alpha1"
+)
 
 // EntityFieldType represents type of a Kong entity field.
 // possible field types include boolean, integer, number, string, array, set, map, record, json, foreign.
@@ -89,3 +106,360 @@ func ExtractEntityFieldDefinitions(schema kong.Schema) EntitySchema {
 	}
 	return retSchema
 }
+
+// customEntitySchemaTimeout is the timeout of fetching a schema of a custom entity type from Kong gateway.
+const customEntitySchemaTimeout = 5 * time.Second
+
+// SchemaGetter gets the schema of a Kong entity type from Kong gateway.
+type SchemaGetter interface {
+	Get(ctx context.Context, entityType string) (kong.Schema, error)
+}
+
+// controllerManagedEntityTypes are the entity types that are generated by the controller from other Kubernetes
+// objects. KongCustomEntities of these types are rejected as they would conflict with the generated entities.
+var controllerManagedEntityTypes = map[string]struct{}{
+	"services":                 {},
+	"routes":                   {},
+	"upstreams":                {},
+	"targets":                  {},
+	"plugins":                  {},
+	"consumers":                {},
+	"consumer_groups":          {},
+	"consumer_group_consumers": {},
+	"certificates":             {},
+	"ca_certificates":          {},
+	"snis":                     {},
+	"vaults":                   {},
+	"licenses":                 {},
+	"keyauth_credentials":      {},
+	"basicauth_credentials":    {},
+	"hmacauth_credentials":     {},
+	"jwt_secrets":              {},
+	"acls":                     {},
+	"oauth2_credentials":       {},
+	"mtls_auth_credentials":    {},
+}
+
+// CustomEntity is a custom Kong entity translated from a KongCustomEntity.
+type CustomEntity struct {
+	// Object is the entity as it is put in the declarative configuration.
+	Object map[string]interface{}
+	// K8sKongCustomEntity is the KongCustomEntity the entity was translated from.
+	K8sKongCustomEntity *kongv1alpha1.KongCustomEntity
+}
+
+// KongCustomEntityCollection is a collection of custom Kong entities of the same type.
+type KongCustomEntityCollection struct {
+	// Schema is the schema of the entity type.
+	Schema EntitySchema
+	// Entities are the entities of the type.
+	Entities []CustomEntity
+}
+
+// FillCustomEntities translates KongCustomEntities to custom Kong entities. Schemas of the entity types are
+// retrieved from Kong gateway with schemaGetter and are used to validate the entities' fields and to find their
+// foreign fields. Foreign fields are filled with the services, routes, consumers or consumer groups that the
+// KongPlugin or KongClusterPlugin referenced in spec.parentRef is attached to, generating one entity per plugin
+// instance. Therefore, it has to be called after plugins and IDs are filled.
+func (ks *KongState) FillCustomEntities(
+	logger logr.Logger,
+	s store.Storer,
+	failuresCollector *failures.ResourceFailuresCollector,
+	schemaGetter SchemaGetter,
+) {
+	entities := s.ListKongCustomEntities()
+	if len(entities) == 0 {
+		return
+	}
+	if schemaGetter == nil {
+		logger.V(util.DebugLevel).Info("No schema getter available, skipping translation of KongCustomEntities")
+		return
+	}
+
+	// Sort the entities to get a stable order of the generated entities.
+	sort.SliceStable(entities, func(i, j int) bool {
+		if entities[i].Namespace != entities[j].Namespace {
+			return entities[i].Namespace < entities[j].Namespace
+		}
+		return entities[i].Name < entities[j].Name
+	})
+
+	schemas := map[string]EntitySchema{}
+	getSchema := func(entityType string) (EntitySchema, error) {
+		if schema, ok := schemas[entityType]; ok {
+			return schema, nil
+		}
+		ctx, cancel := context.WithTimeout(context.Background(), customEntitySchemaTimeout)
+		defer cancel()
+		rawSchema, err := schemaGetter.Get(ctx, entityType)
+		if err != nil {
+			return EntitySchema{}, err
+		}
+		schema := ExtractEntityFieldDefinitions(rawSchema)
+		schemas[entityType] = schema
+		return schema, nil
+	}
+
+	foreignIDs := ks.foreignEntityIDsByName()
+	for _, entity := range entities {
+		entityType := entity.Spec.EntityType
+		if _, ok := controllerManagedEntityTypes[entityType]; ok {
+			failuresCollector.PushResourceFailure(
+				fmt.Sprintf("entity type %q is managed by the controller and cannot be used in KongCustomEntity", entityType), entity,
+			)
+			continue
+		}
+		schema, err := getSchema(entityType)
+		if err != nil {
+			failuresCollector.PushResourceFailure(
+				fmt.Sprintf("failed to fetch schema of entity type %q: %v", entityType, err), entity,
+			)
+			continue
+		}
+		objects, err := ks.translateCustomEntity(s, entity, schema, foreignIDs)
+		if err != nil {
+			failuresCollector.PushResourceFailure(err.Error(), entity)
+			continue
+		}
+
+		if ks.CustomEntities == nil {
+			ks.CustomEntities = map[string]*KongCustomEntityCollection{}
+		}
+		collection, ok := ks.CustomEntities[entityType]
+		if !ok {
+			collection = &KongCustomEntityCollection{Schema: schema}
+			ks.CustomEntities[entityType] = collection
+		}
+		for _, obj := range objects {
+			collection.Entities = append(collection.Entities, CustomEntity{
+				Object:              obj,
+				K8sKongCustomEntity: entity,
+			})
+		}
+	}
+}
+
+// translateCustomEntity translates a KongCustomEntity to custom Kong entities, one for every instance of its parent
+// plugin in case the entity has foreign fields, or a single one otherwise.
+func (ks *KongState) translateCustomEntity(
+	s store.Storer,
+	entity *kongv1alpha1.KongCustomEntity,
+	schema EntitySchema,
+	foreignIDs map[string]map[string]string,
+) ([]map[string]interface{}, error) {
+	config, err := RawConfigToConfiguration(entity.Spec.Fields.Raw)
+	if err != nil {
+		return nil, fmt.Errorf("failed to parse fields of KongCustomEntity: %w", err)
+	}
+	fields := map[string]interface{}(config)
+	if fields == nil {
+		fields = map[string]interface{}{}
+	}
+	var foreignFields []EntityField
+	for _, field := range schema.Fields {
+		if field.Type == EntityFieldTypeForeign {
+			foreignFields = append(foreignFields, field)
+		}
+	}
+	sort.Slice(foreignFields, func(i, j int) bool { return foreignFields[i].Name < foreignFields[j].Name })
+	for name := range fields {
+		field, ok := schema.Fields[name]
+		if !ok {
+			return nil, fmt.Errorf("field %q is not defined in the schema of entity type %q", name, entity.Spec.EntityType)
+		}
+		if field.Type == EntityFieldTypeForeign {
+			return nil, fmt.Errorf("foreign field %q cannot be set in spec.fields, it is filled from spec.parentRef", name)
+		}
+	}
+	if _, ok := schema.Fields["tags"]; ok {
+		fields["tags"] = mergeCustomEntityTags(fields["tags"], util.GenerateTagsForObject(entity))
+	}
+
+	if len(foreignFields) == 0 {
+		return []map[string]interface{}{fields}, nil
+	}
+	if entity.Spec.ParentRef == nil {
+		if lo.ContainsBy(foreignFields, func(f EntityField) bool { return f.Required }) {
+			return nil, fmt.Errorf("spec.parentRef is required to fill the foreign fields of entity type %q", entity.Spec.EntityType)
+		}
+		return []map[string]interface{}{fields}, nil
+	}
+
+	parent, err := getCustomEntityParent(s, entity)
+	if err != nil {
+		return nil, err
+	}
+
+	var (
+		objects []map[string]interface{}
+		seen    = map[string]struct{}{}
+	)
+	for _, plugin := range ks.Plugins {
+		if !isCustomEntityParent(plugin.K8sParent, parent) {
+			continue
+		}
+		obj, ok := fillCustomEntityForeignFields(fields, foreignFields, plugin, foreignIDs)
+		if !ok {
+			continue
+		}
+		// Plugin instances attached to the same entities produce identical custom entities.
+		key, err := json.Marshal(obj)
+		if err != nil {
+			return nil, fmt.Errorf("failed to marshal custom entity: %w", err)
+		}
+		if _, ok := seen[string(key)]; ok {
+			continue
+		}
+		seen[string(key)] = struct{}{}
+		objects = append(objects, obj)
+	}
+	if len(objects) == 0 {
+		return nil, fmt.Errorf("%s %s is not attached to any entity providing the foreign fields of entity type %q",
+			parent.GetObjectKind().GroupVersionKind().Kind, parent.GetName(), entity.Spec.EntityType)
+	}
+	return objects, nil
+}
+
+// getCustomEntityParent returns the KongPlugin or KongClusterPlugin referenced in spec.parentRef of the KongCustomEntity.
+func getCustomEntityParent(s store.Storer, entity *kongv1alpha1.KongCustomEntity) (client.Object, error) {
+	ref := entity.Spec.ParentRef
+	if group := lo.FromPtr(ref.Group); group != "" && group != kongv1.GroupVersion.Group {
+		return nil, fmt.Errorf("unsupported group %q in spec.parentRef, only %q is supported", group, kongv1.GroupVersion.Group)
+	}
+	switch kind := lo.FromPtr(ref.Kind); kind {
+	case "", "KongPlugin":
+		if namespace := lo.FromPtr(ref.Namespace); namespace != "" && namespace != entity.Namespace {
+			return nil, fmt.Errorf("KongPlugin %s/%s in spec.parentRef must be in the same namespace as KongCustomEntity", namespace, ref.Name)
+		}
+		plugin, err := s.GetKongPlugin(entity.Namespace, ref.Name)
+		if err != nil {
+			return nil, fmt.Errorf("failed to get KongPlugin %s/%s in spec.parentRef: %w", entity.Namespace, ref.Name, err)
+		}
+		plugin = plugin.DeepCopy()
+		plugin.SetGroupVersionKind(kongv1.GroupVersion.WithKind("KongPlugin"))
+		return plugin, nil
+	case "KongClusterPlugin":
+		plugin, err := s.GetKongClusterPlugin(ref.Name)
+		if err != nil {
+			return nil, fmt.Errorf("failed to get KongClusterPlugin %s in spec.parentRef: %w", ref.Name, err)
+		}
+		plugin = plugin.DeepCopy()
+		plugin.SetGroupVersionKind(kongv1.GroupVersion.WithKind("KongClusterPlugin"))
+		return plugin, nil
+	default:
+		return nil, fmt.Errorf("unsupported kind %q in spec.parentRef, only KongPlugin and KongClusterPlugin are supported", kind)
+	}
+}
+
+// isCustomEntityParent tells whether the plugin was generated from the given parent object.
+func isCustomEntityParent(pluginParent client.Object, parent client.Object) bool {
+	if pluginParent == nil {
+		return false
+	}
+	switch parent.(type) {
+	case *kongv1.KongPlugin:
+		if _, ok := pluginParent.(*kongv1.KongPlugin); !ok {
+			return false
+		}
+	case *kongv1.KongClusterPlugin:
+		if _, ok := pluginParent.(*kongv1.KongClusterPlugin); !ok {
+			return false
+		}
+	}
+	return pluginParent.GetNamespace() == parent.GetNamespace() && pluginParent.GetName() == parent.GetName()
+}
+
+// fillCustomEntityForeignFields returns a copy of the fields with foreign fields filled with the entities the
+// plugin instance is attached to. It returns false if the plugin instance is not attached to an entity required
+// by the schema.
+func fillCustomEntityForeignFields(
+	fields map[string]interface{},
+	foreignFields []EntityField,
+	plugin Plugin,
+	foreignIDs map[string]map[string]string,
+) (map[string]interface{}, bool) {
+	obj := make(map[string]interface{}, len(fields)+len(foreignFields))
+	for k, v := range fields {
+		obj[k] = v
+	}
+	for _, field := range foreignFields {
+		var name string
+		switch field.Reference {
+		case string(kong.EntityTypeServices):
+			if plugin.Service != nil {
+				name = lo.FromPtr(plugin.Service.ID)
+			}
+		case string(kong.EntityTypeRoutes):
+			if plugin.Route != nil {
+				name = lo.FromPtr(plugin.Route.ID)
+			}
+		case string(kong.EntityTypeConsumers):
+			if plugin.Consumer != nil {
+				name = lo.FromPtr(plugin.Consumer.ID)
+			}
+		case string(kong.EntityTypeConsumerGroups):
+			if plugin.ConsumerGroup != nil {
+				name = lo.FromPtr(plugin.ConsumerGroup.ID)
+			}
+		}
+		if name == "" {
+			if field.Required {
+				return nil, false
+			}
+			continue
+		}
+		// Plugins refer to the entities they're attached to by their names. Use the IDs of the entities when they're
+		// filled, otherwise refer to them by their names that Kong accepts as endpoint keys.
+		if id, ok := foreignIDs[field.Reference][name]; ok {
+			obj[field.Name] = map[string]interface{}{"id": id}
+		} else {
+			obj[field.Name] = name
+		}
+	}
+	return obj, true
+}
+
+// foreignEntityIDsByName returns IDs of the entities custom entities can refer to, indexed by the entity type
+// and the entity name.
+func (ks *KongState) foreignEntityIDsByName() map[string]map[string]string {
+	ids := map[string]map[string]string{
+		string(kong.EntityTypeServices):       {},
+		string(kong.EntityTypeRoutes):         {},
+		string(kong.EntityTypeConsumers):      {},
+		string(kong.EntityTypeConsumerGroups): {},
+	}
+	for _, svc := range ks.Services {
+		if svc.Name != nil && svc.ID != nil {
+			ids[string(kong.EntityTypeServices)][*svc.Name] = *svc.ID
+		}
+		for _, route := range svc.Routes {
+			if route.Name != nil && route.ID != nil {
+				ids[string(kong.EntityTypeRoutes)][*route.Name] = *route.ID
+			}
+		}
+	}
+	for _, c := range ks.Consumers {
+		if c.Username != nil && c.ID != nil {
+			ids[string(kong.EntityTypeConsumers)][*c.Username] = *c.ID
+		}
+	}
+	for _, cg := range ks.ConsumerGroups {
+		if cg.Name != nil && cg.ID != nil {
+			ids[string(kong.EntityTypeConsumerGroups)][*cg.Name] = *cg.ID
+		}
+	}
+	return ids
+}
+
+// mergeCustomEntityTags appends the tags identifying the KongCustomEntity to the tags set in its fields.
+func mergeCustomEntityTags(fieldTags interface{}, k8sTags []*string) []string {
+	var tags []string
+	if list, ok := fieldTags.([]interface{}); ok {
+		for _, t := range list {
+			if tag, ok := t.(string); ok {
+				tags = append(tags, tag)
+			}
+		}
+	}
+	return append(tags, lo.Map(k8sTags, func(t *string, _ int) string { return *t })...)
+}
@@ -1,10 +1,23 @@
 package kongstate
 
 import (
+	"context"
+	"errors"
 	"testing"
 
+	"github.com/go-logr/logr/testr"
 	"github.com/kong/go-kong/kong"
+	"github.com/samber/lo"
+	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
+	apiextensionsv1 "k8s.io/apiextensions-apiserver/pkg/apis/apiextensions/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	"github.com/kong/kubernetes-ingress-controller/v3/internal/annotations"
+	"github.com/kong/kubernetes-ingress-controller/v3/internal/dataplane/failures"
+	"github.com/kong/kubernetes-ingress-controller/v3/internal/store"
+	kongv1 "github.com/kong/kubernetes-ingress-controller/v3/pkg/apis/configuration/v1"
+	kongv1alpha1 "github.com/kong/kubernetes-ingress-controller/v3/pkg/apis/configuration/v1alpha1"
 )
 
 func TestExtractEntityFieldDefinitions(t *testing.T) {
@@ -98,3 +111,193 @@ func TestExtractEntityFieldDefinitions(t *testing.T) {
 		})
 	}
 }
+
+type fakeSchemaGetter map[string]kong.Schema
+
+func (f fakeSchemaGetter) Get(_ context.Context, entityType string) (kong.Schema, error) {
+	schema, ok := f[entityType]
+	if !ok {
+		return nil, errors.New("entity type not found")
+	}
+	return schema, nil
+}
+
+func TestFillCustomEntities(t *testing.T) {
+	schemas := fakeSchemaGetter{
+		"degraphql_routes": kong.Schema{
+			"fields": []interface{}{
+				map[string]interface{}{"id": map[string]interface{}{"type": "string", "uuid": true, "auto": true}},
+				map[string]interface{}{"service": map[string]interface{}{"type": "foreign", "reference": "services", "required": true}},
+				map[string]interface{}{"uri": map[string]interface{}{"type": "string", "required": true}},
+				map[string]interface{}{"query": map[string]interface{}{"type": "string", "required": true}},
+			},
+		},
+		"session_metadatas": kong.Schema{
+			"fields": []interface{}{
+				map[string]interface{}{"id": map[string]interface{}{"type": "string", "uuid": true, "auto": true}},
+				map[string]interface{}{"audience": map[string]interface{}{"type": "string"}},
+				map[string]interface{}{"tags": map[string]interface{}{"type": "set"}},
+			},
+		},
+	}
+	customEntityTypeMeta := metav1.TypeMeta{
+		APIVersion: kongv1alpha1.GroupVersion.String(),
+		Kind:       "KongCustomEntity",
+	}
+	degraphqlPlugin := &kongv1.KongPlugin{
+		TypeMeta: metav1.TypeMeta{
+			APIVersion: kongv1.GroupVersion.String(),
+			Kind:       "KongPlugin",
+		},
+		ObjectMeta: metav1.ObjectMeta{
+			Namespace: "default",
+			Name:      "degraphql",
+		},
+		PluginName: "degraphql",
+	}
+	newCustomEntity := func(name, entityType, fields string, parentRef *kongv1alpha1.ObjectReference) *kongv1alpha1.KongCustomEntity {
+		return &kongv1alpha1.KongCustomEntity{
+			TypeMeta: customEntityTypeMeta,
+			ObjectMeta: metav1.ObjectMeta{
+				Namespace: "default",
+				Name:      name,
+				Annotations: map[string]string{
+					annotations.IngressClassKey: annotations.DefaultIngressClass,
+				},
+			},
+			Spec: kongv1alpha1.KongCustomEntitySpec{
+				EntityType: entityType,
+				Fields:     apiextensionsv1.JSON{Raw: []byte(fields)},
+				ParentRef:  parentRef,
+			},
+		}
+	}
+	newKongState := func() *KongState {
+		return &KongState{
+			Services: []Service{
+				{Service: kong.Service{Name: kong.String("svc-1"), ID: kong.String("svc-1-id")}},
+				{Service: kong.Service{Name: kong.String("svc-2"), ID: kong.String("svc-2-id")}},
+			},
+			Plugins: []Plugin{
+				{
+					Plugin:    kong.Plugin{Name: kong.String("degraphql"), Service: &kong.Service{ID: kong.String("svc-1")}},
+					K8sParent: degraphqlPlugin,
+				},
+				{
+					Plugin:    kong.Plugin{Name: kong.String("degraphql"), Service: &kong.Service{ID: kong.String("svc-2")}},
+					K8sParent: degraphqlPlugin,
+				},
+				{
+					Plugin:    kong.Plugin{Name: kong.String("degraphql"), Route: &kong.Route{ID: kong.String("route-1")}},
+					K8sParent: degraphqlPlugin,
+				},
+			},
+		}
+	}
+
+	testCases := []struct {
+		name             string
+		customEntities   []*kongv1alpha1.KongCustomEntity
+		expectedEntities map[string][]map[string]interface{}
+		// name of KongCustomEntity -> failure message
+		expectedTranslationFailures map[string]string
+	}{
+		{
+			name: "entity with foreign field is generated for every service its parent plugin is attached to",
+			customEntities: []*kongv1alpha1.KongCustomEntity{
+				newCustomEntity("degraphql-route", "degraphql_routes", `{"uri":"/contacts","query":"query{ contacts { name } }"}`,
+					&kongv1alpha1.ObjectReference{Name: "degraphql"}),
+			},
+			expectedEntities: map[string][]map[string]interface{}{
+				"degraphql_routes": {
+					{
+						"uri":     "/contacts",
+						"query":   "query{ contacts { name } }",
+						"service": map[string]interface{}{"id": "svc-1-id"},
+					},
+					{
+						"uri":     "/contacts",
+						"query":   "query{ contacts { name } }",
+						"service": map[string]interface{}{"id": "svc-2-id"},
+					},
+				},
+			},
+		},
+		{
+			name: "entity without foreign fields gets tags of the KongCustomEntity",
+			customEntities: []*kongv1alpha1.KongCustomEntity{
+				newCustomEntity("session-metadata", "session_metadatas", `{"audience":"default","tags":["user-tag"]}`, nil),
+			},
+			expectedEntities: map[string][]map[string]interface{}{
+				"session_metadatas": {
+					{
+						"audience": "default",
+						"tags": []string{
+							"user-tag",
+							"k8s-name:session-metadata",
+							"k8s-namespace:default",
+							"k8s-kind:KongCustomEntity",
+							"k8s-group:configuration.konghq.com",
+							"k8s-version:v1alpha1",
+						},
+					},
+				},
+			},
+		},
+		{
+			name: "invalid entities are rejected",
+			customEntities: []*kongv1alpha1.KongCustomEntity{
+				newCustomEntity("managed-type", "services", `{}`, nil),
+				newCustomEntity("unknown-type", "unknown_entities", `{}`, nil),
+				newCustomEntity("unknown-field", "session_metadatas", `{"unknown":"value"}`, nil),
+				newCustomEntity("foreign-field-set", "degraphql_routes", `{"service":{"id":"svc-1-id"}}`,
+					&kongv1alpha1.ObjectReference{Name: "degraphql"}),
+				newCustomEntity("missing-parent-ref", "degraphql_routes", `{"uri":"/"}`, nil),
+				newCustomEntity("missing-parent", "degraphql_routes", `{"uri":"/"}`,
+					&kongv1alpha1.ObjectReference{Name: "not-found"}),
+				newCustomEntity("parent-in-another-namespace", "degraphql_routes", `{"uri":"/"}`,
+					&kongv1alpha1.ObjectReference{Name: "degraphql", Namespace: lo.ToPtr("other")}),
+			},
+			expectedTranslationFailures: map[string]string{
+				"managed-type":                `entity type "services" is managed by the controller`,
+				"unknown-type":                `failed to fetch schema of entity type "unknown_entities"`,
+				"unknown-field":               `field "unknown" is not defined in the schema`,
+				"foreign-field-set":           `foreign field "service" cannot be set in spec.fields`,
+				"missing-parent-ref":          "spec.parentRef is required",
+				"missing-parent":              "failed to get KongPlugin default/not-found",
+				"parent-in-another-namespace": "must be in the same namespace",
+			},
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			s, err := store.NewFakeStore(store.FakeObjects{
+				KongPlugins:        []*kongv1.KongPlugin{degraphqlPlugin},
+				KongCustomEntities: tc.customEntities,
+			})
+			require.NoError(t, err)
+			logger := testr.New(t)
+			f := failures.NewResourceFailuresCollector(logger)
+			ks := newKongState()
+			ks.FillCustomEntities(logger, s, f, schemas)
+
+			require.Len(t, ks.CustomEntities, len(tc.expectedEntities))
+			for entityType, expectedEntities := range tc.expectedEntities {
+				require.Contains(t, ks.CustomEntities, entityType)
+				objects := lo.Map(ks.CustomEntities[entityType].Entities, func(e CustomEntity, _ int) map[string]interface{} {
+					return e.Object
+				})
+				assert.Equal(t, expectedEntities, objects)
+			}
+
+			translationFailures := f.PopResourceFailures()
+			require.Len(t, translationFailures, len(tc.expectedTranslationFailures))
+			for _, failure := range translationFailures {
+				name := failure.CausingObjects()[0].GetName()
+				require.Contains(t, tc.expectedTranslationFailures, name)
+				assert.Contains(t, failure.Message(), tc.expectedTranslationFailures[name])
+			}
+		})
+	}
+}
@@ -3,8 +3,10 @@ package kongstate
 import (
 	"crypto/sha256"
 	"fmt"
+	"slices"
 	"strconv"
 	"strings"
+	"time"
 
 	"github.com/go-logr/logr"
 	"github.com/kong/go-kong/kong"
@@ -20,6 +22,7 @@ import (
 	"github.com/kong/kubernetes-ingress-controller/v3/internal/dataplane/failures"
 	"github.com/kong/kubernetes-ingress-controller/v3/internal/gatewayapi"
 	"github.com/kong/kubernetes-ingress-controller/v3/internal/store"
+	"github.com/kong/kubernetes-ingress-controller/v3/internal/tenantpolicy"
 	"github.com/kong/kubernetes-ingress-controller/v3/internal/util"
 	kongv1 "github.com/kong/kubernetes-ingress-controller/v3/pkg/apis/configuration/v1"
 	kongv1alpha1 "github.com/kong/kubernetes-ingress-controller/v3/pkg/apis/configuration/v1alpha1"
@@ -37,6 +40,8 @@ type KongState struct {
 	Consumers      []Consumer
 	ConsumerGroups []ConsumerGroup
 	Vaults         []Vault
+	// CustomEntities are custom Kong entities translated from KongCustomEntities, indexed by the entity type.
+	CustomEntities map[string]*KongCustomEntityCollection
 }
 
 // SanitizedCopy returns a shallow copy with sensitive values redacted best-effort.
@@ -68,15 +73,53 @@ func (ks *KongState) SanitizedCopy(uuidGenerator util.UUIDGenerator) *KongState
 		}(),
 		ConsumerGroups: ks.ConsumerGroups,
 		Vaults:         ks.Vaults,
+		CustomEntities: ks.CustomEntities,
 	}
 }
 
+// EntityCounts returns the number of Kong entities in the state per entity type.
+func (ks *KongState) EntityCounts() map[string]int {
+	var routes, targets, customEntities int
+	plugins := len(ks.Plugins)
+	for _, s := range ks.Services {
+		routes += len(s.Routes)
+		plugins += len(s.Plugins)
+		for _, r := range s.Routes {
+			plugins += len(r.Plugins)
+		}
+	}
+	for _, u := range ks.Upstreams {
+		targets += len(u.Targets)
+	}
+	for _, c := range ks.CustomEntities {
+		customEntities += len(c.Entities)
+	}
+	return map[string]int{
+		"services":        len(ks.Services),
+		"routes":          routes,
+		"upstreams":       len(ks.Upstreams),
+		"targets":         targets,
+		"plugins":         plugins,
+		"certificates":    len(ks.Certificates),
+		"ca_certificates": len(ks.CACertificates),
+		"consumers":       len(ks.Consumers),
+		"consumer_groups": len(ks.ConsumerGroups),
+		"vaults":          len(ks.Vaults),
+		"licenses":        len(ks.Licenses),
+		"custom_entities": customEntities,
+	}
+}
+
+// FillConsumersAndCredentials translates KongConsumers and their credentials held by Secrets. Credentials
+// referencing vaults are validated against the vaults translated by FillVaults, which has to be called first.
 func (ks *KongState) FillConsumersAndCredentials(
 	_ logr.Logger,
 	s store.Storer,
 	failuresCollector *failures.ResourceFailuresCollector,
 ) {
 	consumerIndex := make(map[string]Consumer)
+	vaultPrefixes := ks.vaultPrefixes()
+	now := time.Now()
 
 	// build consumer index
 	for _, consumer := range s.ListKongConsumers() {
@@ -117,7 +160,6 @@ func (ks *KongState) FillConsumersAndCredentials(
 				pushCredentialResourceFailures(fmt.Sprintf("Failed to fetch secret: %v", err))
 				continue
 			}
-			credConfig := map[string]interface{}{}
 			// try the label first. if it's present, no need to check the field
 			credType, err := util.ExtractKongCredentialType(secret)
 			if err != nil {
@@ -129,51 +171,35 @@ func (ks *KongState) FillConsumersAndCredentials(
 				)
 				continue
 			}
-			for k, v := range secret.Data {
-				// TODO populate these based on schema from Kong
-				// and remove this workaround
-				if k == "redirect_uris" {
-					credConfig[k] = strings.Split(string(v), ",")
-					continue
-				}
-				// TODO this is a credential type-agnostic mutation that should only apply to Oauth2 credentials.
-				// However, the credential-specific code after deals only in interface{}s, and we can't fix individual
-				// keys. To handle this properly we'd need to refactor the types used in all following code.
-				if k == "hash_secret" {
-					boolVal, err := strconv.ParseBool(string(v))
-					if err != nil {
-						// add a translation error here to tell that parsing hash_secret failed.
-						pushCredentialResourceFailures(
-							fmt.Sprintf("Failed to parse hash_secret to bool: %v. defaulting to false", err),
-						)
-						credConfig[k] = false
-					} else {
-						credConfig[k] = boolVal
-					}
+			versions, err := util.ExtractKongCredentialVersions(secret)
+			if err != nil {
+				pushCredentialResourceFailures(fmt.Sprintf("could not load credential versions from Secret: %s", err))
+				continue
+			}
+			credTags := util.GenerateTagsForObject(secret)
+			var validVersions []util.CredentialVersion
+			for _, version := range versions {
+				// only the versions valid now are provisioned, the others are picked up by later translations
+				// once they become valid.
+				if !version.IsValidAt(now) {
 					continue
 				}
-				// ttl is a field that only appears in keyAuth credentials and has int type.
-				// Same as above, we cannot fix individual keys after translated to credConfig.
-				if k == "ttl" {
-					intVal, err := strconv.Atoi(string(v))
-					if err != nil {
-						// add a translation error here to tell that parsing TTL failed.
-						pushCredentialResourceFailures(
-							fmt.Sprintf("Failed to parse ttl to int: %v, skipfilling the field", err),
-						)
-					} else {
-						credConfig[k] = intVal
-					}
+				if prefix, ok := unknownVaultReference(version.Data, vaultPrefixes); ok {
+					pushCredentialResourceFailures(
+						fmt.Sprintf("failed to provision credential: vault with prefix %q referenced by %s does not exist",
+							prefix, credentialVersionDescription(version)),
+					)
 					continue
 				}
-				credConfig[k] = string(v)
+				validVersions = append(validVersions, version)
 			}
-			credTags := util.GenerateTagsForObject(secret)
-			if err := c.SetCredential(credType, credConfig, credTags); err != nil {
-				pushCredentialResourceFailures(
-					fmt.Sprintf("failed to provision credential: %v", err),
-				)
-				continue
+			for _, version := range newestCredentialVersionsPerUniqueKey(credType, validVersions) {
+				credConfig := credentialConfig(version.Data, pushCredentialResourceFailures)
+				if err := c.SetCredential(credType, credConfig, credTags); err != nil {
+					pushCredentialResourceFailures(
+						fmt.Sprintf("failed to provision credential: %v", err),
+					)
+				}
 			}
 		}
 
@@ -186,6 +212,98 @@ func (ks *KongState) FillConsumersAndCredentials(
 	}
 }
 
+// credentialConfig converts the fields of a credential held by a Secret into the configuration of a Kong credential.
+func credentialConfig(data map[string][]byte, pushCredentialResourceFailures func(string)) map[string]interface{} {
+	credConfig := map[string]interface{}{}
+	for k, v := range data {
+		// TODO populate these based on schema from Kong
+		// and remove this workaround
+		if k == "redirect_uris" {
+			credConfig[k] = strings.Split(string(v), ",")
+			continue
+		}
+		// TODO this is a credential type-agnostic mutation that should only apply to Oauth2 credentials.
+		// However, the credential-specific code after deals only in interface{}s, and we can't fix individual
+		// keys. To handle this properly we'd need to refactor the types used in all following code.
+		if k == "hash_secret" {
+			boolVal, err := strconv.ParseBool(string(v))
+			if err != nil {
+				// add a translation error here to tell that parsing hash_secret failed.
+				pushCredentialResourceFailures(
+					fmt.Sprintf("Failed to parse hash_secret to bool: %v. defaulting to false", err),
+				)
+				credConfig[k] = false
+			} else {
+				credConfig[k] = boolVal
+			}
+			continue
+		}
+		// ttl is a field that only appears in keyAuth credentials and has int type.
+		// Same as above, we cannot fix individual keys after translated to credConfig.
+		if k == "ttl" {
+			intVal, err := strconv.Atoi(string(v))
+			if err != nil {
+				// add a translation error here to tell that parsing TTL failed.
+				pushCredentialResourceFailures(
+					fmt.Sprintf("Failed to parse ttl to int: %v, skipfilling the field", err),
+				)
+			} else {
+				credConfig[k] = intVal
+			}
+			continue
+		}
+		credConfig[k] = string(v)
+	}
+	return credConfig
+}
+
+// newestCredentialVersionsPerUniqueKey returns the versions of a credential that can be provisioned together.
+// Versions valid at the same time during a rotation may share the value of a field Kong requires to be unique
+// (e.g. a basic-auth username not suffixed with the version), in which case only the newest of them is kept.
+// The newest version is the one with the latest not-before time, or the one listed last if they are equal.
+func newestCredentialVersionsPerUniqueKey(credType string, versions []util.CredentialVersion) []util.CredentialVersion {
+	newestFirst := slices.Clone(versions)
+	slices.Reverse(newestFirst)
+	slices.SortStableFunc(newestFirst, func(a, b util.CredentialVersion) int {
+		return b.NotBefore.Compare(a.NotBefore)
+	})
+
+	seen := make(map[string]struct{})
+	kept := make(map[string]struct{}, len(versions))
+	for _, version := range newestFirst {
+		var uniqueValues []string
+		for k, v := range version.Data {
+			if credentials.IsKeyUniqueConstrained(credType, k) {
+				uniqueValues = append(uniqueValues, k+"="+string(v))
+			}
+		}
+		if lo.SomeBy(uniqueValues, func(v string) bool {
+			_, ok := seen[v]
+			return ok
+		}) {
+			continue
+		}
+		for _, v := range uniqueValues {
+			seen[v] = struct{}{}
+		}
+		kept[version.Name] = struct{}{}
+	}
+
+	// Keep the order the versions are listed in.
+	return lo.Filter(versions, func(v util.CredentialVersion, _ int) bool {
+		_, ok := kept[v.Name]
+		return ok
+	})
+}
+
+// credentialVersionDescription describes a version of a credential in translation failures.
+func credentialVersionDescription(version util.CredentialVersion) string {
+	if version.Name == "" {
+		return "the credential"
+	}
+	return fmt.Sprintf("version %q", version.Name)
+}
+
 func (ks *KongState) FillConsumerGroups(_ logr.Logger, s store.Storer) {
 	for _, cg := range s.ListKongConsumerGroups() {
 		ks.ConsumerGroups = append(ks.ConsumerGroups, ConsumerGroup{
@@ -238,6 +356,11 @@ func (ks *KongState) FillUpstreamOverrides(
 	logger logr.Logger,
 	failuresCollector *failures.ResourceFailuresCollector,
 ) {
+	backendLBPolicies, err := s.ListBackendLBPolicies()
+	if err != nil {
+		logger.Error(err, "Failed to list BackendLBPolicies")
+	}
+
 	for i := 0; i < len(ks.Upstreams); i++ {
 		servicesGroup := lo.Values(ks.Upstreams[i].Service.K8sServices)
 
@@ -253,15 +376,59 @@ func (ks *KongState) FillUpstreamOverrides(
 			}
 		}
 
+		// Session persistence configured in Gateway API routes or BackendLBPolicies is translated to consistent
+		// hashing settings. The one configured in route rules takes precedence.
+		sessionPersistence := ks.Upstreams[i].Service.SessionPersistence
+		if sessionPersistence == nil {
+			sessionPersistence = getBackendLBPolicySessionPersistence(backendLBPolicies, servicesGroup, failuresCollector)
+		}
+		ks.Upstreams[i].overrideBySessionPersistence(sessionPersistence)
+
 		kongUpstreamPolicy, err := GetKongUpstreamPolicyForServices(s, servicesGroup)
 		if err != nil {
 			failuresCollector.PushResourceFailure(err.Error(), lo.Map(servicesGroup, servicesAsObjects)...)
 		} else if kongUpstreamPolicy != nil {
-			ks.Upstreams[i].overrideByKongUpstreamPolicy(kongUpstreamPolicy)
+			// A KongUpstreamPolicy configuring its own load balancing conflicts with the session persistence. The policy
+			// is reported as conflicted in its status and not applied to the Upstream then.
+			if sessionPersistence != nil && KongUpstreamPolicyConflictsWithSessionPersistence(kongUpstreamPolicy.Spec) {
+				failuresCollector.PushResourceFailure(
+					fmt.Sprintf("KongUpstreamPolicy %s conflicts with session persistence configured for services %s, it will not be applied",
+						kongUpstreamPolicy.Name, prettyPrintServiceList(servicesGroup)),
+					kongUpstreamPolicy,
+				)
+			} else {
+				ks.Upstreams[i].overrideByKongUpstreamPolicy(kongUpstreamPolicy)
+			}
 		}
 	}
 }
 
+// getBackendLBPolicySessionPersistence returns the session persistence configured by the BackendLBPolicy targeting
+// the services. BackendLBPolicies targeting the services inconsistently or configuring session persistence that
+// can't be translated are reported as translation failures and ignored.
+func getBackendLBPolicySessionPersistence(
+	policies []*gatewayapi.BackendLBPolicy,
+	servicesGroup []*corev1.Service,
+	failuresCollector *failures.ResourceFailuresCollector,
+) *gatewayapi.SessionPersistence {
+	policy, err := GetBackendLBPolicyForServices(policies, servicesGroup)
+	if err != nil {
+		failuresCollector.PushResourceFailure(err.Error(), lo.Map(servicesGroup, servicesAsObjects)...)
+		return nil
+	}
+	if policy == nil {
+		return nil
+	}
+	if err := ValidateSessionPersistence(policy.Spec.SessionPersistence); err != nil {
+		failuresCollector.PushResourceFailure(
+			fmt.Sprintf("BackendLBPolicy %s has invalid session persistence, it will not be applied: %s", policy.Name, err),
+			policy,
+		)
+		return nil
+	}
+	return policy.Spec.SessionPersistence
+}
+
 // compareKongVault compares two `KongVault`s when they have the same `spec.prefix`.
 // When 2 or more KongVaults have the same prefix, only one of them is translated.
 // It returns true when v1 has higher priority then v2, by the following order:
@@ -482,6 +649,7 @@ func buildPlugins(
 	pluginRels map[string]util.ForeignRelations,
 ) []Plugin {
 	var plugins []Plugin
+	tenantPolicies := tenantpolicy.NewEvaluator(s)
 
 	for pluginIdentifier, relations := range pluginRels {
 		identifier := strings.Split(pluginIdentifier, ":")
@@ -494,6 +662,21 @@ func buildPlugins(
 			continue
 		}
 
+		// Plugins are attached to objects in the namespace, so the namespace's KongTenantPolicies decide
+		// whether the plugin can be used, regardless of whether it's a KongPlugin or a KongClusterPlugin.
+		if k8sPlugin != nil {
+			if err := tenantPolicies.ValidatePlugin(namespace, k8sPlugin.PluginName); err != nil {
+				failuresCollector.PushResourceFailure(err.Error(), k8sPlugin)
+				continue
+			}
+		}
+		if k8sClusterPlugin != nil {
+			if err := tenantPolicies.ValidatePlugin(namespace, k8sClusterPlugin.PluginName); err != nil {
+				failuresCollector.PushResourceFailure(err.Error(), k8sClusterPlugin)
+				continue
+			}
+		}
+
 		var plugin Plugin
 		if k8sPlugin != nil {
 			plugin, err = kongPluginFromK8SPlugin(s, *k8sPlugin)
@@ -22,9 +22,11 @@ import (
 	k8stypes "k8s.io/apimachinery/pkg/types"
 	"k8s.io/apimachinery/pkg/util/sets"
 	"sigs.k8s.io/controller-runtime/pkg/client"
+	gatewayv1alpha2 "sigs.k8s.io/gateway-api/apis/v1alpha2"
 
 	"github.com/kong/kubernetes-ingress-controller/v3/internal/annotations"
 	"github.com/kong/kubernetes-ingress-controller/v3/internal/dataplane/failures"
+	"github.com/kong/kubernetes-ingress-controller/v3/internal/gatewayapi"
 	"github.com/kong/kubernetes-ingress-controller/v3/internal/labels"
 	"github.com/kong/kubernetes-ingress-controller/v3/internal/store"
 	"github.com/kong/kubernetes-ingress-controller/v3/internal/util"
@@ -76,6 +78,11 @@ func TestKongState_SanitizedCopy(t *testing.T) {
 						},
 					},
 				},
+				CustomEntities: map[string]*KongCustomEntityCollection{
+					"session_metadatas": {
+						Entities: []CustomEntity{{Object: map[string]interface{}{"audience": "test"}}},
+					},
+				},
 			},
 			want: KongState{
 				Services:       []Service{{Service: kong.Service{ID: kong.String("1")}}},
@@ -100,6 +107,11 @@ func TestKongState_SanitizedCopy(t *testing.T) {
 						},
 					},
 				},
+				CustomEntities: map[string]*KongCustomEntityCollection{
+					"session_metadatas": {
+						Entities: []CustomEntity{{Object: map[string]interface{}{"audience": "test"}}},
+					},
+				},
 			},
 		},
 	} {
@@ -145,6 +157,38 @@ func ensureAllKongStateFieldsAreCoveredInTest(t *testing.T, testedFields []strin
 	}
 }
 
+func TestKongState_EntityCounts(t *testing.T) {
+	ks := KongState{
+		Services: []Service{
+			{
+				Routes: []Route{
+					{Plugins: []kong.Plugin{{Name: kong.String("request-transformer")}}},
+					{},
+				},
+				Plugins: []kong.Plugin{{Name: kong.String("key-auth")}},
+			},
+			{
+				Routes: []Route{{}},
+			},
+		},
+		Upstreams: []Upstream{
+			{Targets: []Target{{}, {}}},
+			{Targets: []Target{{}}},
+		},
+		Plugins:   []Plugin{{}},
+		Consumers: []Consumer{{}},
+	}
+
+	counts := ks.EntityCounts()
+	assert.Equal(t, 2, counts["services"])
+	assert.Equal(t, 3, counts["routes"])
+	assert.Equal(t, 2, counts["upstreams"])
+	assert.Equal(t, 3, counts["targets"])
+	assert.Equal(t, 3, counts["plugins"])
+	assert.Equal(t, 1, counts["consumers"])
+	assert.Equal(t, 0, counts["certificates"])
+}
+
 func TestGetPluginRelations(t *testing.T) {
 	type args struct {
 		state KongState
@@ -793,6 +837,94 @@ func TestFillConsumersAndCredentials(t *testing.T) {
 	}
 }
 
+func TestFillConsumersAndCredentials_VaultReferencesAndVersions(t *testing.T) {
+	now := time.Now()
+	newSecret := func(name string, anns map[string]string, data map[string]string) *corev1.Secret {
+		credType := "key-auth"
+		if _, ok := data["username"]; ok {
+			credType = "basic-auth"
+		}
+		return &corev1.Secret{
+			ObjectMeta: metav1.ObjectMeta{
+				Name:        name,
+				Namespace:   "default",
+				Labels:      map[string]string{labels.CredentialTypeLabel: credType},
+				Annotations: anns,
+			},
+			Data: lo.MapValues(data, func(v string, _ string) []byte { return []byte(v) }),
+		}
+	}
+	secrets := []*corev1.Secret{
+		newSecret("vault-ref", nil, map[string]string{"key": "{vault://my-vault/key}"}),
+		newSecret("bundled-vault-ref", nil, map[string]string{"key": "{vault://env/key}"}),
+		newSecret("unknown-vault-ref", nil, map[string]string{"key": "{vault://unknown/key}"}),
+		newSecret("rotated", map[string]string{
+			"konghq.com/credential-versions":      "v1,v2,v3",
+			"konghq.com/credential-not-after.v1":  now.Add(-time.Hour).Format(time.RFC3339),
+			"konghq.com/credential-not-before.v2": now.Add(-2 * time.Hour).Format(time.RFC3339),
+			"konghq.com/credential-not-after.v2":  now.Add(2 * time.Hour).Format(time.RFC3339),
+			"konghq.com/credential-not-before.v3": now.Add(time.Hour).Format(time.RFC3339),
+		}, map[string]string{"key.v1": "key-1", "key.v2": "key-2", "key.v3": "key-3", "ttl": "60"}),
+		newSecret("overlapping-basic-auth", map[string]string{
+			"konghq.com/credential-versions":      "v2,v1",
+			"konghq.com/credential-not-before.v1": now.Add(-2 * time.Hour).Format(time.RFC3339),
+			"konghq.com/credential-not-after.v1":  now.Add(time.Hour).Format(time.RFC3339),
+			"konghq.com/credential-not-before.v2": now.Add(-time.Hour).Format(time.RFC3339),
+		}, map[string]string{"username": "batman", "password.v1": "password-1", "password.v2": "password-2"}),
+		newSecret("invalid-versions", map[string]string{
+			"konghq.com/credential-versions":      "v1",
+			"konghq.com/credential-not-before.v1": "yesterday",
+		}, map[string]string{"key.v1": "key-1"}),
+	}
+	consumer := &kongv1.KongConsumer{
+		TypeMeta: kongConsumerTypeMeta,
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      "consumer",
+			Namespace: "default",
+			Annotations: map[string]string{
+				annotations.IngressClassKey: annotations.DefaultIngressClass,
+			},
+		},
+		Username: "consumer",
+		Credentials: lo.Map(secrets, func(s *corev1.Secret, _ int) string {
+			return s.Name
+		}),
+	}
+	s, err := store.NewFakeStore(store.FakeObjects{
+		Secrets:       secrets,
+		KongConsumers: []*kongv1.KongConsumer{consumer},
+	})
+	require.NoError(t, err)
+	logger := zapr.NewLogger(zap.NewNop())
+	failuresCollector := failures.NewResourceFailuresCollector(logger)
+
+	state := KongState{
+		Vaults: []Vault{{Vault: kong.Vault{Prefix: kong.String("my-vault")}}},
+	}
+	state.FillConsumersAndCredentials(logger, s, failuresCollector)
+
+	require.Len(t, state.Consumers, 1)
+	keyAuths := lo.Map(state.Consumers[0].KeyAuths, func(k *KeyAuth, _ int) string {
+		return *k.Key
+	})
+	require.ElementsMatch(t, []string{"{vault://my-vault/key}", "{vault://env/key}", "key-2"}, keyAuths)
+	rotated, ok := lo.Find(state.Consumers[0].KeyAuths, func(k *KeyAuth) bool { return *k.Key == "key-2" })
+	require.True(t, ok)
+	require.Equal(t, 60, *rotated.TTL, "fields without a version suffix should be shared by all versions")
+	require.Len(t, state.Consumers[0].BasicAuths, 1,
+		"only the newest of the valid versions sharing a unique username should be provisioned")
+	require.Equal(t, "batman", *state.Consumers[0].BasicAuths[0].Username)
+	require.Equal(t, "password-2", *state.Consumers[0].BasicAuths[0].Password)
+
+	messages := lo.Map(failuresCollector.PopResourceFailures(), func(f failures.ResourceFailure, _ int) string {
+		return f.Message()
+	})
+	require.ElementsMatch(t, []string{
+		`credential "unknown-vault-ref" failure: failed to provision credential: vault with prefix "unknown" referenced by the credential does not exist`,
+		`credential "invalid-versions" failure: could not load credential versions from Secret: invalid konghq.com/credential-not-before.v1 annotation: parsing time "yesterday" as "2006-01-02T15:04:05Z07:00": cannot parse "yesterday" as "2006"`,
+	}, messages)
+}
+
 func TestKongState_FillIDs(t *testing.T) {
 	testCases := []struct {
 		name   string
@@ -984,6 +1116,74 @@ func TestKongState_BuildPluginsCollisions(t *testing.T) {
 	}
 }
 
+func TestKongState_BuildPluginsTenantPolicies(t *testing.T) {
+	preFunction := &kongv1.KongPlugin{
+		TypeMeta: metav1.TypeMeta{
+			APIVersion: kongv1.GroupVersion.String(),
+			Kind:       "KongPlugin",
+		},
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      "pre-function",
+			Namespace: "team-a",
+		},
+		PluginName: "pre-function",
+	}
+	rateLimiting := &kongv1.KongPlugin{
+		TypeMeta: metav1.TypeMeta{
+			APIVersion: kongv1.GroupVersion.String(),
+			Kind:       "KongPlugin",
+		},
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      "rate-limiting",
+			Namespace: "team-a",
+		},
+		PluginName: "rate-limiting",
+	}
+	s, err := store.NewFakeStore(store.FakeObjects{
+		KongPlugins: []*kongv1.KongPlugin{preFunction, rateLimiting},
+		Namespaces: []*corev1.Namespace{
+			{
+				ObjectMeta: metav1.ObjectMeta{
+					Name:   "team-a",
+					Labels: map[string]string{"tenant": "true"},
+				},
+			},
+		},
+		KongTenantPolicies: []*kongv1alpha1.KongTenantPolicy{
+			{
+				ObjectMeta: metav1.ObjectMeta{
+					Name: "tenants",
+				},
+				Spec: kongv1alpha1.KongTenantPolicySpec{
+					NamespaceSelector: metav1.LabelSelector{
+						MatchLabels: map[string]string{"tenant": "true"},
+					},
+					Plugins: &kongv1alpha1.KongTenantPolicyRule{
+						Deny: []string{"pre-function"},
+					},
+				},
+			},
+		},
+	})
+	require.NoError(t, err)
+
+	failuresCollector := failures.NewResourceFailuresCollector(logr.Discard())
+	plugins := buildPlugins(logr.Discard(), s, failuresCollector, map[string]util.ForeignRelations{
+		"team-a:pre-function":  {Route: []string{"route"}},
+		"team-a:rate-limiting": {Route: []string{"route"}},
+	})
+	require.Len(t, plugins, 1)
+	require.Equal(t, "rate-limiting", *plugins[0].Name)
+
+	translationFailures := failuresCollector.PopResourceFailures()
+	require.Len(t, translationFailures, 1)
+	require.Equal(t,
+		`plugin "pre-function" is not allowed in namespace "team-a" by KongTenantPolicy tenants`,
+		translationFailures[0].Message(),
+	)
+	require.Equal(t, []client.Object{preFunction}, translationFailures[0].CausingObjects())
+}
+
 func TestKongState_FillUpstreamOverrides(t *testing.T) {
 	const (
 		kongIngressName        = "kongIngress"
@@ -1011,11 +1211,56 @@ func TestKongState_FillUpstreamOverrides(t *testing.T) {
 		return s
 	}
 
+	leastConnectionsKongUpstreamPolicy := &kongv1beta1.KongUpstreamPolicy{
+		TypeMeta: metav1.TypeMeta{
+			APIVersion: kongv1beta1.GroupVersion.String(),
+			Kind:       "KongUpstreamPolicy",
+		},
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      kongUpstreamPolicyName,
+			Namespace: "default",
+		},
+		Spec: kongv1beta1.KongUpstreamPolicySpec{
+			Algorithm: lo.ToPtr("least-connections"),
+		},
+	}
+
+	serviceTargetedByBackendLBPolicy := func() *corev1.Service {
+		return &corev1.Service{
+			ObjectMeta: metav1.ObjectMeta{
+				Name:      "service",
+				Namespace: "default",
+			},
+		}
+	}
+	backendLBPolicy := func(sessionPersistence gatewayapi.SessionPersistence) *gatewayapi.BackendLBPolicy {
+		return &gatewayapi.BackendLBPolicy{
+			TypeMeta: metav1.TypeMeta{
+				APIVersion: gatewayv1alpha2.GroupVersion.String(),
+				Kind:       "BackendLBPolicy",
+			},
+			ObjectMeta: metav1.ObjectMeta{
+				Name:      "backend-lb-policy",
+				Namespace: "default",
+			},
+			Spec: gatewayapi.BackendLBPolicySpec{
+				TargetRefs: []gatewayapi.LocalPolicyTargetReference{
+					{Kind: "Service", Name: "service"},
+				},
+				SessionPersistence: &sessionPersistence,
+			},
+		}
+	}
+	backendLBPolicyWithTimeout := backendLBPolicy(gatewayapi.SessionPersistence{
+		AbsoluteTimeout: lo.ToPtr(gatewayapi.Duration("1h")),
+	})
+
 	testCases := []struct {
 		name                 string
 		upstream             Upstream
 		kongUpstreamPolicies []*kongv1beta1.KongUpstreamPolicy
 		kongIngresses        []*kongv1.KongIngress
+		backendLBPolicies    []*gatewayapi.BackendLBPolicy
 		expectedUpstream     kong.Upstream
 		expectedFailures     []failures.ResourceFailure
 	}{
@@ -1145,6 +1390,154 @@ func TestKongState_FillUpstreamOverrides(t *testing.T) {
 				Algorithm: kong.String("least-connections"),
 			},
 		},
+		{
+			name: "upstream of service with session persistence",
+			upstream: Upstream{
+				Upstream: kong.Upstream{
+					Name: kong.String("foo-upstream"),
+				},
+				Service: Service{
+					SessionPersistence: &gatewayapi.SessionPersistence{
+						SessionName: lo.ToPtr("session"),
+					},
+				},
+			},
+			expectedUpstream: kong.Upstream{
+				Name:         kong.String("foo-upstream"),
+				Algorithm:    kong.String("consistent-hashing"),
+				HashOn:       kong.String("cookie"),
+				HashOnCookie: kong.String("session"),
+			},
+		},
+		{
+			name: "KongUpstreamPolicy not configuring load balancing is applied along with session persistence",
+			upstream: Upstream{
+				Upstream: kong.Upstream{
+					Name: kong.String("foo-upstream"),
+				},
+				Service: Service{
+					K8sServices: map[string]*corev1.Service{"": serviceAnnotatedWithKongUpstreamPolicy()},
+					SessionPersistence: &gatewayapi.SessionPersistence{
+						Type: lo.ToPtr(gatewayapi.HeaderBasedSessionPersistence),
+					},
+				},
+			},
+			kongUpstreamPolicies: []*kongv1beta1.KongUpstreamPolicy{
+				{
+					ObjectMeta: metav1.ObjectMeta{
+						Name:      kongUpstreamPolicyName,
+						Namespace: "default",
+					},
+					Spec: kongv1beta1.KongUpstreamPolicySpec{
+						Slots: lo.ToPtr(100),
+					},
+				},
+			},
+			expectedUpstream: kong.Upstream{
+				Name:         kong.String("foo-upstream"),
+				Algorithm:    kong.String("consistent-hashing"),
+				HashOn:       kong.String("header"),
+				HashOnHeader: kong.String(DefaultSessionPersistenceName),
+				Slots:        kong.Int(100),
+			},
+		},
+		{
+			name: "KongUpstreamPolicy configuring load balancing conflicts with session persistence and is not applied",
+			upstream: Upstream{
+				Upstream: kong.Upstream{
+					Name: kong.String("foo-upstream"),
+				},
+				Service: Service{
+					K8sServices:        map[string]*corev1.Service{"": serviceAnnotatedWithKongUpstreamPolicy()},
+					SessionPersistence: &gatewayapi.SessionPersistence{},
+				},
+			},
+			kongUpstreamPolicies: []*kongv1beta1.KongUpstreamPolicy{leastConnectionsKongUpstreamPolicy},
+			expectedUpstream: kong.Upstream{
+				Name:         kong.String("foo-upstream"),
+				Algorithm:    kong.String("consistent-hashing"),
+				HashOn:       kong.String("cookie"),
+				HashOnCookie: kong.String(DefaultSessionPersistenceName),
+			},
+			expectedFailures: []failures.ResourceFailure{
+				lo.Must(failures.NewResourceFailure(
+					"KongUpstreamPolicy policy conflicts with session persistence configured for services default/service, it will not be applied",
+					leastConnectionsKongUpstreamPolicy,
+				)),
+			},
+		},
+		{
+			name: "upstream of service targeted by BackendLBPolicy with session persistence",
+			upstream: Upstream{
+				Upstream: kong.Upstream{
+					Name: kong.String("foo-upstream"),
+				},
+				Service: Service{
+					K8sServices: map[string]*corev1.Service{"": serviceAnnotatedWithKongUpstreamPolicy()},
+				},
+			},
+			kongUpstreamPolicies: []*kongv1beta1.KongUpstreamPolicy{leastConnectionsKongUpstreamPolicy},
+			backendLBPolicies: []*gatewayapi.BackendLBPolicy{
+				backendLBPolicy(gatewayapi.SessionPersistence{SessionName: lo.ToPtr("session")}),
+			},
+			expectedUpstream: kong.Upstream{
+				Name:         kong.String("foo-upstream"),
+				Algorithm:    kong.String("consistent-hashing"),
+				HashOn:       kong.String("cookie"),
+				HashOnCookie: kong.String("session"),
+			},
+			expectedFailures: []failures.ResourceFailure{
+				lo.Must(failures.NewResourceFailure(
+					"KongUpstreamPolicy policy conflicts with session persistence configured for services default/service, it will not be applied",
+					leastConnectionsKongUpstreamPolicy,
+				)),
+			},
+		},
+		{
+			name: "session persistence of route rules takes precedence over BackendLBPolicy",
+			upstream: Upstream{
+				Upstream: kong.Upstream{
+					Name: kong.String("foo-upstream"),
+				},
+				Service: Service{
+					K8sServices: map[string]*corev1.Service{"": serviceTargetedByBackendLBPolicy()},
+					SessionPersistence: &gatewayapi.SessionPersistence{
+						SessionName: lo.ToPtr("route-session"),
+					},
+				},
+			},
+			backendLBPolicies: []*gatewayapi.BackendLBPolicy{
+				backendLBPolicy(gatewayapi.SessionPersistence{SessionName: lo.ToPtr("policy-session")}),
+			},
+			expectedUpstream: kong.Upstream{
+				Name:         kong.String("foo-upstream"),
+				Algorithm:    kong.String("consistent-hashing"),
+				HashOn:       kong.String("cookie"),
+				HashOnCookie: kong.String("route-session"),
+			},
+		},
+		{
+			name: "BackendLBPolicy with unsupported session persistence is not applied",
+			upstream: Upstream{
+				Upstream: kong.Upstream{
+					Name: kong.String("foo-upstream"),
+				},
+				Service: Service{
+					K8sServices: map[string]*corev1.Service{"": serviceTargetedByBackendLBPolicy()},
+				},
+			},
+			backendLBPolicies: []*gatewayapi.BackendLBPolicy{backendLBPolicyWithTimeout},
+			expectedUpstream: kong.Upstream{
+				Name: kong.String("foo-upstream"),
+			},
+			expectedFailures: []failures.ResourceFailure{
+				lo.Must(failures.NewResourceFailure(
+					"BackendLBPolicy backend-lb-policy has invalid session persistence, it will not be applied: "+
+						"session persistence timeouts are not supported",
+					backendLBPolicyWithTimeout,
+				)),
+			},
+		},
 	}
 
 	for _, tc := range testCases {
@@ -1152,6 +1545,7 @@ func TestKongState_FillUpstreamOverrides(t *testing.T) {
 			s, err := store.NewFakeStore(store.FakeObjects{
 				KongUpstreamPolicies: tc.kongUpstreamPolicies,
 				KongIngresses:        tc.kongIngresses,
+				BackendLBPolicies:    tc.backendLBPolicies,
 			})
 			require.NoError(t, err)
 			failuresCollector := failures.NewResourceFailuresCollector(logr.Discard())
@@ -171,9 +171,9 @@ func kongPluginFromK8SClusterPlugin(
 	}
 	if k8sPlugin.ConfigFrom != nil {
 		var err error
-		config, err = NamespacedSecretToConfiguration(
+		config, err = NamespacedConfigSourceToConfiguration(
 			s,
-			k8sPlugin.ConfigFrom.SecretValue)
+			*k8sPlugin.ConfigFrom)
 		if err != nil {
 			return Plugin{},
 				fmt.Errorf("error parsing config for KongClusterPlugin %s: %w",
@@ -181,12 +181,12 @@ func kongPluginFromK8SClusterPlugin(
 		}
 	}
 
-	// Prepare sensitive fields metadata for the plugin.
+	// Prepare sensitive fields metadata for the plugin. Values coming from ConfigMaps are not sensitive.
 	sensitiveFieldsMeta := PluginSensitiveFieldsMetadata{
-		JSONPaths: lo.Map(k8sPlugin.ConfigPatches, func(patch kongv1.NamespacedConfigPatch, _ int) string {
-			return patch.Path
+		JSONPaths: lo.FilterMap(k8sPlugin.ConfigPatches, func(patch kongv1.NamespacedConfigPatch, _ int) (string, bool) {
+			return patch.Path, patch.ValueFrom.ConfigMapValue == nil
 		}),
-		WholeConfigIsSensitive: k8sPlugin.ConfigFrom != nil,
+		WholeConfigIsSensitive: k8sPlugin.ConfigFrom != nil && k8sPlugin.ConfigFrom.ConfigMapValue == nil,
 	}
 
 	return Plugin{
@@ -235,8 +235,8 @@ func kongPluginFromK8SPlugin(
 	}
 	if k8sPlugin.ConfigFrom != nil {
 		var err error
-		config, err = SecretToConfiguration(s,
-			k8sPlugin.ConfigFrom.SecretValue, k8sPlugin.Namespace)
+		config, err = ConfigSourceToConfiguration(s,
+			*k8sPlugin.ConfigFrom, k8sPlugin.Namespace)
 		if err != nil {
 			return Plugin{},
 				fmt.Errorf("error parsing config for KongPlugin '%s/%s': %w",
@@ -244,12 +244,12 @@ func kongPluginFromK8SPlugin(
 		}
 	}
 
-	// Prepare sensitive fields metadata for the plugin.
+	// Prepare sensitive fields metadata for the plugin. Values coming from ConfigMaps are not sensitive.
 	sensitiveFieldsMeta := PluginSensitiveFieldsMetadata{
-		JSONPaths: lo.Map(k8sPlugin.ConfigPatches, func(patch kongv1.ConfigPatch, _ int) string {
-			return patch.Path
+		JSONPaths: lo.FilterMap(k8sPlugin.ConfigPatches, func(patch kongv1.ConfigPatch, _ int) (string, bool) {
+			return patch.Path, patch.ValueFrom.ConfigMapValue == nil
 		}),
-		WholeConfigIsSensitive: k8sPlugin.ConfigFrom != nil,
+		WholeConfigIsSensitive: k8sPlugin.ConfigFrom != nil && k8sPlugin.ConfigFrom.ConfigMapValue == nil,
 	}
 
 	return Plugin{
@@ -278,7 +278,8 @@ var (
 	JSONPatchOpReplace JSONPatchOp = "replace"
 )
 
-func applyJSONPatchFromNamespacedSecretRef(s SecretGetter, raw []byte, path string, namespace string, secretName string, key string) ([]byte, error) {
+// secretValue returns the value of the key in the secret.
+func secretValue(s SecretGetter, namespace string, secretName string, key string) ([]byte, error) {
 	secret, err := s.GetSecret(namespace, secretName)
 	if err != nil {
 		return nil, err
@@ -289,7 +290,44 @@ func applyJSONPatchFromNamespacedSecretRef(s SecretGetter, raw []byte, path stri
 			fmt.Errorf("no key '%v' in secret '%v/%v'",
 				key, namespace, secretName)
 	}
+	return secretVal, nil
+}
+
+// configMapValue returns the value of the key in the ConfigMap, looking it up in both its data and binary data.
+func configMapValue(s ConfigMapGetter, namespace string, configMapName string, key string) ([]byte, error) {
+	configMap, err := s.GetConfigMap(namespace, configMapName)
+	if err != nil {
+		return nil, err
+	}
+	if val, ok := configMap.Data[key]; ok {
+		return []byte(val), nil
+	}
+	if val, ok := configMap.BinaryData[key]; ok {
+		return val, nil
+	}
+	return nil,
+		fmt.Errorf("no key '%v' in ConfigMap '%v/%v'",
+			key, namespace, configMapName)
+}
 
+// configSourceValue returns the value referenced by the config source in the namespace.
+func configSourceValue(s ConfigSourceGetter, namespace string, source kongv1.ConfigSource) ([]byte, error) {
+	if ref := source.ConfigMapValue; ref != nil {
+		return configMapValue(s, namespace, ref.ConfigMap, ref.Key)
+	}
+	return secretValue(s, namespace, source.SecretValue.Secret, source.SecretValue.Key)
+}
+
+// namespacedConfigSourceValue returns the value referenced by the namespaced config source.
+func namespacedConfigSourceValue(s ConfigSourceGetter, source kongv1.NamespacedConfigSource) ([]byte, error) {
+	if ref := source.ConfigMapValue; ref != nil {
+		return configMapValue(s, ref.Namespace, ref.ConfigMap, ref.Key)
+	}
+	return secretValue(s, source.SecretValue.Namespace, source.SecretValue.Secret, source.SecretValue.Key)
+}
+
+// applyJSONPatch adds the value at the path of the raw JSON document.
+func applyJSONPatch(raw []byte, path string, value []byte) ([]byte, error) {
 	// JSON patch (RFC6902) specifies the behavior of applying "add" on root,
 	// but because the jsonpatch package could not do "add" on root path (path=""),
 	// we have to use "replace" op on root to set the entire content of document if patch is on root path.
@@ -299,7 +337,7 @@ func applyJSONPatchFromNamespacedSecretRef(s SecretGetter, raw []byte, path stri
 		op = JSONPatchOpReplace
 	}
 
-	rawPatch := fmt.Sprintf(rawPatchPattern, op, path, string(secretVal))
+	rawPatch := fmt.Sprintf(rawPatchPattern, op, path, string(value))
 	p, err := jsonpatch.DecodePatch([]byte(rawPatch))
 	if err != nil {
 		return nil, err
@@ -319,7 +357,7 @@ func applyJSONPatchFromNamespacedSecretRef(s SecretGetter, raw []byte, path stri
 
 // RawConfigurationWithPatchesToConfiguration converts config and add patches from configPatches of KongPlugin.
 func RawConfigurationWithPatchesToConfiguration(
-	s SecretGetter, namespace string,
+	s ConfigSourceGetter, namespace string,
 	rawConfig apiextensionsv1.JSON,
 	patches []kongv1.ConfigPatch,
 ) (kong.Configuration, error) {
@@ -332,15 +370,11 @@ func RawConfigurationWithPatchesToConfiguration(
 
 	// apply patches
 	for _, patch := range patches {
-		var err error
-		raw, err = applyJSONPatchFromNamespacedSecretRef(
-			s,
-			raw,
-			patch.Path,
-			namespace,
-			patch.ValueFrom.SecretValue.Secret,
-			patch.ValueFrom.SecretValue.Key,
-		)
+		value, err := configSourceValue(s, namespace, patch.ValueFrom)
+		if err != nil {
+			return kong.Configuration{}, err
+		}
+		raw, err = applyJSONPatch(raw, patch.Path, value)
 		if err != nil {
 			return kong.Configuration{}, err
 		}
@@ -350,7 +384,7 @@ func RawConfigurationWithPatchesToConfiguration(
 
 // RawConfigurationWithNamespacedPatchesToConfiguration converts config and add patches from configPatches of KongClusterPlugin.
 func RawConfigurationWithNamespacedPatchesToConfiguration(
-	s SecretGetter,
+	s ConfigSourceGetter,
 	rawConfig apiextensionsv1.JSON,
 	patches []kongv1.NamespacedConfigPatch,
 ) (kong.Configuration, error) {
@@ -362,15 +396,11 @@ func RawConfigurationWithNamespacedPatchesToConfiguration(
 		raw = []byte("{}")
 	}
 	for _, patch := range patches {
-		var err error
-		raw, err = applyJSONPatchFromNamespacedSecretRef(
-			s,
-			raw,
-			patch.Path,
-			patch.ValueFrom.SecretValue.Namespace,
-			patch.ValueFrom.SecretValue.Secret,
-			patch.ValueFrom.SecretValue.Key,
-		)
+		value, err := namespacedConfigSourceValue(s, patch.ValueFrom)
+		if err != nil {
+			return kong.Configuration{}, err
+		}
+		raw, err = applyJSONPatch(raw, patch.Path, value)
 		if err != nil {
 			return kong.Configuration{}, err
 		}
@@ -397,6 +427,73 @@ type SecretGetter interface {
 	GetSecret(namespace, name string) (*corev1.Secret, error)
 }
 
+type ConfigMapGetter interface {
+	GetConfigMap(namespace, name string) (*corev1.ConfigMap, error)
+}
+
+// ConfigSourceGetter gets Secrets and ConfigMaps that plugin configurations are sourced from.
+type ConfigSourceGetter interface {
+	SecretGetter
+	ConfigMapGetter
+}
+
+// ConfigSourceToConfiguration fetches the value referenced by the config source in the namespace,
+// then parse the value to Kong plugin configurations.
+// Exported primarily to be used in admission validators.
+func ConfigSourceToConfiguration(
+	s ConfigSourceGetter,
+	source kongv1.ConfigSource, namespace string) (
+	kong.Configuration, error,
+) {
+	if ref := source.ConfigMapValue; ref != nil {
+		return ConfigMapToConfiguration(s, *ref, namespace)
+	}
+	return SecretToConfiguration(s, source.SecretValue, namespace)
+}
+
+// NamespacedConfigSourceToConfiguration fetches the value referenced by the namespaced config source,
+// then parse the value to Kong plugin configurations.
+// Exported primarily to be used in admission validators.
+func NamespacedConfigSourceToConfiguration(
+	s ConfigSourceGetter,
+	source kongv1.NamespacedConfigSource) (
+	kong.Configuration, error,
+) {
+	if ref := source.ConfigMapValue; ref != nil {
+		bareReference := kongv1.ConfigMapValueFromSource{
+			ConfigMap: ref.ConfigMap,
+			Key:       ref.Key,
+		}
+		return ConfigMapToConfiguration(s, bareReference, ref.Namespace)
+	}
+	return NamespacedSecretToConfiguration(s, source.SecretValue)
+}
+
+// ConfigMapToConfiguration fetches specified value from ConfigMap and key in the namespace,
+// then parse the value to Kong plugin configurations.
+func ConfigMapToConfiguration(
+	s ConfigMapGetter,
+	reference kongv1.ConfigMapValueFromSource, namespace string) (
+	kong.Configuration, error,
+) {
+	val, err := configMapValue(s, namespace, reference.ConfigMap, reference.Key)
+	if err != nil {
+		return kong.Configuration{}, fmt.Errorf(
+			"error fetching plugin configuration ConfigMap '%v/%v': %w",
+			namespace, reference.ConfigMap, err)
+	}
+	var config kong.Configuration
+	if err := json.Unmarshal(val, &config); err != nil {
+		if err := yaml.Unmarshal(val, &config); err != nil {
+			return kong.Configuration{},
+				fmt.Errorf("key '%v' in ConfigMap '%v/%v' contains neither "+
+					"valid JSON nor valid YAML",
+					reference.Key, namespace, reference.ConfigMap)
+		}
+	}
+	return config, nil
+}
+
 // SecretToConfiguration fetches specified value from secret and key in the namespace,
 // then parse the value to Kong plugin configurations.
 // Exported primarily to be used in admission validators.
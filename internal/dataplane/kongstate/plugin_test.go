@@ -31,6 +31,21 @@ func TestKongPluginFromK8SClusterPlugin(t *testing.T) {
 				},
 			},
 		},
+		ConfigMaps: []*corev1.ConfigMap{
+			{
+				ObjectMeta: metav1.ObjectMeta{
+					Name:      "conf-configmap",
+					Namespace: "default",
+				},
+				Data: map[string]string{
+					"correlation-id-config":    `{"header_name": "foo"}`,
+					"correlation-id-generator": `"uuid"`,
+				},
+				BinaryData: map[string][]byte{
+					"correlation-id-headername": []byte(`"foo"`),
+				},
+			},
+		},
 	})
 
 	tests := []struct {
@@ -369,6 +384,67 @@ func TestKongPluginFromK8SClusterPlugin(t *testing.T) {
 			},
 			wantErr: true,
 		},
+		{
+			name: "ConfigMap configuration and configPatches",
+			plugin: kongv1.KongClusterPlugin{
+				Protocols:  []kongv1.KongProtocol{"http"},
+				PluginName: "correlation-id",
+				ConfigFrom: &kongv1.NamespacedConfigSource{
+					ConfigMapValue: &kongv1.NamespacedConfigMapValueFromSource{
+						Key:       "correlation-id-config",
+						ConfigMap: "conf-configmap",
+						Namespace: "default",
+					},
+				},
+			},
+			want: Plugin{
+				Plugin: kong.Plugin{
+					Name: kong.String("correlation-id"),
+					Config: kong.Configuration{
+						"header_name": "foo",
+					},
+					Protocols: kong.StringSlice("http"),
+				},
+				SensitiveFieldsMeta: PluginSensitiveFieldsMetadata{
+					JSONPaths: []string{},
+				},
+			},
+		},
+		{
+			name: "configPatches from ConfigMap",
+			plugin: kongv1.KongClusterPlugin{
+				Protocols:  []kongv1.KongProtocol{"http"},
+				PluginName: "correlation-id",
+				Config: apiextensionsv1.JSON{
+					Raw: []byte(`{"header_name": "foo"}`),
+				},
+				ConfigPatches: []kongv1.NamespacedConfigPatch{
+					{
+						Path: "/generator",
+						ValueFrom: kongv1.NamespacedConfigSource{
+							ConfigMapValue: &kongv1.NamespacedConfigMapValueFromSource{
+								Key:       "correlation-id-generator",
+								ConfigMap: "conf-configmap",
+								Namespace: "default",
+							},
+						},
+					},
+				},
+			},
+			want: Plugin{
+				Plugin: kong.Plugin{
+					Name: kong.String("correlation-id"),
+					Config: kong.Configuration{
+						"header_name": "foo",
+						"generator":   "uuid",
+					},
+					Protocols: kong.StringSlice("http"),
+				},
+				SensitiveFieldsMeta: PluginSensitiveFieldsMetadata{
+					JSONPaths: []string{},
+				},
+			},
+		},
 	}
 	for _, tt := range tests {
 		t.Run(tt.name, func(t *testing.T) {
@@ -400,6 +476,21 @@ func TestKongPluginFromK8SPlugin(t *testing.T) {
 				},
 			},
 		},
+		ConfigMaps: []*corev1.ConfigMap{
+			{
+				ObjectMeta: metav1.ObjectMeta{
+					Name:      "conf-configmap",
+					Namespace: "default",
+				},
+				Data: map[string]string{
+					"correlation-id-config":    `{"header_name": "foo"}`,
+					"correlation-id-generator": `"uuid"`,
+				},
+				BinaryData: map[string][]byte{
+					"correlation-id-headername": []byte(`"foo"`),
+				},
+			},
+		},
 	})
 	tests := []struct {
 		name    string
@@ -750,6 +841,127 @@ func TestKongPluginFromK8SPlugin(t *testing.T) {
 			},
 			wantErr: true,
 		},
+		{
+			name: "ConfigMap configuration",
+			plugin: kongv1.KongPlugin{
+				ObjectMeta: metav1.ObjectMeta{
+					Name:      "foo",
+					Namespace: "default",
+				},
+				Protocols:  []kongv1.KongProtocol{"http"},
+				PluginName: "correlation-id",
+				ConfigFrom: &kongv1.ConfigSource{
+					ConfigMapValue: &kongv1.ConfigMapValueFromSource{
+						Key:       "correlation-id-config",
+						ConfigMap: "conf-configmap",
+					},
+				},
+			},
+			want: Plugin{
+				Plugin: kong.Plugin{
+					Name: kong.String("correlation-id"),
+					Config: kong.Configuration{
+						"header_name": "foo",
+					},
+					Protocols: kong.StringSlice("http"),
+				},
+				SensitiveFieldsMeta: PluginSensitiveFieldsMetadata{
+					JSONPaths: []string{},
+				},
+			},
+		},
+		{
+			name: "missing ConfigMap configuration",
+			plugin: kongv1.KongPlugin{
+				ObjectMeta: metav1.ObjectMeta{
+					Name:      "foo",
+					Namespace: "default",
+				},
+				Protocols:  []kongv1.KongProtocol{"http"},
+				PluginName: "correlation-id",
+				ConfigFrom: &kongv1.ConfigSource{
+					ConfigMapValue: &kongv1.ConfigMapValueFromSource{
+						Key:       "correlation-id-config",
+						ConfigMap: "missing-configmap",
+					},
+				},
+			},
+			wantErr: true,
+		},
+		{
+			name: "configPatches from ConfigMap and secret",
+			plugin: kongv1.KongPlugin{
+				ObjectMeta: metav1.ObjectMeta{
+					Name:      "test",
+					Namespace: "default",
+				},
+				Protocols:  []kongv1.KongProtocol{"http"},
+				PluginName: "correlation-id",
+				Config: apiextensionsv1.JSON{
+					Raw: []byte(`{"echo_downstream": true}`),
+				},
+				ConfigPatches: []kongv1.ConfigPatch{
+					{
+						Path: "/header_name",
+						ValueFrom: kongv1.ConfigSource{
+							ConfigMapValue: &kongv1.ConfigMapValueFromSource{
+								Key:       "correlation-id-headername",
+								ConfigMap: "conf-configmap",
+							},
+						},
+					},
+					{
+						Path: "/generator",
+						ValueFrom: kongv1.ConfigSource{
+							SecretValue: kongv1.SecretValueFromSource{
+								Key:    "correlation-id-generator",
+								Secret: "conf-secret",
+							},
+						},
+					},
+				},
+			},
+			want: Plugin{
+				Plugin: kong.Plugin{
+					Name: kong.String("correlation-id"),
+					Config: kong.Configuration{
+						"header_name":     "foo",
+						"generator":       "uuid",
+						"echo_downstream": true,
+					},
+					Protocols: kong.StringSlice("http"),
+				},
+				SensitiveFieldsMeta: PluginSensitiveFieldsMetadata{
+					JSONPaths: []string{"/generator"},
+				},
+			},
+		},
+		{
+			name: "missing key of ConfigMap in configPatches",
+			plugin: kongv1.KongPlugin{
+				ObjectMeta: metav1.ObjectMeta{
+					Name:      "test",
+					Namespace: "default",
+				},
+				Protocols:  []kongv1.KongProtocol{"http"},
+				PluginName: "correlation-id",
+				Config: apiextensionsv1.JSON{
+					Raw: []byte(`{"header_name": "foo"}`),
+				},
+				ConfigPatches: []kongv1.ConfigPatch{
+					{
+						Path: "/generator",
+						ValueFrom: kongv1.ConfigSource{
+							ConfigMapValue: &kongv1.ConfigMapValueFromSource{
+								Key:       "correlation-id-missing",
+								ConfigMap: "conf-configmap",
+							},
+						},
+					},
+				},
+			},
+			wantErr: true,
+		},
 	}
 	for _, tt := range tests {
 		t.Run(tt.name, func(t *testing.T) {
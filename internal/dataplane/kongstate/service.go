@@ -12,6 +12,7 @@ import (
 	"sigs.k8s.io/controller-runtime/pkg/client"
 
 	"github.com/kong/kubernetes-ingress-controller/v3/internal/annotations"
+	"github.com/kong/kubernetes-ingress-controller/v3/internal/gatewayapi"
 	"github.com/kong/kubernetes-ingress-controller/v3/internal/util"
 )
 
@@ -58,6 +59,10 @@ type Service struct {
 	// For example, if this Service was created as a result of translating a Kubernetes Ingress, then
 	// Parent is expected to be the Ingress object itself.
 	Parent client.Object
+
+	// SessionPersistence is the Gateway API session persistence configuration of the route rules routing to this
+	// Service. It's used to configure sticky sessions on the Service's Upstream.
+	SessionPersistence *gatewayapi.SessionPersistence
 }
 
 func (s *Service) overridePath(anns map[string]string) {
@@ -17,11 +17,15 @@ const (
 
 	// ServiceBackendTypeKubernetesService means that the backend is a Kubernetes Service.
 	ServiceBackendTypeKubernetesService ServiceBackendType = "KubernetesService"
+
+	// ServiceBackendTypeServiceImport means that the backend is a Multi-Cluster Services API ServiceImport.
+	ServiceBackendTypeServiceImport ServiceBackendType = "ServiceImport"
 )
 
 type ServiceBackends []ServiceBackend
 
-// ServiceBackend represents a backend for a Kong Service. It can be a Kubernetes Service or a KongServiceFacade.
+// ServiceBackend represents a backend for a Kong Service. It can be a Kubernetes Service, a KongServiceFacade
+// or a ServiceImport.
 type ServiceBackend struct {
 	backendType    ServiceBackendType
 	namespacedName k8stypes.NamespacedName
@@ -69,17 +73,26 @@ func NewServiceBackendForServiceFacade(nn k8stypes.NamespacedName, portDef PortD
 	)
 }
 
+// NewServiceBackendForServiceImport creates a new ServiceBackend for a ServiceImport.
+func NewServiceBackendForServiceImport(nn k8stypes.NamespacedName, portDef PortDef) (ServiceBackend, error) {
+	return NewServiceBackend(
+		ServiceBackendTypeServiceImport,
+		nn,
+		portDef,
+	)
+}
+
 // SetWeight sets the weight of the backend used for load-balancing.
 func (s *ServiceBackend) SetWeight(weight int32) {
 	s.weight = lo.ToPtr(int(weight))
 }
 
-// Name returns the name of the backend resource (Service or KongServiceFacade).
+// Name returns the name of the backend resource (Service, KongServiceFacade or ServiceImport).
 func (s *ServiceBackend) Name() string {
 	return s.namespacedName.Name
 }
 
-// Namespace returns the namespace of the backend resource (Service or KongServiceFacade).
+// Namespace returns the namespace of the backend resource (Service, KongServiceFacade or ServiceImport).
 func (s *ServiceBackend) Namespace() string {
 	return s.namespacedName.Namespace
 }
@@ -97,8 +110,12 @@ func (s *ServiceBackend) Weight() mo.Option[int] {
 	return mo.None[int]()
 }
 
-// IsServiceFacade returns true if the backend is a KongServiceFacade. Otherwise, returns false
-// what means that the backend is a Kubernetes Service.
+// IsServiceFacade returns true if the backend is a KongServiceFacade.
 func (s *ServiceBackend) IsServiceFacade() bool {
 	return s.backendType == ServiceBackendTypeKongServiceFacade
 }
+
+// IsServiceImport returns true if the backend is a ServiceImport.
+func (s *ServiceBackend) IsServiceImport() bool {
+	return s.backendType == ServiceBackendTypeServiceImport
+}
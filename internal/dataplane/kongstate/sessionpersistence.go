@@ -0,0 +1,71 @@
+package kongstate
+
+import (
+	"errors"
+
+	"github.com/kong/go-kong/kong"
+	"github.com/samber/lo"
+
+	"github.com/kong/kubernetes-ingress-controller/v3/internal/gatewayapi"
+	kongv1beta1 "github.com/kong/kubernetes-ingress-controller/v3/pkg/apis/configuration/v1beta1"
+)
+
+const (
+	// DefaultSessionPersistenceName is the name of the cookie or the header used to persist sessions
+	// when the session persistence configuration doesn't specify one.
+	DefaultSessionPersistenceName = "kong-session"
+
+	kongAlgorithmConsistentHashing = "consistent-hashing"
+)
+
+var (
+	ErrSessionPersistenceTimeoutsUnsupported        = errors.New("session persistence timeouts are not supported")
+	ErrSessionPersistencePermanentCookieUnsupported = errors.New("session persistence permanent cookies are not supported")
+)
+
+// ValidateSessionPersistence returns an error if the session persistence configuration uses settings that can't be
+// translated. Kong's consistent hashing cookie is a session cookie set without an expiry time and Kong doesn't track
+// sessions, so session persistence timeouts and permanent cookies can't be configured.
+func ValidateSessionPersistence(sessionPersistence *gatewayapi.SessionPersistence) error {
+	if sessionPersistence == nil {
+		return nil
+	}
+	if sessionPersistence.AbsoluteTimeout != nil || sessionPersistence.IdleTimeout != nil {
+		return ErrSessionPersistenceTimeoutsUnsupported
+	}
+	if sessionPersistence.CookieConfig != nil && sessionPersistence.CookieConfig.LifetimeType != nil &&
+		*sessionPersistence.CookieConfig.LifetimeType == gatewayapi.PermanentCookieLifetimeType {
+		return ErrSessionPersistencePermanentCookieUnsupported
+	}
+	return nil
+}
+
+// TranslateSessionPersistence translates Gateway API SessionPersistence to kong.Upstream consistent hashing settings.
+// Cookie based session persistence makes Kong set the cookie on the first response if the client didn't send it,
+// while header based session persistence relies on the client sending the header.
+// It makes assumption that unsupported settings (timeouts, permanent cookies) have been rejected by validation.
+func TranslateSessionPersistence(sessionPersistence gatewayapi.SessionPersistence) *kong.Upstream {
+	name := lo.FromPtrOr(sessionPersistence.SessionName, DefaultSessionPersistenceName)
+	upstream := &kong.Upstream{
+		Algorithm: kong.String(kongAlgorithmConsistentHashing),
+	}
+	switch lo.FromPtrOr(sessionPersistence.Type, gatewayapi.CookieBasedSessionPersistence) {
+	case gatewayapi.HeaderBasedSessionPersistence:
+		upstream.HashOn = kong.String(KongHashOnTypeHeader)
+		upstream.HashOnHeader = kong.String(name)
+	default:
+		upstream.HashOn = kong.String(KongHashOnTypeCookie)
+		upstream.HashOnCookie = kong.String(name)
+	}
+	return upstream
+}
+
+// KongUpstreamPolicyConflictsWithSessionPersistence returns true if the KongUpstreamPolicy configures load balancing
+// in a way that cannot be combined with session persistence, i.e. it uses an algorithm other than consistent hashing
+// or hashes on its own input.
+func KongUpstreamPolicyConflictsWithSessionPersistence(policy kongv1beta1.KongUpstreamPolicySpec) bool {
+	if policy.HashOn != nil {
+		return true
+	}
+	return policy.Algorithm != nil && *policy.Algorithm != kongAlgorithmConsistentHashing
+}
@@ -0,0 +1,59 @@
+package kongstate
+
+import (
+	"testing"
+
+	"github.com/samber/lo"
+	"github.com/stretchr/testify/require"
+
+	kongv1beta1 "github.com/kong/kubernetes-ingress-controller/v3/pkg/apis/configuration/v1beta1"
+)
+
+func TestKongUpstreamPolicyConflictsWithSessionPersistence(t *testing.T) {
+	testCases := []struct {
+		name     string
+		spec     kongv1beta1.KongUpstreamPolicySpec
+		expected bool
+	}{
+		{
+			name:     "empty policy",
+			expected: false,
+		},
+		{
+			name: "policy configuring slots only",
+			spec: kongv1beta1.KongUpstreamPolicySpec{
+				Slots: lo.ToPtr(100),
+			},
+			expected: false,
+		},
+		{
+			name: "policy configuring consistent-hashing algorithm",
+			spec: kongv1beta1.KongUpstreamPolicySpec{
+				Algorithm: lo.ToPtr("consistent-hashing"),
+			},
+			expected: false,
+		},
+		{
+			name: "policy configuring other algorithm",
+			spec: kongv1beta1.KongUpstreamPolicySpec{
+				Algorithm: lo.ToPtr("least-connections"),
+			},
+			expected: true,
+		},
+		{
+			name: "policy configuring hash input",
+			spec: kongv1beta1.KongUpstreamPolicySpec{
+				HashOn: &kongv1beta1.KongUpstreamHash{
+					Header: lo.ToPtr("foo"),
+				},
+			},
+			expected: true,
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			require.Equal(t, tc.expected, KongUpstreamPolicyConflictsWithSessionPersistence(tc.spec))
+		})
+	}
+}
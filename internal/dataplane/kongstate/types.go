@@ -45,6 +45,11 @@ func (p *PortDef) CanonicalString() string {
 // Target is a wrapper around Target object in Kong.
 type Target struct {
 	kong.Target
+
+	// Zones are the zones of the gateways the target is local to. They're set only with topology aware routing.
+	Zones []string
+	// Terminating indicates the target is terminating but still serving, so it's being drained.
+	Terminating bool
 }
 
 // Certificate represents the certificate object in Kong.
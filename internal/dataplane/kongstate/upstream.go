@@ -1,11 +1,14 @@
 package kongstate
 
 import (
+	"slices"
+
 	"github.com/kong/go-kong/kong"
 	corev1 "k8s.io/api/core/v1"
 	gatewayv1 "sigs.k8s.io/gateway-api/apis/v1"
 
 	"github.com/kong/kubernetes-ingress-controller/v3/internal/annotations"
+	"github.com/kong/kubernetes-ingress-controller/v3/internal/gatewayapi"
 	kongv1 "github.com/kong/kubernetes-ingress-controller/v3/pkg/apis/configuration/v1"
 	kongv1beta1 "github.com/kong/kubernetes-ingress-controller/v3/pkg/apis/configuration/v1beta1"
 )
@@ -140,6 +143,18 @@ func (u *Upstream) overrideByKongUpstreamPolicy(policy *kongv1beta1.KongUpstream
 	}
 }
 
+func (u *Upstream) overrideBySessionPersistence(sessionPersistence *gatewayapi.SessionPersistence) {
+	if u == nil || sessionPersistence == nil {
+		return
+	}
+
+	sessionPersistenceOverrides := TranslateSessionPersistence(*sessionPersistence)
+	u.Algorithm = sessionPersistenceOverrides.Algorithm
+	u.HashOn = sessionPersistenceOverrides.HashOn
+	u.HashOnHeader = sessionPersistenceOverrides.HashOnHeader
+	u.HashOnCookie = sessionPersistenceOverrides.HashOnCookie
+}
+
 // override sets Upstream fields by KongIngress first, then by k8s Service's annotations.
 func (u *Upstream) override(
 	kongIngress *kongv1.KongIngress,
@@ -169,3 +184,40 @@ func (u *Upstream) override(
 		u.overrideByAnnotation(svc.Annotations)
 	}
 }
+
+// WithTargetsPreferringZone returns a shallow copy of the state in which the targets of upstreams having targets
+// local to the zone are preferred: the targets in other zones get a weight of 0, so that they don't receive traffic.
+// Upstreams without local targets that can receive traffic (i.e. with a non-zero weight and not terminating) are left
+// unchanged, so that traffic falls back to the targets in other zones.
+func (ks *KongState) WithTargetsPreferringZone(zone string) *KongState {
+	copied := *ks
+	copied.Upstreams = make([]Upstream, 0, len(ks.Upstreams))
+	for _, u := range ks.Upstreams {
+		copied.Upstreams = append(copied.Upstreams, u.withTargetsPreferringZone(zone))
+	}
+	return &copied
+}
+
+func (u Upstream) withTargetsPreferringZone(zone string) Upstream {
+	isLocal := func(t Target) bool {
+		return slices.Contains(t.Zones, zone)
+	}
+	// Terminating targets are only being drained, so they don't count as local targets that can take the
+	// traffic of the whole zone.
+	hasLocalTargets := slices.ContainsFunc(u.Targets, func(t Target) bool {
+		return isLocal(t) && !t.Terminating && (t.Weight == nil || *t.Weight != 0)
+	})
+	if !hasLocalTargets {
+		return u
+	}
+
+	targets := make([]Target, 0, len(u.Targets))
+	for _, t := range u.Targets {
+		if !isLocal(t) {
+			t.Weight = kong.Int(0)
+		}
+		targets = append(targets, t)
+	}
+	u.Targets = targets
+	return u
+}
@@ -1,6 +1,7 @@
 package kongstate
 
 import (
+	"slices"
 	"testing"
 
 	"github.com/kong/go-kong/kong"
@@ -247,3 +248,106 @@ func TestUpstreamOverrideByKongUpstreamPolicy(t *testing.T) {
 		nilUpstream.overrideByKongUpstreamPolicy(nil)
 	})
 }
+
+func TestKongState_WithTargetsPreferringZone(t *testing.T) {
+	target := func(address string, weight *int, zones ...string) Target {
+		return Target{
+			Target: kong.Target{Target: kong.String(address), Weight: weight},
+			Zones:  zones,
+		}
+	}
+	terminating := func(t Target) Target {
+		t.Terminating = true
+		return t
+	}
+
+	testCases := []struct {
+		name            string
+		targets         []Target
+		expectedTargets []Target
+	}{
+		{
+			name: "targets in other zones get a weight of 0",
+			targets: []Target{
+				target("1.1.1.1:80", nil, "zone-a"),
+				target("2.2.2.2:80", kong.Int(50), "zone-b"),
+				target("3.3.3.3:80", nil),
+			},
+			expectedTargets: []Target{
+				target("1.1.1.1:80", nil, "zone-a"),
+				target("2.2.2.2:80", kong.Int(0), "zone-b"),
+				target("3.3.3.3:80", kong.Int(0)),
+			},
+		},
+		{
+			name: "targets hinted for several zones are local to all of them",
+			targets: []Target{
+				target("1.1.1.1:80", nil, "zone-b", "zone-a"),
+				target("2.2.2.2:80", nil, "zone-b"),
+			},
+			expectedTargets: []Target{
+				target("1.1.1.1:80", nil, "zone-b", "zone-a"),
+				target("2.2.2.2:80", kong.Int(0), "zone-b"),
+			},
+		},
+		{
+			name: "targets are unchanged without local targets",
+			targets: []Target{
+				target("1.1.1.1:80", nil, "zone-b"),
+				target("2.2.2.2:80", nil),
+			},
+			expectedTargets: []Target{
+				target("1.1.1.1:80", nil, "zone-b"),
+				target("2.2.2.2:80", nil),
+			},
+		},
+		{
+			name: "targets are unchanged when local targets have a weight of 0",
+			targets: []Target{
+				target("1.1.1.1:80", kong.Int(0), "zone-a"),
+				target("2.2.2.2:80", nil, "zone-b"),
+			},
+			expectedTargets: []Target{
+				target("1.1.1.1:80", kong.Int(0), "zone-a"),
+				target("2.2.2.2:80", nil, "zone-b"),
+			},
+		},
+		{
+			name: "targets are unchanged when local targets are terminating",
+			targets: []Target{
+				terminating(target("1.1.1.1:80", kong.Int(1), "zone-a")),
+				target("2.2.2.2:80", nil, "zone-b"),
+			},
+			expectedTargets: []Target{
+				terminating(target("1.1.1.1:80", kong.Int(1), "zone-a")),
+				target("2.2.2.2:80", nil, "zone-b"),
+			},
+		},
+		{
+			name: "terminating local targets are kept for draining along with ready local targets",
+			targets: []Target{
+				terminating(target("1.1.1.1:80", kong.Int(1), "zone-a")),
+				target("2.2.2.2:80", nil, "zone-a"),
+				target("3.3.3.3:80", nil, "zone-b"),
+			},
+			expectedTargets: []Target{
+				terminating(target("1.1.1.1:80", kong.Int(1), "zone-a")),
+				target("2.2.2.2:80", nil, "zone-a"),
+				target("3.3.3.3:80", kong.Int(0), "zone-b"),
+			},
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			ks := &KongState{
+				Upstreams: []Upstream{{Targets: tc.targets}},
+			}
+			original := slices.Clone(tc.targets)
+
+			result := ks.WithTargetsPreferringZone("zone-a")
+			require.Equal(t, tc.expectedTargets, result.Upstreams[0].Targets)
+			require.Equal(t, original, ks.Upstreams[0].Targets, "original state must not be modified")
+		})
+	}
+}
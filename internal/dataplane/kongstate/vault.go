@@ -1,7 +1,11 @@
 package kongstate
 
 import (
+	"slices"
+	"strings"
+
 	"github.com/kong/go-kong/kong"
+	"github.com/samber/lo"
 
 	kongv1alpha1 "github.com/kong/kubernetes-ingress-controller/v3/pkg/apis/configuration/v1alpha1"
 )
@@ -11,3 +15,48 @@ type Vault struct {
 
 	K8sKongVault *kongv1alpha1.KongVault
 }
+
+// bundledVaultPrefixes are the names of the vaults bundled with Kong, which can be referenced
+// without configuring a vault entity (e.g. {vault://env/my-env-var}).
+var bundledVaultPrefixes = []string{"env", "aws", "gcp", "hcv", "azure", "conjur"}
+
+// vaultPrefixes returns the prefixes of the vaults that can be referenced: the vaults bundled with Kong
+// and the ones translated from KongVaults.
+func (ks *KongState) vaultPrefixes() map[string]struct{} {
+	prefixes := make(map[string]struct{}, len(bundledVaultPrefixes)+len(ks.Vaults))
+	for _, prefix := range bundledVaultPrefixes {
+		prefixes[prefix] = struct{}{}
+	}
+	for _, vault := range ks.Vaults {
+		prefixes[lo.FromPtr(vault.Prefix)] = struct{}{}
+	}
+	return prefixes
+}
+
+// vaultReferencePrefix returns the prefix of the vault referenced by a {vault://<prefix>/<resource>} reference.
+// It returns false if the value is not a vault reference.
+func vaultReferencePrefix(value string) (string, bool) {
+	reference, ok := strings.CutPrefix(value, "{vault://")
+	if !ok || !strings.HasSuffix(reference, "}") {
+		return "", false
+	}
+	prefix, _, _ := strings.Cut(strings.TrimSuffix(reference, "}"), "/")
+	return prefix, true
+}
+
+// unknownVaultReference returns the prefix of the first vault referenced by the data that is not one of the
+// known prefixes.
+func unknownVaultReference(data map[string][]byte, knownPrefixes map[string]struct{}) (string, bool) {
+	keys := lo.Keys(data)
+	slices.Sort(keys)
+	for _, k := range keys {
+		prefix, ok := vaultReferencePrefix(string(data[k]))
+		if !ok {
+			continue
+		}
+		if _, known := knownPrefixes[prefix]; !known {
+			return prefix, true
+		}
+	}
+	return "", false
+}
@@ -0,0 +1,244 @@
+// Package offline implements translation of Kubernetes manifests into Kong declarative configuration
+// without a connection to a Kubernetes cluster or a Kong Gateway.
+package offline
+
+import (
+	"bufio"
+	"bytes"
+	"context"
+	"errors"
+	"fmt"
+	"io"
+	"os"
+	"path/filepath"
+	"strings"
+
+	"github.com/go-logr/logr"
+	"github.com/kong/go-database-reconciler/pkg/file"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	yamlserializer "k8s.io/apimachinery/pkg/runtime/serializer/yaml"
+	utilyaml "k8s.io/apimachinery/pkg/util/yaml"
+	"sigs.k8s.io/yaml"
+
+	dpconf "github.com/kong/kubernetes-ingress-controller/v3/internal/dataplane/config"
+	"github.com/kong/kubernetes-ingress-controller/v3/internal/dataplane/deckgen"
+	"github.com/kong/kubernetes-ingress-controller/v3/internal/dataplane/failures"
+	"github.com/kong/kubernetes-ingress-controller/v3/internal/dataplane/translator"
+	"github.com/kong/kubernetes-ingress-controller/v3/internal/manager/featuregates"
+	"github.com/kong/kubernetes-ingress-controller/v3/internal/store"
+)
+
+// Config contains the settings used for offline translation. They mirror the settings the controller manager
+// derives from its flags and the Kong Gateway it's connected to.
+type Config struct {
+	// IngressClassName is the name of the ingress class the translated objects have to match.
+	IngressClassName string
+	// KongWorkspace is the Kong Enterprise workspace the configuration is generated for.
+	KongWorkspace string
+	// FeatureGates are the feature gates overrides, as passed to the manager's --feature-gates flag.
+	FeatureGates map[string]bool
+	// RouterFlavor is the router flavor of the Kong Gateway the configuration is generated for.
+	RouterFlavor dpconf.RouterFlavor
+	// EnterpriseEdition indicates whether the configuration is generated for Kong Enterprise.
+	EnterpriseEdition bool
+	// FilterTags are the tags set as decK's select_tags.
+	FilterTags []string
+}
+
+// Result is the result of the offline translation.
+type Result struct {
+	// Content is the generated decK configuration.
+	Content *file.Content
+	// TranslationFailures are the failures that occurred while translating the Kubernetes objects.
+	TranslationFailures []failures.ResourceFailure
+	// Warnings describe the objects that were skipped because the translator doesn't support their kinds.
+	Warnings []string
+}
+
+// Translate translates the Kubernetes objects into Kong declarative configuration using the same
+// translator and feature flags as the controller manager.
+func Translate(ctx context.Context, logger logr.Logger, objects [][]byte, cfg Config) (Result, error) {
+	objects, warnings, err := FilterSupportedObjects(logger, objects)
+	if err != nil {
+		return Result{}, err
+	}
+	result, featureFlags, err := buildKongConfig(logger, objects, cfg)
+	if err != nil {
+		return Result{}, err
+	}
+
+	content := deckgen.ToDeckContent(ctx, logger, result.KongState, deckgen.GenerateDeckContentParams{
+		SelectorTags:     cfg.FilterTags,
+		ExpressionRoutes: featureFlags.ExpressionRoutes,
+		PluginSchemas:    emptyPluginSchemaStore{},
+	})
+
+	return Result{
+		Content:             content,
+		TranslationFailures: result.TranslationFailures,
+		Warnings:            warnings,
+	}, nil
+}
+
+// TranslateToKongState translates the Kubernetes objects the same way as Translate, but returns the Kong
+// configuration in the translator's intermediate representation, in which Kong entities keep references
+// to the Kubernetes objects they were translated from. Objects of kinds the translator doesn't support are skipped.
+func TranslateToKongState(logger logr.Logger, objects [][]byte, cfg Config) (translator.KongConfigBuildingResult, error) {
+	objects, _, err := FilterSupportedObjects(logger, objects)
+	if err != nil {
+		return translator.KongConfigBuildingResult{}, err
+	}
+	result, _, err := buildKongConfig(logger, objects, cfg)
+	return result, err
+}
+
+func buildKongConfig(
+	logger logr.Logger, objects [][]byte, cfg Config,
+) (translator.KongConfigBuildingResult, translator.FeatureFlags, error) {
+	featureGates, err := featuregates.New(logger, cfg.FeatureGates)
+	if err != nil {
+		return translator.KongConfigBuildingResult{}, translator.FeatureFlags{}, fmt.Errorf("failed to configure feature gates: %w", err)
+	}
+
+	cacheStores, err := store.NewCacheStoresFromObjYAML(objects...)
+	if err != nil {
+		return translator.KongConfigBuildingResult{}, translator.FeatureFlags{}, fmt.Errorf("failed to load objects into cache stores: %w", err)
+	}
+
+	featureFlags := translator.NewFeatureFlags(
+		featureGates,
+		cfg.RouterFlavor,
+		dpconf.DBModeOff, // Declarative configuration is generated for DB-less gateways.
+		false,            // Objects reporting is only used for status updates which do not apply here.
+		cfg.EnterpriseEdition,
+	)
+	s := store.New(cacheStores, cfg.IngressClassName, logger)
+	t, err := translator.NewTranslator(logger, s, cfg.KongWorkspace, featureFlags)
+	if err != nil {
+		return translator.KongConfigBuildingResult{}, translator.FeatureFlags{}, fmt.Errorf("failed to create translator: %w", err)
+	}
+
+	return t.BuildKongConfig(), featureFlags, nil
+}
+
+// FilterSupportedObjects returns the objects of kinds the translator supports. Manifests usually contain objects
+// irrelevant to the translation (e.g. Deployments), so the other objects are skipped and a warning describing
+// each of them is returned. An error is returned if the kind of an object can't be read.
+func FilterSupportedObjects(logger logr.Logger, objects [][]byte) ([][]byte, []string, error) {
+	var (
+		supported [][]byte
+		warnings  []string
+	)
+	for _, b := range objects {
+		gvk, err := yamlserializer.DefaultMetaFactory.Interpret(b)
+		if err != nil {
+			return nil, nil, fmt.Errorf("failed to read the kind of an object: %w", err)
+		}
+		if store.IsSupportedGVK(*gvk) {
+			supported = append(supported, b)
+			continue
+		}
+
+		var meta metav1.PartialObjectMetadata
+		if err := yaml.Unmarshal(b, &meta); err != nil {
+			return nil, nil, fmt.Errorf("failed to read the metadata of %s: %w", gvk, err)
+		}
+		name := meta.Name
+		if meta.Namespace != "" {
+			name = meta.Namespace + "/" + name
+		}
+		logger.Info("Skipping object of unsupported kind", "apiVersion", gvk.GroupVersion().String(), "kind", gvk.Kind, "name", name)
+		warnings = append(warnings, fmt.Sprintf("skipped %s %s: %s %s objects are not supported", gvk.Kind, name, gvk.GroupVersion(), gvk.Kind))
+	}
+	return supported, warnings, nil
+}
+
+// ReadManifests reads Kubernetes objects from the YAML files at the given path. If the path is a directory,
+// all files with .yaml, .yml or .json extensions are read recursively. Files can contain multiple YAML documents.
+func ReadManifests(path string) ([][]byte, error) {
+	var objects [][]byte
+	err := filepath.WalkDir(path, func(p string, d os.DirEntry, err error) error {
+		if err != nil {
+			return err
+		}
+		if d.IsDir() {
+			return nil
+		}
+		// Accept any file passed explicitly, filter by extension only when walking a directory.
+		if p != path && !isManifestFile(p) {
+			return nil
+		}
+		f, err := os.Open(p)
+		if err != nil {
+			return fmt.Errorf("failed to open %s: %w", p, err)
+		}
+		defer f.Close()
+
+		docs, err := splitYAMLDocuments(f)
+		if err != nil {
+			return fmt.Errorf("failed to read %s: %w", p, err)
+		}
+		objects = append(objects, docs...)
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+	return objects, nil
+}
+
+func isManifestFile(path string) bool {
+	switch strings.ToLower(filepath.Ext(path)) {
+	case ".yaml", ".yml", ".json":
+		return true
+	default:
+		return false
+	}
+}
+
+// splitYAMLDocuments splits a multi-document YAML stream into separate documents, skipping empty ones.
+func splitYAMLDocuments(r io.Reader) ([][]byte, error) {
+	var docs [][]byte
+	reader := utilyaml.NewYAMLReader(bufio.NewReader(r))
+	for {
+		doc, err := reader.Read()
+		if errors.Is(err, io.EOF) {
+			return docs, nil
+		}
+		if err != nil {
+			return nil, err
+		}
+		if isEmptyYAMLDocument(doc) {
+			continue
+		}
+		docs = append(docs, doc)
+	}
+}
+
+// isEmptyYAMLDocument returns true if the document contains nothing but whitespace and comments.
+func isEmptyYAMLDocument(doc []byte) bool {
+	for _, line := range bytes.Split(doc, []byte("\n")) {
+		line = bytes.TrimSpace(line)
+		if len(line) > 0 && !bytes.HasPrefix(line, []byte("#")) {
+			return false
+		}
+	}
+	return true
+}
+
+// emptyPluginSchemaStore is a deckgen.PluginSchemaStore returning a schema with no config fields for all plugins.
+// Without a Kong Gateway there are no schemas to fill plugins' defaults from, so plugins configs are left as they are.
+type emptyPluginSchemaStore struct{}
+
+func (emptyPluginSchemaStore) Schema(context.Context, string) (map[string]interface{}, error) {
+	return map[string]interface{}{
+		"fields": []interface{}{
+			map[string]interface{}{
+				"config": map[string]interface{}{
+					"type":   "record",
+					"fields": []interface{}{},
+				},
+			},
+		},
+	}, nil
+}
@@ -0,0 +1,170 @@
+package offline_test
+
+import (
+	"context"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/go-logr/logr"
+	"github.com/kong/go-kong/kong"
+	"github.com/samber/lo"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	dpconf "github.com/kong/kubernetes-ingress-controller/v3/internal/dataplane/config"
+	"github.com/kong/kubernetes-ingress-controller/v3/internal/dataplane/offline"
+)
+
+const testManifests = `
+# A leading comment-only document should be skipped.
+---
+apiVersion: networking.k8s.io/v1
+kind: IngressClass
+metadata:
+  name: kong
+spec:
+  controller: ingress-controllers.konghq.com/kong
+---
+apiVersion: networking.k8s.io/v1
+kind: Ingress
+metadata:
+  name: foo
+  namespace: default
+spec:
+  ingressClassName: kong
+  rules:
+  - host: example.com
+    http:
+      paths:
+      - path: /foo
+        pathType: Prefix
+        backend:
+          service:
+            name: foo
+            port:
+              number: 80
+---
+apiVersion: v1
+kind: Service
+metadata:
+  name: foo
+  namespace: default
+spec:
+  ports:
+  - port: 80
+    protocol: TCP
+`
+
+const testBrokenManifests = `
+apiVersion: configuration.konghq.com/v1
+kind: KongConsumer
+metadata:
+  name: consumer
+  namespace: default
+  annotations:
+    kubernetes.io/ingress.class: kong
+username: consumer
+credentials:
+- missing-secret
+`
+
+const testUnsupportedManifests = `
+apiVersion: apps/v1
+kind: Deployment
+metadata:
+  name: foo
+  namespace: default
+spec:
+  selector:
+    matchLabels:
+      app: foo
+  template:
+    metadata:
+      labels:
+        app: foo
+    spec:
+      containers:
+      - name: foo
+        image: kong/httpbin
+---
+apiVersion: v1
+kind: ServiceAccount
+metadata:
+  name: foo
+  namespace: default
+`
+
+func TestReadManifests(t *testing.T) {
+	dir := t.TempDir()
+	require.NoError(t, os.WriteFile(filepath.Join(dir, "manifests.yaml"), []byte(testManifests), 0o600))
+	require.NoError(t, os.MkdirAll(filepath.Join(dir, "nested"), 0o700))
+	require.NoError(t, os.WriteFile(filepath.Join(dir, "nested", "broken.yml"), []byte(testBrokenManifests), 0o600))
+	require.NoError(t, os.WriteFile(filepath.Join(dir, "README.md"), []byte("not a manifest"), 0o600))
+
+	objects, err := offline.ReadManifests(dir)
+	require.NoError(t, err)
+	require.Len(t, objects, 4, "expected all objects from yaml files only")
+
+	objects, err = offline.ReadManifests(filepath.Join(dir, "manifests.yaml"))
+	require.NoError(t, err)
+	require.Len(t, objects, 3)
+}
+
+func TestTranslate(t *testing.T) {
+	dir := t.TempDir()
+	require.NoError(t, os.WriteFile(filepath.Join(dir, "manifests.yaml"), []byte(testManifests+"---\n"+testBrokenManifests), 0o600))
+	objects, err := offline.ReadManifests(dir)
+	require.NoError(t, err)
+
+	result, err := offline.Translate(context.Background(), logr.Discard(), objects, offline.Config{
+		IngressClassName: "kong",
+		RouterFlavor:     dpconf.RouterFlavorTraditionalCompatible,
+	})
+	require.NoError(t, err)
+
+	require.Len(t, result.Content.Services, 1)
+	service := result.Content.Services[0]
+	assert.Equal(t, "default.foo.80", lo.FromPtr(service.Name))
+	require.Len(t, service.Routes, 1)
+	assert.Equal(t, []*string{kong.String("example.com")}, service.Routes[0].Hosts)
+	require.Len(t, result.Content.Upstreams, 1)
+
+	require.Len(t, result.TranslationFailures, 1, "expected the consumer with a missing credential to fail")
+	causingObjects := result.TranslationFailures[0].CausingObjects()
+	require.Len(t, causingObjects, 1)
+	assert.Equal(t, "consumer", causingObjects[0].GetName())
+
+	t.Run("invalid feature gate", func(t *testing.T) {
+		_, err := offline.Translate(context.Background(), logr.Discard(), objects, offline.Config{
+			FeatureGates: map[string]bool{"NotExisting": true},
+		})
+		require.Error(t, err)
+	})
+}
+
+func TestTranslate_SkipsUnsupportedKinds(t *testing.T) {
+	dir := t.TempDir()
+	require.NoError(t, os.WriteFile(filepath.Join(dir, "manifests.yaml"), []byte(testManifests+"---\n"+testUnsupportedManifests), 0o600))
+	objects, err := offline.ReadManifests(dir)
+	require.NoError(t, err)
+	require.Len(t, objects, 5)
+
+	cfg := offline.Config{
+		IngressClassName: "kong",
+		RouterFlavor:     dpconf.RouterFlavorTraditionalCompatible,
+	}
+	result, err := offline.Translate(context.Background(), logr.Discard(), objects, cfg)
+	require.NoError(t, err)
+	require.Len(t, result.Content.Services, 1)
+	require.Len(t, result.Content.Services[0].Routes, 1)
+	require.Empty(t, result.TranslationFailures)
+	require.Equal(t, []string{
+		"skipped Deployment default/foo: apps/v1 Deployment objects are not supported",
+		"skipped ServiceAccount default/foo: v1 ServiceAccount objects are not supported",
+	}, result.Warnings)
+
+	kongState, err := offline.TranslateToKongState(logr.Discard(), objects, cfg)
+	require.NoError(t, err)
+	require.Len(t, kongState.KongState.Services, 1)
+}
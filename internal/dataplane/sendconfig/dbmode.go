@@ -15,6 +15,7 @@ import (
 	"github.com/kong/go-database-reconciler/pkg/state"
 	deckutils "github.com/kong/go-database-reconciler/pkg/utils"
 	"github.com/kong/go-kong/kong"
+	"github.com/samber/lo"
 
 	"github.com/kong/kubernetes-ingress-controller/v3/internal/dataplane/deckerrors"
 	"github.com/kong/kubernetes-ingress-controller/v3/internal/metrics"
@@ -29,6 +30,7 @@ type UpdateStrategyDBMode struct {
 	version           semver.Version
 	concurrency       int
 	isKonnect         bool
+	dryRun            bool
 	logger            logr.Logger
 	resourceErrors    []ResourceError
 	resourceErrorLock *sync.Mutex
@@ -64,7 +66,39 @@ func NewUpdateStrategyDBModeKonnect(
 	return s
 }
 
+// NewUpdateStrategyDBModeDryRun returns an UpdateStrategyDBMode that only computes the diff between the current
+// and the target state without applying it. With Kong Gateway, entities are validated with Kong's schema validation
+// endpoints first, the same way UpdateStrategyInMemoryDryRun does. Konnect doesn't expose these endpoints, so with
+// Konnect only the diff is computed and the configuration is not validated.
+func NewUpdateStrategyDBModeDryRun(
+	client *kong.Client,
+	dumpConfig dump.Config,
+	version semver.Version,
+	concurrency int,
+	isKonnect bool,
+	logger logr.Logger,
+) UpdateStrategyDBMode {
+	s := NewUpdateStrategyDBMode(client, dumpConfig, version, concurrency, logger)
+	s.isKonnect = isKonnect
+	s.dryRun = true
+	return s
+}
+
 func (s UpdateStrategyDBMode) Update(ctx context.Context, targetContent ContentWithHash) error {
+	if len(targetContent.CustomEntities) > 0 {
+		// Custom entities are reported as translation failures for DB-backed gateways, so they can only be
+		// present here when syncing with Konnect.
+		s.logger.V(util.DebugLevel).Info("Custom entities are not supported by Konnect, skipping them",
+			"entity_types", lo.Keys(targetContent.CustomEntities))
+	}
+
+	if s.dryRun && !s.isKonnect {
+		entities := collectEntitiesToValidate(DefaultContentToDBLessConfigConverter{}.Convert(targetContent.Content))
+		if err := validateEntities(ctx, s.client, entities, s.concurrency, s.logger); err != nil {
+			return err
+		}
+	}
+
 	cs, err := s.currentState(ctx)
 	if err != nil {
 		return fmt.Errorf("failed getting current state for %s: %w", s.client.BaseRootURL(), err)
@@ -91,8 +125,15 @@ func (s UpdateStrategyDBMode) Update(ctx context.Context, targetContent ContentW
 	ctx, cancel := context.WithCancel(ctx)
 	go s.HandleEvents(ctx, syncer.GetResultChan())
 
-	_, errs, _ := syncer.Solve(ctx, s.concurrency, false, false)
+	stats, errs, _ := syncer.Solve(ctx, s.concurrency, s.dryRun, false)
 	cancel()
+	if s.dryRun {
+		s.logger.Info("Computed configuration diff without applying it (dry run)",
+			"create", stats.CreateOps.Count(),
+			"update", stats.UpdateOps.Count(),
+			"delete", stats.DeleteOps.Count(),
+		)
+	}
 	s.resourceErrorLock.Lock()
 	defer s.resourceErrorLock.Unlock()
 	resourceFailures := resourceErrorsToResourceFailures(s.resourceErrors, s.logger)
@@ -123,7 +164,11 @@ func (s *UpdateStrategyDBMode) HandleEvents(ctx context.Context, events chan dif
 		select {
 		case event := <-events:
 			if event.Error == nil {
-				s.logger.V(util.DebugLevel).Info("updated gateway entity", "action", event.Action, "kind", event.Entity.Kind, "name", event.Entity.Name)
+				msg := "updated gateway entity"
+				if s.dryRun {
+					msg = "would update gateway entity (dry run)"
+				}
+				s.logger.V(util.DebugLevel).Info(msg, "action", event.Action, "kind", event.Entity.Kind, "name", event.Entity.Name)
 			} else {
 				s.logger.Error(event.Error, "failed updating gateway entity", "action", event.Action, "kind", event.Entity.Kind, "name", event.Entity.Name)
 				parsed, err := resourceErrorFromEntityAction(event)
@@ -195,10 +240,16 @@ func resourceErrorFromEntityAction(event diff.EntityAction) (ResourceError, erro
 }
 
 func (s UpdateStrategyDBMode) MetricsProtocol() metrics.Protocol {
+	if s.dryRun {
+		return metrics.ProtocolDeckDryRun
+	}
 	return metrics.ProtocolDeck
 }
 
 func (s UpdateStrategyDBMode) Type() string {
+	if s.dryRun {
+		return "DBModeDryRun"
+	}
 	return "DBMode"
 }
 
@@ -50,6 +50,7 @@ func NewUpdateStrategyInMemory(
 
 func (s UpdateStrategyInMemory) Update(ctx context.Context, targetState ContentWithHash) error {
 	dblessConfig := s.configConverter.Convert(targetState.Content)
+	dblessConfig.CustomEntities = targetState.CustomEntities
 	config, err := json.Marshal(dblessConfig)
 	if err != nil {
 		return fmt.Errorf("constructing kong configuration: %w", err)
@@ -0,0 +1,250 @@
+package sendconfig
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"sync"
+
+	"github.com/go-logr/logr"
+	"github.com/kong/go-kong/kong"
+	"github.com/samber/lo"
+	"golang.org/x/sync/errgroup"
+
+	"github.com/kong/kubernetes-ingress-controller/v3/internal/metrics"
+)
+
+// UpdateStrategyInMemoryDryRun implements the UpdateStrategy interface. Instead of applying the configuration
+// with Kong's `POST /config` endpoint, it validates every entity of the configuration with Kong's
+// `POST /schemas/{entity}/validate` endpoints. Entities failing validation are reported as resource failures
+// of the Kubernetes objects they were generated from.
+//
+// Please note that schema validation does not cover cross-entity constraints (e.g. uniqueness) that are
+// checked by Kong only when the whole configuration is loaded.
+type UpdateStrategyInMemoryDryRun struct {
+	client          *kong.Client
+	configConverter ContentToDBLessConfigConverter
+	concurrency     int
+	logger          logr.Logger
+}
+
+func NewUpdateStrategyInMemoryDryRun(
+	client *kong.Client,
+	configConverter ContentToDBLessConfigConverter,
+	concurrency int,
+	logger logr.Logger,
+) UpdateStrategyInMemoryDryRun {
+	return UpdateStrategyInMemoryDryRun{
+		client:          client,
+		configConverter: configConverter,
+		concurrency:     concurrency,
+		logger:          logger,
+	}
+}
+
+// entityToValidate is a single Kong entity that is validated against its Kong schema.
+type entityToValidate struct {
+	entityType kong.EntityType
+	name       string
+	tags       []*string
+	entity     any
+}
+
+func (s UpdateStrategyInMemoryDryRun) Update(ctx context.Context, targetState ContentWithHash) error {
+	dblessConfig := s.configConverter.Convert(targetState.Content)
+	dblessConfig.CustomEntities = targetState.CustomEntities
+	entities := collectEntitiesToValidate(dblessConfig)
+	if err := validateEntities(ctx, s.client, entities, s.concurrency, s.logger); err != nil {
+		return err
+	}
+
+	s.logger.Info("Validated configuration without applying it (dry run)", "entities", len(entities))
+	return nil
+}
+
+// validateEntities validates the entities with Kong's schema validation endpoints. Entities failing validation
+// are reported in the returned UpdateError as resource failures of the Kubernetes objects they were generated from.
+func validateEntities(
+	ctx context.Context,
+	client *kong.Client,
+	entities []entityToValidate,
+	concurrency int,
+	logger logr.Logger,
+) error {
+	var (
+		lock           sync.Mutex
+		resourceErrors []ResourceError
+		invalidCount   int
+	)
+	g, ctx := errgroup.WithContext(ctx)
+	if concurrency > 0 {
+		g.SetLimit(concurrency)
+	}
+	for _, e := range entities {
+		e := e
+		g.Go(func() error {
+			valid, msg, err := validateEntity(ctx, client, e)
+			if err != nil {
+				return fmt.Errorf("failed to validate %s %s: %w", e.entityType, e.name, err)
+			}
+			if valid {
+				return nil
+			}
+
+			lock.Lock()
+			defer lock.Unlock()
+			invalidCount++
+			parsed, err := parseRawResourceError(rawResourceError{
+				Name: e.name,
+				Tags: lo.Map(e.tags, func(t *string, _ int) string { return lo.FromPtr(t) }),
+				Problems: map[string]string{
+					fmt.Sprintf("%s:%s", e.entityType, e.name): msg,
+				},
+			})
+			if err != nil {
+				logger.Error(err, "Entity tags missing fields", "type", e.entityType, "name", e.name, "problem", msg)
+				return nil
+			}
+			resourceErrors = append(resourceErrors, parsed)
+			return nil
+		})
+	}
+	if err := g.Wait(); err != nil {
+		return err
+	}
+
+	if invalidCount > 0 {
+		return NewUpdateError(
+			resourceErrorsToResourceFailures(resourceErrors, logger),
+			fmt.Errorf("%d entities failed validation", invalidCount),
+		)
+	}
+	return nil
+}
+
+func validateEntity(ctx context.Context, client *kong.Client, e entityToValidate) (bool, string, error) {
+	switch e.entityType { //nolint:exhaustive
+	case kong.EntityTypePlugins:
+		plugin, ok := e.entity.(*kong.Plugin)
+		if !ok {
+			return false, "", errors.New("unexpected plugin type")
+		}
+		return client.Plugins.Validate(ctx, plugin)
+	default:
+		return client.Schemas.Validate(ctx, e.entityType, e.entity)
+	}
+}
+
+func (s UpdateStrategyInMemoryDryRun) MetricsProtocol() metrics.Protocol {
+	return metrics.ProtocolDBLessDryRun
+}
+
+func (s UpdateStrategyInMemoryDryRun) Type() string {
+	return "InMemoryDryRun"
+}
+
+// collectEntitiesToValidate flattens the configuration into a list of entities that can be validated one by one.
+// Nested entities are validated on their own, without references to their parents.
+func collectEntitiesToValidate(config DBLessConfig) []entityToValidate {
+	var entities []entityToValidate
+	addPlugin := func(p kong.Plugin) {
+		// References are not relevant for schema validation and would have to exist in Kong.
+		p.Service, p.Route, p.Consumer, p.ConsumerGroup = nil, nil, nil, nil
+		entities = append(entities, entityToValidate{
+			entityType: kong.EntityTypePlugins,
+			name:       lo.FromPtr(p.Name),
+			tags:       p.Tags,
+			entity:     &p,
+		})
+	}
+
+	for _, s := range config.Services {
+		entities = append(entities, entityToValidate{
+			entityType: kong.EntityTypeServices,
+			name:       lo.FromPtr(s.Name),
+			tags:       s.Tags,
+			entity:     s.Service,
+		})
+		for _, p := range s.Plugins {
+			addPlugin(p.Plugin)
+		}
+		for _, r := range s.Routes {
+			entities = append(entities, entityToValidate{
+				entityType: kong.EntityTypeRoutes,
+				name:       lo.FromPtr(r.Name),
+				tags:       r.Tags,
+				entity:     r.Route,
+			})
+			for _, p := range r.Plugins {
+				addPlugin(p.Plugin)
+			}
+		}
+	}
+	for _, p := range config.Plugins {
+		addPlugin(p.Plugin)
+	}
+	for _, u := range config.Upstreams {
+		entities = append(entities, entityToValidate{
+			entityType: kong.EntityTypeUpstreams,
+			name:       lo.FromPtr(u.Name),
+			tags:       u.Tags,
+			entity:     u.Upstream,
+		})
+	}
+	for _, c := range config.Certificates {
+		entities = append(entities, entityToValidate{
+			entityType: kong.EntityTypeCertificates,
+			name:       lo.FromPtr(c.ID),
+			tags:       c.Tags,
+			entity: kong.Certificate{
+				Cert: c.Cert,
+				Key:  c.Key,
+				Tags: c.Tags,
+			},
+		})
+	}
+	for _, c := range config.CACertificates {
+		entities = append(entities, entityToValidate{
+			entityType: kong.EntityTypeCACertificates,
+			name:       lo.FromPtr(c.ID),
+			tags:       c.Tags,
+			entity:     c.CACertificate,
+		})
+	}
+	for _, c := range config.Consumers {
+		entities = append(entities, entityToValidate{
+			entityType: kong.EntityTypeConsumers,
+			name:       lo.FromPtr(c.Username),
+			tags:       c.Tags,
+			entity:     c.Consumer,
+		})
+		for _, p := range c.Plugins {
+			addPlugin(p.Plugin)
+		}
+	}
+	for _, cg := range config.ConsumerGroups {
+		entities = append(entities, entityToValidate{
+			entityType: kong.EntityTypeConsumerGroups,
+			name:       lo.FromPtr(cg.Name),
+			tags:       cg.Tags,
+			entity:     cg.ConsumerGroup,
+		})
+	}
+	for entityType, customEntities := range config.CustomEntities {
+		for _, e := range customEntities {
+			name, _ := e["name"].(string)
+			var tags []*string
+			if rawTags, ok := e["tags"].([]string); ok {
+				tags = kong.StringSlice(rawTags...)
+			}
+			entities = append(entities, entityToValidate{
+				entityType: kong.EntityType(entityType),
+				name:       name,
+				tags:       tags,
+				entity:     e,
+			})
+		}
+	}
+
+	return entities
+}
@@ -0,0 +1,156 @@
+package sendconfig_test
+
+import (
+	"context"
+	"errors"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"sync"
+	"testing"
+
+	"github.com/blang/semver/v4"
+	"github.com/go-logr/logr"
+	"github.com/kong/go-database-reconciler/pkg/dump"
+	"github.com/kong/go-database-reconciler/pkg/file"
+	"github.com/kong/go-kong/kong"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/kong/kubernetes-ingress-controller/v3/internal/dataplane/sendconfig"
+	"github.com/kong/kubernetes-ingress-controller/v3/internal/metrics"
+)
+
+// validateEndpointsMock mocks Kong's schema validation endpoints, rejecting entities whose body contains invalidMarker.
+type validateEndpointsMock struct {
+	invalidMarker string
+
+	lock          sync.Mutex
+	validatedURLs []string
+}
+
+func (m *validateEndpointsMock) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	if r.Method != http.MethodPost || !strings.HasSuffix(r.URL.Path, "/validate") {
+		w.WriteHeader(http.StatusNotFound)
+		return
+	}
+	m.lock.Lock()
+	m.validatedURLs = append(m.validatedURLs, r.URL.Path)
+	m.lock.Unlock()
+
+	body, _ := io.ReadAll(r.Body)
+	if strings.Contains(string(body), m.invalidMarker) {
+		w.WriteHeader(http.StatusBadRequest)
+		_, _ = w.Write([]byte(`{"message": "schema violation (host: invalid value)"}`))
+		return
+	}
+	w.WriteHeader(http.StatusOK)
+	_, _ = w.Write([]byte(`{"message": "schema validation successful"}`))
+}
+
+func TestUpdateStrategyInMemoryDryRun(t *testing.T) {
+	validateMock := &validateEndpointsMock{invalidMarker: "invalid.host"}
+	server := httptest.NewServer(validateMock)
+	t.Cleanup(server.Close)
+
+	client, err := kong.NewClient(kong.String(server.URL), server.Client())
+	require.NoError(t, err)
+
+	strategy := sendconfig.NewUpdateStrategyInMemoryDryRun(client, sendconfig.DefaultContentToDBLessConfigConverter{}, 2, logr.Discard())
+	assert.Equal(t, "InMemoryDryRun", strategy.Type())
+	assert.Equal(t, metrics.ProtocolDBLessDryRun, strategy.MetricsProtocol())
+
+	serviceTags := kong.StringSlice(
+		"k8s-name:svc",
+		"k8s-namespace:default",
+		"k8s-kind:Service",
+		"k8s-version:v1",
+		"k8s-uid:a3b8afcc-9f19-42e4-aa8f-5866168c2ad3",
+	)
+	content := func(host string) *file.Content {
+		return &file.Content{
+			Services: []file.FService{
+				{
+					Service: kong.Service{
+						Name: kong.String("default.svc.80"),
+						Host: kong.String(host),
+						Tags: serviceTags,
+					},
+					Routes: []*file.FRoute{
+						{
+							Route: kong.Route{Name: kong.String("default.ingress.svc.example.com.80")},
+							Plugins: []*file.FPlugin{
+								{Plugin: kong.Plugin{Name: kong.String("key-auth")}},
+							},
+						},
+					},
+				},
+			},
+			Plugins: []file.FPlugin{
+				{Plugin: kong.Plugin{Name: kong.String("prometheus")}},
+			},
+		}
+	}
+
+	t.Run("valid configuration", func(t *testing.T) {
+		err := strategy.Update(context.Background(), sendconfig.ContentWithHash{Content: content("svc.default.80.svc")})
+		require.NoError(t, err)
+		assert.ElementsMatch(t, []string{
+			"/schemas/services/validate",
+			"/schemas/routes/validate",
+			"/schemas/plugins/validate",
+			"/schemas/plugins/validate",
+		}, validateMock.validatedURLs)
+	})
+
+	t.Run("invalid entity is reported as a resource failure", func(t *testing.T) {
+		err := strategy.Update(context.Background(), sendconfig.ContentWithHash{Content: content("invalid.host")})
+		require.Error(t, err)
+
+		var updateErr sendconfig.UpdateError
+		require.True(t, errors.As(err, &updateErr))
+		require.Len(t, updateErr.ResourceFailures(), 1)
+		failure := updateErr.ResourceFailures()[0]
+		assert.Equal(t, "invalid services:default.svc.80: schema violation (host: invalid value)", failure.Message())
+		require.Len(t, failure.CausingObjects(), 1)
+		assert.Equal(t, "svc", failure.CausingObjects()[0].GetName())
+	})
+}
+
+func TestUpdateStrategyDBModeDryRun_ValidatesEntities(t *testing.T) {
+	validateMock := &validateEndpointsMock{invalidMarker: "invalid.host"}
+	server := httptest.NewServer(validateMock)
+	t.Cleanup(server.Close)
+
+	client, err := kong.NewClient(kong.String(server.URL), server.Client())
+	require.NoError(t, err)
+
+	strategy := sendconfig.NewUpdateStrategyDBModeDryRun(client, dump.Config{}, semver.MustParse("3.7.0"), 2, false, logr.Discard())
+	assert.Equal(t, "DBModeDryRun", strategy.Type())
+	assert.Equal(t, metrics.ProtocolDeckDryRun, strategy.MetricsProtocol())
+
+	err = strategy.Update(context.Background(), sendconfig.ContentWithHash{Content: &file.Content{
+		Services: []file.FService{
+			{
+				Service: kong.Service{
+					Name: kong.String("default.svc.80"),
+					Host: kong.String("invalid.host"),
+					Tags: kong.StringSlice(
+						"k8s-name:svc",
+						"k8s-namespace:default",
+						"k8s-kind:Service",
+						"k8s-version:v1",
+						"k8s-uid:a3b8afcc-9f19-42e4-aa8f-5866168c2ad3",
+					),
+				},
+			},
+		},
+	}})
+	require.Error(t, err)
+	var updateErr sendconfig.UpdateError
+	require.True(t, errors.As(err, &updateErr), "entities should be validated before computing the diff")
+	require.Len(t, updateErr.ResourceFailures(), 1)
+	assert.Equal(t, "invalid services:default.svc.80: schema violation (host: invalid value)", updateErr.ResourceFailures()[0].Message())
+	assert.Equal(t, []string{"/schemas/services/validate"}, validateMock.validatedURLs)
+}
@@ -1,7 +1,11 @@
 package sendconfig
 
 import (
+	"encoding/json"
+
 	"github.com/kong/go-database-reconciler/pkg/file"
+
+	"github.com/kong/kubernetes-ingress-controller/v3/internal/dataplane/deckgen"
 )
 
 // DBLessConfig is the configuration that is sent to Kong's data-plane via its `POST /config` endpoint after being
@@ -10,6 +14,34 @@ import (
 type DBLessConfig struct {
 	file.Content
 	ConsumerGroupConsumerRelationships []ConsumerGroupConsumerRelationship `json:"consumer_group_consumers,omitempty"`
+	// CustomEntities are marshalled as top-level keys named after their entity types.
+	CustomEntities deckgen.CustomEntitiesByType `json:"-"`
+}
+
+// MarshalJSON marshals the configuration adding custom entities as top-level keys.
+func (c DBLessConfig) MarshalJSON() ([]byte, error) {
+	type dblessConfig DBLessConfig // Avoid infinite recursion.
+	b, err := json.Marshal(dblessConfig(c))
+	if err != nil || len(c.CustomEntities) == 0 {
+		return b, err
+	}
+
+	var config map[string]json.RawMessage
+	if err := json.Unmarshal(b, &config); err != nil {
+		return nil, err
+	}
+	for entityType, entities := range c.CustomEntities {
+		// Entity types defined by decK's schema are never overwritten.
+		if _, ok := config[entityType]; ok {
+			continue
+		}
+		raw, err := json.Marshal(entities)
+		if err != nil {
+			return nil, err
+		}
+		config[entityType] = raw
+	}
+	return json.Marshal(config)
 }
 
 // ConsumerGroupConsumerRelationship is a relationship between a ConsumerGroup and a Consumer.
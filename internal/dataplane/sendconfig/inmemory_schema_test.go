@@ -8,6 +8,7 @@ import (
 	"github.com/kong/go-kong/kong"
 	"github.com/stretchr/testify/require"
 
+	"github.com/kong/kubernetes-ingress-controller/v3/internal/dataplane/deckgen"
 	"github.com/kong/kubernetes-ingress-controller/v3/internal/dataplane/sendconfig"
 )
 
@@ -48,6 +49,55 @@ func TestDBLessConfigMarshalToJSON(t *testing.T) {
 	require.JSONEq(t, expected, string(b))
 }
 
+func TestDBLessConfigMarshalToJSON_CustomEntities(t *testing.T) {
+	dblessConfig := sendconfig.DBLessConfig{
+		Content: file.Content{
+			Services: []file.FService{
+				{
+					Service: kong.Service{
+						Name: kong.String("service-id"),
+					},
+				},
+			},
+		},
+		CustomEntities: deckgen.CustomEntitiesByType{
+			"degraphql_routes": {
+				{
+					"uri":     "/contacts",
+					"query":   "query{ contacts { name } }",
+					"service": map[string]interface{}{"id": "service-id"},
+				},
+			},
+			// Entity types of decK's schema must not be overwritten by custom entities.
+			"services": {
+				{
+					"name": "custom-service",
+				},
+			},
+		},
+	}
+
+	expected := `{
+  "services": [
+    {
+      "name": "service-id"
+    }
+  ],
+  "degraphql_routes": [
+    {
+      "uri": "/contacts",
+      "query": "query{ contacts { name } }",
+      "service": {
+        "id": "service-id"
+      }
+    }
+  ]
+}`
+	b, err := json.Marshal(dblessConfig)
+	require.NoError(t, err)
+	require.JSONEq(t, expected, string(b))
+}
+
 func TestDefaultContentToDBLessConfigConverter(t *testing.T) {
 	converter := sendconfig.DefaultContentToDBLessConfigConverter{}
 
@@ -1,6 +1,8 @@
 package sendconfig
 
 import (
+	"time"
+
 	"github.com/blang/semver/v4"
 )
 
@@ -41,4 +43,30 @@ type Config struct {
 	// UseLastValidConfigForFallback indicates whether to use the last valid config cache to backfill broken objects
 	// when recovering from a config push failure.
 	UseLastValidConfigForFallback bool
+
+	// DryRun indicates whether configuration should only be validated against Kong (or diffed with its current
+	// state in DB mode) without being applied.
+	DryRun bool
+
+	// CanaryRollout configures a staged rollout of configuration to DB-less gateways.
+	CanaryRollout CanaryRolloutConfig
+}
+
+// CanaryRolloutConfig configures a staged rollout in which configuration is pushed to a subset of gateways (canaries)
+// first and pushed to the rest only once the canaries are found healthy with it.
+type CanaryRolloutConfig struct {
+	// Gateways is the number of canary gateways. Staged rollout is disabled when it's 0.
+	Gateways int
+
+	// HealthCheckDelay is the time to wait after pushing configuration to the canaries before checking their health.
+	HealthCheckDelay time.Duration
+
+	// MaxErrorRate is the maximum ratio of requests responded with 5xx status codes to all requests served by
+	// a canary during HealthCheckDelay. It's only checked when the gateway exposes HTTP request metrics.
+	MaxErrorRate float64
+}
+
+// Enabled returns true if the staged rollout is enabled.
+func (c CanaryRolloutConfig) Enabled() bool {
+	return c.Gateways > 0
 }
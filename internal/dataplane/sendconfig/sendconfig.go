@@ -41,12 +41,13 @@ func PerformUpdate(
 	client AdminAPIClient,
 	config Config,
 	targetContent *file.Content,
+	customEntities deckgen.CustomEntitiesByType,
 	promMetrics *metrics.CtrlFuncMetrics,
 	updateStrategyResolver UpdateStrategyResolver,
 	configChangeDetector ConfigurationChangeDetector,
 ) ([]byte, error) {
 	oldSHA := client.LastConfigSHA()
-	newSHA, err := deckgen.GenerateSHA(targetContent)
+	newSHA, err := deckgen.GenerateSHA(targetContent, customEntities)
 	if err != nil {
 		return oldSHA, fmt.Errorf("failed to generate SHA for target content: %w", err)
 	}
@@ -71,8 +72,9 @@ func PerformUpdate(
 	logger = logger.WithValues("update_strategy", updateStrategy.Type())
 	timeStart := time.Now()
 	err = updateStrategy.Update(ctx, ContentWithHash{
-		Content: targetContent,
-		Hash:    newSHA,
+		Content:        targetContent,
+		CustomEntities: customEntities,
+		Hash:           newSHA,
 	})
 	duration := time.Since(timeStart)
 
@@ -81,7 +83,7 @@ func PerformUpdate(
 		// For UpdateError, record the failure and return the error.
 		var updateError UpdateError
 		if errors.As(err, &updateError) {
-			promMetrics.RecordPushFailure(metricsProtocol, duration, client.BaseRootURL(), len(updateError.ResourceFailures()), updateError.err)
+			promMetrics.RecordPushFailure(metricsProtocol, duration, client.BaseRootURL(), updateError.ResourceFailures(), updateError.err)
 			return nil, updateError
 		}
 
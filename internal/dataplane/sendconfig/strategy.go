@@ -9,13 +9,17 @@ import (
 	"github.com/kong/go-kong/kong"
 
 	"github.com/kong/kubernetes-ingress-controller/v3/internal/adminapi"
+	"github.com/kong/kubernetes-ingress-controller/v3/internal/dataplane/deckgen"
 	"github.com/kong/kubernetes-ingress-controller/v3/internal/metrics"
 )
 
 // ContentWithHash encapsulates file.Content along with its precalculated hash.
 type ContentWithHash struct {
 	Content *file.Content
-	Hash    []byte
+	// CustomEntities are custom Kong entities that are not part of decK's schema. They're only supported
+	// by the DB-less update strategies.
+	CustomEntities deckgen.CustomEntitiesByType
+	Hash           []byte
 }
 
 // UpdateStrategy is the way we approach updating data-plane's configuration, depending on its type.
@@ -86,6 +90,18 @@ func (r DefaultUpdateStrategyResolver) resolveUpdateStrategy(client UpdateClient
 	// In case the client communicates with Konnect Admin API, we know it has to use DB-mode. There's no need to check
 	// config.InMemory that is meant for regular Kong Gateway clients.
 	if client.IsKonnect() {
+		if r.config.DryRun {
+			return NewUpdateStrategyDBModeDryRun(
+				adminAPIClient,
+				dump.Config{
+					KonnectControlPlane: client.KonnectControlPlane(),
+				},
+				r.config.Version,
+				r.config.Concurrency,
+				true,
+				r.logger,
+			)
+		}
 		return NewUpdateStrategyDBModeKonnect(
 			adminAPIClient,
 			dump.Config{
@@ -98,19 +114,39 @@ func (r DefaultUpdateStrategyResolver) resolveUpdateStrategy(client UpdateClient
 	}
 
 	if !r.config.InMemory {
+		dumpConfig := dump.Config{
+			SkipCACerts:     r.config.SkipCACertificates,
+			SelectorTags:    r.config.FilterTags,
+			IncludeLicenses: true,
+		}
+		if r.config.DryRun {
+			return NewUpdateStrategyDBModeDryRun(
+				adminAPIClient,
+				dumpConfig,
+				r.config.Version,
+				r.config.Concurrency,
+				false,
+				r.logger,
+			)
+		}
 		return NewUpdateStrategyDBMode(
 			adminAPIClient,
-			dump.Config{
-				SkipCACerts:     r.config.SkipCACertificates,
-				SelectorTags:    r.config.FilterTags,
-				IncludeLicenses: true,
-			},
+			dumpConfig,
 			r.config.Version,
 			r.config.Concurrency,
 			r.logger,
 		)
 	}
 
+	if r.config.DryRun {
+		return NewUpdateStrategyInMemoryDryRun(
+			adminAPIClient,
+			DefaultContentToDBLessConfigConverter{},
+			r.config.Concurrency,
+			r.logger,
+		)
+	}
+
 	return NewUpdateStrategyInMemory(
 		adminAPIClient,
 		DefaultContentToDBLessConfigConverter{},
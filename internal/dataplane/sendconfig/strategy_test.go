@@ -48,6 +48,7 @@ func TestDefaultUpdateStrategyResolver_ResolveUpdateStrategy(t *testing.T) {
 	testCases := []struct {
 		isKonnect                     bool
 		inMemory                      bool
+		dryRun                        bool
 		expectedStrategyType          string
 		expectKonnectControlPlaneCall bool
 	}{
@@ -73,10 +74,28 @@ func TestDefaultUpdateStrategyResolver_ResolveUpdateStrategy(t *testing.T) {
 			inMemory:             true,
 			expectedStrategyType: "InMemory",
 		},
+		{
+			isKonnect:                     true,
+			dryRun:                        true,
+			expectedStrategyType:          "WithBackoff(DBModeDryRun)",
+			expectKonnectControlPlaneCall: true,
+		},
+		{
+			isKonnect:            false,
+			inMemory:             false,
+			dryRun:               true,
+			expectedStrategyType: "DBModeDryRun",
+		},
+		{
+			isKonnect:            false,
+			inMemory:             true,
+			dryRun:               true,
+			expectedStrategyType: "InMemoryDryRun",
+		},
 	}
 
 	for _, tc := range testCases {
-		t.Run(fmt.Sprintf("isKonnect=%v inMemory=%v", tc.isKonnect, tc.inMemory), func(t *testing.T) {
+		t.Run(fmt.Sprintf("isKonnect=%v inMemory=%v dryRun=%v", tc.isKonnect, tc.inMemory, tc.dryRun), func(t *testing.T) {
 			client := &clientMock{
 				isKonnect: tc.isKonnect,
 			}
@@ -90,6 +109,7 @@ func TestDefaultUpdateStrategyResolver_ResolveUpdateStrategy(t *testing.T) {
 
 			resolver := sendconfig.NewDefaultUpdateStrategyResolver(sendconfig.Config{
 				InMemory: tc.inMemory,
+				DryRun:   tc.dryRun,
 			}, zapr.NewLogger(zap.NewNop()))
 
 			strategy := resolver.ResolveUpdateStrategy(updateClient)
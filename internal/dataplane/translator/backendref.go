@@ -9,8 +9,11 @@ import (
 
 	"github.com/kong/kubernetes-ingress-controller/v3/internal/dataplane/kongstate"
 	"github.com/kong/kubernetes-ingress-controller/v3/internal/gatewayapi"
+	"github.com/kong/kubernetes-ingress-controller/v3/internal/manager/featuregates"
+	mcsv1alpha1 "github.com/kong/kubernetes-ingress-controller/v3/internal/mcsapi/v1alpha1"
 	"github.com/kong/kubernetes-ingress-controller/v3/internal/store"
 	"github.com/kong/kubernetes-ingress-controller/v3/internal/util"
+	incubatorv1alpha1 "github.com/kong/kubernetes-ingress-controller/v3/pkg/apis/incubator/v1alpha1"
 )
 
 // backendRefsToKongStateBackends takes a list of BackendRefs and returns a list of ServiceBackends.
@@ -18,7 +21,8 @@ import (
 // not included in the returned list:
 // - If a BackendRef is not permitted by the provided ReferenceGrantTo set,
 // - If a BackendRef is not found,
-// - If a BackendRef Group & Kind pair is not supported (currently only Service is supported),
+// - If a BackendRef Group & Kind pair is not supported (Service, KongServiceFacade for HTTPRoutes and GRPCRoutes
+// when the KongServiceFacade feature is enabled, or ServiceImport when the MultiClusterServices feature is enabled),
 // - If a BackendRef is missing a port.
 // The provided client is used to retrieve the Backend referenced by the BackendRef
 // to check if it exists.
@@ -28,6 +32,7 @@ func backendRefsToKongStateBackends(
 	route client.Object,
 	backendRefs []gatewayapi.BackendRef,
 	allowed map[gatewayapi.Namespace][]gatewayapi.ReferenceGrantTo,
+	featureFlags FeatureFlags,
 ) kongstate.ServiceBackends {
 	backends := kongstate.ServiceBackends{}
 
@@ -48,12 +53,21 @@ func backendRefsToKongStateBackends(
 			continue
 		}
 
-		var err error
-		switch *backendRef.Kind {
-		case "Service":
+		var (
+			err           error
+			serviceFacade *incubatorv1alpha1.KongServiceFacade
+			serviceImport *mcsv1alpha1.ServiceImport
+		)
+		switch {
+		case util.IsBackendRefKongServiceFacade(backendRef.Group, backendRef.Kind):
+			serviceFacade, err = getKongServiceFacadeForBackendRef(storer, route, nn, featureFlags)
+		case util.IsBackendRefServiceImport(backendRef.Group, backendRef.Kind):
+			serviceImport, err = getServiceImportForBackendRef(storer, nn, featureFlags)
+		case *backendRef.Kind == "Service":
 			_, err = storer.GetService(nn.Namespace, nn.Name)
 		default:
-			err = fmt.Errorf("unsupported kind %q, only 'Service' is supported", *backendRef.Kind)
+			err = fmt.Errorf("unsupported kind %q, only 'Service', '%s' and '%s' are supported",
+				*backendRef.Kind, incubatorv1alpha1.KongServiceFacadeKind, mcsv1alpha1.ServiceImportKind)
 		}
 		if err != nil {
 			if errors.As(err, &store.NotFoundError{}) {
@@ -64,7 +78,7 @@ func backendRefsToKongStateBackends(
 			continue
 		}
 
-		if !util.IsBackendRefGroupKindSupported(backendRef.Group, backendRef.Kind) ||
+		if (serviceFacade == nil && serviceImport == nil && !util.IsBackendRefGroupKindSupported(backendRef.Group, backendRef.Kind)) ||
 			!gatewayapi.NewRefCheckerForRoute(route, backendRef).IsRefAllowedByGrant(allowed) {
 			// we log impermissible refs rather than failing the entire rule. while we cannot actually route to
 			// these, we do not want a single impermissible ref to take the entire rule offline. in the case of edits,
@@ -78,13 +92,34 @@ func backendRefsToKongStateBackends(
 		if backendRef.Port != nil {
 			port = int32(*backendRef.Port)
 		}
-		backend, err := kongstate.NewServiceBackendForService(
-			nn,
-			kongstate.PortDef{
-				Mode:   kongstate.PortModeByNumber,
-				Number: port,
-			},
-		)
+		var backend kongstate.ServiceBackend
+		switch {
+		case serviceFacade != nil:
+			// KongServiceFacade defines the port of its backing Service, so the port of the backendRef is ignored.
+			backend, err = kongstate.NewServiceBackendForServiceFacade(
+				nn,
+				kongstate.PortDef{
+					Mode:   kongstate.PortModeByNumber,
+					Number: serviceFacade.Spec.Backend.Port,
+				},
+			)
+		case serviceImport != nil:
+			backend, err = kongstate.NewServiceBackendForServiceImport(
+				nn,
+				kongstate.PortDef{
+					Mode:   kongstate.PortModeByNumber,
+					Number: port,
+				},
+			)
+		default:
+			backend, err = kongstate.NewServiceBackendForService(
+				nn,
+				kongstate.PortDef{
+					Mode:   kongstate.PortModeByNumber,
+					Number: port,
+				},
+			)
+		}
 		if err != nil {
 			logger.Error(err, "failed to create ServiceBackend for backendRef")
 			continue
@@ -98,6 +133,38 @@ func backendRefsToKongStateBackends(
 	return backends
 }
 
+// getKongServiceFacadeForBackendRef returns the KongServiceFacade referenced by a route's backendRef. KongServiceFacades
+// can be referenced only by HTTPRoutes and GRPCRoutes when the KongServiceFacade feature is enabled.
+func getKongServiceFacadeForBackendRef(
+	storer store.Storer,
+	route client.Object,
+	nn client.ObjectKey,
+	featureFlags FeatureFlags,
+) (*incubatorv1alpha1.KongServiceFacade, error) {
+	if !featureFlags.KongServiceFacade {
+		return nil, fmt.Errorf("KongServiceFacade is not enabled, please set the %q feature gate to 'true' to enable it", featuregates.KongServiceFacade)
+	}
+	switch route.(type) {
+	case *gatewayapi.HTTPRoute, *gatewayapi.GRPCRoute:
+	default:
+		return nil, fmt.Errorf("KongServiceFacade can be referenced only by HTTPRoutes and GRPCRoutes")
+	}
+	return storer.GetKongServiceFacade(nn.Namespace, nn.Name)
+}
+
+// getServiceImportForBackendRef returns the ServiceImport referenced by a route's backendRef. ServiceImports can be
+// referenced only when the MultiClusterServices feature is enabled.
+func getServiceImportForBackendRef(
+	storer store.Storer,
+	nn client.ObjectKey,
+	featureFlags FeatureFlags,
+) (*mcsv1alpha1.ServiceImport, error) {
+	if !featureFlags.MultiClusterServices {
+		return nil, fmt.Errorf("ServiceImport is not enabled, please set the %q feature gate to 'true' to enable it", featuregates.MultiClusterServices)
+	}
+	return storer.GetServiceImport(nn.Namespace, nn.Name)
+}
+
 func loggerForBackendRef(logger logr.Logger, route client.Object, backendRef gatewayapi.BackendRef) logr.Logger {
 	var (
 		namespace = route.GetNamespace()
@@ -13,18 +13,21 @@ import (
 
 	"github.com/kong/kubernetes-ingress-controller/v3/internal/dataplane/kongstate"
 	"github.com/kong/kubernetes-ingress-controller/v3/internal/gatewayapi"
+	mcsv1alpha1 "github.com/kong/kubernetes-ingress-controller/v3/internal/mcsapi/v1alpha1"
 	"github.com/kong/kubernetes-ingress-controller/v3/internal/store"
 	"github.com/kong/kubernetes-ingress-controller/v3/internal/util/builder"
+	incubatorv1alpha1 "github.com/kong/kubernetes-ingress-controller/v3/pkg/apis/incubator/v1alpha1"
 )
 
 func TestBackendRefsToKongStateBackends(t *testing.T) {
 	testcases := []struct {
-		name        string
-		route       client.Object
-		backendRefs []gatewayapi.BackendRef
-		allowed     map[gatewayapi.Namespace][]gatewayapi.ReferenceGrantTo
-		objects     store.FakeObjects
-		expected    kongstate.ServiceBackends
+		name         string
+		route        client.Object
+		backendRefs  []gatewayapi.BackendRef
+		allowed      map[gatewayapi.Namespace][]gatewayapi.ReferenceGrantTo
+		objects      store.FakeObjects
+		featureFlags FeatureFlags
+		expected     kongstate.ServiceBackends
 	}{
 		{
 			name: "correct ReferenceGrant and an existing Service as backendRef returns a KongStateBackend with a Service",
@@ -153,6 +156,197 @@ func TestBackendRefsToKongStateBackends(t *testing.T) {
 			},
 			expected: kongstate.ServiceBackends{},
 		},
+		{
+			name: "existing KongServiceFacade as backendRef returns a KongStateBackend with the KongServiceFacade and its port",
+			route: &gatewayapi.HTTPRoute{
+				ObjectMeta: metav1.ObjectMeta{
+					Name:      "basic-httproute",
+					Namespace: corev1.NamespaceDefault,
+				},
+			},
+			backendRefs: []gatewayapi.BackendRef{
+				builder.NewBackendRef("fake-facade").
+					WithGroup(incubatorv1alpha1.GroupVersion.Group).
+					WithKind(incubatorv1alpha1.KongServiceFacadeKind).
+					Build(),
+			},
+			objects: store.FakeObjects{
+				KongServiceFacades: []*incubatorv1alpha1.KongServiceFacade{
+					&incubatorv1alpha1.KongServiceFacade{
+						ObjectMeta: metav1.ObjectMeta{
+							Name:      "fake-facade",
+							Namespace: corev1.NamespaceDefault,
+						},
+						Spec: incubatorv1alpha1.KongServiceFacadeSpec{
+							Backend: incubatorv1alpha1.KongServiceFacadeBackend{
+								Name: "fake-service",
+								Port: 8080,
+							},
+						},
+					},
+				},
+			},
+			featureFlags: FeatureFlags{KongServiceFacade: true},
+			expected: func() kongstate.ServiceBackends {
+				backend, err := kongstate.NewServiceBackendForServiceFacade(
+					k8stypes.NamespacedName{Namespace: corev1.NamespaceDefault, Name: "fake-facade"},
+					kongstate.PortDef{
+						Mode:   kongstate.PortModeByNumber,
+						Number: 8080,
+					},
+				)
+				require.NoError(t, err)
+				return kongstate.ServiceBackends{backend}
+			}(),
+		},
+		{
+			name: "KongServiceFacade as backendRef doesn't return a KongStateBackend when the feature is disabled",
+			route: &gatewayapi.HTTPRoute{
+				ObjectMeta: metav1.ObjectMeta{
+					Name:      "basic-httproute",
+					Namespace: corev1.NamespaceDefault,
+				},
+			},
+			backendRefs: []gatewayapi.BackendRef{
+				builder.NewBackendRef("fake-facade").
+					WithGroup(incubatorv1alpha1.GroupVersion.Group).
+					WithKind(incubatorv1alpha1.KongServiceFacadeKind).
+					Build(),
+			},
+			objects: store.FakeObjects{
+				KongServiceFacades: []*incubatorv1alpha1.KongServiceFacade{
+					&incubatorv1alpha1.KongServiceFacade{
+						ObjectMeta: metav1.ObjectMeta{
+							Name:      "fake-facade",
+							Namespace: corev1.NamespaceDefault,
+						},
+						Spec: incubatorv1alpha1.KongServiceFacadeSpec{
+							Backend: incubatorv1alpha1.KongServiceFacadeBackend{
+								Name: "fake-service",
+								Port: 8080,
+							},
+						},
+					},
+				},
+			},
+			expected: kongstate.ServiceBackends{},
+		},
+		{
+			name: "KongServiceFacade as backendRef of a TCPRoute doesn't return a KongStateBackend",
+			route: &gatewayapi.TCPRoute{
+				ObjectMeta: metav1.ObjectMeta{
+					Name:      "basic-tcproute",
+					Namespace: corev1.NamespaceDefault,
+				},
+			},
+			backendRefs: []gatewayapi.BackendRef{
+				builder.NewBackendRef("fake-facade").
+					WithGroup(incubatorv1alpha1.GroupVersion.Group).
+					WithKind(incubatorv1alpha1.KongServiceFacadeKind).
+					Build(),
+			},
+			objects: store.FakeObjects{
+				KongServiceFacades: []*incubatorv1alpha1.KongServiceFacade{
+					&incubatorv1alpha1.KongServiceFacade{
+						ObjectMeta: metav1.ObjectMeta{
+							Name:      "fake-facade",
+							Namespace: corev1.NamespaceDefault,
+						},
+						Spec: incubatorv1alpha1.KongServiceFacadeSpec{
+							Backend: incubatorv1alpha1.KongServiceFacadeBackend{
+								Name: "fake-service",
+								Port: 8080,
+							},
+						},
+					},
+				},
+			},
+			featureFlags: FeatureFlags{KongServiceFacade: true},
+			expected:     kongstate.ServiceBackends{},
+		},
+		{
+			name: "existing ServiceImport as backendRef of a TCPRoute returns a KongStateBackend with the ServiceImport",
+			route: &gatewayapi.TCPRoute{
+				ObjectMeta: metav1.ObjectMeta{
+					Name:      "basic-tcproute",
+					Namespace: corev1.NamespaceDefault,
+				},
+			},
+			backendRefs: []gatewayapi.BackendRef{
+				builder.NewBackendRef("fake-import").
+					WithGroup(mcsv1alpha1.GroupVersion.Group).
+					WithKind(mcsv1alpha1.ServiceImportKind).
+					WithPort(8080).
+					Build(),
+			},
+			objects: store.FakeObjects{
+				ServiceImports: []*mcsv1alpha1.ServiceImport{
+					{
+						ObjectMeta: metav1.ObjectMeta{
+							Name:      "fake-import",
+							Namespace: corev1.NamespaceDefault,
+						},
+					},
+				},
+			},
+			featureFlags: FeatureFlags{MultiClusterServices: true},
+			expected: func() kongstate.ServiceBackends {
+				backend, err := kongstate.NewServiceBackendForServiceImport(
+					k8stypes.NamespacedName{Namespace: corev1.NamespaceDefault, Name: "fake-import"},
+					kongstate.PortDef{
+						Mode:   kongstate.PortModeByNumber,
+						Number: 8080,
+					},
+				)
+				require.NoError(t, err)
+				return kongstate.ServiceBackends{backend}
+			}(),
+		},
+		{
+			name: "ServiceImport as backendRef doesn't return a KongStateBackend when the feature is disabled",
+			route: &gatewayapi.HTTPRoute{
+				ObjectMeta: metav1.ObjectMeta{
+					Name:      "basic-httproute",
+					Namespace: corev1.NamespaceDefault,
+				},
+			},
+			backendRefs: []gatewayapi.BackendRef{
+				builder.NewBackendRef("fake-import").
+					WithGroup(mcsv1alpha1.GroupVersion.Group).
+					WithKind(mcsv1alpha1.ServiceImportKind).
+					WithPort(8080).
+					Build(),
+			},
+			objects: store.FakeObjects{
+				ServiceImports: []*mcsv1alpha1.ServiceImport{
+					{
+						ObjectMeta: metav1.ObjectMeta{
+							Name:      "fake-import",
+							Namespace: corev1.NamespaceDefault,
+						},
+					},
+				},
+			},
+			expected: kongstate.ServiceBackends{},
+		},
+		{
+			name: "non existing ServiceImport as backendRef doesn't return a KongStateBackend",
+			route: &gatewayapi.HTTPRoute{
+				ObjectMeta: metav1.ObjectMeta{
+					Name:      "basic-httproute",
+					Namespace: corev1.NamespaceDefault,
+				},
+			},
+			backendRefs: []gatewayapi.BackendRef{
+				builder.NewBackendRef("fake-import").
+					WithGroup(mcsv1alpha1.GroupVersion.Group).
+					WithKind(mcsv1alpha1.ServiceImportKind).
+					WithPort(8080).
+					Build(),
+			},
+			featureFlags: FeatureFlags{MultiClusterServices: true},
+			expected:     kongstate.ServiceBackends{},
+		},
 	}
 
 	for _, tc := range testcases {
@@ -160,7 +354,7 @@ func TestBackendRefsToKongStateBackends(t *testing.T) {
 			fakestore, err := store.NewFakeStore(tc.objects)
 			require.NoError(t, err)
 			logger := logr.Discard()
-			ret := backendRefsToKongStateBackends(logger, fakestore, tc.route, tc.backendRefs, tc.allowed)
+			ret := backendRefsToKongStateBackends(logger, fakestore, tc.route, tc.backendRefs, tc.allowed, tc.featureFlags)
 			require.Equal(t, tc.expected, ret)
 		})
 	}
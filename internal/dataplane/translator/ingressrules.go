@@ -2,6 +2,9 @@ package translator
 
 import (
 	"fmt"
+	"maps"
+	"slices"
+	"sort"
 	"strings"
 
 	"github.com/go-logr/logr"
@@ -9,14 +12,20 @@ import (
 	"github.com/samber/lo"
 	corev1 "k8s.io/api/core/v1"
 	netv1 "k8s.io/api/networking/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	k8stypes "k8s.io/apimachinery/pkg/types"
+	"k8s.io/apimachinery/pkg/util/intstr"
 	"k8s.io/apimachinery/pkg/util/sets"
 	"sigs.k8s.io/controller-runtime/pkg/client"
 
 	"github.com/kong/kubernetes-ingress-controller/v3/internal/annotations"
 	"github.com/kong/kubernetes-ingress-controller/v3/internal/dataplane/failures"
 	"github.com/kong/kubernetes-ingress-controller/v3/internal/dataplane/kongstate"
+	"github.com/kong/kubernetes-ingress-controller/v3/internal/dataplane/translator/subtranslator"
+	"github.com/kong/kubernetes-ingress-controller/v3/internal/hostownership"
+	mcsv1alpha1 "github.com/kong/kubernetes-ingress-controller/v3/internal/mcsapi/v1alpha1"
 	"github.com/kong/kubernetes-ingress-controller/v3/internal/store"
+	"github.com/kong/kubernetes-ingress-controller/v3/internal/tenantpolicy"
 	"github.com/kong/kubernetes-ingress-controller/v3/internal/util"
 )
 
@@ -44,6 +53,10 @@ func mergeIngressRules(objs ...ingressRules) ingressRules {
 	for _, obj := range objs {
 		result.SecretNameToSNIs.merge(obj.SecretNameToSNIs)
 		for k, v := range obj.ServiceNameToServices {
+			// Kong services of KongServiceFacades are shared by routes of all kinds, so their routes are kept.
+			if existing, ok := result.ServiceNameToServices[k]; ok && isServiceFacadeService(existing) && isServiceFacadeService(v) {
+				v.Routes = slices.Concat(existing.Routes, v.Routes)
+			}
 			result.ServiceNameToServices[k] = v
 		}
 		for k, v := range obj.ServiceNameToParent {
@@ -53,6 +66,11 @@ func mergeIngressRules(objs ...ingressRules) ingressRules {
 	return result
 }
 
+// isServiceFacadeService returns true if the Kong service is backed by a single KongServiceFacade.
+func isServiceFacadeService(service kongstate.Service) bool {
+	return len(service.Backends) == 1 && service.Backends[0].IsServiceFacade()
+}
+
 // populateServices populates the ServiceNameToServices map with additional information
 // and returns a map of services to be skipped.
 func (ir *ingressRules) populateServices(
@@ -62,6 +80,7 @@ func (ir *ingressRules) populateServices(
 	translatedObjectsCollector *ObjectsCollector,
 ) map[string]interface{} {
 	serviceNamesToSkip := make(map[string]interface{})
+	tenantPolicies := tenantpolicy.NewEvaluator(s)
 
 	// populate Kubernetes Service
 	for key, service := range ir.ServiceNameToServices {
@@ -83,6 +102,13 @@ func (ir *ingressRules) populateServices(
 			continue
 		}
 
+		// Kong services backed by Kubernetes services using annotations forbidden by KongTenantPolicies
+		// are skipped, as the annotations would otherwise affect every route using them.
+		if !k8sServicesComplyWithTenantPolicies(k8sServices, tenantPolicies, failuresCollector) {
+			serviceNamesToSkip[key] = nil
+			continue
+		}
+
 		for _, k8sService := range k8sServices {
 			// at this point we know the Kubernetes service itself is valid and can be
 			// used for traffic, so cache it amongst the kong Services k8s services.
@@ -129,6 +155,111 @@ func (ir *ingressRules) populateServices(
 	return serviceNamesToSkip
 }
 
+// k8sServicesComplyWithTenantPolicies returns false when any of the Kubernetes services violates the KongTenantPolicies
+// selecting its namespace. A translation failure is reported for every violating service.
+func k8sServicesComplyWithTenantPolicies(
+	k8sServices []*corev1.Service,
+	tenantPolicies *tenantpolicy.Evaluator,
+	failuresCollector *failures.ResourceFailuresCollector,
+) bool {
+	comply := true
+	for _, k8sService := range k8sServices {
+		if err := tenantPolicies.ValidateObject(k8sService); err != nil {
+			failuresCollector.PushResourceFailure(err.Error(), k8sService)
+			comply = false
+		}
+	}
+	return comply
+}
+
+// excludeTenantPolicyViolations returns the objects complying with the KongTenantPolicies selecting their namespaces,
+// so that routes are not translated from objects using forbidden annotations, hosts or paths. A translation failure is
+// reported for every excluded object.
+func excludeTenantPolicyViolations[T client.Object](t *Translator, objs []T) []T {
+	tenantPolicies := tenantpolicy.NewEvaluator(t.storer)
+	return lo.Filter(objs, func(obj T, _ int) bool {
+		if err := tenantPolicies.ValidateObject(obj); err != nil {
+			t.registerTranslationFailure(err.Error(), obj)
+			return false
+		}
+		return true
+	})
+}
+
+// excludeHostnameOwnershipViolations returns the objects that do not route hostnames owned by other namespaces when
+// the HostnameOwnership feature is enabled. A translation failure is reported for every excluded object.
+func excludeHostnameOwnershipViolations[T client.Object](t *Translator, objs []T) []T {
+	if !t.featureFlags.HostnameOwnership {
+		return objs
+	}
+	owners, err := hostownership.NewOwnersFromStore(t.storer)
+	if err != nil {
+		t.logger.Error(err, "Failed to determine hostname owners")
+		return objs
+	}
+	return lo.Filter(objs, func(obj T, _ int) bool {
+		if err := owners.Validate(obj); err != nil {
+			t.registerTranslationFailure(err.Error(), obj)
+			return false
+		}
+		return true
+	})
+}
+
+// reportInvalidRoutePriorities reports a translation failure for every object with an invalid konghq.com/route-priority
+// annotation. Routes are still translated from such objects, ignoring the annotation.
+func reportInvalidRoutePriorities[T client.Object](t *Translator, objs []T) {
+	for _, obj := range objs {
+		if err := subtranslator.ValidateUserRoutePriority(obj.GetAnnotations()); err != nil {
+			t.registerTranslationFailure(fmt.Sprintf("%s, ignoring it", err), obj)
+		}
+	}
+}
+
+// reportExpressionRoutePriorityConflicts reports a translation failure for every pair of expression routes translated
+// from objs that have the same priority and the same expression. Kong evaluates such routes in an undefined order,
+// so requests are routed to either of them. Users can order them with the konghq.com/route-priority annotation.
+// It's not needed for HTTPRoutes, as every split HTTPRoute match is assigned a distinct priority.
+func reportExpressionRoutePriorityConflicts[T client.Object](t *Translator, services map[string]kongstate.Service, objs []T) {
+	type routeMatch struct {
+		priority   uint64
+		expression string
+	}
+	objsByName := lo.SliceToMap(objs, func(obj T) (k8stypes.NamespacedName, client.Object) {
+		return client.ObjectKeyFromObject(obj), obj
+	})
+
+	serviceNames := lo.Keys(services)
+	sort.Strings(serviceNames)
+	matchedRoutes := make(map[routeMatch]kongstate.Route)
+	for _, serviceName := range serviceNames {
+		for _, route := range services[serviceName].Routes {
+			if !route.ExpressionRoutes || route.Priority == nil || route.Expression == nil {
+				continue
+			}
+			match := routeMatch{priority: *route.Priority, expression: *route.Expression}
+			conflicting, ok := matchedRoutes[match]
+			if !ok {
+				matchedRoutes[match] = route
+				continue
+			}
+
+			causingObjects := lo.Uniq(lo.FilterMap([]util.K8sObjectInfo{conflicting.Ingress, route.Ingress},
+				func(info util.K8sObjectInfo, _ int) (client.Object, bool) {
+					obj, ok := objsByName[k8stypes.NamespacedName{Namespace: info.Namespace, Name: info.Name}]
+					return obj, ok
+				},
+			))
+			t.registerTranslationFailure(
+				fmt.Sprintf("Kong route %s has the same priority and matches the same requests as Kong route %s, "+
+					"use the %s%s annotation to order them",
+					*route.Name, *conflicting.Name, annotations.AnnotationPrefix, annotations.RoutePriorityKey),
+				causingObjects...,
+			)
+		}
+	}
+}
+
 func (ir *ingressRules) generateKongServiceTags(
 	k8sServices []*corev1.Service,
 	service kongstate.Service,
@@ -150,7 +281,7 @@ func (ir *ingressRules) generateKongServiceTags(
 		if len(service.Backends) == 1 && service.Backends[0].IsServiceFacade() {
 			return util.GenerateTagsForObject(service.Parent)
 		}
-		// ... or use the backing Kubernetes Service.
+		// ... or use the backing Kubernetes Service (or the Service standing in for a ServiceImport).
 		return util.GenerateTagsForObject(k8sServices[0])
 	}
 
@@ -353,6 +484,20 @@ func resolveKubernetesServiceForBackend(
 		return k8sService, nil
 	}
 
+	// In case of ServiceImport, there's no Kubernetes Service backing it in this cluster. A Service standing in for it
+	// is built, so that it can be translated the same way as Services.
+	if backend.IsServiceImport() {
+		serviceImport, err := storer.GetServiceImport(backend.Namespace(), backend.Name())
+		if err != nil {
+			return nil, fmt.Errorf("failed to fetch ServiceImport %s/%s: %w", backend.Namespace(), backend.Name(), err)
+		}
+
+		// After ServiceImport is fetched successfully, we can consider it a translated object.
+		translatedObjectsCollector.Add(serviceImport)
+
+		return serviceForServiceImport(serviceImport), nil
+	}
+
 	// In case of Kubernetes Service, we just need to fetch it.
 	k8sService, err := storer.GetService(backend.Namespace(), backend.Name())
 	if err != nil {
@@ -365,6 +510,40 @@ func resolveKubernetesServiceForBackend(
 	return k8sService, nil
 }
 
+// serviceForServiceImport returns a Kubernetes Service standing in for a ServiceImport in translation. It has the
+// ServiceImport's ports and annotations (so e.g. konghq.com/protocol can be set on the ServiceImport), and its
+// TypeMeta and UID, so that tags and translation failures refer to the ServiceImport.
+func serviceForServiceImport(serviceImport *mcsv1alpha1.ServiceImport) *corev1.Service {
+	return &corev1.Service{
+		TypeMeta: metav1.TypeMeta{
+			APIVersion: mcsv1alpha1.GroupVersion.String(),
+			Kind:       mcsv1alpha1.ServiceImportKind,
+		},
+		ObjectMeta: metav1.ObjectMeta{
+			Namespace:   serviceImport.Namespace,
+			Name:        serviceImport.Name,
+			UID:         serviceImport.UID,
+			Annotations: maps.Clone(serviceImport.Annotations),
+		},
+		Spec: corev1.ServiceSpec{
+			Type: corev1.ServiceTypeClusterIP,
+			Ports: lo.Map(serviceImport.Spec.Ports, func(port mcsv1alpha1.ServicePort, _ int) corev1.ServicePort {
+				protocol := port.Protocol
+				if protocol == "" {
+					protocol = corev1.ProtocolTCP
+				}
+				return corev1.ServicePort{
+					Name:        port.Name,
+					Protocol:    protocol,
+					AppProtocol: port.AppProtocol,
+					Port:        port.Port,
+					TargetPort:  intstr.FromInt32(port.Port),
+				}
+			}),
+		},
+	}
+}
+
 // collectInconsistentAnnotations takes a list of services and annotation+value pairs and confirms that all services
 // have those annotations with those values. If any service does not have one of the annotation+value pairs, push
 // a resource failure to the provided collector for all services indicating the problem annotation.
@@ -14,6 +14,7 @@ import (
 	corev1 "k8s.io/api/core/v1"
 	netv1 "k8s.io/api/networking/v1"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	k8stypes "k8s.io/apimachinery/pkg/types"
 	"k8s.io/apimachinery/pkg/util/uuid"
 	"sigs.k8s.io/controller-runtime/pkg/client"
 
@@ -42,6 +43,15 @@ func TestMergeIngressRules(t *testing.T) {
 	var (
 		parent1 = &netv1.Ingress{ObjectMeta: metav1.ObjectMeta{UID: uuid.NewUUID()}}
 		parent2 = &netv1.Ingress{ObjectMeta: metav1.ObjectMeta{UID: uuid.NewUUID()}}
+
+		facadeBackend = lo.Must(kongstate.NewServiceBackendForServiceFacade(
+			k8stypes.NamespacedName{Namespace: "default", Name: "facade"},
+			kongstate.PortDef{Mode: kongstate.PortModeByNumber, Number: 80},
+		))
+		serviceBackend = lo.Must(kongstate.NewServiceBackendForService(
+			k8stypes.NamespacedName{Namespace: "default", Name: "svc"},
+			kongstate.PortDef{Mode: kongstate.PortModeByNumber, Number: 80},
+		))
 	)
 
 	for _, tt := range []struct {
@@ -159,6 +169,59 @@ func TestMergeIngressRules(t *testing.T) {
 				ServiceNameToParent:   map[string]client.Object{},
 			},
 		},
+		{
+			name: "keeps routes of services of KongServiceFacades",
+			inputs: []ingressRules{
+				{
+					ServiceNameToServices: map[string]kongstate.Service{"svc-name": {
+						Backends: []kongstate.ServiceBackend{facadeBackend},
+						Routes:   []kongstate.Route{{Route: kong.Route{Name: kong.String("httproute")}}},
+					}},
+				},
+				{
+					ServiceNameToServices: map[string]kongstate.Service{"svc-name": {
+						Backends: []kongstate.ServiceBackend{facadeBackend},
+						Routes:   []kongstate.Route{{Route: kong.Route{Name: kong.String("ingress")}}},
+					}},
+				},
+			},
+			wantOutput: &ingressRules{
+				SecretNameToSNIs: newSecretNameToSNIs(),
+				ServiceNameToServices: map[string]kongstate.Service{"svc-name": {
+					Backends: []kongstate.ServiceBackend{facadeBackend},
+					Routes: []kongstate.Route{
+						{Route: kong.Route{Name: kong.String("httproute")}},
+						{Route: kong.Route{Name: kong.String("ingress")}},
+					},
+				}},
+				ServiceNameToParent: map[string]client.Object{},
+			},
+		},
+		{
+			name: "overwrites routes of services of Kubernetes Services",
+			inputs: []ingressRules{
+				{
+					ServiceNameToServices: map[string]kongstate.Service{"svc-name": {
+						Backends: []kongstate.ServiceBackend{serviceBackend},
+						Routes:   []kongstate.Route{{Route: kong.Route{Name: kong.String("old")}}},
+					}},
+				},
+				{
+					ServiceNameToServices: map[string]kongstate.Service{"svc-name": {
+						Backends: []kongstate.ServiceBackend{serviceBackend},
+						Routes:   []kongstate.Route{{Route: kong.Route{Name: kong.String("new")}}},
+					}},
+				},
+			},
+			wantOutput: &ingressRules{
+				SecretNameToSNIs: newSecretNameToSNIs(),
+				ServiceNameToServices: map[string]kongstate.Service{"svc-name": {
+					Backends: []kongstate.ServiceBackend{serviceBackend},
+					Routes:   []kongstate.Route{{Route: kong.Route{Name: kong.String("new")}}},
+				}},
+				ServiceNameToParent: map[string]client.Object{},
+			},
+		},
 	} {
 		t.Run(tt.name, func(t *testing.T) {
 			gotOutput := mergeIngressRules(tt.inputs...)
@@ -1,8 +1,11 @@
 package subtranslator
 
 import (
+	"fmt"
+	"strconv"
 	"strings"
 
+	"github.com/kong/kubernetes-ingress-controller/v3/internal/annotations"
 	"github.com/kong/kubernetes-ingress-controller/v3/internal/dataplane/translator/atc"
 )
 
@@ -26,6 +29,39 @@ type (
 	RoutePriorityType = uint64
 )
 
+// MaxUserRoutePriority is the maximum value of the konghq.com/route-priority annotation.
+const MaxUserRoutePriority = 1023
+
+// UserRoutePriority returns the priority set by the konghq.com/route-priority annotation, which lets users order
+// expression routes that would be given the same precedence by their matches. Invalid values are ignored.
+func UserRoutePriority(anns map[string]string) int {
+	priority, err := parseUserRoutePriority(anns)
+	if err != nil {
+		return 0
+	}
+	return priority
+}
+
+// ValidateUserRoutePriority returns an error when the konghq.com/route-priority annotation is set to a value that is
+// not an integer in [0, MaxUserRoutePriority].
+func ValidateUserRoutePriority(anns map[string]string) error {
+	_, err := parseUserRoutePriority(anns)
+	return err
+}
+
+func parseUserRoutePriority(anns map[string]string) (int, error) {
+	value := annotations.ExtractRoutePriority(anns)
+	if value == "" {
+		return 0, nil
+	}
+	priority, err := strconv.Atoi(value)
+	if err != nil || priority < 0 || priority > MaxUserRoutePriority {
+		return 0, fmt.Errorf("invalid %s%s annotation %q: must be an integer between 0 and %d",
+			annotations.AnnotationPrefix, annotations.RoutePriorityKey, value, MaxUserRoutePriority)
+	}
+	return priority, nil
+}
+
 const (
 	// CatchAllHTTPExpression is the expression to match all HTTP/HTTPS requests.
 	// For rules with no matches and no hostnames in its parent HTTPRoute or GRPCRoute,
@@ -42,3 +42,56 @@ func TestHostMatcherFromHosts(t *testing.T) {
 		})
 	}
 }
+
+func TestUserRoutePriority(t *testing.T) {
+	testCases := []struct {
+		name            string
+		value           string
+		expected        int
+		expectedInvalid bool
+	}{
+		{
+			name:     "not set",
+			expected: 0,
+		},
+		{
+			name:     "valid priority",
+			value:    "10",
+			expected: 10,
+		},
+		{
+			name:            "not an integer",
+			value:           "high",
+			expected:        0,
+			expectedInvalid: true,
+		},
+		{
+			name:            "negative priority",
+			value:           "-1",
+			expected:        0,
+			expectedInvalid: true,
+		},
+		{
+			name:            "priority exceeding limit",
+			value:           "5000",
+			expected:        0,
+			expectedInvalid: true,
+		},
+	}
+
+	for _, tc := range testCases {
+		tc := tc
+		t.Run(tc.name, func(t *testing.T) {
+			anns := map[string]string{}
+			if tc.value != "" {
+				anns["konghq.com/route-priority"] = tc.value
+			}
+			require.Equal(t, tc.expected, UserRoutePriority(anns))
+			if tc.expectedInvalid {
+				require.Error(t, ValidateUserRoutePriority(anns))
+			} else {
+				require.NoError(t, ValidateUserRoutePriority(anns))
+			}
+		})
+	}
+}
@@ -2,6 +2,7 @@ package subtranslator
 
 import (
 	"fmt"
+	"maps"
 
 	"github.com/kong/go-kong/kong"
 	"github.com/samber/lo"
@@ -33,6 +34,94 @@ func getGRPCMatchDefaults() (
 		}
 }
 
+// ValidateGRPCRoute checks whether the matches of the provided GRPCRoute can be translated
+// to Kong routes with the router flavor determined by expressionRoutes, and whether its filters
+// can be translated to Kong plugins.
+func ValidateGRPCRoute(grpcroute *gatewayapi.GRPCRoute, expressionRoutes bool) error {
+	for ruleIndex, rule := range grpcroute.Spec.Rules {
+		for filterIndex, filter := range rule.Filters {
+			httpFilter, err := httpRouteFilterFromGRPCRouteFilter(filter)
+			if err == nil && httpFilter.Type == gatewayapi.HTTPRouteFilterExtensionRef {
+				_, err = generateExtensionRefKongPlugin(httpFilter.ExtensionRef)
+			}
+			if err != nil {
+				return fmt.Errorf("rules[%d].filters[%d]: %w", ruleIndex, filterIndex, err)
+			}
+		}
+		for matchIndex, match := range rule.Matches {
+			if match.Method != nil && match.Method.Service == nil && match.Method.Method == nil {
+				return fmt.Errorf("rules[%d].matches[%d]: %w",
+					ruleIndex, matchIndex, ErrRouteValidationGRPCMethodMatchEmpty)
+			}
+			// The traditional router matches header values literally, so a regular expression
+			// would be silently treated as an exact match.
+			if expressionRoutes {
+				continue
+			}
+			for headerIndex, header := range match.Headers {
+				if header.Type != nil && *header.Type == gatewayapi.HeaderMatchRegularExpression {
+					return fmt.Errorf("rules[%d].matches[%d].headers[%d]: %w",
+						ruleIndex, matchIndex, headerIndex, ErrRouteValidationGRPCRegexHeaderMatchNotSupported)
+				}
+			}
+		}
+	}
+	return nil
+}
+
+// SetGRPCRoutePlugins converts GRPCRouteFilters into Kong plugins set into the given kongstate.Route.
+// The filters are translated the same way as the corresponding HTTPRouteFilters.
+func SetGRPCRoutePlugins(route *kongstate.Route, filters []gatewayapi.GRPCRouteFilter, tags []*string) error {
+	if len(filters) == 0 {
+		return nil
+	}
+	httpFilters := make([]gatewayapi.HTTPRouteFilter, 0, len(filters))
+	for _, filter := range filters {
+		httpFilter, err := httpRouteFilterFromGRPCRouteFilter(filter)
+		if err != nil {
+			return err
+		}
+		httpFilters = append(httpFilters, httpFilter)
+	}
+	// Routes generated from the matches of the same rule share their annotations, so they're cloned
+	// to not add the plugins referenced by ExtensionRef filters to the annotations more than once.
+	route.Ingress.Annotations = maps.Clone(route.Ingress.Annotations)
+	// Neither of the supported filters depends on the path or the router flavor.
+	return SetRoutePlugins(route, httpFilters, "", tags, false)
+}
+
+// httpRouteFilterFromGRPCRouteFilter returns the HTTPRouteFilter equivalent to the GRPCRouteFilter.
+// RequestMirror filters are not supported, as mirroring is implemented only for HTTP requests.
+func httpRouteFilterFromGRPCRouteFilter(filter gatewayapi.GRPCRouteFilter) (gatewayapi.HTTPRouteFilter, error) {
+	var httpFilter gatewayapi.HTTPRouteFilter
+	switch filter.Type {
+	case gatewayapi.GRPCRouteFilterRequestHeaderModifier:
+		httpFilter = gatewayapi.HTTPRouteFilter{
+			Type:                  gatewayapi.HTTPRouteFilterRequestHeaderModifier,
+			RequestHeaderModifier: filter.RequestHeaderModifier,
+		}
+		if filter.RequestHeaderModifier == nil {
+			return httpFilter, fmt.Errorf("%s filter is missing its configuration", filter.Type)
+		}
+	case gatewayapi.GRPCRouteFilterResponseHeaderModifier:
+		httpFilter = gatewayapi.HTTPRouteFilter{
+			Type:                   gatewayapi.HTTPRouteFilterResponseHeaderModifier,
+			ResponseHeaderModifier: filter.ResponseHeaderModifier,
+		}
+		if filter.ResponseHeaderModifier == nil {
+			return httpFilter, fmt.Errorf("%s filter is missing its configuration", filter.Type)
+		}
+	case gatewayapi.GRPCRouteFilterExtensionRef:
+		httpFilter = gatewayapi.HTTPRouteFilter{
+			Type:         gatewayapi.HTTPRouteFilterExtensionRef,
+			ExtensionRef: filter.ExtensionRef,
+		}
+	default:
+		return httpFilter, fmt.Errorf("%w: %s", ErrRouteValidationGRPCFilterNotSupported, filter.Type)
+	}
+	return httpFilter, nil
+}
+
 func GenerateKongRoutesFromGRPCRouteRule(
 	grpcroute *gatewayapi.GRPCRoute,
 	ruleNumber int,
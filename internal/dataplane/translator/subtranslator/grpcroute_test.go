@@ -330,3 +330,159 @@ func TestGetGRPCRouteHostnamesAsSliceOfStringPointers(t *testing.T) {
 		})
 	}
 }
+
+func TestValidateGRPCRoute(t *testing.T) {
+	testCases := []struct {
+		name             string
+		rule             gatewayapi.GRPCRouteRule
+		expressionRoutes bool
+		expectedErr      error
+	}{
+		{
+			name: "exact header and method matches are valid",
+			rule: gatewayapi.GRPCRouteRule{
+				Matches: []gatewayapi.GRPCRouteMatch{
+					{
+						Method: &gatewayapi.GRPCMethodMatch{
+							Service: lo.ToPtr("service0"),
+						},
+						Headers: []gatewayapi.GRPCHeaderMatch{
+							{Name: "x-foo", Value: "bar"},
+						},
+					},
+				},
+			},
+		},
+		{
+			name: "method match without service and method is rejected",
+			rule: gatewayapi.GRPCRouteRule{
+				Matches: []gatewayapi.GRPCRouteMatch{
+					{
+						Method: &gatewayapi.GRPCMethodMatch{
+							Type: lo.ToPtr(gatewayapi.GRPCMethodMatchRegularExpression),
+						},
+					},
+				},
+			},
+			expressionRoutes: true,
+			expectedErr:      ErrRouteValidationGRPCMethodMatchEmpty,
+		},
+		{
+			name: "regular expression header match is rejected with traditional routes",
+			rule: gatewayapi.GRPCRouteRule{
+				Matches: []gatewayapi.GRPCRouteMatch{
+					{
+						Headers: []gatewayapi.GRPCHeaderMatch{
+							{Type: lo.ToPtr(gatewayapi.HeaderMatchRegularExpression), Name: "x-foo", Value: "ba.*"},
+						},
+					},
+				},
+			},
+			expectedErr: ErrRouteValidationGRPCRegexHeaderMatchNotSupported,
+		},
+		{
+			name: "regular expression header match is valid with expression routes",
+			rule: gatewayapi.GRPCRouteRule{
+				Matches: []gatewayapi.GRPCRouteMatch{
+					{
+						Headers: []gatewayapi.GRPCHeaderMatch{
+							{Type: lo.ToPtr(gatewayapi.HeaderMatchRegularExpression), Name: "x-foo", Value: "ba.*"},
+						},
+					},
+				},
+			},
+			expressionRoutes: true,
+		},
+		{
+			name: "header modifier and ExtensionRef filters are valid",
+			rule: gatewayapi.GRPCRouteRule{
+				Filters: []gatewayapi.GRPCRouteFilter{
+					{
+						Type: gatewayapi.GRPCRouteFilterRequestHeaderModifier,
+						RequestHeaderModifier: &gatewayapi.HTTPHeaderFilter{
+							Set: []gatewayapi.HTTPHeader{{Name: "x-foo", Value: "bar"}},
+						},
+					},
+					{
+						Type: gatewayapi.GRPCRouteFilterExtensionRef,
+						ExtensionRef: &gatewayapi.LocalObjectReference{
+							Group: "configuration.konghq.com",
+							Kind:  "KongClusterPlugin",
+							Name:  "auth",
+						},
+					},
+				},
+			},
+		},
+		{
+			name: "RequestMirror filter is rejected",
+			rule: gatewayapi.GRPCRouteRule{
+				Filters: []gatewayapi.GRPCRouteFilter{
+					{
+						Type: gatewayapi.GRPCRouteFilterRequestMirror,
+						RequestMirror: &gatewayapi.HTTPRequestMirrorFilter{
+							BackendRef: gatewayapi.BackendObjectReference{Name: "mirror", Port: lo.ToPtr(gatewayapi.PortNumber(80))},
+						},
+					},
+				},
+			},
+			expectedErr: ErrRouteValidationGRPCFilterNotSupported,
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			grpcroute := makeTestGRPCRoute("grpcroute", "default", nil, nil, []gatewayapi.GRPCRouteRule{tc.rule})
+			err := ValidateGRPCRoute(grpcroute, tc.expressionRoutes)
+			if tc.expectedErr != nil {
+				require.ErrorIs(t, err, tc.expectedErr)
+				return
+			}
+			require.NoError(t, err)
+		})
+	}
+}
+
+func TestSetGRPCRoutePlugins(t *testing.T) {
+	grpcroute := makeTestGRPCRoute("grpcroute", "default", map[string]string{
+		"konghq.com/plugins": "rate-limit",
+	}, nil, []gatewayapi.GRPCRouteRule{
+		{
+			Matches: []gatewayapi.GRPCRouteMatch{
+				{Method: &gatewayapi.GRPCMethodMatch{Service: lo.ToPtr("service0")}},
+				{Method: &gatewayapi.GRPCMethodMatch{Service: lo.ToPtr("service1")}},
+			},
+			Filters: []gatewayapi.GRPCRouteFilter{
+				{
+					Type: gatewayapi.GRPCRouteFilterResponseHeaderModifier,
+					ResponseHeaderModifier: &gatewayapi.HTTPHeaderFilter{
+						Add: []gatewayapi.HTTPHeader{{Name: "x-foo", Value: "bar"}},
+					},
+				},
+				{
+					Type: gatewayapi.GRPCRouteFilterExtensionRef,
+					ExtensionRef: &gatewayapi.LocalObjectReference{
+						Group: "configuration.konghq.com",
+						Kind:  "KongPlugin",
+						Name:  "auth",
+					},
+				},
+			},
+		},
+	})
+
+	routes := GenerateKongRoutesFromGRPCRouteRule(grpcroute, 0)
+	require.Len(t, routes, 2)
+	for i := range routes {
+		require.NoError(t, SetGRPCRoutePlugins(&routes[i], grpcroute.Spec.Rules[0].Filters, routes[i].Tags))
+	}
+	for _, route := range routes {
+		require.Equal(t, "rate-limit,auth", route.Ingress.Annotations["konghq.com/plugins"])
+		require.Len(t, route.Plugins, 1)
+		require.Equal(t, "response-transformer", *route.Plugins[0].Name)
+		require.Equal(t, kong.Configuration{
+			"append": TransformerPluginConfig{Headers: []string{"x-foo:bar"}},
+		}, route.Plugins[0].Config)
+	}
+	require.Equal(t, "rate-limit", grpcroute.Annotations["konghq.com/plugins"], "GRPCRoute annotations must not be modified")
+}
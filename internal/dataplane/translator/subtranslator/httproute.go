@@ -16,15 +16,17 @@ import (
 	"github.com/kong/kubernetes-ingress-controller/v3/internal/dataplane/kongstate"
 	"github.com/kong/kubernetes-ingress-controller/v3/internal/dataplane/translator/atc"
 	"github.com/kong/kubernetes-ingress-controller/v3/internal/gatewayapi"
+	"github.com/kong/kubernetes-ingress-controller/v3/internal/util"
 )
 
 // KongServiceTranslation is a translation of a single HTTPRoute into metadata
 // that can be used to instantiate Kong routes and services.
 // Routes from this object should route traffic to BackendRefs from this object.
 type KongServiceTranslation struct {
-	Name        string
-	BackendRefs []gatewayapi.HTTPBackendRef
-	KongRoutes  []KongRouteTranslation
+	Name               string
+	BackendRefs        []gatewayapi.HTTPBackendRef
+	SessionPersistence *gatewayapi.SessionPersistence
+	KongRoutes         []KongRouteTranslation
 }
 
 // KongRouteTranslation is a translation of a single HTTPRoute rule into metadata
@@ -39,7 +41,7 @@ type KongRouteTranslation struct {
 // objects that can be used to instantiate Kong routes and services.
 // The translation is done by grouping the HTTPRoutes by their backendRefs.
 // This means that all the rules of a single HTTPRoute will be grouped together
-// if they share the same backendRefs.
+// if they share the same backendRefs and session persistence configuration.
 func TranslateHTTPRoute(route *gatewayapi.HTTPRoute) []*KongServiceTranslation {
 	index := httpRouteTranslationIndex{}
 	index.setRoute(route)
@@ -88,9 +90,10 @@ func (i *httpRouteTranslationIndex) translate() []*KongServiceTranslation {
 
 func (i *httpRouteTranslationIndex) translateToKongService(rulesMeta []httpRouteRuleMeta) *KongServiceTranslation {
 	return &KongServiceTranslation{
-		Name:        i.translateToKongServiceName(rulesMeta),
-		BackendRefs: i.translateToKongServiceBackends(rulesMeta),
-		KongRoutes:  nil,
+		Name:               i.translateToKongServiceName(rulesMeta),
+		BackendRefs:        i.translateToKongServiceBackends(rulesMeta),
+		SessionPersistence: i.translateToKongServiceSessionPersistence(rulesMeta),
+		KongRoutes:         nil,
 	}
 }
 
@@ -119,6 +122,15 @@ func (i *httpRouteTranslationIndex) translateToKongServiceBackends(rulesMeta []h
 	return rulesMeta[0].Rule.BackendRefs
 }
 
+func (i *httpRouteTranslationIndex) translateToKongServiceSessionPersistence(rulesMeta []httpRouteRuleMeta) *gatewayapi.SessionPersistence {
+	if len(rulesMeta) == 0 {
+		return nil
+	}
+	// get the session persistence from any rule, as they are all the same,
+	// because the rules are processed in groups with the same backendRefs and session persistence.
+	return rulesMeta[0].Rule.SessionPersistence
+}
+
 func (i *httpRouteTranslationIndex) translateToKongServiceRoutes(s *KongServiceTranslation, rulesMeta []httpRouteRuleMeta) {
 	for _, rulesByFilter := range groupRulesByFilter(rulesMeta) {
 		// each filter group must be a separate Kong route, not eligible for consolidation
@@ -194,11 +206,14 @@ func (i *httpRouteTranslationIndex) translateToKongRouteName(matchesMeta httpRou
 	)
 }
 
-// groupRulesByBackendRefs groups the rules by their backendRefs.
+// groupRulesByBackendRefs groups the rules by their backendRefs and session persistence configuration,
+// as the latter is configured on the Kong upstream shared by all the rules routing to the same Kong service.
 // The backendRefs are grouped by their key function.
 // The elements in the groups have the order of the original slice, but the groups themselves are not ordered.
 func groupRulesByBackendRefs(ruleEntries []httpRouteRuleMeta) map[string][]httpRouteRuleMeta {
-	return groupSliceByKeyFn(ruleEntries, httpRouteRuleMeta.getHTTPBackendRefsKey)
+	return groupSliceByKeyFn(ruleEntries, func(m httpRouteRuleMeta) string {
+		return m.getHTTPBackendRefsKey() + m.getSessionPersistenceKey()
+	})
 }
 
 // groupRulesByFilter groups the rules by their filters.
@@ -241,6 +256,14 @@ func (m httpRouteRuleMeta) getHTTPBackendRefsKey() string {
 	return getSortedItemsString(m.Rule.BackendRefs)
 }
 
+// getSessionPersistenceKey computes a key from the session persistence configuration.
+func (m httpRouteRuleMeta) getSessionPersistenceKey() string {
+	if m.Rule.SessionPersistence == nil {
+		return ""
+	}
+	return mustMarshalJSON(m.Rule.SessionPersistence)
+}
+
 func (m *httpRouteRuleMeta) matches() httpRouteMatchMetaList {
 	matches := make([]httpRouteMatchMeta, 0, len(m.Rule.Matches))
 
@@ -369,7 +392,7 @@ func SetRoutePlugins(
 	// TODO: https://github.com/Kong/kubernetes-ingress-controller/issues/3686
 	expressionsRouterEnabled bool,
 ) error {
-	generatedPlugins, err := generatePluginsFromHTTPRouteFilters(filters, path, tags, expressionsRouterEnabled)
+	generatedPlugins, err := generatePluginsFromHTTPRouteFilters(filters, path, route.Ingress.Namespace, tags, expressionsRouterEnabled)
 	if err != nil {
 		return err
 	}
@@ -400,6 +423,7 @@ type kongRouteModifier func(*kongstate.Route)
 
 // generatePluginsFromHTTPRouteFilters converts HTTPRouteFilter into Kong plugins.
 // path is the parameter to be used by the redirect plugin, to perform redirection.
+// namespace is the namespace of the HTTPRoute, used for RequestMirror backends that do not specify one.
 // It returns httpRouteFiltersOriginatedPlugins which contains:
 // - generated plugins
 // - Kong Route modifiers that need to be applied to the Kong Route
@@ -407,6 +431,7 @@ type kongRouteModifier func(*kongstate.Route)
 func generatePluginsFromHTTPRouteFilters(
 	filters []gatewayapi.HTTPRouteFilter,
 	path string,
+	namespace string,
 	tags []*string,
 	expressionsRouterEnabled bool,
 ) (httpRouteFiltersOriginatedPlugins, error) {
@@ -418,6 +443,7 @@ func generatePluginsFromHTTPRouteFilters(
 		kongPlugins                 []kong.Plugin
 		pluginNamesFromExtensionRef []string
 		kongRouteModifiers          []kongRouteModifier
+		mirrorURLs                  []string
 	)
 
 	for _, filter := range filters {
@@ -449,8 +475,11 @@ func generatePluginsFromHTTPRouteFilters(
 			kongRouteModifiers = append(kongRouteModifiers, routeModifiers...)
 
 		case gatewayapi.HTTPRouteFilterRequestMirror:
-			// not supported
-			return httpRouteFiltersOriginatedPlugins{}, fmt.Errorf("httpFilter %s unsupported", filter.Type)
+			mirrorURL, err := requestMirrorURL(filter.RequestMirror, namespace)
+			if err != nil {
+				return httpRouteFiltersOriginatedPlugins{}, err
+			}
+			mirrorURLs = append(mirrorURLs, mirrorURL)
 		}
 	}
 
@@ -461,6 +490,10 @@ func generatePluginsFromHTTPRouteFilters(
 		return httpRouteFiltersOriginatedPlugins{}, fmt.Errorf("failed to merge transformerPlugins of the same type: %w", err)
 	}
 	kongPlugins = append(kongPlugins, transformerPluginsToKongPlugins(transformerPlugins)...)
+	if len(mirrorURLs) > 0 {
+		// All mirrors of a rule are handled by a single plugin as a plugin can be configured only once per route.
+		kongPlugins = append(kongPlugins, generateRequestMirrorKongPlugin(mirrorURLs))
+	}
 
 	for _, p := range kongPlugins {
 		// This plugin is derived from an HTTPRoute filter, not a KongPlugin, so we apply tags indicating that
@@ -551,6 +584,76 @@ func generateKongRouteModifierFromExtensionRef(pluginNamesFromExtensionRef []str
 	}
 }
 
+// requestMirrorURL returns the URL of the Kubernetes Service the RequestMirror filter mirrors requests to.
+// The Service is addressed by its cluster DNS name so that the mirrored requests are load balanced by Kubernetes.
+// Backends that do not serve plain HTTP are dropped by the translator before the HTTPRoute gets here.
+func requestMirrorURL(filter *gatewayapi.HTTPRequestMirrorFilter, namespace string) (string, error) {
+	if filter == nil {
+		return "", fmt.Errorf("%s filter is missing its configuration", gatewayapi.HTTPRouteFilterRequestMirror)
+	}
+	ref := filter.BackendRef
+	if !util.IsBackendRefGroupKindSupported(ref.Group, ref.Kind) {
+		return "", fmt.Errorf("%s backendRef %s is not a supported kind, only Service is supported",
+			gatewayapi.HTTPRouteFilterRequestMirror, ref.Name)
+	}
+	if ref.Port == nil {
+		return "", fmt.Errorf("%s backendRef %s is missing a port", gatewayapi.HTTPRouteFilterRequestMirror, ref.Name)
+	}
+	if ref.Namespace != nil && *ref.Namespace != "" {
+		namespace = string(*ref.Namespace)
+	}
+	return fmt.Sprintf("http://%s.%s.svc:%d", ref.Name, namespace, *ref.Port), nil
+}
+
+// requestMirrorLuaTemplate is the Lua code of the post-function plugin mirroring requests. It's executed in the access
+// phase after all other plugins, so only requests accepted by Kong (e.g. authenticated) are mirrored. Mirrored
+// requests are sent asynchronously and their responses are discarded, so they do not affect the proxied request.
+// Request bodies are read only when requests have one. Bodies that were buffered to disk by NGINX are not mirrored.
+// If Kong Gateway does not allow requiring resty.http, the error is logged and requests are proxied without mirroring.
+const requestMirrorLuaTemplate = `local mirror_urls = { %s }
+local ok, http = pcall(require, "resty.http")
+if not ok then
+  kong.log.err("failed to load resty.http, requests are not mirrored: ", http)
+  return
+end
+local method = ngx.req.get_method()
+local uri = ngx.var.request_uri
+local headers = ngx.req.get_headers()
+local body
+if ngx.var.http_content_length or ngx.var.http_transfer_encoding then
+  ngx.req.read_body()
+  body = ngx.req.get_body_data()
+end
+for _, mirror_url in ipairs(mirror_urls) do
+  ngx.timer.at(0, function(premature)
+    if premature then
+      return
+    end
+    local httpc = http.new()
+    httpc:set_timeout(60000)
+    local _, err = httpc:request_uri(mirror_url .. uri, { method = method, headers = headers, body = body })
+    if err then
+      kong.log.warn("failed to mirror request to ", mirror_url, ": ", err)
+    end
+  end)
+end`
+
+// RequestMirrorPluginName is the name of the plugin generated for RequestMirror filters.
+const RequestMirrorPluginName = "post-function"
+
+// generateRequestMirrorKongPlugin generates a post-function plugin mirroring requests to the given URLs.
+// Please note that Kong Gateway has to allow the plugin to require the resty.http module
+// (e.g. with untrusted_lua_sandbox_requires=resty.http).
+func generateRequestMirrorKongPlugin(mirrorURLs []string) kong.Plugin {
+	quoted := lo.Map(mirrorURLs, func(u string, _ int) string { return fmt.Sprintf("%q", u) })
+	return kong.Plugin{
+		Name: kong.String(RequestMirrorPluginName),
+		Config: kong.Configuration{
+			"access": []string{fmt.Sprintf(requestMirrorLuaTemplate, strings.Join(quoted, ", "))},
+		},
+	}
+}
+
 // generateRequestRedirectKongPlugin generates configurations of plugins to satisfy the specification
 // of request redirect filter.
 func generateRequestRedirectKongPlugin(modifier *gatewayapi.HTTPRequestRedirectFilter, path string) (kong.Plugin, transformerPlugin) {
@@ -593,8 +696,13 @@ func generateRequestRedirectKongPlugin(modifier *gatewayapi.HTTPRequestRedirectF
 	return requestTerminationPlugin, transformerPlugin
 }
 
+// generateExtensionRefKongPlugin returns the name of the KongPlugin or KongClusterPlugin referenced by the filter.
+// Plugins are attached to routes by their names, like with the konghq.com/plugins annotation.
 func generateExtensionRefKongPlugin(modifier *gatewayapi.LocalObjectReference) (string, error) {
-	if modifier.Group != "configuration.konghq.com" || modifier.Kind != "KongPlugin" {
+	if modifier == nil {
+		return "", fmt.Errorf("%s filter is missing its configuration", gatewayapi.HTTPRouteFilterExtensionRef)
+	}
+	if modifier.Group != "configuration.konghq.com" || (modifier.Kind != "KongPlugin" && modifier.Kind != "KongClusterPlugin") {
 		return "", fmt.Errorf("plugin %s/%s unsupported", modifier.Group, modifier.Kind)
 	}
 	return string(modifier.Name), nil
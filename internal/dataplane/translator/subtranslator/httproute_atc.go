@@ -395,7 +395,8 @@ func CalculateHTTPRouteMatchPriorityTraits(match SplitHTTPRouteMatch) HTTPRouteP
 // M: set to 1 if Method match is specified.
 // Header No.: number of header matches.
 // Query No.: number of query parameter matches.
-// relative order: relative order of creation timestamp, namespace and name and internal rule/match order between different (split) HTTPRoutes.
+// relative order: relative order of user priority, creation timestamp, namespace and name and internal rule/match order
+// between different (split) HTTPRoutes.
 func (t HTTPRoutePriorityTraits) EncodeToPriority() RoutePriorityType {
 	const (
 		// PreciseHostnameShiftBits assigns bit 43 for marking if the hostname is non-wildcard.
@@ -414,7 +415,7 @@ func (t HTTPRoutePriorityTraits) EncodeToPriority() RoutePriorityType {
 		HeaderNumberShiftBits = 17
 		// QueryParamNumberShiftBits makes bits 12-16 used for number of query params (max number of query params = 16)
 		QueryParamNumberShiftBits = 12
-		// bits 0-11 are used for relative order of creation timestamp, namespace/name, and internal order of rules and matches.
+		// bits 0-11 are used for relative order of user priority, creation timestamp, namespace/name, and internal order of rules and matches.
 		// the bits are calculated by sorting HTTPRoutes with the same priority calculated from the fields above
 		// and start from all 1s, then decrease by one for each HTTPRoute.
 	)
@@ -454,6 +455,8 @@ func (t HTTPRoutePriorityTraits) EncodeToPriority() RoutePriorityType {
 // If ties exists in the first step, where multiple matches has the same priority
 // calculated from the fields, we run a sort for the matches in the tie
 // and assign the bits for "relative order" according to the sorting result of these matches.
+// The priority set by the konghq.com/route-priority annotation only takes part in this sort, so that it orders
+// matches having the same precedence according to Gateway API without changing the precedence of other matches.
 func AssignRoutePriorityToSplitHTTPRouteMatches(
 	logger logr.Logger,
 	splitHTTPRouteMatches []SplitHTTPRouteMatch,
@@ -512,6 +515,10 @@ func AssignRoutePriorityToSplitHTTPRouteMatches(
 func compareSplitHTTPRouteMatchesRelativePriority(match1, match2 SplitHTTPRouteMatch) bool {
 	route1 := match1.Source
 	route2 := match2.Source
+	// compare by priority set by users.
+	if priority1, priority2 := UserRoutePriority(route1.Annotations), UserRoutePriority(route2.Annotations); priority1 != priority2 {
+		return priority1 > priority2
+	}
 	// compare by creation timestamp.
 	if !route1.CreationTimestamp.Equal(&route2.CreationTimestamp) {
 		return route1.CreationTimestamp.Before(&route2.CreationTimestamp)
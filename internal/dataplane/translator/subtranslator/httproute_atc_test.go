@@ -891,6 +891,82 @@ func TestAssignRoutePriorityToSplitHTTPRouteMatches(t *testing.T) {
 				}.EncodeToPriority() + maxRelativeOrderPriorityBits - 1,
 			},
 		},
+		{
+			name: "break tie by route priority annotation before creation timestamp",
+			matches: []SplitHTTPRouteMatch{
+				{
+					Source: &gatewayapi.HTTPRoute{
+						ObjectMeta: metav1.ObjectMeta{
+							Namespace:         "default",
+							Name:              "httproute-1",
+							CreationTimestamp: metav1.NewTime(now.Add(-5 * time.Second)),
+						},
+						Spec: gatewayapi.HTTPRouteSpec{
+							Hostnames: []gatewayapi.Hostname{"foo.com"},
+							Rules: []gatewayapi.HTTPRouteRule{
+								{
+									Matches: builder.NewHTTPRouteMatch().WithPathExact("/foo").ToSlice(),
+								},
+							},
+						},
+					},
+					Hostname:   "foo.com",
+					Match:      builder.NewHTTPRouteMatch().WithPathExact("/foo").Build(),
+					RuleIndex:  0,
+					MatchIndex: 0,
+				},
+				{
+					Source: &gatewayapi.HTTPRoute{
+						ObjectMeta: metav1.ObjectMeta{
+							Namespace:         "default",
+							Name:              "httproute-2",
+							CreationTimestamp: metav1.NewTime(now.Add(-1 * time.Second)),
+							Annotations: map[string]string{
+								"konghq.com/route-priority": "10",
+							},
+						},
+						Spec: gatewayapi.HTTPRouteSpec{
+							Hostnames: []gatewayapi.Hostname{"bar.com"},
+							Rules: []gatewayapi.HTTPRouteRule{
+								{
+									Matches: builder.NewHTTPRouteMatch().WithPathExact("/foo").ToSlice(),
+								},
+							},
+						},
+					},
+					Hostname:   "bar.com",
+					Match:      builder.NewHTTPRouteMatch().WithPathExact("/foo").Build(),
+					RuleIndex:  0,
+					MatchIndex: 0,
+				},
+			},
+			priorities: map[splitHTTPRouteIndex]RoutePriorityType{
+				{
+					namespace:  "default",
+					name:       "httproute-1",
+					hostname:   "foo.com",
+					ruleIndex:  0,
+					matchIndex: 0,
+				}: HTTPRoutePriorityTraits{
+					PreciseHostname: true,
+					HostnameLength:  len("foo.com"),
+					PathType:        gatewayapi.PathMatchExact,
+					PathLength:      len("/foo"),
+				}.EncodeToPriority() + maxRelativeOrderPriorityBits - 1,
+				{
+					namespace:  "default",
+					name:       "httproute-2",
+					hostname:   "bar.com",
+					ruleIndex:  0,
+					matchIndex: 0,
+				}: HTTPRoutePriorityTraits{
+					PreciseHostname: true,
+					HostnameLength:  len("bar.com"),
+					PathType:        gatewayapi.PathMatchExact,
+					PathLength:      len("/foo"),
+				}.EncodeToPriority() + maxRelativeOrderPriorityBits,
+			},
+		},
 		{
 			name: "break tie by namespace and name",
 			matches: []SplitHTTPRouteMatch{
@@ -2,6 +2,7 @@ package subtranslator
 
 import (
 	"errors"
+	"fmt"
 	"testing"
 
 	"github.com/kong/go-kong/kong"
@@ -215,6 +216,56 @@ func TestGeneratePluginsFromHTTPRouteFilters(t *testing.T) {
 			},
 			expectedErr: errors.New("plugin configuration.konghq.com/WrongKind unsupported"),
 		},
+		{
+			name: "request mirror filters",
+			filters: []gatewayapi.HTTPRouteFilter{
+				{
+					Type: gatewayapi.HTTPRouteFilterRequestMirror,
+					RequestMirror: &gatewayapi.HTTPRequestMirrorFilter{
+						BackendRef: gatewayapi.BackendObjectReference{
+							Kind: util.StringToGatewayAPIKindPtr("Service"),
+							Name: "mirror",
+							Port: lo.ToPtr(gatewayapi.PortNumber(8080)),
+						},
+					},
+				},
+				{
+					Type: gatewayapi.HTTPRouteFilterRequestMirror,
+					RequestMirror: &gatewayapi.HTTPRequestMirrorFilter{
+						BackendRef: gatewayapi.BackendObjectReference{
+							Kind:      util.StringToGatewayAPIKindPtr("Service"),
+							Name:      "other-mirror",
+							Namespace: lo.ToPtr(gatewayapi.Namespace("other")),
+							Port:      lo.ToPtr(gatewayapi.PortNumber(80)),
+						},
+					},
+				},
+			},
+			expectedPlugins: []kong.Plugin{
+				{
+					Name: kong.String("post-function"),
+					Config: kong.Configuration{
+						"access": []string{fmt.Sprintf(requestMirrorLuaTemplate,
+							`"http://mirror.default.svc:8080", "http://other-mirror.other.svc:80"`)},
+					},
+				},
+			},
+		},
+		{
+			name: "request mirror filter without port",
+			filters: []gatewayapi.HTTPRouteFilter{
+				{
+					Type: gatewayapi.HTTPRouteFilterRequestMirror,
+					RequestMirror: &gatewayapi.HTTPRequestMirrorFilter{
+						BackendRef: gatewayapi.BackendObjectReference{
+							Kind: util.StringToGatewayAPIKindPtr("Service"),
+							Name: "mirror",
+						},
+					},
+				},
+			},
+			expectedErr: errors.New("RequestMirror backendRef mirror is missing a port"),
+		},
 		{
 			name: "RequestHeaderModifier and PrefixMatchHTTPPathModifier",
 			filters: []gatewayapi.HTTPRouteFilter{
@@ -272,7 +323,7 @@ func TestGeneratePluginsFromHTTPRouteFilters(t *testing.T) {
 	for _, tc := range testCases {
 		tc := tc
 		t.Run(tc.name, func(t *testing.T) {
-			result, err := generatePluginsFromHTTPRouteFilters(tc.filters, tc.path, nil, false)
+			result, err := generatePluginsFromHTTPRouteFilters(tc.filters, tc.path, "default", nil, false)
 			require.Equal(t, tc.expectedErr, err)
 			require.Equal(t, tc.expectedPlugins, result.Plugins)
 
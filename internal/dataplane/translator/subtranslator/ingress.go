@@ -345,7 +345,7 @@ func (m *ingressTranslationMeta) translateIntoKongStateService(
 			Namespace: m.parentIngress.GetNamespace(),
 			Service: kong.Service{
 				Name:           kong.String(kongServiceName),
-				Host:           kong.String(fmt.Sprintf("%s.%s.svc.facade", m.parentIngress.GetNamespace(), m.backend.name)),
+				Host:           kong.String(KongServiceNameForKongServiceFacade(m.parentIngress.GetNamespace(), m.backend.name)),
 				Port:           kong.Int(defaultHTTPPort),
 				Protocol:       kong.String("http"),
 				Path:           kong.String("/"),
@@ -392,8 +392,7 @@ func (m *ingressTranslationMeta) translateIntoKongStateService(
 func (m *ingressTranslationMeta) generateKongServiceName() string {
 	if m.backend.isServiceFacade() {
 		// For KongServiceFacade we create one Kong Service per KongServiceFacade.
-		// The naming pattern is `<facade-namespace>.<facade-name>.svc.facade`.
-		return fmt.Sprintf("%s.%s.svc.facade", m.parentIngress.GetNamespace(), m.backend.name)
+		return KongServiceNameForKongServiceFacade(m.parentIngress.GetNamespace(), m.backend.name)
 	}
 
 	// For Kubernetes Services, we create one Kong Service per Kubernetes Service + port combination.
@@ -406,6 +405,12 @@ func (m *ingressTranslationMeta) generateKongServiceName() string {
 	)
 }
 
+// KongServiceNameForKongServiceFacade returns the name of the Kong Service created for a KongServiceFacade.
+// The naming pattern is `<facade-namespace>.<facade-name>.svc.facade`.
+func KongServiceNameForKongServiceFacade(namespace, name string) string {
+	return fmt.Sprintf("%s.%s.svc.facade", namespace, name)
+}
+
 func (m *ingressTranslationMeta) translateIntoKongRoute() *kongstate.Route {
 	ingressHost := m.ingressHost
 	if strings.Contains(ingressHost, "*") {
@@ -224,6 +224,7 @@ type IngressRoutePriorityTraits struct {
 	HeaderCount   int
 	MaxPathLength int
 	HasRegexPath  bool
+	UserPriority  int
 }
 
 // EncodeToPriority encodes the traits to `priority` field used in Kong expression based routes.
@@ -233,14 +234,15 @@ type IngressRoutePriorityTraits struct {
 //	3 2 1 0 9 8 7 6 5 4 3 2 1 0 9 8 7 6 5 4 3 2 1 0 9 8 7 6 5 4 3 2 1 0 9 8 7 6 5 4 3 2 1 0
 //
 // +-+-+-+-+-+-+-+-+-+-+-+-+-+-+-+-+-+-+-+-+-+-+-+-+-+-+-+-+-+-+-+-+-+-+-+-+-+-+-+-+-+-+-+-+
-// | MF  | Header Number |P|         PRESERVED           |R|          Path Length          |
+// | MF  | Header Number |P|PRESERVED|   User Priority   |R|          Path Length          |
 // +-+-+-+-+-+-+-+-+-+-+-+-+-+-+-+-+-+-+-+-+-+-+-+-+-+-+-+-+-+-+-+-+-+-+-+-+-+-+-+-+-+-+-+-+
 //
 // Where:
 //   - MF (Match Fields): how many fields there are to match on (path, host, headers, methods, SNIs).
 //   - Header Number: number of headers to match.
-//   - PRESERVED: reserved for future use if we want add other fields into consideration.
 //   - P (Plain Host): set if ALL hosts are non-wildcard.
+//   - PRESERVED: reserved for future use if we want add other fields into consideration.
+//   - User Priority: priority set by the konghq.com/route-priority annotation.
 //   - R (Regex): if set, regex match is used.
 //   - Path Length: maximum length of the path to match.
 func (t IngressRoutePriorityTraits) EncodeToPriority() RoutePriorityType {
@@ -251,7 +253,9 @@ func (t IngressRoutePriorityTraits) EncodeToPriority() RoutePriorityType {
 
 		// regexPathShiftBits uses the 16th bit for marking if regex match on path exists.
 		regexPathShiftBits = 16
-		// bits 17~31 are preserved.
+		// userPriorityShiftBits makes bits 17~26 used for the priority set by users.
+		userPriorityShiftBits = 17
+		// bits 27~31 are preserved.
 
 		// plainHostShiftBits uses the 32nd bit for marking if ALL hosts are non-wildcard.
 		plainHostShiftBits = 32
@@ -274,6 +278,9 @@ func (t IngressRoutePriorityTraits) EncodeToPriority() RoutePriorityType {
 	if t.HasRegexPath {
 		priority += (1 << regexPathShiftBits)
 	}
+	// add user priority.
+	t.UserPriority = min(max(t.UserPriority, 0), MaxUserRoutePriority)
+	priority += RoutePriorityType(t.UserPriority << userPriorityShiftBits)
 	// add plain host mark.
 	if t.PlainHostOnly {
 		priority += (1 << plainHostShiftBits)
@@ -302,6 +309,7 @@ func calculateIngressRoutePriorityTraits(
 		HeaderCount:   0,
 		MaxPathLength: 0,
 		HasRegexPath:  false,
+		UserPriority:  UserRoutePriority(ingressAnnotations),
 	}
 
 	// add 1 to matchFields if path is non-empty.
@@ -391,8 +399,9 @@ func calculateIngressRoutePriorityTraits(
 //   - Then, if both routes have hosts, the routes having only plain(non-wildcard) hosts has
 //     higher priority than routes having at least one wildcard host
 //   - Then, sort by numer of different headers (maximum header count = 255).
+//   - Then, sort by the konghq.com/route-priority annotation, which replaces regex_priority field
+//     (not supported in KIC with expression routes).
 //   - Then, paths with regex match has higher priority than prefix match.
-//   - Then, sort by regex_priority field (not supported in KIC with expression routes).
 //   - At last, sort by maximum length of paths in the route.
 func calculateExpressionRoutePriority(
 	paths []netv1.HTTPIngressPath,
@@ -545,6 +545,24 @@ func TestCalculateIngressRoutePriorityTraits(t *testing.T) {
 				HasRegexPath:  false,
 			},
 		},
+		{
+			name: "route priority annotation",
+			paths: []netv1.HTTPIngressPath{
+				{
+					Path:     "/foo",
+					PathType: lo.ToPtr(netv1.PathTypeExact),
+				},
+			},
+			ingressAnnotations: map[string]string{
+				"konghq.com/route-priority": "10",
+			},
+			expectedTraits: IngressRoutePriorityTraits{
+				MatchFields:   1,
+				MaxPathLength: len("/foo"),
+				HasRegexPath:  true,
+				UserPriority:  10,
+			},
+		},
 	}
 
 	for _, tc := range testCases {
@@ -605,6 +623,26 @@ func TestEncodeIngressRoutePriorityFromTraits(t *testing.T) {
 			},
 			expectedPriority: (3 << 44) | (2 << 41) | (1 << 32) | 65535,
 		},
+		{
+			name: "user priority",
+			traits: IngressRoutePriorityTraits{
+				MatchFields:   2,
+				PlainHostOnly: true,
+				MaxPathLength: 5,
+				HasRegexPath:  true,
+				UserPriority:  10,
+			},
+			expectedPriority: (3 << 44) | (2 << 41) | (1 << 32) | (10 << 17) | (1 << 16) | 5,
+		},
+		{
+			name: "user priority exceed limit",
+			traits: IngressRoutePriorityTraits{
+				MatchFields:   1,
+				MaxPathLength: 5,
+				UserPriority:  5000,
+			},
+			expectedPriority: (3 << 44) | (1 << 41) | (1023 << 17) | 5,
+		},
 	}
 
 	for _, tc := range testCases {
@@ -1,10 +1,20 @@
 package subtranslator
 
-import "errors"
+import (
+	"errors"
+
+	"github.com/kong/kubernetes-ingress-controller/v3/internal/dataplane/kongstate"
+)
 
 var (
 	ErrRouteValidationNoRules                          = errors.New("no rules provided")
-	ErrRouteValidationQueryParamMatchesUnsupported     = errors.New("query param matches are not yet supported")
 	ErrRouteValidationNoMatchRulesOrHostnamesSpecified = errors.New("no match rules or hostnames specified")
 	ErrRotueValidationRuleNoBackendRef                 = errors.New("no backendRefs in rule")
+
+	ErrRouteValidationSessionPersistenceTimeoutsUnsupported        = kongstate.ErrSessionPersistenceTimeoutsUnsupported
+	ErrRouteValidationSessionPersistencePermanentCookieUnsupported = kongstate.ErrSessionPersistencePermanentCookieUnsupported
+
+	ErrRouteValidationGRPCMethodMatchEmpty             = errors.New("method match must specify at least one of service or method")
+	ErrRouteValidationGRPCRegexHeaderMatchNotSupported = errors.New("regular expression header matches are supported only with expression routes")
+	ErrRouteValidationGRPCFilterNotSupported           = errors.New("filter type is not supported")
 )
@@ -0,0 +1,217 @@
+package translator
+
+import (
+	"crypto/sha256"
+	"crypto/x509"
+	"encoding/hex"
+	"encoding/pem"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/kong/go-kong/kong"
+	"github.com/samber/lo"
+	corev1 "k8s.io/api/core/v1"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	"github.com/kong/kubernetes-ingress-controller/v3/internal/annotations"
+	"github.com/kong/kubernetes-ingress-controller/v3/internal/dataplane/kongstate"
+	"github.com/kong/kubernetes-ingress-controller/v3/internal/gatewayapi"
+	"github.com/kong/kubernetes-ingress-controller/v3/internal/util"
+)
+
+// backendTLSPolicyCACertKey is the key of a ConfigMap or a Secret holding the CA certificate bundle referenced
+// by a BackendTLSPolicy.
+const backendTLSPolicyCACertKey = "ca.crt"
+
+// backendTLSProtocols maps Kong Service protocols to their TLS counterparts used when a BackendTLSPolicy applies.
+var backendTLSProtocols = map[string]string{
+	"http":  "https",
+	"https": "https",
+	"grpc":  "grpcs",
+	"grpcs": "grpcs",
+	"ws":    "wss",
+	"wss":   "wss",
+	"tcp":   "tls",
+	"tls":   "tls",
+}
+
+// fillBackendTLSPolicies configures Kong Services whose Kubernetes Services are targeted by BackendTLSPolicies
+// to connect to their backends over TLS and verify the backends' certificates against the CA certificates
+// referenced by the policies and the hostname from the policies. The referenced CA certificates are added
+// to the state's CA certificates.
+func (t *Translator) fillBackendTLSPolicies(ks *kongstate.KongState) {
+	policies, err := t.storer.ListBackendTLSPolicies()
+	if err != nil {
+		t.logger.Error(err, "Failed to list BackendTLSPolicies")
+		return
+	}
+	if len(policies) == 0 {
+		return
+	}
+
+	// CA certificates are unique by their content in Kong, reuse the IDs of the ones already in the state.
+	caCertIDsByDigest := make(map[string]string, len(ks.CACertificates))
+	for _, caCert := range ks.CACertificates {
+		caCertIDsByDigest[caCertDigest(lo.FromPtr(caCert.Cert))] = lo.FromPtr(caCert.ID)
+	}
+
+	for i := range ks.Services {
+		service := &ks.Services[i]
+		servicesGroup := lo.Values(service.K8sServices)
+		policy, err := kongstate.GetBackendTLSPolicyForServices(policies, servicesGroup, lo.FromPtr(service.Port))
+		if err != nil {
+			t.registerTranslationFailure(err.Error(), lo.Map(servicesGroup, func(svc *corev1.Service, _ int) client.Object {
+				return svc
+			})...)
+			continue
+		}
+		if policy == nil {
+			continue
+		}
+
+		protocol, ok := backendTLSProtocols[lo.FromPtrOr(service.Protocol, "http")]
+		if !ok {
+			t.registerTranslationFailure(
+				fmt.Sprintf("BackendTLSPolicy cannot be applied to Kong service %s with protocol %s",
+					lo.FromPtr(service.Name), lo.FromPtr(service.Protocol)),
+				policy,
+			)
+			continue
+		}
+
+		caCerts, err := t.getBackendTLSPolicyCACerts(policy)
+		if err != nil {
+			t.registerTranslationFailure(fmt.Sprintf("invalid BackendTLSPolicy CA certificates: %s", err), policy)
+			continue
+		}
+		caCertIDs := make([]string, 0, len(caCerts))
+		for _, caCert := range caCerts {
+			digest := caCertDigest(lo.FromPtr(caCert.Cert))
+			if id, ok := caCertIDsByDigest[digest]; ok {
+				caCertIDs = append(caCertIDs, id)
+				continue
+			}
+			caCertIDsByDigest[digest] = lo.FromPtr(caCert.ID)
+			caCertIDs = append(caCertIDs, lo.FromPtr(caCert.ID))
+			ks.CACertificates = append(ks.CACertificates, caCert)
+		}
+
+		service.Protocol = kong.String(protocol)
+		service.TLSVerify = kong.Bool(true)
+		if len(caCertIDs) > 0 {
+			// The same certificate may be referenced more than once by the policy, e.g. in a bundle and a Secret.
+			service.CACertificates = kong.StringSlice(lo.Uniq(caCertIDs)...)
+		}
+
+		// Kong uses the Host header sent to the upstream as the SNI and the name the upstream certificate is verified
+		// against. Set it to the policy's hostname and make sure routes do not override it with the client's Host header,
+		// unless preserving it is explicitly requested with the konghq.com/preserve-host annotation.
+		hostname := string(policy.Spec.Validation.Hostname)
+		for j := range ks.Upstreams {
+			if lo.FromPtr(ks.Upstreams[j].Name) == lo.FromPtr(service.Host) {
+				ks.Upstreams[j].HostHeader = kong.String(hostname)
+			}
+		}
+		for j := range service.Routes {
+			if annotations.ExtractPreserveHost(service.Routes[j].Ingress.Annotations) != "" {
+				continue
+			}
+			service.Routes[j].PreserveHost = kong.Bool(false)
+		}
+
+		t.registerSuccessfullyTranslatedObject(policy)
+	}
+}
+
+// getBackendTLSPolicyCACerts translates the CA certificates referenced by the BackendTLSPolicy to kong.CACertificates.
+// References to ConfigMaps and Secrets are supported, the bundle is expected under the ca.crt key.
+func (t *Translator) getBackendTLSPolicyCACerts(policy *gatewayapi.BackendTLSPolicy) ([]kong.CACertificate, error) {
+	var caCerts []kong.CACertificate
+	for _, ref := range policy.Spec.Validation.CACertificateRefs {
+		if ref.Group != "" && ref.Group != "core" {
+			return nil, fmt.Errorf("unsupported group %q of CA certificate reference %s", ref.Group, ref.Name)
+		}
+
+		var (
+			obj    client.Object
+			bundle []byte
+		)
+		switch ref.Kind {
+		case "ConfigMap":
+			configMap, err := t.storer.GetConfigMap(policy.Namespace, string(ref.Name))
+			if err != nil {
+				return nil, fmt.Errorf("failed to fetch ConfigMap %s: %w", ref.Name, err)
+			}
+			obj, bundle = configMap, []byte(configMap.Data[backendTLSPolicyCACertKey])
+		case "Secret":
+			secret, err := t.storer.GetSecret(policy.Namespace, string(ref.Name))
+			if err != nil {
+				return nil, fmt.Errorf("failed to fetch Secret %s: %w", ref.Name, err)
+			}
+			obj, bundle = secret, secret.Data[backendTLSPolicyCACertKey]
+		default:
+			return nil, fmt.Errorf("unsupported kind %q of CA certificate reference %s", ref.Kind, ref.Name)
+		}
+		if len(bundle) == 0 {
+			return nil, fmt.Errorf("%s %s is missing '%s' field in data", ref.Kind, ref.Name, backendTLSPolicyCACertKey)
+		}
+
+		certs, err := toKongCACertificatesFromBundle(obj, bundle)
+		if err != nil {
+			return nil, fmt.Errorf("%s %s: %w", ref.Kind, ref.Name, err)
+		}
+		caCerts = append(caCerts, certs...)
+	}
+	return caCerts, nil
+}
+
+// toKongCACertificatesFromBundle translates a PEM bundle to kong.CACertificates, one per certificate in the bundle,
+// as Kong accepts a single certificate per CA certificate entity. IDs are generated from the certificates' content.
+func toKongCACertificatesFromBundle(obj client.Object, bundle []byte) ([]kong.CACertificate, error) {
+	var caCerts []kong.CACertificate
+	rest := bundle
+	for {
+		var pemBlock *pem.Block
+		pemBlock, rest = pem.Decode(rest)
+		if pemBlock == nil {
+			break
+		}
+		if pemBlock.Type != "CERTIFICATE" {
+			continue
+		}
+		x509Cert, err := x509.ParseCertificate(pemBlock.Bytes)
+		if err != nil {
+			return nil, errors.New("failed to parse certificate")
+		}
+		if !x509Cert.IsCA {
+			return nil, errors.New("certificate is missing the 'CA' basic constraint")
+		}
+		if time.Now().After(x509Cert.NotAfter) {
+			return nil, errors.New("expired")
+		}
+
+		cert := string(pem.EncodeToMemory(pemBlock))
+		caCerts = append(caCerts, kong.CACertificate{
+			ID:   kong.String(uuid.NewSHA1(uuid.NameSpaceOID, []byte(caCertDigest(cert))).String()),
+			Cert: kong.String(cert),
+			Tags: util.GenerateTagsForObject(obj),
+		})
+	}
+	if len(caCerts) == 0 {
+		return nil, errors.New("invalid PEM block")
+	}
+	return caCerts, nil
+}
+
+// caCertDigest returns a digest of the PEM-encoded certificate used to tell whether two certificates are the same.
+func caCertDigest(cert string) string {
+	block, _ := pem.Decode([]byte(cert))
+	if block == nil {
+		sum := sha256.Sum256([]byte(cert))
+		return hex.EncodeToString(sum[:])
+	}
+	sum := sha256.Sum256(block.Bytes)
+	return hex.EncodeToString(sum[:])
+}
@@ -0,0 +1,186 @@
+package translator
+
+import (
+	"testing"
+
+	"github.com/kong/go-kong/kong"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	"github.com/kong/kubernetes-ingress-controller/v3/internal/dataplane/kongstate"
+	"github.com/kong/kubernetes-ingress-controller/v3/internal/gatewayapi"
+	"github.com/kong/kubernetes-ingress-controller/v3/internal/store"
+	"github.com/kong/kubernetes-ingress-controller/v3/internal/util"
+	"github.com/kong/kubernetes-ingress-controller/v3/test/helpers/certificate"
+)
+
+func TestFillBackendTLSPolicies(t *testing.T) {
+	caCert1, _ := certificate.MustGenerateSelfSignedCertPEMFormat(certificate.WithCATrue())
+	caCert2, _ := certificate.MustGenerateSelfSignedCertPEMFormat(certificate.WithCATrue())
+	notCACert, _ := certificate.MustGenerateSelfSignedCertPEMFormat()
+
+	k8sService := &corev1.Service{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      "svc",
+			Namespace: "default",
+		},
+		Spec: corev1.ServiceSpec{
+			Ports: []corev1.ServicePort{{Name: "https", Port: 443}},
+		},
+	}
+	newKongState := func(protocol string) kongstate.KongState {
+		return kongstate.KongState{
+			Services: []kongstate.Service{
+				{
+					Service: kong.Service{
+						Name:     kong.String("default.svc.443"),
+						Host:     kong.String("svc.default.443.svc"),
+						Port:     kong.Int(443),
+						Protocol: kong.String(protocol),
+					},
+					Routes: []kongstate.Route{
+						{Route: kong.Route{Name: kong.String("route"), PreserveHost: kong.Bool(true)}},
+					},
+					K8sServices: map[string]*corev1.Service{"default/svc": k8sService},
+				},
+			},
+			Upstreams: []kongstate.Upstream{
+				{Upstream: kong.Upstream{Name: kong.String("svc.default.443.svc")}},
+			},
+		}
+	}
+	newPolicy := func(caCertRefs ...gatewayapi.LocalObjectReference) *gatewayapi.BackendTLSPolicy {
+		return &gatewayapi.BackendTLSPolicy{
+			TypeMeta: metav1.TypeMeta{
+				APIVersion: "gateway.networking.k8s.io/v1alpha3",
+				Kind:       "BackendTLSPolicy",
+			},
+			ObjectMeta: metav1.ObjectMeta{
+				Name:      "policy",
+				Namespace: "default",
+			},
+			Spec: gatewayapi.BackendTLSPolicySpec{
+				TargetRefs: []gatewayapi.LocalPolicyTargetReferenceWithSectionName{
+					{
+						LocalPolicyTargetReference: gatewayapi.LocalPolicyTargetReference{
+							Kind: "Service",
+							Name: "svc",
+						},
+					},
+				},
+				Validation: gatewayapi.BackendTLSPolicyValidation{
+					CACertificateRefs: caCertRefs,
+					Hostname:          "backend.example.com",
+				},
+			},
+		}
+	}
+	configMaps := []*corev1.ConfigMap{
+		{
+			ObjectMeta: metav1.ObjectMeta{Name: "ca-bundle", Namespace: "default"},
+			Data:       map[string]string{"ca.crt": string(caCert1) + string(caCert2)},
+		},
+		{
+			ObjectMeta: metav1.ObjectMeta{Name: "not-ca", Namespace: "default"},
+			Data:       map[string]string{"ca.crt": string(notCACert)},
+		},
+	}
+	secrets := []*corev1.Secret{
+		{
+			ObjectMeta: metav1.ObjectMeta{Name: "ca", Namespace: "default"},
+			Data:       map[string][]byte{"ca.crt": caCert1},
+		},
+	}
+
+	t.Run("service targeted by policy is configured with TLS verification", func(t *testing.T) {
+		s, err := store.NewFakeStore(store.FakeObjects{
+			BackendTLSPolicies: []*gatewayapi.BackendTLSPolicy{
+				newPolicy(
+					gatewayapi.LocalObjectReference{Kind: "ConfigMap", Name: "ca-bundle"},
+					gatewayapi.LocalObjectReference{Kind: "Secret", Name: "ca"},
+				),
+			},
+			ConfigMaps: configMaps,
+			Secrets:    secrets,
+		})
+		require.NoError(t, err)
+		p := mustNewTranslator(t, s)
+
+		ks := newKongState("http")
+		p.fillBackendTLSPolicies(&ks)
+		require.Empty(t, p.popTranslationFailures())
+
+		// The Secret holds the same certificate as the first one in the ConfigMap's bundle, so it's deduplicated.
+		require.Len(t, ks.CACertificates, 2)
+		service := ks.Services[0]
+		assert.Equal(t, "https", *service.Protocol)
+		assert.True(t, *service.TLSVerify)
+		assert.ElementsMatch(t, []*string{ks.CACertificates[0].ID, ks.CACertificates[1].ID}, service.CACertificates)
+		assert.Equal(t, "backend.example.com", *ks.Upstreams[0].HostHeader)
+		assert.False(t, *service.Routes[0].PreserveHost)
+		assert.Len(t, p.popConfiguredKubernetesObjects(), 1)
+	})
+
+	t.Run("preserve-host annotation of a route is respected", func(t *testing.T) {
+		s, err := store.NewFakeStore(store.FakeObjects{
+			BackendTLSPolicies: []*gatewayapi.BackendTLSPolicy{
+				newPolicy(gatewayapi.LocalObjectReference{Kind: "Secret", Name: "ca"}),
+			},
+			Secrets: secrets,
+		})
+		require.NoError(t, err)
+		p := mustNewTranslator(t, s)
+
+		ks := newKongState("http")
+		ks.Services[0].Routes = append(ks.Services[0].Routes, kongstate.Route{
+			Route: kong.Route{Name: kong.String("route-preserving-host"), PreserveHost: kong.Bool(true)},
+			Ingress: util.K8sObjectInfo{
+				Annotations: map[string]string{"konghq.com/preserve-host": "true"},
+			},
+		})
+		p.fillBackendTLSPolicies(&ks)
+		require.Empty(t, p.popTranslationFailures())
+
+		assert.False(t, *ks.Services[0].Routes[0].PreserveHost)
+		assert.True(t, *ks.Services[0].Routes[1].PreserveHost)
+	})
+
+	t.Run("invalid CA certificate gives translation failure", func(t *testing.T) {
+		s, err := store.NewFakeStore(store.FakeObjects{
+			BackendTLSPolicies: []*gatewayapi.BackendTLSPolicy{
+				newPolicy(gatewayapi.LocalObjectReference{Kind: "ConfigMap", Name: "not-ca"}),
+			},
+			ConfigMaps: configMaps,
+		})
+		require.NoError(t, err)
+		p := mustNewTranslator(t, s)
+
+		ks := newKongState("http")
+		p.fillBackendTLSPolicies(&ks)
+		failures := p.popTranslationFailures()
+		require.Len(t, failures, 1)
+		assert.Contains(t, failures[0].Message(), "certificate is missing the 'CA' basic constraint")
+		assert.Empty(t, ks.CACertificates)
+		assert.Equal(t, "http", *ks.Services[0].Protocol)
+		assert.Nil(t, ks.Services[0].TLSVerify)
+	})
+
+	t.Run("service with protocol not supporting TLS gives translation failure", func(t *testing.T) {
+		s, err := store.NewFakeStore(store.FakeObjects{
+			BackendTLSPolicies: []*gatewayapi.BackendTLSPolicy{
+				newPolicy(gatewayapi.LocalObjectReference{Kind: "Secret", Name: "ca"}),
+			},
+			Secrets: secrets,
+		})
+		require.NoError(t, err)
+		p := mustNewTranslator(t, s)
+
+		ks := newKongState("udp")
+		p.fillBackendTLSPolicies(&ks)
+		failures := p.popTranslationFailures()
+		require.Len(t, failures, 1)
+		assert.Contains(t, failures[0].Message(), "BackendTLSPolicy cannot be applied to Kong service default.svc.443 with protocol udp")
+	})
+}
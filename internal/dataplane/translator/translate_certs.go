@@ -3,11 +3,15 @@ package translator
 import (
 	"bytes"
 	"crypto/tls"
+	"crypto/x509"
+	"encoding/pem"
+	"errors"
 	"fmt"
 	"sort"
 	"strings"
 
 	"github.com/go-logr/logr"
+	"github.com/google/uuid"
 	"github.com/kong/go-kong/kong"
 	corev1 "k8s.io/api/core/v1"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
@@ -37,6 +41,40 @@ func getCertFromSecret(secret *corev1.Secret) (string, string, error) {
 	return string(cert), string(key), nil
 }
 
+// maxListenerCertificateRefs is the maximum number of certificateRefs of a Gateway listener. Kong certificates can hold
+// an alternate certificate and key next to the main ones, so that a listener can serve e.g. both RSA and ECDSA ones.
+const maxListenerCertificateRefs = 2
+
+// certificatePublicKeyAlgorithm returns the public key algorithm of the first certificate in a PEM string.
+func certificatePublicKeyAlgorithm(cert string) (x509.PublicKeyAlgorithm, error) {
+	block, _ := pem.Decode([]byte(cert))
+	if block == nil {
+		return x509.UnknownPublicKeyAlgorithm, errors.New("invalid PEM block")
+	}
+	x509Cert, err := x509.ParseCertificate(block.Bytes)
+	if err != nil {
+		return x509.UnknownPublicKeyAlgorithm, fmt.Errorf("failed to parse certificate: %w", err)
+	}
+	return x509Cert.PublicKeyAlgorithm, nil
+}
+
+// validateAlternateCertificate ensures that the alternate certificate has a key of another type than the main one,
+// as Kong selects the certificate to serve based on the key types supported by the client.
+func validateAlternateCertificate(cert, altCert string) error {
+	algorithm, err := certificatePublicKeyAlgorithm(cert)
+	if err != nil {
+		return err
+	}
+	altAlgorithm, err := certificatePublicKeyAlgorithm(altCert)
+	if err != nil {
+		return err
+	}
+	if algorithm == altAlgorithm {
+		return fmt.Errorf("both certificates have %s keys, they must have keys of different types", algorithm)
+	}
+	return nil
+}
+
 type certWrapper struct {
 	identifier        string
 	cert              kong.Certificate
@@ -82,59 +120,89 @@ func (t *Translator) getGatewayCerts() []certWrapper {
 				continue
 			}
 
-			if listener.TLS != nil {
-				if len(listener.TLS.CertificateRefs) > 0 {
-					if len(listener.TLS.CertificateRefs) > 1 {
-						// TODO support cert_alt and key_alt if there are 2 SecretObjectReferences
-						// https://github.com/Kong/kubernetes-ingress-controller/issues/2604
-						t.registerTranslationFailure("listener '%s' has more than one certificateRef, it's not supported", gateway)
-						continue
-					}
-
-					// determine the Secret Namespace
-					ref := listener.TLS.CertificateRefs[0]
-					namespace := gateway.Namespace
-					if ref.Namespace != nil {
-						namespace = string(*ref.Namespace)
-					}
-
-					// retrieve the Secret and extract the PEM strings
-					secret, err := s.GetSecret(namespace, string(ref.Name))
-					if err != nil {
-						logger.Error(err, "Failed to fetch secret",
-							"gateway", gateway.Name,
-							"listener", listener.Name,
-							"secret_name", string(ref.Name),
-							"secret_namespace", namespace,
-						)
-						continue
-					}
-					cert, key, err := getCertFromSecret(secret)
-					if err != nil {
-						t.registerTranslationFailure("failed to construct certificate from secret", secret, gateway)
-						continue
-					}
-
-					// determine the SNI
-					hostname := "*"
-					if listener.Hostname != nil {
-						hostname = string(*listener.Hostname)
-					}
-
-					// create a Kong certificate, wrap it in metadata, and add it to the certs slice
-					certs = append(certs, certWrapper{
-						identifier: cert + key,
-						cert: kong.Certificate{
-							ID:   kong.String(string(secret.UID)),
-							Cert: kong.String(cert),
-							Key:  kong.String(key),
-							Tags: util.GenerateTagsForObject(secret),
-						},
-						CreationTimestamp: secret.CreationTimestamp,
-						snis:              []string{hostname},
-					})
+			if listener.TLS == nil || len(listener.TLS.CertificateRefs) == 0 {
+				continue
+			}
+			if len(listener.TLS.CertificateRefs) > maxListenerCertificateRefs {
+				t.registerTranslationFailure(fmt.Sprintf(
+					"listener '%s' has more than %d certificateRefs, it's not supported", listener.Name, maxListenerCertificateRefs,
+				), gateway)
+				continue
+			}
+
+			// retrieve the Secrets and extract the PEM strings
+			var (
+				secrets  []*corev1.Secret
+				keyPairs [][2]string
+			)
+			for _, ref := range listener.TLS.CertificateRefs {
+				// determine the Secret Namespace
+				namespace := gateway.Namespace
+				if ref.Namespace != nil {
+					namespace = string(*ref.Namespace)
+				}
+
+				secret, err := s.GetSecret(namespace, string(ref.Name))
+				if err != nil {
+					logger.Error(err, "Failed to fetch secret",
+						"gateway", gateway.Name,
+						"listener", listener.Name,
+						"secret_name", string(ref.Name),
+						"secret_namespace", namespace,
+					)
+					break
+				}
+				cert, key, err := getCertFromSecret(secret)
+				if err != nil {
+					t.registerTranslationFailure("failed to construct certificate from secret", secret, gateway)
+					break
+				}
+				secrets = append(secrets, secret)
+				keyPairs = append(keyPairs, [2]string{cert, key})
+			}
+			if len(secrets) != len(listener.TLS.CertificateRefs) {
+				continue
+			}
+
+			// determine the SNI
+			hostname := "*"
+			if listener.Hostname != nil {
+				hostname = string(*listener.Hostname)
+			}
+
+			// create a Kong certificate, wrap it in metadata, and add it to the certs slice
+			secret, cert, key := secrets[0], keyPairs[0][0], keyPairs[0][1]
+			wrapper := certWrapper{
+				identifier: cert + key,
+				cert: kong.Certificate{
+					ID:   kong.String(string(secret.UID)),
+					Cert: kong.String(cert),
+					Key:  kong.String(key),
+					Tags: util.GenerateTagsForObject(secret),
+				},
+				CreationTimestamp: secret.CreationTimestamp,
+				snis:              []string{hostname},
+			}
+
+			// the second certificate is served as the alternate one, e.g. an ECDSA certificate next to an RSA one
+			if len(secrets) > 1 {
+				altSecret, altCert, altKey := secrets[1], keyPairs[1][0], keyPairs[1][1]
+				if err := validateAlternateCertificate(cert, altCert); err != nil {
+					t.registerTranslationFailure(
+						fmt.Sprintf("listener '%s' has invalid certificateRefs: %s", listener.Name, err), secret, altSecret, gateway,
+					)
+					continue
+				}
+				wrapper.identifier += altCert + altKey
+				// the Secret ID can't be reused as the primary certificate may be also served on its own
+				wrapper.cert.ID = kong.String(uuid.NewSHA1(uuid.NameSpaceOID, []byte(string(secret.UID)+string(altSecret.UID))).String())
+				wrapper.cert.CertAlt = kong.String(altCert)
+				wrapper.cert.KeyAlt = kong.String(altKey)
+				if altSecret.CreationTimestamp.After(secret.CreationTimestamp.Time) {
+					wrapper.CreationTimestamp = altSecret.CreationTimestamp
 				}
 			}
+			certs = append(certs, wrapper)
 		}
 	}
 	return certs
@@ -0,0 +1,149 @@
+package translator
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/google/uuid"
+	"github.com/samber/lo"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	k8stypes "k8s.io/apimachinery/pkg/types"
+
+	"github.com/kong/kubernetes-ingress-controller/v3/internal/gatewayapi"
+	"github.com/kong/kubernetes-ingress-controller/v3/internal/store"
+	"github.com/kong/kubernetes-ingress-controller/v3/test/helpers/certificate"
+)
+
+func TestGetGatewayCerts(t *testing.T) {
+	tlsSecret := func(name string, opts ...certificate.SelfSignedCertificateOption) *corev1.Secret {
+		cert, key := certificate.MustGenerateSelfSignedCertPEMFormat(opts...)
+		return &corev1.Secret{
+			TypeMeta: metav1.TypeMeta{
+				APIVersion: "v1",
+				Kind:       "Secret",
+			},
+			ObjectMeta: metav1.ObjectMeta{
+				Namespace: "default",
+				Name:      name,
+				UID:       k8stypes.UID(uuid.NewString()),
+			},
+			Data: map[string][]byte{
+				corev1.TLSCertKey:       cert,
+				corev1.TLSPrivateKeyKey: key,
+			},
+		}
+	}
+	rsaSecret := tlsSecret("rsa")
+	anotherRSASecret := tlsSecret("another-rsa")
+	ecdsaSecret := tlsSecret("ecdsa", certificate.WithECDSAKey())
+
+	newGateway := func(certificateRefs ...string) *gatewayapi.Gateway {
+		return &gatewayapi.Gateway{
+			TypeMeta: gatewayapi.V1GatewayTypeMeta,
+			ObjectMeta: metav1.ObjectMeta{
+				Namespace:  "default",
+				Name:       "gateway",
+				Generation: 1,
+			},
+			Spec: gatewayapi.GatewaySpec{
+				GatewayClassName: "kong",
+				Listeners: []gatewayapi.Listener{
+					{
+						Name:     "https",
+						Port:     443,
+						Protocol: gatewayapi.HTTPSProtocolType,
+						Hostname: lo.ToPtr(gatewayapi.Hostname("example.com")),
+						TLS: &gatewayapi.GatewayTLSConfig{
+							CertificateRefs: lo.Map(certificateRefs, func(name string, _ int) gatewayapi.SecretObjectReference {
+								return gatewayapi.SecretObjectReference{Name: gatewayapi.ObjectName(name)}
+							}),
+						},
+					},
+				},
+			},
+			Status: gatewayapi.GatewayStatus{
+				Listeners: []gatewayapi.ListenerStatus{
+					{
+						Name: "https",
+						Conditions: []metav1.Condition{
+							{
+								Type:               string(gatewayapi.ListenerConditionProgrammed),
+								Status:             metav1.ConditionTrue,
+								Reason:             string(gatewayapi.ListenerReasonProgrammed),
+								ObservedGeneration: 1,
+							},
+						},
+					},
+				},
+			},
+		}
+	}
+
+	testCases := []struct {
+		name                    string
+		gateway                 *gatewayapi.Gateway
+		expectedCert            *corev1.Secret
+		expectedAltCert         *corev1.Secret
+		expectTranslationFailed bool
+	}{
+		{
+			name:         "single certificate",
+			gateway:      newGateway("rsa"),
+			expectedCert: rsaSecret,
+		},
+		{
+			name:            "RSA and ECDSA certificates are merged into a single certificate",
+			gateway:         newGateway("rsa", "ecdsa"),
+			expectedCert:    rsaSecret,
+			expectedAltCert: ecdsaSecret,
+		},
+		{
+			name:                    "certificates with keys of the same type are rejected",
+			gateway:                 newGateway("rsa", "another-rsa"),
+			expectTranslationFailed: true,
+		},
+		{
+			name:                    "more than two certificates are rejected",
+			gateway:                 newGateway("rsa", "ecdsa", "another-rsa"),
+			expectTranslationFailed: true,
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			s, err := store.NewFakeStore(store.FakeObjects{
+				Gateways: []*gatewayapi.Gateway{tc.gateway},
+				Secrets:  []*corev1.Secret{rsaSecret, anotherRSASecret, ecdsaSecret},
+			})
+			require.NoError(t, err)
+			translator := mustNewTranslator(t, s)
+
+			certs := translator.getGatewayCerts()
+			failures := translator.failuresCollector.PopResourceFailures()
+			if tc.expectTranslationFailed {
+				require.Empty(t, certs)
+				require.Len(t, failures, 1)
+				return
+			}
+			require.Empty(t, failures)
+			require.Len(t, certs, 1)
+			cert := certs[0].cert
+			assert.Equal(t, []string{"example.com"}, certs[0].snis)
+			assert.Equal(t, strings.TrimSpace(string(tc.expectedCert.Data[corev1.TLSCertKey])), lo.FromPtr(cert.Cert))
+			assert.Equal(t, strings.TrimSpace(string(tc.expectedCert.Data[corev1.TLSPrivateKeyKey])), lo.FromPtr(cert.Key))
+			if tc.expectedAltCert == nil {
+				assert.Equal(t, string(tc.expectedCert.UID), lo.FromPtr(cert.ID))
+				assert.Nil(t, cert.CertAlt)
+				assert.Nil(t, cert.KeyAlt)
+				return
+			}
+			assert.NotEqual(t, string(tc.expectedCert.UID), lo.FromPtr(cert.ID),
+				"certificate with an alternate one should not reuse the ID of the Secret")
+			assert.Equal(t, strings.TrimSpace(string(tc.expectedAltCert.Data[corev1.TLSCertKey])), lo.FromPtr(cert.CertAlt))
+			assert.Equal(t, strings.TrimSpace(string(tc.expectedAltCert.Data[corev1.TLSPrivateKeyKey])), lo.FromPtr(cert.KeyAlt))
+		})
+	}
+}
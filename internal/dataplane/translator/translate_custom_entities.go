@@ -0,0 +1,77 @@
+package translator
+
+import (
+	"context"
+	"errors"
+	"sync"
+
+	"github.com/kong/go-kong/kong"
+
+	"github.com/kong/kubernetes-ingress-controller/v3/internal/dataplane/kongstate"
+)
+
+// SchemaServiceProvider provides a schema service of a Kong gateway. It returns false when no gateway is available.
+type SchemaServiceProvider interface {
+	GetSchemasService() (kong.AbstractSchemaService, bool)
+}
+
+// customEntitySchemaGetter gets schemas of custom entity types from a Kong gateway and caches them, so that they're
+// fetched once instead of on every translation. Failures are not cached.
+type customEntitySchemaGetter struct {
+	provider SchemaServiceProvider
+
+	lock    sync.RWMutex
+	schemas map[string]kong.Schema
+}
+
+func newCustomEntitySchemaGetter(provider SchemaServiceProvider) *customEntitySchemaGetter {
+	return &customEntitySchemaGetter{
+		provider: provider,
+		schemas:  map[string]kong.Schema{},
+	}
+}
+
+// Get returns the schema of the entity type, fetching it from a Kong gateway if it's not cached yet.
+func (g *customEntitySchemaGetter) Get(ctx context.Context, entityType string) (kong.Schema, error) {
+	g.lock.RLock()
+	schema, ok := g.schemas[entityType]
+	g.lock.RUnlock()
+	if ok {
+		return schema, nil
+	}
+
+	schemaService, ok := g.provider.GetSchemasService()
+	if !ok {
+		return nil, errors.New("no Kong gateway available to fetch the schema from")
+	}
+	schema, err := schemaService.Get(ctx, entityType)
+	if err != nil {
+		return nil, err
+	}
+
+	g.lock.Lock()
+	defer g.lock.Unlock()
+	g.schemas[entityType] = schema
+	return schema, nil
+}
+
+// fillCustomEntities translates KongCustomEntities to custom Kong entities using schemas retrieved from a gateway.
+func (t *Translator) fillCustomEntities(result *kongstate.KongState) {
+	if t.featureFlags.DBMode {
+		for _, entity := range t.storer.ListKongCustomEntities() {
+			t.registerTranslationFailure("KongCustomEntities are only supported with DB-less Kong gateways", entity)
+		}
+		return
+	}
+
+	var schemaGetter kongstate.SchemaGetter
+	if t.customEntitySchemaGetter != nil {
+		schemaGetter = t.customEntitySchemaGetter
+	}
+	result.FillCustomEntities(t.logger, t.storer, t.failuresCollector, schemaGetter)
+	for _, collection := range result.CustomEntities {
+		for _, entity := range collection.Entities {
+			t.registerSuccessfullyTranslatedObject(entity.K8sKongCustomEntity)
+		}
+	}
+}
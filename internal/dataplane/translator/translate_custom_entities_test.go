@@ -0,0 +1,140 @@
+package translator
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/kong/go-kong/kong"
+	"github.com/stretchr/testify/require"
+	apiextensionsv1 "k8s.io/apiextensions-apiserver/pkg/apis/apiextensions/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	"github.com/kong/kubernetes-ingress-controller/v3/internal/annotations"
+	"github.com/kong/kubernetes-ingress-controller/v3/internal/store"
+	kongv1alpha1 "github.com/kong/kubernetes-ingress-controller/v3/pkg/apis/configuration/v1alpha1"
+)
+
+type fakeSchemaService struct {
+	kong.AbstractSchemaService
+
+	schemas map[string]kong.Schema
+	calls   int
+}
+
+func (f *fakeSchemaService) Get(_ context.Context, entityType string) (kong.Schema, error) {
+	f.calls++
+	schema, ok := f.schemas[entityType]
+	if !ok {
+		return nil, errors.New("entity type not found")
+	}
+	return schema, nil
+}
+
+type fakeSchemaServiceProvider struct {
+	schemaService *fakeSchemaService
+}
+
+func (p fakeSchemaServiceProvider) GetSchemasService() (kong.AbstractSchemaService, bool) {
+	if p.schemaService == nil {
+		return nil, false
+	}
+	return p.schemaService, true
+}
+
+func TestCustomEntitySchemaGetter(t *testing.T) {
+	ctx := context.Background()
+	schema := kong.Schema{
+		"fields": []interface{}{
+			map[string]interface{}{"audience": map[string]interface{}{"type": "string"}},
+		},
+	}
+
+	t.Run("schemas are cached", func(t *testing.T) {
+		schemaService := &fakeSchemaService{schemas: map[string]kong.Schema{"session_metadatas": schema}}
+		getter := newCustomEntitySchemaGetter(fakeSchemaServiceProvider{schemaService: schemaService})
+
+		for i := 0; i < 3; i++ {
+			actual, err := getter.Get(ctx, "session_metadatas")
+			require.NoError(t, err)
+			require.Equal(t, schema, actual)
+		}
+		require.Equal(t, 1, schemaService.calls, "schema should be fetched only once")
+	})
+
+	t.Run("failures are not cached", func(t *testing.T) {
+		schemaService := &fakeSchemaService{schemas: map[string]kong.Schema{}}
+		getter := newCustomEntitySchemaGetter(fakeSchemaServiceProvider{schemaService: schemaService})
+
+		_, err := getter.Get(ctx, "session_metadatas")
+		require.Error(t, err)
+		schemaService.schemas["session_metadatas"] = schema
+		actual, err := getter.Get(ctx, "session_metadatas")
+		require.NoError(t, err)
+		require.Equal(t, schema, actual)
+		require.Equal(t, 2, schemaService.calls)
+	})
+
+	t.Run("no gateway available", func(t *testing.T) {
+		getter := newCustomEntitySchemaGetter(fakeSchemaServiceProvider{})
+
+		_, err := getter.Get(ctx, "session_metadatas")
+		require.Error(t, err)
+	})
+}
+
+func TestTranslator_FillCustomEntities(t *testing.T) {
+	entity := &kongv1alpha1.KongCustomEntity{
+		TypeMeta: metav1.TypeMeta{
+			APIVersion: kongv1alpha1.GroupVersion.String(),
+			Kind:       "KongCustomEntity",
+		},
+		ObjectMeta: metav1.ObjectMeta{
+			Namespace: "default",
+			Name:      "session-metadata",
+			Annotations: map[string]string{
+				annotations.IngressClassKey: annotations.DefaultIngressClass,
+			},
+		},
+		Spec: kongv1alpha1.KongCustomEntitySpec{
+			EntityType: "session_metadatas",
+			Fields:     apiextensionsv1.JSON{Raw: []byte(`{"audience":"foo"}`)},
+		},
+	}
+	schemaService := &fakeSchemaService{schemas: map[string]kong.Schema{
+		"session_metadatas": {
+			"fields": []interface{}{
+				map[string]interface{}{"audience": map[string]interface{}{"type": "string"}},
+			},
+		},
+	}}
+
+	t.Run("DB-less mode", func(t *testing.T) {
+		s, err := store.NewFakeStore(store.FakeObjects{KongCustomEntities: []*kongv1alpha1.KongCustomEntity{entity}})
+		require.NoError(t, err)
+		translator := mustNewTranslator(t, s)
+		translator.InjectSchemaServiceProvider(fakeSchemaServiceProvider{schemaService: schemaService})
+
+		for i := 0; i < 2; i++ {
+			result := translator.BuildKongConfig()
+			require.Empty(t, result.TranslationFailures)
+			require.Len(t, result.KongState.CustomEntities["session_metadatas"].Entities, 1)
+		}
+		require.Equal(t, 1, schemaService.calls, "schema should be fetched only once across translations")
+	})
+
+	t.Run("DB mode", func(t *testing.T) {
+		s, err := store.NewFakeStore(store.FakeObjects{KongCustomEntities: []*kongv1alpha1.KongCustomEntity{entity}})
+		require.NoError(t, err)
+		translator := mustNewTranslator(t, s)
+		translator.featureFlags.DBMode = true
+		translator.InjectSchemaServiceProvider(fakeSchemaServiceProvider{schemaService: schemaService})
+
+		result := translator.BuildKongConfig()
+		require.Empty(t, result.KongState.CustomEntities)
+		require.Len(t, result.TranslationFailures, 1)
+		require.Equal(t, "KongCustomEntities are only supported with DB-less Kong gateways", result.TranslationFailures[0].Message())
+		require.Equal(t, []client.Object{entity}, result.TranslationFailures[0].CausingObjects())
+	})
+}
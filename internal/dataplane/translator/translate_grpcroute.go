@@ -1,8 +1,12 @@
 package translator
 
 import (
+	"errors"
 	"fmt"
 
+	k8stypes "k8s.io/apimachinery/pkg/types"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
 	"github.com/kong/kubernetes-ingress-controller/v3/internal/dataplane/translator/subtranslator"
 	"github.com/kong/kubernetes-ingress-controller/v3/internal/gatewayapi"
 )
@@ -21,6 +25,7 @@ func (t *Translator) ingressRulesFromGRPCRoutes() ingressRules {
 		t.logger.Error(err, "Failed to list GRPCRoutes")
 		return result
 	}
+	grpcRouteList = excludeTenantPolicyViolations(t, grpcRouteList)
 
 	if t.featureFlags.ExpressionRoutes {
 		t.ingressRulesFromGRPCRoutesUsingExpressionRoutes(grpcRouteList, &result)
@@ -29,6 +34,12 @@ func (t *Translator) ingressRulesFromGRPCRoutes() ingressRules {
 
 	var errs []error
 	for _, grpcRoute := range grpcRouteList {
+		if err := subtranslator.ValidateGRPCRoute(grpcRoute, false); err != nil {
+			errs = append(errs, err)
+			t.registerTranslationFailure(err.Error(), grpcRoute)
+			continue
+		}
+
 		if err := t.ingressRulesFromGRPCRoute(&result, grpcRoute); err != nil {
 			err = fmt.Errorf("GRPCRoute %s/%s can't be routed: %w", grpcRoute.Namespace, grpcRoute.Name, err)
 			errs = append(errs, err)
@@ -56,12 +67,18 @@ func (t *Translator) ingressRulesFromGRPCRoute(result *ingressRules, grpcroute *
 		// Create a service and attach the routes to it. Protocol for Service can be set via K8s object annotation
 		// "konghq.com/protocol", by default use "grpcs" to not break existing behavior when annotation is not specified.
 		service, err := generateKongServiceFromBackendRefWithRuleNumber(
-			t.logger, t.storer, result, grpcroute, ruleNumber, "grpcs", grpcBackendRefsToBackendRefs(rule.BackendRefs)...,
+			t.logger, t.storer, t.featureFlags, result, grpcroute, ruleNumber, "grpcs", grpcBackendRefsToBackendRefs(rule.BackendRefs)...,
 		)
 		if err != nil {
 			return err
 		}
-		service.Routes = append(service.Routes, subtranslator.GenerateKongRoutesFromGRPCRouteRule(grpcroute, ruleNumber)...)
+		routes := subtranslator.GenerateKongRoutesFromGRPCRouteRule(grpcroute, ruleNumber)
+		for i := range routes {
+			if err := subtranslator.SetGRPCRoutePlugins(&routes[i], rule.Filters, routes[i].Tags); err != nil {
+				return fmt.Errorf("failed to translate filters of rule %d: %w", ruleNumber, err)
+			}
+		}
+		service.Routes = append(service.Routes, routes...)
 
 		// cache the service to avoid duplicates in further loop iterations
 		result.ServiceNameToServices[*service.Service.Name] = service
@@ -82,19 +99,36 @@ func (t *Translator) ingressRulesFromGRPCRoutesUsingExpressionRoutes(grpcRoutes
 	// after they are translated, register the success event in the translator.
 	translatedGRPCRoutes := []*gatewayapi.GRPCRoute{}
 	for _, grpcRoute := range grpcRoutes {
+		if err := subtranslator.ValidateGRPCRoute(grpcRoute, true); err != nil {
+			t.logger.Error(err, "Could not generate route from GRPCRoute")
+			t.registerTranslationFailure(err.Error(), grpcRoute)
+			continue
+		}
 		splitGRPCRouteMatches = append(splitGRPCRouteMatches, subtranslator.SplitGRPCRoute(grpcRoute)...)
 		translatedGRPCRoutes = append(translatedGRPCRoutes, grpcRoute)
 	}
 
 	// assign priorities to split GRPCRoutes.
 	splitGRPCRouteMatchesWithPriorities := subtranslator.AssignRoutePriorityToSplitGRPCRouteMatches(t.logger, splitGRPCRouteMatches)
+	grpcRouteNameToTranslationFailure := map[k8stypes.NamespacedName][]error{}
 	// generate Kong service and route from each split GRPC route with its assigned priority of Kong route.
 	for _, splitGRPCRouteMatchWithPriority := range splitGRPCRouteMatchesWithPriorities {
-		t.ingressRulesFromGRPCRouteWithPriority(result, splitGRPCRouteMatchWithPriority)
+		if err := t.ingressRulesFromGRPCRouteWithPriority(result, splitGRPCRouteMatchWithPriority); err != nil {
+			nsName := client.ObjectKeyFromObject(splitGRPCRouteMatchWithPriority.Match.Source)
+			grpcRouteNameToTranslationFailure[nsName] = append(grpcRouteNameToTranslationFailure[nsName], err)
+		}
 	}
 
-	// register successful translation of GRPCRoutes.
+	// register successful translation of GRPCRoutes and translation failures.
+	// Because one GRPCRoute may be split into multiple GRPCRoutes, we need to de-duplicate by namespace and name.
 	for _, grpcRoute := range translatedGRPCRoutes {
+		if translationFailures, ok := grpcRouteNameToTranslationFailure[client.ObjectKeyFromObject(grpcRoute)]; ok {
+			t.registerTranslationFailure(
+				fmt.Sprintf("GRPCRoute can't be routed: %v", errors.Join(translationFailures...)),
+				grpcRoute,
+			)
+			continue
+		}
 		t.registerSuccessfullyTranslatedObject(grpcRoute)
 	}
 }
@@ -102,7 +136,7 @@ func (t *Translator) ingressRulesFromGRPCRoutesUsingExpressionRoutes(grpcRoutes
 func (t *Translator) ingressRulesFromGRPCRouteWithPriority(
 	rules *ingressRules,
 	splitGRPCRouteMatchWithPriority subtranslator.SplitGRPCRouteMatchToPriority,
-) {
+) error {
 	match := splitGRPCRouteMatchWithPriority.Match
 	grpcRoute := splitGRPCRouteMatchWithPriority.Match.Source
 	// (very unlikely that) the rule index split from the source GRPCRoute is larger then length of original rules.
@@ -110,7 +144,7 @@ func (t *Translator) ingressRulesFromGRPCRouteWithPriority(
 		t.logger.Error(nil, "Split rule index is greater than the length of rules in source GRPCRoute",
 			"rule_index", match.RuleIndex,
 			"rule_count", len(grpcRoute.Spec.Rules))
-		return
+		return nil
 	}
 	grpcRouteRule := grpcRoute.Spec.Rules[match.RuleIndex]
 
@@ -121,19 +155,24 @@ func (t *Translator) ingressRulesFromGRPCRouteWithPriority(
 	kongService, _ := generateKongServiceFromBackendRefWithName(
 		t.logger,
 		t.storer,
+		t.featureFlags,
 		rules,
 		serviceName,
 		grpcRoute,
 		"grpcs",
 		grpcBackendRefsToBackendRefs(grpcRouteRule.BackendRefs)...,
 	)
-	kongService.Routes = append(
-		kongService.Routes,
-		subtranslator.KongExpressionRouteFromSplitGRPCRouteMatchWithPriority(splitGRPCRouteMatchWithPriority),
-	)
+	route := subtranslator.KongExpressionRouteFromSplitGRPCRouteMatchWithPriority(splitGRPCRouteMatchWithPriority)
+	// the route is not generated without the plugins translated from its filters, as it would route requests
+	// without applying them.
+	if err := subtranslator.SetGRPCRoutePlugins(&route, grpcRouteRule.Filters, route.Tags); err != nil {
+		return fmt.Errorf("failed to translate filters of rule %d: %w", match.RuleIndex, err)
+	}
+	kongService.Routes = append(kongService.Routes, route)
 	// cache the service to avoid duplicates in further loop iterations
-	rules.ServiceNameToServices[serviceName] = kongService
-	rules.ServiceNameToParent[serviceName] = grpcRoute
+	rules.ServiceNameToServices[*kongService.Service.Name] = kongService
+	rules.ServiceNameToParent[*kongService.Service.Name] = kongService.Parent
+	return nil
 }
 
 func grpcBackendRefsToBackendRefs(grpcBackendRef []gatewayapi.GRPCBackendRef) []gatewayapi.BackendRef {
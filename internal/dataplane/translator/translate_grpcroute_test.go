@@ -466,3 +466,125 @@ func TestIngressRulesFromGRPCRoutesUsingExpressionRoutes(t *testing.T) {
 
 	}
 }
+
+func TestIngressRulesFromGRPCRoutesWithFilters(t *testing.T) {
+	newGRPCRoute := func(name string, filters ...gatewayapi.GRPCRouteFilter) *gatewayapi.GRPCRoute {
+		return &gatewayapi.GRPCRoute{
+			TypeMeta: gatewayapi.GRPCRouteTypeMeta,
+			ObjectMeta: metav1.ObjectMeta{
+				Namespace: "default",
+				Name:      name,
+			},
+			Spec: gatewayapi.GRPCRouteSpec{
+				Rules: []gatewayapi.GRPCRouteRule{
+					{
+						Matches: []gatewayapi.GRPCRouteMatch{
+							{Method: &gatewayapi.GRPCMethodMatch{Service: lo.ToPtr("v1"), Method: lo.ToPtr("foo")}},
+						},
+						Filters: filters,
+						BackendRefs: []gatewayapi.GRPCBackendRef{
+							{BackendRef: builder.NewBackendRef("service0").WithPort(80).Build()},
+						},
+					},
+				},
+			},
+		}
+	}
+	grpcRoutes := []*gatewayapi.GRPCRoute{
+		newGRPCRoute("grpcroute-filters",
+			gatewayapi.GRPCRouteFilter{
+				Type: gatewayapi.GRPCRouteFilterRequestHeaderModifier,
+				RequestHeaderModifier: &gatewayapi.HTTPHeaderFilter{
+					Set: []gatewayapi.HTTPHeader{{Name: "x-foo", Value: "bar"}},
+				},
+			},
+			gatewayapi.GRPCRouteFilter{
+				Type: gatewayapi.GRPCRouteFilterExtensionRef,
+				ExtensionRef: &gatewayapi.LocalObjectReference{
+					Group: "configuration.konghq.com",
+					Kind:  "KongPlugin",
+					Name:  "auth",
+				},
+			},
+		),
+		newGRPCRoute("grpcroute-mirror",
+			gatewayapi.GRPCRouteFilter{
+				Type: gatewayapi.GRPCRouteFilterRequestMirror,
+				RequestMirror: &gatewayapi.HTTPRequestMirrorFilter{
+					BackendRef: gatewayapi.BackendObjectReference{Name: "service0", Port: lo.ToPtr(gatewayapi.PortNumber(80))},
+				},
+			},
+		),
+	}
+
+	for _, expressionRoutes := range []bool{false, true} {
+		t.Run("expression routes "+strconv.FormatBool(expressionRoutes), func(t *testing.T) {
+			fakestore, err := store.NewFakeStore(store.FakeObjects{
+				GRPCRoutes: grpcRoutes,
+				Services: []*corev1.Service{
+					{ObjectMeta: metav1.ObjectMeta{Namespace: "default", Name: "service0"}},
+				},
+			})
+			require.NoError(t, err)
+			translator := mustNewTranslator(t, fakestore)
+			translator.featureFlags.ExpressionRoutes = expressionRoutes
+
+			result := translator.ingressRulesFromGRPCRoutes()
+			require.Len(t, result.ServiceNameToServices, 1)
+			for _, service := range result.ServiceNameToServices {
+				require.Len(t, service.Routes, 1)
+				route := service.Routes[0]
+				require.Equal(t, "auth", route.Ingress.Annotations["konghq.com/plugins"])
+				require.Len(t, route.Plugins, 1)
+				require.Equal(t, "request-transformer", *route.Plugins[0].Name)
+			}
+
+			translationFailures := translator.failuresCollector.PopResourceFailures()
+			require.Len(t, translationFailures, 1)
+			require.Equal(t, "grpcroute-mirror", translationFailures[0].CausingObjects()[0].GetName())
+			require.Contains(t, translationFailures[0].Message(), "rules[0].filters[0]: filter type is not supported: RequestMirror")
+		})
+	}
+}
+
+func TestIngressRulesFromGRPCRouteWithPriority_FilterTranslationFailure(t *testing.T) {
+	grpcRoute := &gatewayapi.GRPCRoute{
+		TypeMeta: gatewayapi.GRPCRouteTypeMeta,
+		ObjectMeta: metav1.ObjectMeta{
+			Namespace: "default",
+			Name:      "grpcroute-mirror",
+		},
+		Spec: gatewayapi.GRPCRouteSpec{
+			Rules: []gatewayapi.GRPCRouteRule{
+				{
+					Filters: []gatewayapi.GRPCRouteFilter{
+						{
+							Type: gatewayapi.GRPCRouteFilterRequestMirror,
+							RequestMirror: &gatewayapi.HTTPRequestMirrorFilter{
+								BackendRef: gatewayapi.BackendObjectReference{Name: "service0", Port: lo.ToPtr(gatewayapi.PortNumber(80))},
+							},
+						},
+					},
+					BackendRefs: []gatewayapi.GRPCBackendRef{
+						{BackendRef: builder.NewBackendRef("service0").WithPort(80).Build()},
+					},
+				},
+			},
+		},
+	}
+	fakestore, err := store.NewFakeStore(store.FakeObjects{
+		Services: []*corev1.Service{
+			{ObjectMeta: metav1.ObjectMeta{Namespace: "default", Name: "service0"}},
+		},
+	})
+	require.NoError(t, err)
+	translator := mustNewTranslator(t, fakestore)
+	translator.featureFlags.ExpressionRoutes = true
+
+	result := newIngressRules()
+	err = translator.ingressRulesFromGRPCRouteWithPriority(&result, subtranslator.SplitGRPCRouteMatchToPriority{
+		Match: subtranslator.SplitGRPCRouteMatch{Source: grpcRoute},
+	})
+	require.ErrorContains(t, err, "failed to translate filters of rule 0")
+	require.Empty(t, result.ServiceNameToServices, "route should not be generated without the plugins of its filters")
+}
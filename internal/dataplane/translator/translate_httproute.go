@@ -3,17 +3,22 @@ package translator
 import (
 	"errors"
 	"fmt"
+	"slices"
 	"strings"
 	"time"
 
 	"github.com/kong/go-kong/kong"
 	"github.com/samber/lo"
 	k8stypes "k8s.io/apimachinery/pkg/types"
+	"k8s.io/apimachinery/pkg/util/sets"
+	"sigs.k8s.io/controller-runtime/pkg/client"
 
 	"github.com/kong/kubernetes-ingress-controller/v3/internal/dataplane/kongstate"
 	"github.com/kong/kubernetes-ingress-controller/v3/internal/dataplane/translator/subtranslator"
 	"github.com/kong/kubernetes-ingress-controller/v3/internal/gatewayapi"
+	"github.com/kong/kubernetes-ingress-controller/v3/internal/manager/featuregates"
 	"github.com/kong/kubernetes-ingress-controller/v3/internal/util"
+	incubatorv1alpha1 "github.com/kong/kubernetes-ingress-controller/v3/pkg/apis/incubator/v1alpha1"
 )
 
 // -----------------------------------------------------------------------------
@@ -30,15 +35,22 @@ func (t *Translator) ingressRulesFromHTTPRoutes() ingressRules {
 		t.logger.Error(err, "Failed to list HTTPRoutes")
 		return result
 	}
+	httpRouteList = excludeTenantPolicyViolations(t, httpRouteList)
+	httpRouteList = excludeHostnameOwnershipViolations(t, httpRouteList)
 
 	httpRoutesToTranslate := make([]*gatewayapi.HTTPRoute, 0, len(httpRouteList))
 	for _, httproute := range httpRouteList {
 		// Validate each HTTPRoute before translating and register translation failures if an HTTPRoute is invalid.
-		if err := validateHTTPRoute(httproute, t.featureFlags); err != nil {
+		if err := validateHTTPRoute(httproute); err != nil {
 			t.registerTranslationFailure(fmt.Sprintf("HTTPRoute can't be routed: %v", err), httproute)
 			continue
 		}
-		httpRoutesToTranslate = append(httpRoutesToTranslate, httproute)
+		if !t.featureFlags.RequestMirror && hasRequestMirrorFilters(httproute) {
+			t.registerTranslationFailure(fmt.Sprintf("HTTPRoute can't be routed: %s filters require the %s feature gate to be enabled",
+				gatewayapi.HTTPRouteFilterRequestMirror, featuregates.RequestMirror), httproute)
+			continue
+		}
+		httpRoutesToTranslate = append(httpRoutesToTranslate, t.dropUnresolvedRequestMirrorFilters(httproute))
 	}
 
 	if t.featureFlags.ExpressionRoutes {
@@ -69,10 +81,11 @@ func (t *Translator) ingressRulesFromHTTPRoute(result *ingressRules, httproute *
 		serviceName := kongServiceTranslation.Name
 
 		// create a service and attach the routes to it
-		service, err := generateKongServiceFromBackendRefWithName(t.logger, t.storer, result, serviceName, httproute, "http", backendRefs...)
+		service, err := generateKongServiceFromBackendRefWithName(t.logger, t.storer, t.featureFlags, result, serviceName, httproute, "http", backendRefs...)
 		if err != nil {
 			return err
 		}
+		service.SessionPersistence = kongServiceTranslation.SessionPersistence
 
 		// generate the routes for the service and attach them to the service
 		for _, kongRouteTranslation := range kongServiceTranslation.KongRoutes {
@@ -85,7 +98,7 @@ func (t *Translator) ingressRulesFromHTTPRoute(result *ingressRules, httproute *
 
 		// cache the service to avoid duplicates in further loop iterations
 		result.ServiceNameToServices[*service.Service.Name] = service
-		result.ServiceNameToParent[serviceName] = httproute
+		result.ServiceNameToParent[*service.Service.Name] = service.Parent
 	}
 	applyTimeoutsToService(httproute, result)
 	return nil
@@ -118,23 +131,298 @@ func applyTimeoutsToService(httpRoute *gatewayapi.HTTPRoute, rules *ingressRules
 		return
 	}
 
+	// Services generated for KongServiceFacades have the KongServiceFacade as their parent instead of the HTTPRoute.
+	serviceFacades := httpRouteServiceFacades(httpRoute)
+
 	// due rules.ServiceNameToServices is a map, we need to iterate over the map to find the service
 	// which has the same parent as the HTTPRoute.
 	for serviceName, service := range rules.ServiceNameToServices {
-		if service.Parent.GetObjectKind() == httpRoute.GetObjectKind() && service.Parent.GetName() == httpRoute.Name && service.Parent.GetNamespace() == httpRoute.Namespace {
-			// Due to only one field being available in the Gateway API to control this behavior,
-			// when users set `spec.rules[].timeouts` in HTTPRoute,
-			// KIC will also set ReadTimeout, WriteTimeout and ConnectTimeout for the service to this value
-			// https://github.com/Kong/kubernetes-ingress-controller/issues/4914#issuecomment-1813964669
-			service.Service.ReadTimeout = kong.Int(backendRequestTimeout)
-			service.Service.ConnectTimeout = kong.Int(backendRequestTimeout)
-			service.Service.WriteTimeout = kong.Int(backendRequestTimeout)
-			rules.ServiceNameToServices[serviceName] = service
+		if serviceFacade, ok := service.Parent.(*incubatorv1alpha1.KongServiceFacade); ok {
+			if !serviceFacades.Has(client.ObjectKeyFromObject(serviceFacade)) {
+				continue
+			}
+			// The service of a KongServiceFacade is shared by all the routes using it. When they set different
+			// timeouts, the longest one is used so that none of the routes' requests time out too early.
+			if currentTimeout := service.Service.ReadTimeout; currentTimeout != nil &&
+				*currentTimeout != DefaultServiceTimeout && *currentTimeout > backendRequestTimeout {
+				continue
+			}
+		} else if service.Parent.GetObjectKind() != httpRoute.GetObjectKind() ||
+			service.Parent.GetName() != httpRoute.Name || service.Parent.GetNamespace() != httpRoute.Namespace {
+			continue
+		}
+
+		// Due to only one field being available in the Gateway API to control this behavior,
+		// when users set `spec.rules[].timeouts` in HTTPRoute,
+		// KIC will also set ReadTimeout, WriteTimeout and ConnectTimeout for the service to this value
+		// https://github.com/Kong/kubernetes-ingress-controller/issues/4914#issuecomment-1813964669
+		service.Service.ReadTimeout = kong.Int(backendRequestTimeout)
+		service.Service.ConnectTimeout = kong.Int(backendRequestTimeout)
+		service.Service.WriteTimeout = kong.Int(backendRequestTimeout)
+		rules.ServiceNameToServices[serviceName] = service
+	}
+}
+
+// httpRouteServiceFacades returns the KongServiceFacades referenced by the HTTPRoute's backendRefs.
+func httpRouteServiceFacades(httpRoute *gatewayapi.HTTPRoute) sets.Set[k8stypes.NamespacedName] {
+	serviceFacades := sets.New[k8stypes.NamespacedName]()
+	for _, rule := range httpRoute.Spec.Rules {
+		for _, backendRef := range rule.BackendRefs {
+			if !util.IsBackendRefKongServiceFacade(backendRef.Group, backendRef.Kind) {
+				continue
+			}
+			namespace := httpRoute.Namespace
+			if backendRef.Namespace != nil && *backendRef.Namespace != "" {
+				namespace = string(*backendRef.Namespace)
+			}
+			serviceFacades.Insert(k8stypes.NamespacedName{Namespace: namespace, Name: string(backendRef.Name)})
 		}
 	}
+	return serviceFacades
 }
 
-func validateHTTPRoute(httproute *gatewayapi.HTTPRoute, featureFlags FeatureFlags) error {
+// dropUnresolvedRequestMirrorFilters returns the HTTPRoute without the RequestMirror filters whose backends do not
+// exist, are not supported, do not serve plain HTTP or are not permitted by a ReferenceGrant. Similarly to backendRefs, such filters are
+// skipped rather than failing the whole rule, as the mirrored traffic is not essential for routing requests.
+// The HTTPRoute controller reports these backends in the ResolvedRefs condition.
+func (t *Translator) dropUnresolvedRequestMirrorFilters(httproute *gatewayapi.HTTPRoute) *gatewayapi.HTTPRoute {
+	if !hasRequestMirrorFilters(httproute) {
+		return httproute
+	}
+
+	grants, err := t.storer.ListReferenceGrants()
+	if err != nil {
+		t.logger.Error(err, "Failed to list ReferenceGrants, skipping RequestMirror filters")
+	}
+	allowed := GetPermittedForReferenceGrantFrom(gatewayapi.ReferenceGrantFrom{
+		Group:     gatewayapi.Group(httproute.GetObjectKind().GroupVersionKind().Group),
+		Kind:      gatewayapi.Kind(httproute.GetObjectKind().GroupVersionKind().Kind),
+		Namespace: gatewayapi.Namespace(httproute.Namespace),
+	}, grants)
+
+	isResolved := func(filter gatewayapi.HTTPRouteFilter) bool {
+		if filter.Type != gatewayapi.HTTPRouteFilterRequestMirror {
+			return true
+		}
+		if filter.RequestMirror == nil || err != nil {
+			return false
+		}
+		backendRef := gatewayapi.BackendRef{BackendObjectReference: filter.RequestMirror.BackendRef}
+		// Group and Kind are defaulted by the API server, but make sure they're set before checking ReferenceGrants.
+		if backendRef.Group == nil {
+			backendRef.Group = lo.ToPtr(gatewayapi.Group(""))
+		}
+		if backendRef.Kind == nil {
+			backendRef.Kind = lo.ToPtr(gatewayapi.Kind("Service"))
+		}
+		logger := loggerForBackendRef(t.logger, httproute, backendRef)
+		if !util.IsBackendRefGroupKindSupported(backendRef.Group, backendRef.Kind) {
+			logger.Error(nil, "Object requested RequestMirror backendRef to target, but its kind is not supported, skipping...")
+			return false
+		}
+		namespace := httproute.Namespace
+		if backendRef.Namespace != nil && *backendRef.Namespace != "" {
+			namespace = string(*backendRef.Namespace)
+		}
+		service, err := t.storer.GetService(namespace, string(backendRef.Name))
+		if err != nil {
+			logger.Error(err, "Object requested RequestMirror backendRef to target, but it does not exist, skipping...")
+			return false
+		}
+		if err := util.ValidateServicePortServesHTTP(service, backendRef.Port); err != nil {
+			logger.Error(err, "Object requested RequestMirror backendRef to target, but requests can't be mirrored to it, skipping...")
+			return false
+		}
+		if !gatewayapi.NewRefCheckerForRoute(httproute, backendRef).IsRefAllowedByGrant(allowed) {
+			logger.Error(nil, "Object requested RequestMirror backendRef to target, but no ReferenceGrant permits it, skipping...")
+			return false
+		}
+		return true
+	}
+
+	resolved := httproute.DeepCopy()
+	for i, rule := range resolved.Spec.Rules {
+		resolved.Spec.Rules[i].Filters = lo.Filter(rule.Filters, func(f gatewayapi.HTTPRouteFilter, _ int) bool {
+			return isResolved(f)
+		})
+	}
+	return resolved
+}
+
+// hasRequestMirrorFilters returns true if any rule of the HTTPRoute has a RequestMirror filter.
+func hasRequestMirrorFilters(httproute *gatewayapi.HTTPRoute) bool {
+	return lo.ContainsBy(httproute.Spec.Rules, func(rule gatewayapi.HTTPRouteRule) bool {
+		return lo.ContainsBy(rule.Filters, func(f gatewayapi.HTTPRouteFilter) bool {
+			return f.Type == gatewayapi.HTTPRouteFilterRequestMirror
+		})
+	})
+}
+
+// dropRequestMirrorPluginsConflictingWithPostFunctions removes the post-function plugins generated for RequestMirror
+// filters from the routes affected by post-function plugins configured by users. Kong allows a single post-function
+// plugin per route, and the generated one would take precedence over the ones configured for the service or globally,
+// so mirroring is skipped for these routes and a translation failure is reported for their HTTPRoutes.
+func (t *Translator) dropRequestMirrorPluginsConflictingWithPostFunctions(ks *kongstate.KongState) {
+	userPostFunctions := lo.Filter(ks.Plugins, func(p kongstate.Plugin, _ int) bool {
+		return lo.FromPtr(p.Name) == subtranslator.RequestMirrorPluginName && p.Consumer == nil && p.ConsumerGroup == nil
+	})
+	if len(userPostFunctions) == 0 {
+		return
+	}
+
+	var httpRoutes []*gatewayapi.HTTPRoute
+	for i := range ks.Services {
+		service := &ks.Services[i]
+		for j := range service.Routes {
+			route := &service.Routes[j]
+			if route.Ingress.GroupVersionKind.Kind != "HTTPRoute" {
+				continue
+			}
+			mirrorPluginIdx := slices.IndexFunc(route.Plugins, func(p kong.Plugin) bool {
+				return lo.FromPtr(p.Name) == subtranslator.RequestMirrorPluginName
+			})
+			if mirrorPluginIdx == -1 {
+				continue
+			}
+			conflicting, found := lo.Find(userPostFunctions, func(p kongstate.Plugin) bool {
+				switch {
+				case p.Route != nil:
+					return lo.FromPtr(p.Route.ID) == lo.FromPtr(route.Name)
+				case p.Service != nil:
+					return lo.FromPtr(p.Service.ID) == lo.FromPtr(service.Name)
+				default:
+					return true
+				}
+			})
+			if !found {
+				continue
+			}
+			route.Plugins = slices.Delete(route.Plugins, mirrorPluginIdx, mirrorPluginIdx+1)
+
+			if httpRoutes == nil {
+				var err error
+				if httpRoutes, err = t.storer.ListHTTPRoutes(); err != nil {
+					t.logger.Error(err, "Failed to list HTTPRoutes")
+				}
+			}
+			httpRoute, ok := lo.Find(httpRoutes, func(r *gatewayapi.HTTPRoute) bool {
+				return r.Namespace == route.Ingress.Namespace && r.Name == route.Ingress.Name
+			})
+			if !ok {
+				continue
+			}
+			conflictingName := conflicting.K8sParent.GetName()
+			if conflicting.K8sParent.GetNamespace() != "" {
+				conflictingName = conflicting.K8sParent.GetNamespace() + "/" + conflictingName
+			}
+			t.registerTranslationFailure(fmt.Sprintf("%s filters are not applied to Kong route %s: it already has the %s plugin %s",
+				gatewayapi.HTTPRouteFilterRequestMirror, lo.FromPtr(route.Name),
+				subtranslator.RequestMirrorPluginName, conflictingName,
+			), httpRoute)
+		}
+	}
+}
+
+// mergeQueryParamMatchPluginsWithPreFunctions merges the pre-function plugins generated to match query params on
+// traditional routes with the pre-function plugins configured by users for these routes. Kong allows a single
+// pre-function plugin per route, and the generated one would take precedence over the ones configured for the service
+// or globally, so the code matching query params is run first by the plugin that applies to the route.
+func mergeQueryParamMatchPluginsWithPreFunctions(ks *kongstate.KongState) {
+	var userPreFunctionIdxs []int
+	for i, p := range ks.Plugins {
+		if lo.FromPtr(p.Name) == queryParamMatchPluginName && p.Consumer == nil && p.ConsumerGroup == nil {
+			userPreFunctionIdxs = append(userPreFunctionIdxs, i)
+		}
+	}
+	if len(userPreFunctionIdxs) == 0 {
+		return
+	}
+
+	for i := range ks.Services {
+		service := &ks.Services[i]
+		for j := range service.Routes {
+			route := &service.Routes[j]
+			if route.Ingress.GroupVersionKind.Kind != "HTTPRoute" {
+				continue
+			}
+			matchPluginIdx := slices.IndexFunc(route.Plugins, func(p kong.Plugin) bool {
+				return lo.FromPtr(p.Name) == queryParamMatchPluginName
+			})
+			if matchPluginIdx == -1 {
+				continue
+			}
+
+			// Route plugins take precedence over service plugins, which take precedence over global ones.
+			var (
+				userPluginIdx = -1
+				isRoutePlugin bool
+			)
+			for _, idx := range userPreFunctionIdxs {
+				p := ks.Plugins[idx]
+				switch {
+				case p.Route != nil:
+					if lo.FromPtr(p.Route.ID) == lo.FromPtr(route.Name) {
+						userPluginIdx, isRoutePlugin = idx, true
+					}
+				case p.Service != nil:
+					if lo.FromPtr(p.Service.ID) == lo.FromPtr(service.Name) && !isRoutePlugin {
+						userPluginIdx = idx
+					}
+				default:
+					if userPluginIdx == -1 {
+						userPluginIdx = idx
+					}
+				}
+			}
+			if userPluginIdx == -1 {
+				continue
+			}
+
+			userPlugin := &ks.Plugins[userPluginIdx]
+			config := mergePreFunctionConfigs(route.Plugins[matchPluginIdx].Config, userPlugin.Plugin)
+			if isRoutePlugin {
+				// The user's plugin is already attached to the route, it's extended with the generated code.
+				userPlugin.Config = config
+				userPlugin.Enabled = kong.Bool(true)
+				route.Plugins = slices.Delete(route.Plugins, matchPluginIdx, matchPluginIdx+1)
+				continue
+			}
+			route.Plugins[matchPluginIdx].Config = config
+		}
+	}
+}
+
+// mergePreFunctionConfigs returns the configuration of the user's pre-function plugin running the access phase code
+// of the generated pre-function plugin before its own. When the user's plugin is disabled, the configuration of the
+// generated plugin is returned.
+func mergePreFunctionConfigs(generated kong.Configuration, user kong.Plugin) kong.Configuration {
+	if user.Enabled != nil && !*user.Enabled {
+		return kong.Configuration{"access": preFunctionAccessCode(generated)}
+	}
+	merged := user.Config.DeepCopy()
+	if merged == nil {
+		merged = kong.Configuration{}
+	}
+	merged["access"] = append(preFunctionAccessCode(generated), preFunctionAccessCode(user.Config)...)
+	return merged
+}
+
+// preFunctionAccessCode returns the access phase code chunks of a pre-function plugin configuration.
+func preFunctionAccessCode(config kong.Configuration) []string {
+	switch access := config["access"].(type) {
+	case []string:
+		return slices.Clone(access)
+	case []interface{}:
+		return lo.FilterMap(access, func(chunk interface{}, _ int) (string, bool) {
+			s, ok := chunk.(string)
+			return s, ok
+		})
+	default:
+		return nil
+	}
+}
+
+func validateHTTPRoute(httproute *gatewayapi.HTTPRoute) error {
 	spec := httproute.Spec
 
 	// validation for HTTPRoutes will happen at a higher layer, but in spite of that we run
@@ -145,15 +433,9 @@ func validateHTTPRoute(httproute *gatewayapi.HTTPRoute, featureFlags FeatureFlag
 		return subtranslator.ErrRouteValidationNoRules
 	}
 
-	// Kong supports query parameter match only with expression router,
-	// so we return error when query param match is specified and expression router is not enabled in the translator.
-	if !featureFlags.ExpressionRoutes {
-		for _, rule := range spec.Rules {
-			for _, match := range rule.Matches {
-				if len(match.QueryParams) > 0 {
-					return subtranslator.ErrRouteValidationQueryParamMatchesUnsupported
-				}
-			}
+	for _, rule := range spec.Rules {
+		if err := kongstate.ValidateSessionPersistence(rule.SessionPersistence); err != nil {
+			return err
 		}
 	}
 
@@ -166,6 +448,7 @@ func validateHTTPRoute(httproute *gatewayapi.HTTPRoute, featureFlags FeatureFlag
 // We need to split the HTTPRoutes into ones with only one hostname and one match, then assign priority to them
 // and finally translate the split HTTPRoutes into Kong services and routes with assigned priorities.
 func (t *Translator) ingressRulesFromHTTPRoutesUsingExpressionRoutes(httpRoutes []*gatewayapi.HTTPRoute, result *ingressRules) {
+	reportInvalidRoutePriorities(t, httpRoutes)
 	// first, split HTTPRoutes by hostnames and matches.
 	splitHTTPRouteMatches := []subtranslator.SplitHTTPRouteMatch{}
 	for _, httproute := range httpRoutes {
@@ -306,6 +589,14 @@ func generateKongRoutesFromHTTPRouteMatches(
 		r.Route.Headers = headers
 	}
 
+	// Traditional routes can't match on query params, so they're matched by a plugin instead.
+	// As with headers, the matches are guaranteed to share query params.
+	if len(matches[0].QueryParams) > 0 {
+		plugin := generateQueryParamMatchKongPlugin(matches[0].QueryParams)
+		plugin.Tags = tags
+		r.Plugins = append(r.Plugins, plugin)
+	}
+
 	// stripPath needs to be disabled by default to be conformant with the Gateway API
 	r.StripPath = kong.Bool(false)
 
@@ -438,6 +729,49 @@ func generateKongRoutePathFromHTTPRouteMatch(match gatewayapi.HTTPRouteMatch) []
 	return []string{""} // unreachable code
 }
 
+// queryParamMatchLuaTemplate is the Lua code of the pre-function plugin matching query params of requests
+// routed by traditional Kong routes. Requests not matching the query params are rejected the same way Kong rejects
+// requests not matching any route.
+const queryParamMatchLuaTemplate = `local matches = { %s }
+for _, m in ipairs(matches) do
+  local value = kong.request.get_query_arg(m.name)
+  if type(value) == "table" then
+    value = value[1]
+  end
+  local matched = type(value) == "string"
+  if matched and m.regex then
+    matched = ngx.re.find(value, m.value, "jo") ~= nil
+  elseif matched then
+    matched = value == m.value
+  end
+  if not matched then
+    return kong.response.exit(404, { message = "no Route matched with those values" })
+  end
+end`
+
+// queryParamMatchPluginName is the name of the plugin generated for query param matches of traditional routes.
+const queryParamMatchPluginName = "pre-function"
+
+// generateQueryParamMatchKongPlugin generates a pre-function plugin rejecting requests that don't match
+// the given query params. Please note that unlike with the expressions router, requests not matching
+// the query params don't fall through to other routes.
+func generateQueryParamMatchKongPlugin(queryParams []gatewayapi.HTTPQueryParamMatch) kong.Plugin {
+	// If multiple entries specify equivalent query param names, only the first one must be considered.
+	queryParams = lo.UniqBy(queryParams, func(qp gatewayapi.HTTPQueryParamMatch) gatewayapi.HTTPHeaderName {
+		return qp.Name
+	})
+	luaMatches := lo.Map(queryParams, func(qp gatewayapi.HTTPQueryParamMatch, _ int) string {
+		isRegex := qp.Type != nil && *qp.Type == gatewayapi.QueryParamMatchRegularExpression
+		return fmt.Sprintf("{ name = %q, value = %q, regex = %t }", qp.Name, qp.Value, isRegex)
+	})
+	return kong.Plugin{
+		Name: kong.String(queryParamMatchPluginName),
+		Config: kong.Configuration{
+			"access": []string{fmt.Sprintf(queryParamMatchLuaTemplate, strings.Join(luaMatches, ", "))},
+		},
+	}
+}
+
 func generateKongstateHTTPRoute(routeName string, ingressObjectInfo util.K8sObjectInfo, hostnames []*string) kongstate.Route {
 	// build the route object using the method and pathing information
 	r := kongstate.Route{
@@ -488,6 +822,7 @@ func (t *Translator) ingressRulesFromSplitHTTPRouteMatchWithPriority(
 	kongService, err := generateKongServiceFromBackendRefWithName(
 		t.logger,
 		t.storer,
+		t.featureFlags,
 		rules,
 		serviceName,
 		httpRoute,
@@ -497,6 +832,7 @@ func (t *Translator) ingressRulesFromSplitHTTPRouteMatchWithPriority(
 	if err != nil {
 		return err
 	}
+	kongService.SessionPersistence = rule.SessionPersistence
 
 	additionalRoutes, err := subtranslator.KongExpressionRouteFromHTTPRouteMatchWithPriority(httpRouteMatchWithPriority)
 	if err != nil {
@@ -508,7 +844,7 @@ func (t *Translator) ingressRulesFromSplitHTTPRouteMatchWithPriority(
 		*additionalRoutes,
 	)
 	// cache the service to avoid duplicates in further loop iterations
-	rules.ServiceNameToServices[serviceName] = kongService
-	rules.ServiceNameToParent[serviceName] = httpRoute
+	rules.ServiceNameToServices[*kongService.Service.Name] = kongService
+	rules.ServiceNameToParent[*kongService.Service.Name] = kongService.Parent
 	return nil
 }
@@ -1,6 +1,8 @@
 package translator
 
 import (
+	"fmt"
+	"slices"
 	"testing"
 
 	"github.com/go-logr/zapr"
@@ -16,6 +18,7 @@ import (
 	"sigs.k8s.io/controller-runtime/pkg/client"
 	gatewayv1beta1 "sigs.k8s.io/gateway-api/apis/v1beta1"
 
+	"github.com/kong/kubernetes-ingress-controller/v3/internal/annotations"
 	"github.com/kong/kubernetes-ingress-controller/v3/internal/dataplane/failures"
 	"github.com/kong/kubernetes-ingress-controller/v3/internal/dataplane/kongstate"
 	"github.com/kong/kubernetes-ingress-controller/v3/internal/dataplane/translator/subtranslator"
@@ -23,6 +26,8 @@ import (
 	"github.com/kong/kubernetes-ingress-controller/v3/internal/store"
 	"github.com/kong/kubernetes-ingress-controller/v3/internal/util"
 	"github.com/kong/kubernetes-ingress-controller/v3/internal/util/builder"
+	kongv1 "github.com/kong/kubernetes-ingress-controller/v3/pkg/apis/configuration/v1"
+	incubatorv1alpha1 "github.com/kong/kubernetes-ingress-controller/v3/pkg/apis/incubator/v1alpha1"
 )
 
 // httprouteGVK is the GVK for HTTPRoutes, needed in unit tests because
@@ -44,10 +49,9 @@ type testCaseIngressRulesFromHTTPRoutes struct {
 
 func TestValidateHTTPRoute(t *testing.T) {
 	testCases := []struct {
-		name             string
-		httpRoute        *gatewayapi.HTTPRoute
-		expressionRoutes bool
-		expectedError    error
+		name          string
+		httpRoute     *gatewayapi.HTTPRoute
+		expectedError error
 	}{
 		{
 			name: "valid HTTPRoute should pass the validation",
@@ -69,8 +73,7 @@ func TestValidateHTTPRoute(t *testing.T) {
 					}},
 				},
 			},
-			expressionRoutes: false,
-			expectedError:    nil,
+			expectedError: nil,
 		},
 		{
 			name: "HTTPRoute with no rules should not pass the validation",
@@ -87,11 +90,10 @@ func TestValidateHTTPRoute(t *testing.T) {
 					},
 				},
 			},
-			expressionRoutes: false,
-			expectedError:    subtranslator.ErrRouteValidationNoRules,
+			expectedError: subtranslator.ErrRouteValidationNoRules,
 		},
 		{
-			name: "HTTPRoute with query param match should pass validation with expression routes",
+			name: "HTTPRoute with query param match should pass validation",
 			httpRoute: &gatewayapi.HTTPRoute{
 				ObjectMeta: metav1.ObjectMeta{
 					Name:      "httproute-query-param-match",
@@ -111,42 +113,82 @@ func TestValidateHTTPRoute(t *testing.T) {
 					}},
 				},
 			},
-			expressionRoutes: true,
-			expectedError:    nil,
+			expectedError: nil,
 		},
 		{
-			name: "HTTPRoute with query param match should not pass validation when expression routes disabled",
+			name: "HTTPRoute with session persistence should pass validation",
 			httpRoute: &gatewayapi.HTTPRoute{
 				ObjectMeta: metav1.ObjectMeta{
-					Name:      "httproute-query-param-match",
+					Name:      "httproute-session-persistence",
 					Namespace: corev1.NamespaceDefault,
 				},
 				Spec: gatewayapi.HTTPRouteSpec{
 					CommonRouteSpec: commonRouteSpecMock("fake-gateway-1"),
-					Hostnames: []gatewayapi.Hostname{
-						"konghq.com",
-						"www.konghq.com",
-					},
 					Rules: []gatewayapi.HTTPRouteRule{{
 						BackendRefs: []gatewayapi.HTTPBackendRef{
 							builder.NewHTTPBackendRef("fake-service").WithPort(80).Build(),
 						},
-						Matches: builder.NewHTTPRouteMatch().WithQueryParam("foo", "bar").ToSlice(),
+						SessionPersistence: &gatewayapi.SessionPersistence{
+							SessionName: lo.ToPtr("session"),
+							CookieConfig: &gatewayapi.CookieConfig{
+								LifetimeType: lo.ToPtr(gatewayapi.CookieLifetimeType("Session")),
+							},
+						},
+					}},
+				},
+			},
+			expectedError: nil,
+		},
+		{
+			name: "HTTPRoute with session persistence timeouts should not pass validation",
+			httpRoute: &gatewayapi.HTTPRoute{
+				ObjectMeta: metav1.ObjectMeta{
+					Name:      "httproute-session-persistence-timeouts",
+					Namespace: corev1.NamespaceDefault,
+				},
+				Spec: gatewayapi.HTTPRouteSpec{
+					CommonRouteSpec: commonRouteSpecMock("fake-gateway-1"),
+					Rules: []gatewayapi.HTTPRouteRule{{
+						BackendRefs: []gatewayapi.HTTPBackendRef{
+							builder.NewHTTPBackendRef("fake-service").WithPort(80).Build(),
+						},
+						SessionPersistence: &gatewayapi.SessionPersistence{
+							IdleTimeout: lo.ToPtr(gatewayapi.Duration("10m")),
+						},
 					}},
 				},
 			},
-			expressionRoutes: false,
-			expectedError:    subtranslator.ErrRouteValidationQueryParamMatchesUnsupported,
+			expectedError: subtranslator.ErrRouteValidationSessionPersistenceTimeoutsUnsupported,
+		},
+		{
+			name: "HTTPRoute with session persistence permanent cookie should not pass validation",
+			httpRoute: &gatewayapi.HTTPRoute{
+				ObjectMeta: metav1.ObjectMeta{
+					Name:      "httproute-session-persistence-permanent-cookie",
+					Namespace: corev1.NamespaceDefault,
+				},
+				Spec: gatewayapi.HTTPRouteSpec{
+					CommonRouteSpec: commonRouteSpecMock("fake-gateway-1"),
+					Rules: []gatewayapi.HTTPRouteRule{{
+						BackendRefs: []gatewayapi.HTTPBackendRef{
+							builder.NewHTTPBackendRef("fake-service").WithPort(80).Build(),
+						},
+						SessionPersistence: &gatewayapi.SessionPersistence{
+							CookieConfig: &gatewayapi.CookieConfig{
+								LifetimeType: lo.ToPtr(gatewayapi.PermanentCookieLifetimeType),
+							},
+						},
+					}},
+				},
+			},
+			expectedError: subtranslator.ErrRouteValidationSessionPersistencePermanentCookieUnsupported,
 		},
 	}
 
 	for _, tc := range testCases {
 		tc := tc
 		t.Run(tc.name, func(t *testing.T) {
-			featureFlags := FeatureFlags{
-				ExpressionRoutes: tc.expressionRoutes,
-			}
-			err := validateHTTPRoute(tc.httpRoute, featureFlags)
+			err := validateHTTPRoute(tc.httpRoute)
 			if tc.expectedError == nil {
 				require.NoError(t, err, "should pass the validation")
 			} else {
@@ -2168,6 +2210,45 @@ func TestIngressRulesFromHTTPRoutesUsingExpressionRoutes(t *testing.T) {
 	}
 }
 
+func TestIngressRulesFromHTTPRoutesUsingExpressionRoutes_InvalidRoutePriority(t *testing.T) {
+	httpRoute := &gatewayapi.HTTPRoute{
+		TypeMeta: metav1.TypeMeta{Kind: "HTTPRoute", APIVersion: gatewayv1beta1.GroupVersion.String()},
+		ObjectMeta: metav1.ObjectMeta{
+			Namespace: "default",
+			Name:      "httproute-1",
+			Annotations: map[string]string{
+				"konghq.com/route-priority": "-1",
+			},
+		},
+		Spec: gatewayapi.HTTPRouteSpec{
+			Rules: []gatewayapi.HTTPRouteRule{
+				{
+					Matches: []gatewayapi.HTTPRouteMatch{
+						builder.NewHTTPRouteMatch().WithPathExact("/v1/foo").Build(),
+					},
+					BackendRefs: []gatewayapi.HTTPBackendRef{
+						builder.NewHTTPBackendRef("service1").WithPort(80).Build(),
+					},
+				},
+			},
+		},
+	}
+	fakestore, err := store.NewFakeStore(store.FakeObjects{})
+	require.NoError(t, err)
+	translator := mustNewTranslator(t, fakestore)
+	translator.featureFlags.ExpressionRoutes = true
+
+	result := newIngressRules()
+	translator.ingressRulesFromHTTPRoutesUsingExpressionRoutes([]*gatewayapi.HTTPRoute{httpRoute}, &result)
+	require.Len(t, result.ServiceNameToServices, 1, "HTTPRoute should still be translated")
+
+	translationFailures := translator.failuresCollector.PopResourceFailures()
+	require.Len(t, translationFailures, 1)
+	require.Equal(t, `invalid konghq.com/route-priority annotation "-1": must be an integer between 0 and 1023, ignoring it`,
+		translationFailures[0].Message())
+	require.Equal(t, []client.Object{httpRoute}, translationFailures[0].CausingObjects())
+}
+
 func TestIngressRulesFromSplitHTTPRouteMatchWithPriority(t *testing.T) {
 	httpRouteTypeMeta := metav1.TypeMeta{Kind: "HTTPRoute", APIVersion: gatewayv1beta1.GroupVersion.String()}
 
@@ -2559,3 +2640,520 @@ func commonRouteSpecMock(parentReferentName string) gatewayapi.CommonRouteSpec {
 		}},
 	}
 }
+
+func TestDropUnresolvedRequestMirrorFilters(t *testing.T) {
+	mirrorFilter := func(namespace *gatewayapi.Namespace, name string) gatewayapi.HTTPRouteFilter {
+		return gatewayapi.HTTPRouteFilter{
+			Type: gatewayapi.HTTPRouteFilterRequestMirror,
+			RequestMirror: &gatewayapi.HTTPRequestMirrorFilter{
+				BackendRef: gatewayapi.BackendObjectReference{
+					Group:     lo.ToPtr(gatewayapi.Group("")),
+					Kind:      lo.ToPtr(gatewayapi.Kind("Service")),
+					Namespace: namespace,
+					Name:      gatewayapi.ObjectName(name),
+					Port:      lo.ToPtr(gatewayapi.PortNumber(80)),
+				},
+			},
+		}
+	}
+	headerFilter := gatewayapi.HTTPRouteFilter{
+		Type: gatewayapi.HTTPRouteFilterRequestHeaderModifier,
+		RequestHeaderModifier: &gatewayapi.HTTPHeaderFilter{
+			Add: []gatewayapi.HTTPHeader{{Name: "foo", Value: "bar"}},
+		},
+	}
+	httproute := &gatewayapi.HTTPRoute{
+		TypeMeta: metav1.TypeMeta{
+			APIVersion: gatewayapi.GroupVersion.String(),
+			Kind:       "HTTPRoute",
+		},
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      "route",
+			Namespace: "default",
+		},
+		Spec: gatewayapi.HTTPRouteSpec{
+			Rules: []gatewayapi.HTTPRouteRule{
+				{
+					Filters: []gatewayapi.HTTPRouteFilter{
+						headerFilter,
+						mirrorFilter(nil, "mirror"),
+						mirrorFilter(nil, "missing"),
+						mirrorFilter(lo.ToPtr(gatewayapi.Namespace("granted")), "mirror"),
+						mirrorFilter(lo.ToPtr(gatewayapi.Namespace("not-granted")), "mirror"),
+						mirrorFilter(nil, "mirror-https"),
+						mirrorFilter(nil, "mirror-h2c"),
+						mirrorFilter(nil, "mirror-other-port"),
+					},
+				},
+			},
+		},
+	}
+
+	fakestore, err := store.NewFakeStore(store.FakeObjects{
+		Services: []*corev1.Service{
+			mirrorService("default", "mirror", nil, corev1.ServicePort{Port: 80}),
+			mirrorService("granted", "mirror", nil, corev1.ServicePort{Port: 80}),
+			mirrorService("not-granted", "mirror", nil, corev1.ServicePort{Port: 80}),
+			mirrorService("default", "mirror-https", map[string]string{
+				annotations.AnnotationPrefix + annotations.ProtocolKey: "https",
+			}, corev1.ServicePort{Port: 80}),
+			mirrorService("default", "mirror-h2c", nil, corev1.ServicePort{Port: 80, AppProtocol: lo.ToPtr("kubernetes.io/h2c")}),
+			mirrorService("default", "mirror-other-port", nil, corev1.ServicePort{Port: 8080}),
+		},
+		ReferenceGrants: []*gatewayapi.ReferenceGrant{
+			{
+				ObjectMeta: metav1.ObjectMeta{Name: "grant", Namespace: "granted"},
+				Spec: gatewayapi.ReferenceGrantSpec{
+					From: []gatewayapi.ReferenceGrantFrom{
+						{Group: gatewayapi.V1Group, Kind: "HTTPRoute", Namespace: "default"},
+					},
+					To: []gatewayapi.ReferenceGrantTo{
+						{Group: "", Kind: "Service"},
+					},
+				},
+			},
+		},
+	})
+	require.NoError(t, err)
+	translator := mustNewTranslator(t, fakestore)
+
+	resolved := translator.dropUnresolvedRequestMirrorFilters(httproute)
+	require.Len(t, resolved.Spec.Rules, 1)
+	assert.Equal(t, []gatewayapi.HTTPRouteFilter{
+		headerFilter,
+		mirrorFilter(nil, "mirror"),
+		mirrorFilter(lo.ToPtr(gatewayapi.Namespace("granted")), "mirror"),
+	}, resolved.Spec.Rules[0].Filters)
+	assert.Len(t, httproute.Spec.Rules[0].Filters, 8, "the original HTTPRoute should not be modified")
+}
+
+func mirrorService(namespace, name string, anns map[string]string, ports ...corev1.ServicePort) *corev1.Service {
+	return &corev1.Service{
+		ObjectMeta: metav1.ObjectMeta{Name: name, Namespace: namespace, Annotations: anns},
+		Spec:       corev1.ServiceSpec{Ports: ports},
+	}
+}
+
+func TestIngressRulesFromHTTPRoutes_RequestMirrorFeatureGate(t *testing.T) {
+	httproute := &gatewayapi.HTTPRoute{
+		TypeMeta: metav1.TypeMeta{
+			APIVersion: gatewayapi.GroupVersion.String(),
+			Kind:       "HTTPRoute",
+		},
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      "route",
+			Namespace: "default",
+		},
+		Spec: gatewayapi.HTTPRouteSpec{
+			Rules: []gatewayapi.HTTPRouteRule{
+				{
+					Matches: builder.NewHTTPRouteMatch().WithPathPrefix("/mirrored").ToSlice(),
+					BackendRefs: []gatewayapi.HTTPBackendRef{
+						builder.NewHTTPBackendRef("fake-service").WithPort(80).Build(),
+					},
+					Filters: []gatewayapi.HTTPRouteFilter{
+						{
+							Type: gatewayapi.HTTPRouteFilterRequestMirror,
+							RequestMirror: &gatewayapi.HTTPRequestMirrorFilter{
+								BackendRef: gatewayapi.BackendObjectReference{
+									Group: lo.ToPtr(gatewayapi.Group("")),
+									Kind:  lo.ToPtr(gatewayapi.Kind("Service")),
+									Name:  "mirror",
+									Port:  lo.ToPtr(gatewayapi.PortNumber(80)),
+								},
+							},
+						},
+					},
+				},
+			},
+		},
+	}
+	fakestore, err := store.NewFakeStore(store.FakeObjects{
+		HTTPRoutes: []*gatewayapi.HTTPRoute{httproute},
+		Services: []*corev1.Service{
+			mirrorService("default", "mirror", nil, corev1.ServicePort{Port: 80}),
+		},
+	})
+	require.NoError(t, err)
+
+	t.Run("disabled", func(t *testing.T) {
+		translator := mustNewTranslator(t, fakestore)
+		rules := translator.ingressRulesFromHTTPRoutes()
+		require.Empty(t, rules.ServiceNameToServices)
+		failures := translator.failuresCollector.PopResourceFailures()
+		require.Len(t, failures, 1)
+		require.Equal(t, "HTTPRoute can't be routed: RequestMirror filters require the RequestMirror feature gate to be enabled",
+			failures[0].Message())
+	})
+
+	t.Run("enabled", func(t *testing.T) {
+		translator := mustNewTranslator(t, fakestore)
+		translator.featureFlags.RequestMirror = true
+		rules := translator.ingressRulesFromHTTPRoutes()
+		require.Empty(t, translator.failuresCollector.PopResourceFailures())
+		require.Len(t, rules.ServiceNameToServices, 1)
+		for _, service := range rules.ServiceNameToServices {
+			require.Len(t, service.Routes, 1)
+			require.Len(t, service.Routes[0].Plugins, 1)
+			require.Equal(t, subtranslator.RequestMirrorPluginName, *service.Routes[0].Plugins[0].Name)
+		}
+	})
+}
+
+func TestDropRequestMirrorPluginsConflictingWithPostFunctions(t *testing.T) {
+	httproute := &gatewayapi.HTTPRoute{
+		TypeMeta: metav1.TypeMeta{
+			APIVersion: gatewayapi.GroupVersion.String(),
+			Kind:       "HTTPRoute",
+		},
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      "route",
+			Namespace: "default",
+		},
+	}
+	postFunction := &kongv1.KongPlugin{
+		ObjectMeta: metav1.ObjectMeta{Name: "post-function", Namespace: "default"},
+		PluginName: "post-function",
+	}
+	routeInfo := util.FromK8sObject(httproute)
+	newRoute := func(name string) kongstate.Route {
+		return kongstate.Route{
+			Route: kong.Route{Name: kong.String(name)},
+			Plugins: []kong.Plugin{
+				{Name: kong.String("request-transformer")},
+				{Name: kong.String(subtranslator.RequestMirrorPluginName)},
+			},
+			Ingress: routeInfo,
+		}
+	}
+	newKongState := func(plugins ...kongstate.Plugin) *kongstate.KongState {
+		return &kongstate.KongState{
+			Services: []kongstate.Service{
+				{
+					Service: kong.Service{Name: kong.String("service-1")},
+					Routes:  []kongstate.Route{newRoute("route-1"), newRoute("route-2")},
+				},
+				{
+					Service: kong.Service{Name: kong.String("service-2")},
+					Routes:  []kongstate.Route{newRoute("route-3")},
+				},
+			},
+			Plugins: plugins,
+		}
+	}
+	pluginNames := func(route kongstate.Route) []string {
+		return lo.Map(route.Plugins, func(p kong.Plugin, _ int) string { return *p.Name })
+	}
+	mirrored := []string{"request-transformer", subtranslator.RequestMirrorPluginName}
+	notMirrored := []string{"request-transformer"}
+
+	testCases := []struct {
+		name                 string
+		plugins              []kongstate.Plugin
+		expectedRoutePlugins [][]string
+		expectedFailures     int
+	}{
+		{
+			name: "post-function plugin on a route",
+			plugins: []kongstate.Plugin{
+				{Plugin: kong.Plugin{Name: kong.String("post-function"), Route: &kong.Route{ID: kong.String("route-1")}}, K8sParent: postFunction},
+			},
+			expectedRoutePlugins: [][]string{notMirrored, mirrored, mirrored},
+			expectedFailures:     1,
+		},
+		{
+			name: "post-function plugin on a service",
+			plugins: []kongstate.Plugin{
+				{Plugin: kong.Plugin{Name: kong.String("post-function"), Service: &kong.Service{ID: kong.String("service-1")}}, K8sParent: postFunction},
+			},
+			expectedRoutePlugins: [][]string{notMirrored, notMirrored, mirrored},
+			expectedFailures:     2,
+		},
+		{
+			name: "global post-function plugin",
+			plugins: []kongstate.Plugin{
+				{Plugin: kong.Plugin{Name: kong.String("post-function")}, K8sParent: postFunction},
+			},
+			expectedRoutePlugins: [][]string{notMirrored, notMirrored, notMirrored},
+			expectedFailures:     3,
+		},
+		{
+			name: "post-function plugin on a consumer and other plugins on routes",
+			plugins: []kongstate.Plugin{
+				{Plugin: kong.Plugin{Name: kong.String("post-function"), Consumer: &kong.Consumer{ID: kong.String("consumer")}}, K8sParent: postFunction},
+				{Plugin: kong.Plugin{Name: kong.String("key-auth"), Route: &kong.Route{ID: kong.String("route-1")}}, K8sParent: postFunction},
+			},
+			expectedRoutePlugins: [][]string{mirrored, mirrored, mirrored},
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			fakestore, err := store.NewFakeStore(store.FakeObjects{
+				HTTPRoutes: []*gatewayapi.HTTPRoute{httproute},
+			})
+			require.NoError(t, err)
+			translator := mustNewTranslator(t, fakestore)
+
+			ks := newKongState(tc.plugins...)
+			translator.dropRequestMirrorPluginsConflictingWithPostFunctions(ks)
+			routes := slices.Concat(ks.Services[0].Routes, ks.Services[1].Routes)
+			require.Equal(t, tc.expectedRoutePlugins, lo.Map(routes, func(r kongstate.Route, _ int) []string { return pluginNames(r) }))
+			require.Len(t, translator.failuresCollector.PopResourceFailures(), tc.expectedFailures)
+		})
+	}
+}
+
+func TestMergeQueryParamMatchPluginsWithPreFunctions(t *testing.T) {
+	routeInfo := util.FromK8sObject(&gatewayapi.HTTPRoute{
+		TypeMeta: metav1.TypeMeta{
+			APIVersion: gatewayapi.GroupVersion.String(),
+			Kind:       "HTTPRoute",
+		},
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      "route",
+			Namespace: "default",
+		},
+	})
+	matchPlugin := func() kong.Plugin {
+		return kong.Plugin{
+			Name:   kong.String(queryParamMatchPluginName),
+			Config: kong.Configuration{"access": []string{"match()"}},
+		}
+	}
+	newKongState := func(plugins ...kongstate.Plugin) *kongstate.KongState {
+		return &kongstate.KongState{
+			Services: []kongstate.Service{
+				{
+					Service: kong.Service{Name: kong.String("service-1")},
+					Routes: []kongstate.Route{
+						{Route: kong.Route{Name: kong.String("route-1")}, Plugins: []kong.Plugin{matchPlugin()}, Ingress: routeInfo},
+						{Route: kong.Route{Name: kong.String("route-2")}, Ingress: routeInfo},
+					},
+				},
+				{
+					Service: kong.Service{Name: kong.String("service-2")},
+					Routes: []kongstate.Route{
+						{Route: kong.Route{Name: kong.String("route-3")}, Plugins: []kong.Plugin{matchPlugin()}, Ingress: routeInfo},
+					},
+				},
+			},
+			Plugins: plugins,
+		}
+	}
+	userPreFunction := func(config kong.Configuration) kong.Plugin {
+		return kong.Plugin{Name: kong.String("pre-function"), Config: config}
+	}
+
+	t.Run("pre-function plugin on a route", func(t *testing.T) {
+		plugin := userPreFunction(kong.Configuration{"access": []interface{}{"user()"}, "header_filter": []interface{}{"filter()"}})
+		plugin.Route = &kong.Route{ID: kong.String("route-1")}
+		ks := newKongState(kongstate.Plugin{Plugin: plugin})
+
+		mergeQueryParamMatchPluginsWithPreFunctions(ks)
+		require.Empty(t, ks.Services[0].Routes[0].Plugins, "generated plugin should be merged into the user's one")
+		require.Equal(t, kong.Configuration{
+			"access":        []string{"match()", "user()"},
+			"header_filter": []interface{}{"filter()"},
+		}, ks.Plugins[0].Config)
+		require.Equal(t, []kong.Plugin{matchPlugin()}, ks.Services[1].Routes[0].Plugins)
+	})
+
+	t.Run("pre-function plugins on a service and globally", func(t *testing.T) {
+		servicePlugin := userPreFunction(kong.Configuration{"access": []interface{}{"service()"}})
+		servicePlugin.Service = &kong.Service{ID: kong.String("service-1")}
+		globalPlugin := userPreFunction(kong.Configuration{"access": []interface{}{"global()"}})
+		ks := newKongState(kongstate.Plugin{Plugin: globalPlugin}, kongstate.Plugin{Plugin: servicePlugin})
+
+		mergeQueryParamMatchPluginsWithPreFunctions(ks)
+		require.Equal(t, kong.Configuration{"access": []string{"match()", "service()"}}, ks.Services[0].Routes[0].Plugins[0].Config)
+		require.Equal(t, kong.Configuration{"access": []string{"match()", "global()"}}, ks.Services[1].Routes[0].Plugins[0].Config)
+		require.Equal(t, kong.Configuration{"access": []interface{}{"service()"}}, ks.Plugins[1].Config, "user's plugin should not be modified")
+	})
+
+	t.Run("disabled pre-function plugin on a route", func(t *testing.T) {
+		plugin := userPreFunction(kong.Configuration{"access": []interface{}{"user()"}})
+		plugin.Route = &kong.Route{ID: kong.String("route-1")}
+		plugin.Enabled = kong.Bool(false)
+		ks := newKongState(kongstate.Plugin{Plugin: plugin})
+
+		mergeQueryParamMatchPluginsWithPreFunctions(ks)
+		require.Empty(t, ks.Services[0].Routes[0].Plugins)
+		require.Equal(t, kong.Configuration{"access": []string{"match()"}}, ks.Plugins[0].Config)
+		require.True(t, *ks.Plugins[0].Enabled)
+	})
+
+	t.Run("pre-function plugin on a consumer", func(t *testing.T) {
+		plugin := userPreFunction(kong.Configuration{"access": []interface{}{"user()"}})
+		plugin.Consumer = &kong.Consumer{ID: kong.String("consumer")}
+		ks := newKongState(kongstate.Plugin{Plugin: plugin})
+
+		mergeQueryParamMatchPluginsWithPreFunctions(ks)
+		require.Equal(t, []kong.Plugin{matchPlugin()}, ks.Services[0].Routes[0].Plugins)
+		require.Equal(t, []kong.Plugin{matchPlugin()}, ks.Services[1].Routes[0].Plugins)
+	})
+}
+
+func TestIngressRulesFromHTTPRoute_SessionPersistence(t *testing.T) {
+	sessionPersistence := &gatewayapi.SessionPersistence{
+		SessionName: lo.ToPtr("session"),
+	}
+	httproute := &gatewayapi.HTTPRoute{
+		TypeMeta: metav1.TypeMeta{
+			APIVersion: gatewayapi.GroupVersion.String(),
+			Kind:       "HTTPRoute",
+		},
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      "route",
+			Namespace: "default",
+		},
+		Spec: gatewayapi.HTTPRouteSpec{
+			Rules: []gatewayapi.HTTPRouteRule{
+				{
+					Matches: builder.NewHTTPRouteMatch().WithPathPrefix("/sticky").ToSlice(),
+					BackendRefs: []gatewayapi.HTTPBackendRef{
+						builder.NewHTTPBackendRef("fake-service").WithPort(80).Build(),
+					},
+					SessionPersistence: sessionPersistence,
+				},
+				{
+					Matches: builder.NewHTTPRouteMatch().WithPathPrefix("/not-sticky").ToSlice(),
+					BackendRefs: []gatewayapi.HTTPBackendRef{
+						builder.NewHTTPBackendRef("fake-service").WithPort(80).Build(),
+					},
+				},
+			},
+		},
+	}
+
+	fakestore, err := store.NewFakeStore(store.FakeObjects{})
+	require.NoError(t, err)
+	translator := mustNewTranslator(t, fakestore)
+
+	result := newIngressRules()
+	require.NoError(t, translator.ingressRulesFromHTTPRoute(&result, httproute))
+
+	// Rules with the same backendRefs but different session persistence are translated to separate services,
+	// as session persistence is configured on the services' upstreams.
+	require.Len(t, result.ServiceNameToServices, 2)
+	sticky, ok := result.ServiceNameToServices["httproute.default.route.0"]
+	require.True(t, ok)
+	assert.Equal(t, sessionPersistence, sticky.SessionPersistence)
+	notSticky, ok := result.ServiceNameToServices["httproute.default.route.1"]
+	require.True(t, ok)
+	assert.Nil(t, notSticky.SessionPersistence)
+}
+
+func TestGenerateKongRouteFromTranslation_QueryParamMatches(t *testing.T) {
+	httproute := &gatewayapi.HTTPRoute{
+		TypeMeta: metav1.TypeMeta{
+			APIVersion: gatewayapi.GroupVersion.String(),
+			Kind:       "HTTPRoute",
+		},
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      "route",
+			Namespace: "default",
+		},
+	}
+	translation := subtranslator.KongRouteTranslation{
+		Name: "httproute.default.route.0.0",
+		Matches: builder.NewHTTPRouteMatch().
+			WithPathPrefix("/foo").
+			WithQueryParam("version", "v1").
+			WithQueryParamRegex("user", "^a.*").
+			WithQueryParam("version", "v2").
+			ToSlice(),
+	}
+
+	routes, err := GenerateKongRouteFromTranslation(httproute, translation, false)
+	require.NoError(t, err)
+	require.Len(t, routes, 1)
+	route := routes[0]
+	assert.Equal(t, kong.StringSlice("~/foo$", "/foo/"), route.Paths)
+	require.Len(t, route.Plugins, 1)
+	plugin := route.Plugins[0]
+	assert.Equal(t, "pre-function", *plugin.Name)
+	assert.Equal(t, route.Tags, plugin.Tags)
+	access, ok := plugin.Config["access"].([]string)
+	require.True(t, ok)
+	require.Len(t, access, 1)
+	// Only the first entry of query params with the same name is considered.
+	assert.Contains(t, access[0],
+		`local matches = { { name = "version", value = "v1", regex = false }, { name = "user", value = "^a.*", regex = true } }`,
+	)
+}
+
+func TestIngressRulesFromHTTPRoutes_TimeoutsWithKongServiceFacade(t *testing.T) {
+	httpRouteWithTimeout := func(name string, timeout gatewayapi.Duration) *gatewayapi.HTTPRoute {
+		return &gatewayapi.HTTPRoute{
+			TypeMeta: metav1.TypeMeta{
+				APIVersion: gatewayapi.GroupVersion.String(),
+				Kind:       "HTTPRoute",
+			},
+			ObjectMeta: metav1.ObjectMeta{
+				Name:      name,
+				Namespace: corev1.NamespaceDefault,
+			},
+			Spec: gatewayapi.HTTPRouteSpec{
+				Rules: []gatewayapi.HTTPRouteRule{
+					{
+						Matches: builder.NewHTTPRouteMatch().WithPathPrefix("/" + name).ToSlice(),
+						BackendRefs: []gatewayapi.HTTPBackendRef{
+							builder.NewHTTPBackendRef("facade").
+								WithGroup(incubatorv1alpha1.GroupVersion.Group).
+								WithKind(incubatorv1alpha1.KongServiceFacadeKind).
+								Build(),
+						},
+						Timeouts: &gatewayapi.HTTPRouteTimeouts{BackendRequest: &timeout},
+					},
+				},
+			},
+		}
+	}
+	fakestore, err := store.NewFakeStore(store.FakeObjects{
+		HTTPRoutes: []*gatewayapi.HTTPRoute{
+			httpRouteWithTimeout("short", "5s"),
+			httpRouteWithTimeout("long", "10s"),
+		},
+		Services: []*corev1.Service{
+			{ObjectMeta: metav1.ObjectMeta{Name: "svc", Namespace: corev1.NamespaceDefault}},
+		},
+		KongServiceFacades: []*incubatorv1alpha1.KongServiceFacade{
+			{
+				TypeMeta: metav1.TypeMeta{
+					Kind:       incubatorv1alpha1.KongServiceFacadeKind,
+					APIVersion: incubatorv1alpha1.GroupVersion.String(),
+				},
+				ObjectMeta: metav1.ObjectMeta{
+					Name:      "facade",
+					Namespace: corev1.NamespaceDefault,
+					Annotations: map[string]string{
+						annotations.IngressClassKey: annotations.DefaultIngressClass,
+					},
+				},
+				Spec: incubatorv1alpha1.KongServiceFacadeSpec{
+					Backend: incubatorv1alpha1.KongServiceFacadeBackend{Name: "svc", Port: 80},
+				},
+			},
+		},
+	})
+	require.NoError(t, err)
+
+	for _, expressionRoutes := range []bool{false, true} {
+		t.Run(fmt.Sprintf("expression routes: %t", expressionRoutes), func(t *testing.T) {
+			translator := mustNewTranslator(t, fakestore)
+			translator.featureFlags.KongServiceFacade = true
+			translator.featureFlags.ExpressionRoutes = expressionRoutes
+
+			rules := translator.ingressRulesFromHTTPRoutes()
+			require.Empty(t, translator.failuresCollector.PopResourceFailures())
+			require.Len(t, rules.ServiceNameToServices, 1)
+			service := rules.ServiceNameToServices["default.facade.svc.facade"]
+			require.NotNil(t, service.Service.Name, "the KongServiceFacade's service should be generated")
+			// The service is shared by both HTTPRoutes, so the longest timeout is used.
+			require.Equal(t, 10000, *service.Service.ReadTimeout)
+			require.Equal(t, 10000, *service.Service.ConnectTimeout)
+			require.Equal(t, 10000, *service.Service.WriteTimeout)
+		})
+	}
+}
@@ -22,7 +22,8 @@ import (
 func (t *Translator) ingressRulesFromIngressV1() ingressRules {
 	result := newIngressRules()
 
-	ingressList := t.storer.ListIngressesV1()
+	ingressList := excludeTenantPolicyViolations(t, t.storer.ListIngressesV1())
+	ingressList = excludeHostnameOwnershipViolations(t, ingressList)
 	icp, err := getIngressClassParametersOrDefault(t.storer)
 	if err != nil {
 		if !errors.As(err, &store.NotFoundError{}) {
@@ -68,6 +69,10 @@ func (t *Translator) ingressRulesFromIngressV1() ingressRules {
 		result.ServiceNameToServices[*service.Name] = service
 		result.ServiceNameToParent[*service.Name] = service.Parent
 	}
+	if t.featureFlags.ExpressionRoutes {
+		reportInvalidRoutePriorities(t, ingressList)
+		reportExpressionRoutePriorityConflicts(t, result.ServiceNameToServices, ingressList)
+	}
 
 	// Add a default backend if it exists.
 	defaultBackendService, ok := getDefaultBackendService(t.storer, t.failuresCollector, allDefaultBackends, t.featureFlags)
@@ -22,6 +22,7 @@ func (t *Translator) ingressRulesFromTCPIngressV1beta1() ingressRules {
 		t.logger.Error(err, "Failed to list TCPIngresses")
 		return result
 	}
+	ingressList = excludeTenantPolicyViolations(t, ingressList)
 
 	sort.SliceStable(ingressList, func(i, j int) bool {
 		return ingressList[i].CreationTimestamp.Before(
@@ -112,6 +113,7 @@ func (t *Translator) ingressRulesFromUDPIngressV1beta1() ingressRules {
 		t.logger.Error(err, "Failed to list UDPIngresses")
 		return result
 	}
+	ingressList = excludeTenantPolicyViolations(t, ingressList)
 
 	sort.SliceStable(ingressList, func(i, j int) bool {
 		return ingressList[i].CreationTimestamp.Before(&ingressList[j].CreationTimestamp)
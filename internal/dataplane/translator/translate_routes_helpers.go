@@ -73,6 +73,61 @@ func generateKongRoutesFromRouteRule[T tRoute, TRule tRouteRule](
 	}, nil
 }
 
+// GenerateKongRoutesFromL4Route converts all rules of a TCPRoute, UDPRoute or TLSRoute to Kong routes
+// destined to gwPorts. It rejects the same routes the translator does, so it can be used to validate
+// the route before it gets translated.
+func GenerateKongRoutesFromL4Route[T tRoute](
+	route T,
+	gwPorts []gatewayapi.PortNumber,
+	expressionRoutes bool,
+) ([]kongstate.Route, error) {
+	var (
+		routes []kongstate.Route
+		err    error
+	)
+	switch r := any(route).(type) {
+	case *gatewayapi.TCPRoute:
+		if err = validateTCPRoute(r); err == nil {
+			routes, err = generateKongRoutesFromRouteRules(r, gwPorts, r.Spec.Rules)
+		}
+	case *gatewayapi.UDPRoute:
+		if err = validateUDPRoute(r); err == nil {
+			routes, err = generateKongRoutesFromRouteRules(r, gwPorts, r.Spec.Rules)
+		}
+	case *gatewayapi.TLSRoute:
+		// TLSRoute matches based on hostname with Gateway listener thus passing gwPorts is pointless.
+		if err = validateTLSRoute(r); err == nil {
+			routes, err = generateKongRoutesFromRouteRules(r, nil, r.Spec.Rules)
+		}
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	if expressionRoutes {
+		for i := range routes {
+			applyExpressionToL4Route(&routes[i])
+		}
+	}
+	return routes, nil
+}
+
+func generateKongRoutesFromRouteRules[T tRoute, TRule tRouteRule](
+	route T,
+	gwPorts []gatewayapi.PortNumber,
+	rules []TRule,
+) ([]kongstate.Route, error) {
+	var routes []kongstate.Route
+	for ruleNumber, rule := range rules {
+		ruleRoutes, err := generateKongRoutesFromRouteRule(route, gwPorts, ruleNumber, rule)
+		if err != nil {
+			return nil, err
+		}
+		routes = append(routes, ruleRoutes...)
+	}
+	return routes, nil
+}
+
 // routeToKongRoute converts Gateway Route to kong.Route.
 func routeToKongRoute[TRoute tTCPorUDPorTLSRoute](
 	r TRoute,
@@ -182,16 +237,26 @@ func (t *Translator) getGatewayListeningPorts(
 			continue // Skip when attached Gateway is not found.
 		}
 
-		// Get explicitly referenced Gateway listening ports by ParentReference configuration.
-		// If no sectionName is specified, all ports are used (according to the specification
-		// "When unspecified (empty string), this will reference the entire resource." - see
-		// https://github.com/kubernetes-sigs/gateway-api/blob/ebe9f31ef27819c3b29f698a3e9b91d279453c59/apis/v1/shared_types.go#L107).
-		gwPorts = append(gwPorts, lo.FilterMap(gw.Spec.Listeners, func(l gatewayapi.Listener, _ int) (gatewayapi.PortNumber, bool) {
-			if (pr.SectionName == nil || *pr.SectionName == l.Name) && protocol == l.Protocol {
-				return l.Port, true
-			}
-			return 0, false
-		})...)
+		gwPorts = append(gwPorts, GatewayListenerPortsForParentRef(gw, protocol, pr)...)
 	}
 	return gwPorts
 }
+
+// GatewayListenerPortsForParentRef returns the ports of the Gateway's listeners using the given protocol
+// that are referenced by the provided ParentReference.
+func GatewayListenerPortsForParentRef(
+	gw *gatewayapi.Gateway,
+	protocol gatewayapi.ProtocolType,
+	pr gatewayapi.ParentReference,
+) []gatewayapi.PortNumber {
+	// Get explicitly referenced Gateway listening ports by ParentReference configuration.
+	// If no sectionName is specified, all ports are used (according to the specification
+	// "When unspecified (empty string), this will reference the entire resource." - see
+	// https://github.com/kubernetes-sigs/gateway-api/blob/ebe9f31ef27819c3b29f698a3e9b91d279453c59/apis/v1/shared_types.go#L107).
+	return lo.FilterMap(gw.Spec.Listeners, func(l gatewayapi.Listener, _ int) (gatewayapi.PortNumber, bool) {
+		if (pr.SectionName == nil || *pr.SectionName == l.Name) && protocol == l.Protocol {
+			return l.Port, true
+		}
+		return 0, false
+	})
+}
@@ -9,6 +9,7 @@ import (
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 
 	"github.com/kong/kubernetes-ingress-controller/v3/internal/dataplane/kongstate"
+	"github.com/kong/kubernetes-ingress-controller/v3/internal/dataplane/translator/subtranslator"
 	"github.com/kong/kubernetes-ingress-controller/v3/internal/gatewayapi"
 	"github.com/kong/kubernetes-ingress-controller/v3/internal/util"
 )
@@ -327,3 +328,75 @@ func TestGenerateKongRoutesFromRouteRule_TLS(t *testing.T) {
 		})
 	}
 }
+
+func TestGenerateKongRoutesFromL4Route(t *testing.T) {
+	backendRefs := []gatewayapi.BackendRef{
+		{
+			BackendObjectReference: gatewayapi.BackendObjectReference{
+				Name: "backend",
+				Port: lo.ToPtr(gatewayapi.PortNumber(1234)),
+			},
+		},
+	}
+	objectMeta := metav1.ObjectMeta{
+		Name:      "route",
+		Namespace: "default",
+	}
+
+	t.Run("TCPRoute rules are translated to routes destined to Gateway ports", func(t *testing.T) {
+		tcproute := &gatewayapi.TCPRoute{
+			ObjectMeta: objectMeta,
+			Spec: gatewayapi.TCPRouteSpec{
+				Rules: []gatewayapi.TCPRouteRule{{BackendRefs: backendRefs}, {BackendRefs: backendRefs}},
+			},
+		}
+		routes, err := GenerateKongRoutesFromL4Route(tcproute, []gatewayapi.PortNumber{8080}, false)
+		require.NoError(t, err)
+		require.Len(t, routes, 2)
+		for _, r := range routes {
+			require.Equal(t, []*kong.CIDRPort{{Port: lo.ToPtr(8080)}}, r.Destinations)
+		}
+	})
+
+	t.Run("TCPRoute is translated to expression routes", func(t *testing.T) {
+		tcproute := &gatewayapi.TCPRoute{
+			ObjectMeta: objectMeta,
+			Spec: gatewayapi.TCPRouteSpec{
+				Rules: []gatewayapi.TCPRouteRule{{BackendRefs: backendRefs}},
+			},
+		}
+		routes, err := GenerateKongRoutesFromL4Route(tcproute, []gatewayapi.PortNumber{8080}, true)
+		require.NoError(t, err)
+		require.Len(t, routes, 1)
+		require.True(t, routes[0].ExpressionRoutes)
+		require.Equal(t, "net.dst.port == 8080", lo.FromPtr(routes[0].Expression))
+		require.Nil(t, routes[0].Destinations)
+	})
+
+	t.Run("TCPRoute without rules is rejected", func(t *testing.T) {
+		_, err := GenerateKongRoutesFromL4Route(&gatewayapi.TCPRoute{ObjectMeta: objectMeta}, nil, false)
+		require.ErrorIs(t, err, subtranslator.ErrRouteValidationNoRules)
+	})
+
+	t.Run("UDPRoute rule without backendRefs is rejected", func(t *testing.T) {
+		udproute := &gatewayapi.UDPRoute{
+			ObjectMeta: objectMeta,
+			Spec: gatewayapi.UDPRouteSpec{
+				Rules: []gatewayapi.UDPRouteRule{{}},
+			},
+		}
+		_, err := GenerateKongRoutesFromL4Route(udproute, nil, false)
+		require.ErrorIs(t, err, subtranslator.ErrRotueValidationRuleNoBackendRef)
+	})
+
+	t.Run("TLSRoute without hostnames is rejected", func(t *testing.T) {
+		tlsroute := &gatewayapi.TLSRoute{
+			ObjectMeta: objectMeta,
+			Spec: gatewayapi.TLSRouteSpec{
+				Rules: []gatewayapi.TLSRouteRule{{BackendRefs: backendRefs}},
+			},
+		}
+		_, err := GenerateKongRoutesFromL4Route(tlsroute, nil, false)
+		require.ErrorContains(t, err, "no hostnames provided")
+	})
+}
@@ -21,6 +21,7 @@ func (t *Translator) ingressRulesFromTCPRoutes() ingressRules {
 		t.logger.Error(err, "Failed to list TCPRoutes")
 		return result
 	}
+	tcpRouteList = excludeTenantPolicyViolations(t, tcpRouteList)
 
 	var errs []error
 	for _, tcproute := range tcpRouteList {
@@ -47,8 +48,8 @@ func (t *Translator) ingressRulesFromTCPRoutes() ingressRules {
 
 func (t *Translator) ingressRulesFromTCPRoute(result *ingressRules, tcproute *gatewayapi.TCPRoute) error {
 	spec := tcproute.Spec
-	if len(spec.Rules) == 0 {
-		return subtranslator.ErrRouteValidationNoRules
+	if err := validateTCPRoute(tcproute); err != nil {
+		return err
 	}
 
 	gwPorts := t.getGatewayListeningPorts(tcproute.Namespace, gatewayapi.TCPProtocolType, spec.CommonRouteSpec.ParentRefs)
@@ -64,7 +65,7 @@ func (t *Translator) ingressRulesFromTCPRoute(result *ingressRules, tcproute *ga
 		}
 
 		// create a service and attach the routes to it
-		service, err := generateKongServiceFromBackendRefWithRuleNumber(t.logger, t.storer, result, tcproute, ruleNumber, "tcp", rule.BackendRefs...)
+		service, err := generateKongServiceFromBackendRefWithRuleNumber(t.logger, t.storer, t.featureFlags, result, tcproute, ruleNumber, "tcp", rule.BackendRefs...)
 		if err != nil {
 			return err
 		}
@@ -77,3 +78,11 @@ func (t *Translator) ingressRulesFromTCPRoute(result *ingressRules, tcproute *ga
 
 	return nil
 }
+
+// validateTCPRoute validates TCPRoute, and return a translation error if the spec is invalid.
+func validateTCPRoute(tcproute *gatewayapi.TCPRoute) error {
+	if len(tcproute.Spec.Rules) == 0 {
+		return subtranslator.ErrRouteValidationNoRules
+	}
+	return nil
+}
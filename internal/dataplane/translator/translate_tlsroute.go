@@ -26,6 +26,7 @@ func (t *Translator) ingressRulesFromTLSRoutes() ingressRules {
 		t.logger.Error(err, "Failed to list TLSRoutes")
 		return result
 	}
+	tlsRouteList = excludeTenantPolicyViolations(t, tlsRouteList)
 
 	var errs []error
 	for _, tlsroute := range tlsRouteList {
@@ -52,12 +53,8 @@ func (t *Translator) ingressRulesFromTLSRoutes() ingressRules {
 
 func (t *Translator) ingressRulesFromTLSRoute(result *ingressRules, tlsroute *gatewayapi.TLSRoute) error {
 	spec := tlsroute.Spec
-
-	if len(spec.Hostnames) == 0 {
-		return fmt.Errorf("no hostnames provided")
-	}
-	if len(spec.Rules) == 0 {
-		return subtranslator.ErrRouteValidationNoRules
+	if err := validateTLSRoute(tlsroute); err != nil {
+		return err
 	}
 
 	tlsPassthrough, err := t.isTLSRoutePassthrough(tlsroute)
@@ -82,7 +79,7 @@ func (t *Translator) ingressRulesFromTLSRoute(result *ingressRules, tlsroute *ga
 		}
 
 		// create a service and attach the routes to it
-		service, err := generateKongServiceFromBackendRefWithRuleNumber(t.logger, t.storer, result, tlsroute, ruleNumber, "tcp", rule.BackendRefs...)
+		service, err := generateKongServiceFromBackendRefWithRuleNumber(t.logger, t.storer, t.featureFlags, result, tlsroute, ruleNumber, "tcp", rule.BackendRefs...)
 		if err != nil {
 			return err
 		}
@@ -147,3 +144,14 @@ func (t *Translator) isTLSRoutePassthrough(tlsroute *gatewayapi.TLSRoute) (bool,
 
 	return false, nil
 }
+
+// validateTLSRoute validates TLSRoute, and return a translation error if the spec is invalid.
+func validateTLSRoute(tlsroute *gatewayapi.TLSRoute) error {
+	if len(tlsroute.Spec.Hostnames) == 0 {
+		return errors.New("no hostnames provided")
+	}
+	if len(tlsroute.Spec.Rules) == 0 {
+		return subtranslator.ErrRouteValidationNoRules
+	}
+	return nil
+}
@@ -21,6 +21,7 @@ func (t *Translator) ingressRulesFromUDPRoutes() ingressRules {
 		t.logger.Error(err, "Failed to list UDPRoutes")
 		return result
 	}
+	udpRouteList = excludeTenantPolicyViolations(t, udpRouteList)
 
 	var errs []error
 	for _, udproute := range udpRouteList {
@@ -69,7 +70,7 @@ func (t *Translator) ingressRulesFromUDPRoute(result *ingressRules, udproute *ga
 		}
 
 		// create a service and attach the routes to it
-		service, err := generateKongServiceFromBackendRefWithRuleNumber(t.logger, t.storer, result, udproute, ruleNumber, "udp", rule.BackendRefs...)
+		service, err := generateKongServiceFromBackendRefWithRuleNumber(t.logger, t.storer, t.featureFlags, result, udproute, ruleNumber, "udp", rule.BackendRefs...)
 		if err != nil {
 			return err
 		}
@@ -13,6 +13,7 @@ import (
 
 	"github.com/kong/kubernetes-ingress-controller/v3/internal/annotations"
 	"github.com/kong/kubernetes-ingress-controller/v3/internal/dataplane/kongstate"
+	mcsv1alpha1 "github.com/kong/kubernetes-ingress-controller/v3/internal/mcsapi/v1alpha1"
 	"github.com/kong/kubernetes-ingress-controller/v3/internal/store"
 	"github.com/kong/kubernetes-ingress-controller/v3/internal/util"
 	kongv1alpha1 "github.com/kong/kubernetes-ingress-controller/v3/pkg/apis/configuration/v1alpha1"
@@ -76,7 +77,7 @@ func (t *Translator) getUpstreams(serviceMap map[string]kongstate.Service) ([]ko
 				serviceMap[serviceName] = service
 
 				// get the new targets for this backend service
-				newTargets := getServiceEndpoints(t.logger, t.storer, k8sService, port)
+				newTargets := getServiceEndpoints(t.logger, t.storer, k8sService, port, backend.IsServiceImport())
 
 				if len(newTargets) == 0 {
 					t.logger.V(util.InfoLevel).Info("No targets could be found for kubernetes service",
@@ -109,6 +110,13 @@ func (t *Translator) getUpstreams(serviceMap map[string]kongstate.Service) ([]ko
 					}
 				}
 
+				// terminating targets are kept only to be drained, so they get the lowest non-zero weight.
+				for i := range newTargets {
+					if newTargets[i].Terminating && targetWeightOrDefault(newTargets[i].Weight) != 0 {
+						newTargets[i].Weight = lo.ToPtr(terminatingTargetWeight)
+					}
+				}
+
 				for _, t := range newTargets {
 					targetMap = updateTargetMap(targetMap, t)
 				}
@@ -186,11 +194,14 @@ func findPort(svc *corev1.Service, wantPort kongstate.PortDef) (*corev1.ServiceP
 	return nil, fmt.Errorf("no suitable port found")
 }
 
+// getServiceEndpoints returns the targets for a Kubernetes Service port. With isServiceImport, svc stands in for
+// a ServiceImport and the targets are the endpoints imported from the clusters exporting the service.
 func getServiceEndpoints(
 	logger logr.Logger,
 	s store.Storer,
 	svc *corev1.Service,
 	servicePort *corev1.ServicePort,
+	isServiceImport bool,
 ) []kongstate.Target {
 	logger = logger.WithValues(
 		"service_name", svc.Name,
@@ -203,19 +214,36 @@ func getServiceEndpoints(
 	// for TCP as this is the default protocol for service ports.
 	protocols := listProtocols(svc)
 
-	// Check if the service is an upstream service through Ingress Class parameters.
-	var isSvcUpstream bool
+	// Check if the service is an upstream service and if topology aware routing is enabled
+	// through Ingress Class parameters.
+	var isSvcUpstream, topologyAware bool
 	ingressClassParameters, err := getIngressClassParametersOrDefault(s)
 	if err != nil {
 		logger.V(util.DebugLevel).Info("Unable to retrieve IngressClassParameters", "error", err)
 	} else {
 		isSvcUpstream = ingressClassParameters.ServiceUpstream
+		topologyAware = ingressClassParameters.TopologyAwareRouting
+	}
+
+	getEndpointSlices := s.GetEndpointSlicesForService
+	if isServiceImport {
+		// ServiceImports are resolvable only in the ClusterSet domain, so they're used as upstream services by their
+		// ClusterSet hostname.
+		if isSvcUpstream || annotations.HasServiceUpstreamAnnotation(svc.Annotations) {
+			return targetsForEndpoints([]util.Endpoint{
+				{
+					Address: mcsv1alpha1.ClusterSetHostname(svc.Namespace, svc.Name),
+					Port:    fmt.Sprint(servicePort.Port),
+				},
+			})
+		}
+		getEndpointSlices = s.GetEndpointSlicesForServiceImport
 	}
 
 	// Check all protocols for associated endpoints.
 	endpoints := []util.Endpoint{}
 	for protocol := range protocols {
-		newEndpoints := getEndpoints(logger, svc, servicePort, protocol, s.GetEndpointSlicesForService, isSvcUpstream)
+		newEndpoints := getEndpoints(logger, svc, servicePort, protocol, getEndpointSlices, isSvcUpstream, topologyAware)
 		endpoints = append(endpoints, newEndpoints...)
 	}
 	if len(endpoints) == 0 {
@@ -246,6 +274,8 @@ func getIngressClassParametersOrDefault(s store.Storer) (kongv1alpha1.IngressCla
 // getEndpoints returns a list of <endpoint ip>:<port> for a given service/target port combination.
 // It also checks if the service is an upstream service either by its annotations
 // of by IngressClassParameters configuration provided as a flag.
+// With topologyAware, endpoints carry the zones they are local to and terminating endpoints that are
+// still serving are returned as well.
 func getEndpoints(
 	logger logr.Logger,
 	service *corev1.Service,
@@ -253,6 +283,7 @@ func getEndpoints(
 	proto corev1.Protocol,
 	getEndpointSlices func(string, string) ([]*discoveryv1.EndpointSlice, error),
 	isSvcUpstream bool,
+	topologyAware bool,
 ) []util.Endpoint {
 	if service == nil || port == nil {
 		return []util.Endpoint{}
@@ -296,7 +327,7 @@ func getEndpoints(
 
 	// Avoid duplicated upstream servers when the service contains
 	// multiple port definitions sharing the same target port.
-	uniqueUpstream := make(map[util.Endpoint]struct{})
+	uniqueUpstream := make(map[string]struct{})
 	upstreamServers := make([]util.Endpoint, 0)
 	for _, endpointSlice := range endpointSlices {
 		for _, p := range endpointSlice.Ports {
@@ -310,8 +341,14 @@ func getEndpoints(
 				// In most cases consumers should interpret this unknown state as ready.
 				// Field Ready has the same semantic as Endpoints from CoreV1 in Addresses.
 				// https://kubernetes.io/docs/concepts/services-networking/endpoint-slices/#conditions
+				// Terminating endpoints are not ready, but they may still be serving. With topology aware
+				// routing they're kept to be drained gracefully.
+				terminating := false
 				if endpoint.Conditions.Ready != nil && !*endpoint.Conditions.Ready {
-					continue
+					if !topologyAware || !isTerminatingAndServing(endpoint) {
+						continue
+					}
+					terminating = true
 				}
 				// One address per endpoint is rather expected (allowing multiple is due to historical reasons)
 				// read more https://github.com/kubernetes/kubernetes/issues/106267#issuecomment-978770401.
@@ -320,9 +357,14 @@ func getEndpoints(
 					Address: endpoint.Addresses[0],
 					Port:    upstreamPort,
 				}
-				if _, exists := uniqueUpstream[upstreamServer]; !exists {
+				if topologyAware {
+					upstreamServer.Zones = endpointZones(endpoint)
+					upstreamServer.Terminating = terminating
+				}
+				key := net.JoinHostPort(upstreamServer.Address, upstreamServer.Port)
+				if _, exists := uniqueUpstream[key]; !exists {
 					upstreamServers = append(upstreamServers, upstreamServer)
-					uniqueUpstream[upstreamServer] = struct{}{}
+					uniqueUpstream[key] = struct{}{}
 				}
 			}
 		}
@@ -331,6 +373,26 @@ func getEndpoints(
 	return upstreamServers
 }
 
+// isTerminatingAndServing returns true if the endpoint is terminating, but still serving traffic.
+func isTerminatingAndServing(endpoint discoveryv1.Endpoint) bool {
+	return lo.FromPtr(endpoint.Conditions.Terminating) && lo.FromPtr(endpoint.Conditions.Serving)
+}
+
+// endpointZones returns the zones an endpoint is local to: the zones hinted by the EndpointSlice controller or,
+// without hints, the zone of the endpoint itself.
+func endpointZones(endpoint discoveryv1.Endpoint) []string {
+	if endpoint.Hints != nil && len(endpoint.Hints.ForZones) > 0 {
+		return lo.Map(endpoint.Hints.ForZones, func(z discoveryv1.ForZone, _ int) string { return z.Name })
+	}
+	if zone := lo.FromPtr(endpoint.Zone); zone != "" {
+		return []string{zone}
+	}
+	return nil
+}
+
+// terminatingTargetWeight is the weight of the targets of terminating endpoints, kept to be drained gracefully.
+const terminatingTargetWeight = 1
+
 // targetWeightOrDefault returns the effective value of a target weight pointer. If the pointer is non-nil, it returns
 // the pointee. If the pointer is nil, it returns 100, the default Kong target weight. This allows us to sum
 // deduplicated targets' weights if one happens to be unset in the controller.
@@ -377,6 +439,8 @@ func targetsForEndpoints(endpoints []util.Endpoint) []kongstate.Target {
 			Target: kong.Target{
 				Target: kong.String(addr + ":" + endpoint.Port),
 			},
+			Zones:       endpoint.Zones,
+			Terminating: endpoint.Terminating,
 		}
 		targets = append(targets, target)
 	}
@@ -66,10 +66,12 @@ func GetPermittedForReferenceGrantFrom(
 }
 
 // generateKongServiceFromBackendRefWithName translates backendRefs into a Kong service for use with the
-// rules generated from a Gateway APIs route. The service name is provided by the caller.
+// rules generated from a Gateway APIs route. The service name is provided by the caller, unless the only
+// backendRef is a KongServiceFacade: routes backed by the same KongServiceFacade share its Kong service.
 func generateKongServiceFromBackendRefWithName(
 	logger logr.Logger,
 	storer store.Storer,
+	featureFlags FeatureFlags,
 	rules *ingressRules,
 	serviceName string,
 	route client.Object,
@@ -88,7 +90,17 @@ func generateKongServiceFromBackendRefWithName(
 		Namespace: gatewayapi.Namespace(route.GetNamespace()),
 	}, grants)
 
-	backends := backendRefsToKongStateBackends(logger, storer, route, backendRefs, allowed)
+	backends := backendRefsToKongStateBackends(logger, storer, route, backendRefs, allowed, featureFlags)
+
+	parent := client.Object(route)
+	if len(backendRefs) == 1 && len(backends) == 1 && backends[0].IsServiceFacade() {
+		serviceFacade, err := storer.GetKongServiceFacade(backends[0].Namespace(), backends[0].Name())
+		if err != nil {
+			return kongstate.Service{}, fmt.Errorf("could not retrieve KongServiceFacade for %s: %w", objName, err)
+		}
+		serviceName = subtranslator.KongServiceNameForKongServiceFacade(serviceFacade.Namespace, serviceFacade.Name)
+		parent = serviceFacade
+	}
 
 	// the service host needs to be a resolvable name due to legacy logic so we'll
 	// use the anchor backendRef as the basis for the name
@@ -107,9 +119,9 @@ func generateKongServiceFromBackendRefWithName(
 				WriteTimeout:   kong.Int(DefaultServiceTimeout),
 				Retries:        kong.Int(DefaultRetries),
 			},
-			Namespace: route.GetNamespace(),
+			Namespace: parent.GetNamespace(),
 			Backends:  backends,
-			Parent:    route,
+			Parent:    parent,
 		}
 	}
 
@@ -138,6 +150,7 @@ func generateKongServiceFromBackendRefWithName(
 func generateKongServiceFromBackendRefWithRuleNumber(
 	logger logr.Logger,
 	storer store.Storer,
+	featureFlags FeatureFlags,
 	rules *ingressRules,
 	route client.Object,
 	ruleNumber int,
@@ -151,6 +164,7 @@ func generateKongServiceFromBackendRefWithRuleNumber(
 	return generateKongServiceFromBackendRefWithName(
 		logger,
 		storer,
+		featureFlags,
 		rules,
 		serviceName,
 		route,
@@ -162,9 +176,15 @@ func generateKongServiceFromBackendRefWithRuleNumber(
 func applyExpressionToIngressRules(result *ingressRules) {
 	for _, svc := range result.ServiceNameToServices {
 		for i := range svc.Routes {
-			subtranslator.ApplyExpressionToL4KongRoute(&svc.Routes[i])
-			svc.Routes[i].Destinations = nil
-			svc.Routes[i].SNIs = nil
+			applyExpressionToL4Route(&svc.Routes[i])
 		}
 	}
 }
+
+// applyExpressionToL4Route converts the L4 route to an expression based one, dropping the fields
+// that are not allowed in expression routes.
+func applyExpressionToL4Route(r *kongstate.Route) {
+	subtranslator.ApplyExpressionToL4KongRoute(r)
+	r.Destinations = nil
+	r.SNIs = nil
+}
@@ -1,6 +1,8 @@
 package translator
 
 import (
+	"time"
+
 	"github.com/go-logr/logr"
 	"sigs.k8s.io/controller-runtime/pkg/client"
 
@@ -10,6 +12,7 @@ import (
 	"github.com/kong/kubernetes-ingress-controller/v3/internal/gatewayapi"
 	"github.com/kong/kubernetes-ingress-controller/v3/internal/license"
 	"github.com/kong/kubernetes-ingress-controller/v3/internal/manager/featuregates"
+	"github.com/kong/kubernetes-ingress-controller/v3/internal/metrics"
 	"github.com/kong/kubernetes-ingress-controller/v3/internal/store"
 )
 
@@ -45,11 +48,26 @@ type FeatureFlags struct {
 
 	// KongServiceFacade indicates whether we should support KongServiceFacades as Ingress backends.
 	KongServiceFacade bool
+
+	// HostnameOwnership indicates whether Ingresses and HTTPRoutes routing a hostname owned by another namespace
+	// should be excluded from the translation.
+	HostnameOwnership bool
+
+	// RequestMirror indicates whether to translate HTTPRoute RequestMirror filters to plugins mirroring requests.
+	RequestMirror bool
+
+	// MultiClusterServices indicates whether we should support ServiceImports as Gateway API routes' backendRefs.
+	MultiClusterServices bool
+
+	// DBMode indicates whether the Kong gateways are DB-backed. KongCustomEntities are only supported by DB-less
+	// gateways, so they're reported as translation failures in DB mode.
+	DBMode bool
 }
 
 func NewFeatureFlags(
 	featureGates featuregates.FeatureGates,
 	routerFlavor dpconf.RouterFlavor,
+	dbMode dpconf.DBMode,
 	updateStatusFlag bool,
 	enterpriseEdition bool,
 ) FeatureFlags {
@@ -60,6 +78,10 @@ func NewFeatureFlags(
 		FillIDs:                           featureGates.Enabled(featuregates.FillIDsFeature),
 		RewriteURIs:                       featureGates.Enabled(featuregates.RewriteURIsFeature),
 		KongServiceFacade:                 featureGates.Enabled(featuregates.KongServiceFacade),
+		HostnameOwnership:                 featureGates.Enabled(featuregates.HostnameOwnership),
+		RequestMirror:                     featureGates.Enabled(featuregates.RequestMirror),
+		MultiClusterServices:              featureGates.Enabled(featuregates.MultiClusterServices),
+		DBMode:                            dbMode.IsDBBacked(),
 	}
 }
 
@@ -73,6 +95,8 @@ type Translator struct {
 	licenseGetter license.Getter
 	featureFlags  FeatureFlags
 
+	customEntitySchemaGetter *customEntitySchemaGetter
+
 	failuresCollector          *failures.ResourceFailuresCollector
 	translatedObjectsCollector *ObjectsCollector
 }
@@ -118,6 +142,9 @@ type KongConfigBuildingResult struct {
 
 	// ConfiguredKubernetesObjects is a list of Kubernetes objects that were successfully translated.
 	ConfiguredKubernetesObjects []client.Object
+
+	// TranslationDurations holds durations of the translation stages.
+	TranslationDurations map[metrics.TranslationStage]time.Duration
 }
 
 // UpdateCache updates the store cache used by the translator.
@@ -129,17 +156,32 @@ func (t *Translator) UpdateCache(c store.CacheStores) {
 // BuildKongConfig creates a Kong configuration from Ingress and Custom resources
 // defined in Kubernetes.
 func (t *Translator) BuildKongConfig() KongConfigBuildingResult {
+	// Measure how long the translation stages take to expose it in metrics.
+	durations := make(map[metrics.TranslationStage]time.Duration)
+	stageStart := time.Now()
+	endStage := func(stage metrics.TranslationStage) {
+		now := time.Now()
+		durations[stage] += now.Sub(stageStart)
+		stageStart = now
+	}
+
 	// Translate and merge all rules together from all Kubernetes API sources
-	ingressRules := mergeIngressRules(
+	ingressRulesFromIngresses := mergeIngressRules(
 		t.ingressRulesFromIngressV1(),
 		t.ingressRulesFromTCPIngressV1beta1(),
 		t.ingressRulesFromUDPIngressV1beta1(),
-		t.ingressRulesFromHTTPRoutes(),
+	)
+	endStage(metrics.TranslationStageIngress)
+	ingressRulesFromHTTPRoutes := t.ingressRulesFromHTTPRoutes()
+	endStage(metrics.TranslationStageHTTPRoute)
+	ingressRulesFromOtherRoutes := mergeIngressRules(
 		t.ingressRulesFromUDPRoutes(),
 		t.ingressRulesFromTCPRoutes(),
 		t.ingressRulesFromTLSRoutes(),
 		t.ingressRulesFromGRPCRoutes(),
 	)
+	endStage(metrics.TranslationStageOtherRoutes)
+	ingressRules := mergeIngressRules(ingressRulesFromIngresses, ingressRulesFromHTTPRoutes, ingressRulesFromOtherRoutes)
 
 	// populate any Kubernetes Service objects relevant objects and get the
 	// services to be skipped because of annotations inconsistency
@@ -163,6 +205,13 @@ func (t *Translator) BuildKongConfig() KongConfigBuildingResult {
 
 	// merge KongIngress with Routes, Services and Upstream
 	result.FillOverrides(t.logger, t.storer, t.failuresCollector)
+	endStage(metrics.TranslationStageServices)
+
+	// generate vaults, before consumers and credentials which may reference them
+	result.FillVaults(t.logger, t.storer, t.failuresCollector)
+	for i := range result.Vaults {
+		t.registerSuccessfullyTranslatedObject(result.Vaults[i].K8sKongVault)
+	}
 
 	// generate consumers and credentials
 	result.FillConsumersAndCredentials(t.logger, t.storer, t.failuresCollector)
@@ -170,23 +219,21 @@ func (t *Translator) BuildKongConfig() KongConfigBuildingResult {
 		t.registerSuccessfullyTranslatedObject(&result.Consumers[i].K8sKongConsumer)
 	}
 
-	// generate vaults
-	result.FillVaults(t.logger, t.storer, t.failuresCollector)
-	for i := range result.Vaults {
-		t.registerSuccessfullyTranslatedObject(result.Vaults[i].K8sKongVault)
-	}
-
 	// process consumer groups
 	result.FillConsumerGroups(t.logger, t.storer)
 	for i := range result.ConsumerGroups {
 		t.registerSuccessfullyTranslatedObject(&result.ConsumerGroups[i].K8sKongConsumerGroup)
 	}
+	endStage(metrics.TranslationStageConsumers)
 
 	// process annotation plugins
 	result.FillPlugins(t.logger, t.storer, t.failuresCollector)
 	for i := range result.Plugins {
 		t.registerSuccessfullyTranslatedObject(result.Plugins[i].K8sParent)
 	}
+	t.dropRequestMirrorPluginsConflictingWithPostFunctions(&result)
+	mergeQueryParamMatchPluginsWithPreFunctions(&result)
+	endStage(metrics.TranslationStagePlugins)
 
 	// generate Certificates and SNIs
 	ingressCerts := t.getCerts(ingressRules.SecretNameToSNIs)
@@ -197,6 +244,10 @@ func (t *Translator) BuildKongConfig() KongConfigBuildingResult {
 	// populate CA certificates in Kong
 	result.CACertificates = t.getCACerts()
 
+	// configure upstream TLS for Services targeted by BackendTLSPolicies
+	t.fillBackendTLSPolicies(&result)
+	endStage(metrics.TranslationStageCertificates)
+
 	if t.licenseGetter != nil && t.featureFlags.EnterpriseEdition {
 		optionalLicense := t.licenseGetter.GetLicense()
 		if l, ok := optionalLicense.Get(); ok {
@@ -209,10 +260,14 @@ func (t *Translator) BuildKongConfig() KongConfigBuildingResult {
 		result.FillIDs(t.logger, t.workspace)
 	}
 
+	// Custom entities refer to the entities their parent plugins are attached to, so they're filled last.
+	t.fillCustomEntities(&result)
+
 	return KongConfigBuildingResult{
 		KongState:                   &result,
 		TranslationFailures:         t.popTranslationFailures(),
 		ConfiguredKubernetesObjects: t.popConfiguredKubernetesObjects(),
+		TranslationDurations:        durations,
 	}
 }
 
@@ -225,6 +280,12 @@ func (t *Translator) InjectLicenseGetter(licenseGetter license.Getter) {
 	t.licenseGetter = licenseGetter
 }
 
+// InjectSchemaServiceProvider sets a schema service provider to be used by the translator to retrieve schemas of
+// custom entities. KongCustomEntities are not translated when it's not set.
+func (t *Translator) InjectSchemaServiceProvider(provider SchemaServiceProvider) {
+	t.customEntitySchemaGetter = newCustomEntitySchemaGetter(provider)
+}
+
 // -----------------------------------------------------------------------------
 // Translator - Private Methods
 // -----------------------------------------------------------------------------
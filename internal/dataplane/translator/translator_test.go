@@ -3,6 +3,7 @@ package translator
 import (
 	"fmt"
 	"sort"
+	"strconv"
 	"strings"
 	"testing"
 	"time"
@@ -25,13 +26,18 @@ import (
 
 	"github.com/kong/kubernetes-ingress-controller/v3/internal/annotations"
 	dpconf "github.com/kong/kubernetes-ingress-controller/v3/internal/dataplane/config"
+	"github.com/kong/kubernetes-ingress-controller/v3/internal/dataplane/failures"
 	"github.com/kong/kubernetes-ingress-controller/v3/internal/dataplane/kongstate"
+	"github.com/kong/kubernetes-ingress-controller/v3/internal/gatewayapi"
 	"github.com/kong/kubernetes-ingress-controller/v3/internal/manager/featuregates"
 	"github.com/kong/kubernetes-ingress-controller/v3/internal/manager/scheme"
+	mcsv1alpha1 "github.com/kong/kubernetes-ingress-controller/v3/internal/mcsapi/v1alpha1"
+	"github.com/kong/kubernetes-ingress-controller/v3/internal/metrics"
 	"github.com/kong/kubernetes-ingress-controller/v3/internal/store"
 	"github.com/kong/kubernetes-ingress-controller/v3/internal/util"
 	"github.com/kong/kubernetes-ingress-controller/v3/internal/util/builder"
 	kongv1 "github.com/kong/kubernetes-ingress-controller/v3/pkg/apis/configuration/v1"
+	kongv1alpha1 "github.com/kong/kubernetes-ingress-controller/v3/pkg/apis/configuration/v1alpha1"
 	kongv1beta1 "github.com/kong/kubernetes-ingress-controller/v3/pkg/apis/configuration/v1beta1"
 	incubatorv1alpha1 "github.com/kong/kubernetes-ingress-controller/v3/pkg/apis/incubator/v1alpha1"
 	"github.com/kong/kubernetes-ingress-controller/v3/test/helpers/certificate"
@@ -78,6 +84,23 @@ func TestGlobalPlugin(t *testing.T) {
 	})
 }
 
+func TestBuildKongConfigTranslationDurations(t *testing.T) {
+	s, err := store.NewFakeStore(store.FakeObjects{})
+	require.NoError(t, err)
+	p := mustNewTranslator(t, s)
+
+	result := p.BuildKongConfig()
+	require.ElementsMatch(t, []metrics.TranslationStage{
+		metrics.TranslationStageIngress,
+		metrics.TranslationStageHTTPRoute,
+		metrics.TranslationStageOtherRoutes,
+		metrics.TranslationStageServices,
+		metrics.TranslationStageConsumers,
+		metrics.TranslationStagePlugins,
+		metrics.TranslationStageCertificates,
+	}, lo.Keys(result.TranslationDurations))
+}
+
 func TestSecretConfigurationPlugin(t *testing.T) {
 	jwtPluginConfig := `{"run_on_preflight": false}`  // JSON
 	basicAuthPluginConfig := "hide_credentials: true" // YAML
@@ -2171,6 +2194,199 @@ func TestKongProcessClasslessIngress(t *testing.T) {
 	})
 }
 
+func TestKongTenantPolicies(t *testing.T) {
+	newIngress := func(name, host string) *netv1.Ingress {
+		return &netv1.Ingress{
+			TypeMeta: metav1.TypeMeta{Kind: "Ingress", APIVersion: netv1.SchemeGroupVersion.String()},
+			ObjectMeta: metav1.ObjectMeta{
+				Name:      name,
+				Namespace: "team-a",
+				Annotations: map[string]string{
+					annotations.IngressClassKey: annotations.DefaultIngressClass,
+				},
+			},
+			Spec: netv1.IngressSpec{
+				Rules: []netv1.IngressRule{
+					{
+						Host: host,
+						IngressRuleValue: netv1.IngressRuleValue{
+							HTTP: &netv1.HTTPIngressRuleValue{
+								Paths: []netv1.HTTPIngressPath{
+									{
+										Path:     "/",
+										PathType: lo.ToPtr(netv1.PathTypePrefix),
+										Backend: netv1.IngressBackend{
+											Service: &netv1.IngressServiceBackend{
+												Name: name,
+												Port: netv1.ServiceBackendPort{
+													Number: 80,
+												},
+											},
+										},
+									},
+								},
+							},
+						},
+					},
+				},
+			},
+		}
+	}
+	newService := func(name string) *corev1.Service {
+		return &corev1.Service{
+			TypeMeta: metav1.TypeMeta{Kind: "Service", APIVersion: corev1.SchemeGroupVersion.String()},
+			ObjectMeta: metav1.ObjectMeta{
+				Name:      name,
+				Namespace: "team-a",
+			},
+			Spec: corev1.ServiceSpec{
+				Ports: []corev1.ServicePort{{Port: 80}},
+			},
+		}
+	}
+	violatingIngress := newIngress("shared", "shared.example.com")
+
+	store, err := store.NewFakeStore(store.FakeObjects{
+		Namespaces: []*corev1.Namespace{
+			{
+				ObjectMeta: metav1.ObjectMeta{
+					Name:   "team-a",
+					Labels: map[string]string{"tenant": "true"},
+				},
+			},
+		},
+		KongTenantPolicies: []*kongv1alpha1.KongTenantPolicy{
+			{
+				ObjectMeta: metav1.ObjectMeta{
+					Name: "tenants",
+				},
+				Spec: kongv1alpha1.KongTenantPolicySpec{
+					NamespaceSelector: metav1.LabelSelector{
+						MatchLabels: map[string]string{"tenant": "true"},
+					},
+					Hosts: &kongv1alpha1.KongTenantPolicyRule{
+						Allow: []string{"*.team-a.example.com"},
+					},
+				},
+			},
+		},
+		IngressesV1: []*netv1.Ingress{
+			newIngress("api", "api.team-a.example.com"),
+			violatingIngress,
+		},
+		Services: []*corev1.Service{
+			newService("api"),
+			newService("shared"),
+		},
+	})
+	require.NoError(t, err)
+
+	result := mustNewTranslator(t, store).BuildKongConfig()
+	require.Len(t, result.KongState.Services, 1, "expected only the service of the compliant Ingress to be rendered")
+	require.Len(t, result.KongState.Services[0].Routes, 1)
+	require.Equal(t, []*string{kong.String("api.team-a.example.com")}, result.KongState.Services[0].Routes[0].Hosts)
+
+	require.Len(t, result.TranslationFailures, 1)
+	failure := result.TranslationFailures[0]
+	require.Equal(t, []client.Object{violatingIngress}, failure.CausingObjects())
+	require.Equal(t,
+		`host "shared.example.com" is not allowed in namespace "team-a" by KongTenantPolicy tenants`,
+		failure.Message(),
+	)
+}
+
+func TestKongHostnameOwnership(t *testing.T) {
+	newIngress := func(namespace string, created time.Time) *netv1.Ingress {
+		return &netv1.Ingress{
+			TypeMeta: metav1.TypeMeta{Kind: "Ingress", APIVersion: netv1.SchemeGroupVersion.String()},
+			ObjectMeta: metav1.ObjectMeta{
+				Name:              "api",
+				Namespace:         namespace,
+				CreationTimestamp: metav1.NewTime(created),
+				Annotations: map[string]string{
+					annotations.IngressClassKey: annotations.DefaultIngressClass,
+				},
+			},
+			Spec: netv1.IngressSpec{
+				Rules: []netv1.IngressRule{
+					{
+						Host: "api.example.com",
+						IngressRuleValue: netv1.IngressRuleValue{
+							HTTP: &netv1.HTTPIngressRuleValue{
+								Paths: []netv1.HTTPIngressPath{
+									{
+										Path:     "/" + namespace,
+										PathType: lo.ToPtr(netv1.PathTypePrefix),
+										Backend: netv1.IngressBackend{
+											Service: &netv1.IngressServiceBackend{
+												Name: "api",
+												Port: netv1.ServiceBackendPort{
+													Number: 80,
+												},
+											},
+										},
+									},
+								},
+							},
+						},
+					},
+				},
+			},
+		}
+	}
+	newService := func(namespace string) *corev1.Service {
+		return &corev1.Service{
+			TypeMeta: metav1.TypeMeta{Kind: "Service", APIVersion: corev1.SchemeGroupVersion.String()},
+			ObjectMeta: metav1.ObjectMeta{
+				Name:      "api",
+				Namespace: namespace,
+			},
+			Spec: corev1.ServiceSpec{
+				Ports: []corev1.ServicePort{{Port: 80}},
+			},
+		}
+	}
+	now := time.Now()
+	hijackingIngress := newIngress("team-b", now.Add(time.Minute))
+	newStore := func(t *testing.T) store.Storer {
+		s, err := store.NewFakeStore(store.FakeObjects{
+			IngressesV1: []*netv1.Ingress{
+				hijackingIngress,
+				newIngress("team-a", now),
+			},
+			Services: []*corev1.Service{
+				newService("team-a"),
+				newService("team-b"),
+			},
+		})
+		require.NoError(t, err)
+		return s
+	}
+
+	t.Run("hostnames routed in several namespaces are merged when the feature is disabled", func(t *testing.T) {
+		result := mustNewTranslator(t, newStore(t)).BuildKongConfig()
+		require.Empty(t, result.TranslationFailures)
+		require.Len(t, result.KongState.Services, 2)
+	})
+
+	t.Run("only the namespace that routed a hostname first is translated when the feature is enabled", func(t *testing.T) {
+		p := mustNewTranslator(t, newStore(t))
+		p.featureFlags.HostnameOwnership = true
+		result := p.BuildKongConfig()
+
+		require.Len(t, result.KongState.Services, 1)
+		require.Equal(t, "team-a.api.80", *result.KongState.Services[0].Name)
+
+		require.Len(t, result.TranslationFailures, 1)
+		failure := result.TranslationFailures[0]
+		require.Equal(t, []client.Object{hijackingIngress}, failure.CausingObjects())
+		require.Equal(t,
+			`host "api.example.com" is owned by namespace "team-a" where it was first claimed by Ingress api`,
+			failure.Message(),
+		)
+	})
+}
+
 func TestKongServiceAnnotations(t *testing.T) {
 	t.Run("path annotation is correctly processed", func(t *testing.T) {
 		ingresses := []*netv1.Ingress{
@@ -3731,6 +3947,7 @@ func TestGetEndpoints(t *testing.T) {
 		fn                func(string, string) ([]*discoveryv1.EndpointSlice, error)
 		result            []util.Endpoint
 		isServiceUpstream bool
+		topologyAware     bool
 	}{
 		{
 			name:  "no service should return 0 endpoints",
@@ -4085,12 +4302,128 @@ func TestGetEndpoints(t *testing.T) {
 				},
 			},
 		},
+		{
+			name: "topology aware endpoints have zones and include terminating endpoints that are serving",
+			svc: &corev1.Service{
+				ObjectMeta: metav1.ObjectMeta{
+					Name:      "foo",
+					Namespace: "bar",
+				},
+				Spec: corev1.ServiceSpec{
+					Type: corev1.ServiceTypeClusterIP,
+				},
+			},
+			port: &corev1.ServicePort{
+				Name:       "default",
+				TargetPort: intstr.FromInt(80),
+			},
+			proto: corev1.ProtocolTCP,
+			fn: func(string, string) ([]*discoveryv1.EndpointSlice, error) {
+				return []*discoveryv1.EndpointSlice{
+					{
+						Endpoints: []discoveryv1.Endpoint{
+							{
+								Addresses: []string{"1.1.1.1"},
+								Zone:      lo.ToPtr("zone-a"),
+								Hints: &discoveryv1.EndpointHints{
+									ForZones: []discoveryv1.ForZone{{Name: "zone-a"}, {Name: "zone-b"}},
+								},
+							},
+							{
+								Addresses: []string{"2.2.2.2"},
+								Zone:      lo.ToPtr("zone-b"),
+								Conditions: discoveryv1.EndpointConditions{
+									Ready:       lo.ToPtr(false),
+									Serving:     lo.ToPtr(true),
+									Terminating: lo.ToPtr(true),
+								},
+							},
+							{
+								Addresses: []string{"3.3.3.3"},
+								Zone:      lo.ToPtr("zone-b"),
+								Conditions: discoveryv1.EndpointConditions{
+									Ready:       lo.ToPtr(false),
+									Serving:     lo.ToPtr(false),
+									Terminating: lo.ToPtr(true),
+								},
+							},
+							{
+								Addresses: []string{"4.4.4.4"},
+							},
+						},
+						Ports: builder.NewEndpointPort(80).WithName("default").WithProtocol(corev1.ProtocolTCP).IntoSlice(),
+					},
+				}, nil
+			},
+			topologyAware: true,
+			result: []util.Endpoint{
+				{
+					Address: "1.1.1.1",
+					Port:    "80",
+					Zones:   []string{"zone-a", "zone-b"},
+				},
+				{
+					Address:     "2.2.2.2",
+					Port:        "80",
+					Zones:       []string{"zone-b"},
+					Terminating: true,
+				},
+				{
+					Address: "4.4.4.4",
+					Port:    "80",
+				},
+			},
+		},
+		{
+			name: "terminating endpoints are not included without topology aware routing",
+			svc: &corev1.Service{
+				ObjectMeta: metav1.ObjectMeta{
+					Name:      "foo",
+					Namespace: "bar",
+				},
+				Spec: corev1.ServiceSpec{
+					Type: corev1.ServiceTypeClusterIP,
+				},
+			},
+			port: &corev1.ServicePort{
+				Name:       "default",
+				TargetPort: intstr.FromInt(80),
+			},
+			proto: corev1.ProtocolTCP,
+			fn: func(string, string) ([]*discoveryv1.EndpointSlice, error) {
+				return []*discoveryv1.EndpointSlice{
+					{
+						Endpoints: []discoveryv1.Endpoint{
+							{
+								Addresses: []string{"1.1.1.1"},
+								Zone:      lo.ToPtr("zone-a"),
+							},
+							{
+								Addresses: []string{"2.2.2.2"},
+								Conditions: discoveryv1.EndpointConditions{
+									Ready:       lo.ToPtr(false),
+									Serving:     lo.ToPtr(true),
+									Terminating: lo.ToPtr(true),
+								},
+							},
+						},
+						Ports: builder.NewEndpointPort(80).WithName("default").WithProtocol(corev1.ProtocolTCP).IntoSlice(),
+					},
+				}, nil
+			},
+			result: []util.Endpoint{
+				{
+					Address: "1.1.1.1",
+					Port:    "80",
+				},
+			},
+		},
 	}
 
 	for _, testCase := range tests {
 		t.Run(testCase.name, func(t *testing.T) {
 			result := getEndpoints(zapr.NewLogger(zap.NewNop()), testCase.svc, testCase.port, testCase.proto, testCase.fn,
-				testCase.isServiceUpstream)
+				testCase.isServiceUpstream, testCase.topologyAware)
 			require.Equal(t, testCase.result, result)
 		})
 	}
@@ -4674,6 +5007,7 @@ func TestNewFeatureFlags(t *testing.T) {
 
 		featureGates      map[string]bool
 		routerFlavor      dpconf.RouterFlavor
+		dbMode            dpconf.DBMode
 		updateStatusFlag  bool
 		enterpriseEdition bool
 
@@ -4707,11 +5041,27 @@ func TestNewFeatureFlags(t *testing.T) {
 				KongServiceFacade: true,
 			},
 		},
+		{
+			name: "HostnameOwnership enabled",
+			featureGates: map[string]bool{
+				featuregates.HostnameOwnership: true,
+			},
+			expectedFeatureFlags: FeatureFlags{
+				HostnameOwnership: true,
+			},
+		},
+		{
+			name:   "DB mode",
+			dbMode: dpconf.DBModePostgres,
+			expectedFeatureFlags: FeatureFlags{
+				DBMode: true,
+			},
+		},
 	}
 
 	for _, tc := range testCases {
 		t.Run(tc.name, func(t *testing.T) {
-			actualFlags := NewFeatureFlags(tc.featureGates, tc.routerFlavor, tc.updateStatusFlag, tc.enterpriseEdition)
+			actualFlags := NewFeatureFlags(tc.featureGates, tc.routerFlavor, tc.dbMode, tc.updateStatusFlag, tc.enterpriseEdition)
 
 			require.Equal(t, tc.expectedFeatureFlags, actualFlags)
 		})
@@ -5193,3 +5543,376 @@ func TestTranslator_UpdateStore(t *testing.T) {
 	require.NotEqual(t, originalBuildConfigResult.KongState, newBuildConfigResult.KongState, "KongState should be different after updating the store")
 	require.Len(t, newBuildConfigResult.KongState.Consumers, 1, "expected 1 consumer in the KongState")
 }
+
+func TestExpressionRoutePriorityConflicts(t *testing.T) {
+	newIngress := func(name string, anns map[string]string) *netv1.Ingress {
+		anns[annotations.IngressClassKey] = annotations.DefaultIngressClass
+		return &netv1.Ingress{
+			TypeMeta: metav1.TypeMeta{Kind: "Ingress", APIVersion: netv1.SchemeGroupVersion.String()},
+			ObjectMeta: metav1.ObjectMeta{
+				Name:        name,
+				Namespace:   "default",
+				Annotations: anns,
+			},
+			Spec: netv1.IngressSpec{
+				Rules: []netv1.IngressRule{
+					{
+						Host: "api.example.com",
+						IngressRuleValue: netv1.IngressRuleValue{
+							HTTP: &netv1.HTTPIngressRuleValue{
+								Paths: []netv1.HTTPIngressPath{
+									{
+										Path:     "/api",
+										PathType: lo.ToPtr(netv1.PathTypePrefix),
+										Backend: netv1.IngressBackend{
+											Service: &netv1.IngressServiceBackend{
+												Name: name,
+												Port: netv1.ServiceBackendPort{
+													Number: 80,
+												},
+											},
+										},
+									},
+								},
+							},
+						},
+					},
+				},
+			},
+		}
+	}
+	newService := func(name string) *corev1.Service {
+		return &corev1.Service{
+			TypeMeta: metav1.TypeMeta{Kind: "Service", APIVersion: corev1.SchemeGroupVersion.String()},
+			ObjectMeta: metav1.ObjectMeta{
+				Name:      name,
+				Namespace: "default",
+			},
+			Spec: corev1.ServiceSpec{
+				Ports: []corev1.ServicePort{{Port: 80}},
+			},
+		}
+	}
+
+	const conflictFailure = "Kong route default.stable.stable.api.example.com.80 has the same priority and matches the same requests " +
+		"as Kong route default.canary.canary.api.example.com.80, use the konghq.com/route-priority annotation to order them"
+
+	testCases := []struct {
+		name             string
+		canaryAnnotation map[string]string
+		expectedFailures []string
+	}{
+		{
+			name:             "routes with the same priority and matches are reported",
+			canaryAnnotation: map[string]string{},
+			expectedFailures: []string{conflictFailure},
+		},
+		{
+			name:             "routes ordered by the route priority annotation are not reported",
+			canaryAnnotation: map[string]string{"konghq.com/route-priority": "1"},
+		},
+		{
+			name:             "invalid route priority annotation is reported and ignored",
+			canaryAnnotation: map[string]string{"konghq.com/route-priority": "high"},
+			expectedFailures: []string{
+				`invalid konghq.com/route-priority annotation "high": must be an integer between 0 and 1023, ignoring it`,
+				conflictFailure,
+			},
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			canary := newIngress("canary", tc.canaryAnnotation)
+			stable := newIngress("stable", map[string]string{})
+			s, err := store.NewFakeStore(store.FakeObjects{
+				IngressesV1: []*netv1.Ingress{canary, stable},
+				Services:    []*corev1.Service{newService("canary"), newService("stable")},
+			})
+			require.NoError(t, err)
+			p := mustNewTranslator(t, s)
+			p.featureFlags.ExpressionRoutes = true
+			result := p.BuildKongConfig()
+
+			require.Len(t, result.KongState.Services, 2)
+			require.ElementsMatch(t, tc.expectedFailures, lo.Map(result.TranslationFailures,
+				func(f failures.ResourceFailure, _ int) string { return f.Message() },
+			))
+			for _, failure := range result.TranslationFailures {
+				if failure.Message() == conflictFailure {
+					require.ElementsMatch(t, []client.Object{canary, stable}, failure.CausingObjects())
+				} else {
+					require.Equal(t, []client.Object{canary}, failure.CausingObjects())
+				}
+			}
+		})
+	}
+}
+
+func TestTranslator_KongServiceFacadeAsGatewayAPIBackend(t *testing.T) {
+	facadeBackendRef := builder.NewBackendRef("svc-facade").
+		WithGroup(incubatorv1alpha1.GroupVersion.Group).
+		WithKind(incubatorv1alpha1.KongServiceFacadeKind).
+		Build()
+	newHTTPRoute := func(name, path string) *gatewayapi.HTTPRoute {
+		return &gatewayapi.HTTPRoute{
+			TypeMeta: gatewayapi.V1HTTPRouteTypeMeta,
+			ObjectMeta: metav1.ObjectMeta{
+				Namespace: "default",
+				Name:      name,
+			},
+			Spec: gatewayapi.HTTPRouteSpec{
+				Rules: []gatewayapi.HTTPRouteRule{
+					{
+						Matches:     []gatewayapi.HTTPRouteMatch{builder.NewHTTPRouteMatch().WithPathPrefix(path).Build()},
+						BackendRefs: []gatewayapi.HTTPBackendRef{{BackendRef: facadeBackendRef}},
+					},
+				},
+			},
+		}
+	}
+	objects := store.FakeObjects{
+		HTTPRoutes: []*gatewayapi.HTTPRoute{
+			newHTTPRoute("httproute-1", "/foo"),
+			newHTTPRoute("httproute-2", "/bar"),
+		},
+		GRPCRoutes: []*gatewayapi.GRPCRoute{
+			{
+				TypeMeta: gatewayapi.GRPCRouteTypeMeta,
+				ObjectMeta: metav1.ObjectMeta{
+					Namespace: "default",
+					Name:      "grpcroute",
+				},
+				Spec: gatewayapi.GRPCRouteSpec{
+					Rules: []gatewayapi.GRPCRouteRule{
+						{
+							Matches: []gatewayapi.GRPCRouteMatch{
+								{Method: &gatewayapi.GRPCMethodMatch{Service: lo.ToPtr("v1"), Method: lo.ToPtr("foo")}},
+							},
+							BackendRefs: []gatewayapi.GRPCBackendRef{{BackendRef: facadeBackendRef}},
+						},
+					},
+				},
+			},
+		},
+		KongServiceFacades: []*incubatorv1alpha1.KongServiceFacade{
+			{
+				ObjectMeta: metav1.ObjectMeta{
+					Namespace: "default",
+					Name:      "svc-facade",
+					Annotations: map[string]string{
+						annotations.IngressClassKey:                           annotations.DefaultIngressClass,
+						annotations.AnnotationPrefix + annotations.PluginsKey: "auth",
+					},
+				},
+				Spec: incubatorv1alpha1.KongServiceFacadeSpec{
+					Backend: incubatorv1alpha1.KongServiceFacadeBackend{
+						Name: "svc",
+						Port: 80,
+					},
+				},
+			},
+		},
+		KongPlugins: []*kongv1.KongPlugin{
+			{
+				ObjectMeta: metav1.ObjectMeta{
+					Namespace: "default",
+					Name:      "auth",
+				},
+				PluginName: "key-auth",
+			},
+		},
+		Services: []*corev1.Service{
+			{
+				ObjectMeta: metav1.ObjectMeta{
+					Namespace: "default",
+					Name:      "svc",
+				},
+				Spec: corev1.ServiceSpec{
+					Ports: []corev1.ServicePort{{Port: 80}},
+				},
+			},
+		},
+	}
+
+	for _, expressionRoutes := range []bool{false, true} {
+		t.Run("expression routes "+strconv.FormatBool(expressionRoutes), func(t *testing.T) {
+			s, err := store.NewFakeStore(objects)
+			require.NoError(t, err)
+			p := mustNewTranslator(t, s)
+			p.featureFlags.ExpressionRoutes = expressionRoutes
+
+			result := p.BuildKongConfig()
+			require.Empty(t, result.TranslationFailures)
+
+			require.Len(t, result.KongState.Services, 1, "routes backed by the same KongServiceFacade should share its Kong service")
+			service := result.KongState.Services[0]
+			require.Equal(t, "default.svc-facade.svc.facade", *service.Name)
+			require.Len(t, service.Routes, 3)
+
+			require.Len(t, result.KongState.Plugins, 1)
+			plugin := result.KongState.Plugins[0]
+			require.Equal(t, "key-auth", *plugin.Name)
+			require.NotNil(t, plugin.Service, "plugin attached to the KongServiceFacade should be attached to its Kong service")
+
+			require.Len(t, result.KongState.Upstreams, 1)
+		})
+	}
+}
+
+func TestTranslator_ServiceImportAsGatewayAPIBackend(t *testing.T) {
+	importBackendRef := builder.NewBackendRef("svc").
+		WithGroup(mcsv1alpha1.GroupVersion.Group).
+		WithKind(mcsv1alpha1.ServiceImportKind).
+		WithPort(80).
+		Build()
+	objects := store.FakeObjects{
+		Gateways: []*gatewayapi.Gateway{
+			{
+				ObjectMeta: metav1.ObjectMeta{
+					Namespace: "default",
+					Name:      "gateway",
+				},
+				Spec: gatewayapi.GatewaySpec{
+					Listeners: []gatewayapi.Listener{
+						builder.NewListener("tcp9000").WithPort(9000).TCP().Build(),
+					},
+				},
+			},
+		},
+		HTTPRoutes: []*gatewayapi.HTTPRoute{
+			{
+				TypeMeta: gatewayapi.V1HTTPRouteTypeMeta,
+				ObjectMeta: metav1.ObjectMeta{
+					Namespace: "default",
+					Name:      "httproute",
+				},
+				Spec: gatewayapi.HTTPRouteSpec{
+					Rules: []gatewayapi.HTTPRouteRule{
+						{
+							Matches:     []gatewayapi.HTTPRouteMatch{builder.NewHTTPRouteMatch().WithPathPrefix("/foo").Build()},
+							BackendRefs: []gatewayapi.HTTPBackendRef{{BackendRef: importBackendRef}},
+						},
+					},
+				},
+			},
+		},
+		GRPCRoutes: []*gatewayapi.GRPCRoute{
+			{
+				TypeMeta: gatewayapi.GRPCRouteTypeMeta,
+				ObjectMeta: metav1.ObjectMeta{
+					Namespace: "default",
+					Name:      "grpcroute",
+				},
+				Spec: gatewayapi.GRPCRouteSpec{
+					Rules: []gatewayapi.GRPCRouteRule{
+						{
+							Matches: []gatewayapi.GRPCRouteMatch{
+								{Method: &gatewayapi.GRPCMethodMatch{Service: lo.ToPtr("v1"), Method: lo.ToPtr("foo")}},
+							},
+							BackendRefs: []gatewayapi.GRPCBackendRef{{BackendRef: importBackendRef}},
+						},
+					},
+				},
+			},
+		},
+		TCPRoutes: []*gatewayapi.TCPRoute{
+			{
+				TypeMeta: gatewayapi.TCPRouteTypeMeta,
+				ObjectMeta: metav1.ObjectMeta{
+					Namespace: "default",
+					Name:      "tcproute",
+				},
+				Spec: gatewayapi.TCPRouteSpec{
+					CommonRouteSpec: gatewayapi.CommonRouteSpec{
+						ParentRefs: []gatewayapi.ParentReference{{Name: "gateway"}},
+					},
+					Rules: []gatewayapi.TCPRouteRule{
+						{BackendRefs: []gatewayapi.BackendRef{importBackendRef}},
+					},
+				},
+			},
+		},
+		ServiceImports: []*mcsv1alpha1.ServiceImport{
+			{
+				ObjectMeta: metav1.ObjectMeta{
+					Namespace: "default",
+					Name:      "svc",
+				},
+				Spec: mcsv1alpha1.ServiceImportSpec{
+					Type:  mcsv1alpha1.ClusterSetIP,
+					Ports: []mcsv1alpha1.ServicePort{{Name: "http", Port: 80, Protocol: corev1.ProtocolTCP}},
+				},
+			},
+		},
+		// A local Service with the same name as the ServiceImport. Its endpoints must not be used.
+		Services: []*corev1.Service{
+			{
+				ObjectMeta: metav1.ObjectMeta{
+					Namespace: "default",
+					Name:      "svc",
+				},
+				Spec: corev1.ServiceSpec{
+					Ports: []corev1.ServicePort{{Name: "http", Port: 80, TargetPort: intstr.FromInt32(8080)}},
+				},
+			},
+		},
+		EndpointSlices: []*discoveryv1.EndpointSlice{
+			{
+				ObjectMeta: metav1.ObjectMeta{
+					Namespace: "default",
+					Name:      "svc-imported",
+					Labels:    map[string]string{mcsv1alpha1.LabelServiceName: "svc"},
+				},
+				AddressType: discoveryv1.AddressTypeIPv4,
+				Endpoints: []discoveryv1.Endpoint{
+					{Addresses: []string{"10.1.0.1"}, Conditions: discoveryv1.EndpointConditions{Ready: lo.ToPtr(true)}},
+				},
+				Ports: []discoveryv1.EndpointPort{
+					{Name: lo.ToPtr("http"), Port: lo.ToPtr(int32(8080)), Protocol: lo.ToPtr(corev1.ProtocolTCP)},
+				},
+			},
+			{
+				ObjectMeta: metav1.ObjectMeta{
+					Namespace: "default",
+					Name:      "svc-local",
+					Labels:    map[string]string{discoveryv1.LabelServiceName: "svc"},
+				},
+				AddressType: discoveryv1.AddressTypeIPv4,
+				Endpoints: []discoveryv1.Endpoint{
+					{Addresses: []string{"10.0.0.1"}, Conditions: discoveryv1.EndpointConditions{Ready: lo.ToPtr(true)}},
+				},
+				Ports: []discoveryv1.EndpointPort{
+					{Name: lo.ToPtr("http"), Port: lo.ToPtr(int32(8080)), Protocol: lo.ToPtr(corev1.ProtocolTCP)},
+				},
+			},
+		},
+	}
+
+	t.Run("endpoints are resolved from EndpointSlices labelled with the ServiceImport", func(t *testing.T) {
+		s, err := store.NewFakeStore(objects)
+		require.NoError(t, err)
+		p := mustNewTranslator(t, s)
+		p.featureFlags.MultiClusterServices = true
+
+		result := p.BuildKongConfig()
+		require.Empty(t, result.TranslationFailures)
+		require.Len(t, result.KongState.Services, 3, "expected a Kong service per route")
+		require.Len(t, result.KongState.Upstreams, 3)
+		for _, upstream := range result.KongState.Upstreams {
+			require.Len(t, upstream.Targets, 1)
+			require.Equal(t, "10.1.0.1:8080", *upstream.Targets[0].Target.Target)
+		}
+	})
+
+	t.Run("ServiceImport backends are rejected when the feature is disabled", func(t *testing.T) {
+		s, err := store.NewFakeStore(objects)
+		require.NoError(t, err)
+		p := mustNewTranslator(t, s)
+
+		result := p.BuildKongConfig()
+		for _, upstream := range result.KongState.Upstreams {
+			require.Empty(t, upstream.Targets)
+		}
+	})
+}
@@ -0,0 +1,195 @@
+package diagnostics
+
+import (
+	"encoding/json"
+	"fmt"
+	"sort"
+
+	"github.com/kong/go-database-reconciler/pkg/file"
+	"github.com/samber/lo"
+)
+
+// ConfigDiff is an entity-level difference between two config dumps.
+type ConfigDiff struct {
+	// From is the ID of the config dump the diff is computed from.
+	From uint64 `json:"from"`
+	// To is the ID of the config dump the diff is computed to.
+	To uint64 `json:"to"`
+
+	Services     EntityDiff `json:"services"`
+	Routes       EntityDiff `json:"routes"`
+	Plugins      EntityDiff `json:"plugins"`
+	Upstreams    EntityDiff `json:"upstreams"`
+	Certificates EntityDiff `json:"certificates"`
+}
+
+// EntityDiff lists entities of a single type that differ between two config dumps.
+// Entities are identified by keys that are stable across config pushes (e.g. names).
+type EntityDiff struct {
+	Added    []string       `json:"added,omitempty"`
+	Removed  []string       `json:"removed,omitempty"`
+	Modified []EntityChange `json:"modified,omitempty"`
+}
+
+// EntityChange describes an entity present in both config dumps with different contents.
+type EntityChange struct {
+	Key  string          `json:"key"`
+	From json.RawMessage `json:"from"`
+	To   json.RawMessage `json:"to"`
+}
+
+// diffConfigDumps computes an entity-level diff between two config dumps.
+func diffConfigDumps(from, to ConfigDumpEntry) (ConfigDiff, error) {
+	fromEntities, err := flattenEntities(from.Config)
+	if err != nil {
+		return ConfigDiff{}, fmt.Errorf("failed to flatten config dump %d: %w", from.ID, err)
+	}
+	toEntities, err := flattenEntities(to.Config)
+	if err != nil {
+		return ConfigDiff{}, fmt.Errorf("failed to flatten config dump %d: %w", to.ID, err)
+	}
+
+	return ConfigDiff{
+		From:         from.ID,
+		To:           to.ID,
+		Services:     diffEntities(fromEntities.services, toEntities.services),
+		Routes:       diffEntities(fromEntities.routes, toEntities.routes),
+		Plugins:      diffEntities(fromEntities.plugins, toEntities.plugins),
+		Upstreams:    diffEntities(fromEntities.upstreams, toEntities.upstreams),
+		Certificates: diffEntities(fromEntities.certificates, toEntities.certificates),
+	}, nil
+}
+
+// entitiesByKey maps entity keys to their JSON representation.
+type entitiesByKey map[string]json.RawMessage
+
+func (e entitiesByKey) add(key string, entity any) error {
+	b, err := json.Marshal(entity)
+	if err != nil {
+		return fmt.Errorf("failed to marshal %s: %w", key, err)
+	}
+	e[key] = b
+	return nil
+}
+
+type flattenedEntities struct {
+	services     entitiesByKey
+	routes       entitiesByKey
+	plugins      entitiesByKey
+	upstreams    entitiesByKey
+	certificates entitiesByKey
+}
+
+// flattenEntities extracts entities nested in the declarative config so that each of them can be compared
+// on its own, e.g. a change in a route's plugin is reported as a plugin modification only.
+func flattenEntities(content file.Content) (flattenedEntities, error) {
+	f := flattenedEntities{
+		services:     entitiesByKey{},
+		routes:       entitiesByKey{},
+		plugins:      entitiesByKey{},
+		upstreams:    entitiesByKey{},
+		certificates: entitiesByKey{},
+	}
+
+	for _, s := range content.Services {
+		serviceName := lo.FromPtr(s.Name)
+		if err := f.services.add(serviceName, s.Service); err != nil {
+			return flattenedEntities{}, err
+		}
+		for _, p := range s.Plugins {
+			if err := f.plugins.add(pluginKey("service", serviceName, p), p.Plugin); err != nil {
+				return flattenedEntities{}, err
+			}
+		}
+		for _, r := range s.Routes {
+			routeName := lo.FromPtr(r.Name)
+			if err := f.routes.add(routeName, r.Route); err != nil {
+				return flattenedEntities{}, err
+			}
+			for _, p := range r.Plugins {
+				if err := f.plugins.add(pluginKey("route", routeName, p), p.Plugin); err != nil {
+					return flattenedEntities{}, err
+				}
+			}
+		}
+	}
+	for _, c := range content.Consumers {
+		for _, p := range c.Plugins {
+			if err := f.plugins.add(pluginKey("consumer", lo.FromPtr(c.Username), p), p.Plugin); err != nil {
+				return flattenedEntities{}, err
+			}
+		}
+	}
+	for _, p := range content.Plugins {
+		if err := f.plugins.add(pluginKey("global", "", &p), p.Plugin); err != nil {
+			return flattenedEntities{}, err
+		}
+	}
+	for _, u := range content.Upstreams {
+		if err := f.upstreams.add(lo.FromPtr(u.Name), u); err != nil {
+			return flattenedEntities{}, err
+		}
+	}
+	for _, c := range content.Certificates {
+		// Certificates generated by KIC always have an ID derived from the source Secret's UID.
+		// Fall back to the certificate itself when it's missing.
+		key := lo.FromPtr(c.ID)
+		if key == "" {
+			key = lo.FromPtr(c.Cert)
+		}
+		if err := f.certificates.add(key, c); err != nil {
+			return flattenedEntities{}, err
+		}
+	}
+
+	return f, nil
+}
+
+// pluginKey returns a key identifying a plugin in the scope of the entity it's nested in.
+// For global plugins, the key also includes the entities the plugin refers to.
+func pluginKey(scope, parent string, p *file.FPlugin) string {
+	key := fmt.Sprintf("%s:%s:%s", scope, parent, lo.FromPtr(p.Name))
+	if p.Service != nil {
+		key += fmt.Sprintf(":service=%s", lo.FromPtr(p.Service.Name))
+	}
+	if p.Route != nil {
+		key += fmt.Sprintf(":route=%s", lo.FromPtr(p.Route.Name))
+	}
+	if p.Consumer != nil {
+		key += fmt.Sprintf(":consumer=%s", lo.FromPtr(p.Consumer.Username))
+	}
+	if p.InstanceName != nil {
+		key += fmt.Sprintf(":instance=%s", *p.InstanceName)
+	}
+	return key
+}
+
+func diffEntities(from, to entitiesByKey) EntityDiff {
+	var diff EntityDiff
+	for key, fromEntity := range from {
+		toEntity, ok := to[key]
+		if !ok {
+			diff.Removed = append(diff.Removed, key)
+			continue
+		}
+		if string(fromEntity) != string(toEntity) {
+			diff.Modified = append(diff.Modified, EntityChange{
+				Key:  key,
+				From: fromEntity,
+				To:   toEntity,
+			})
+		}
+	}
+	for key := range to {
+		if _, ok := from[key]; !ok {
+			diff.Added = append(diff.Added, key)
+		}
+	}
+
+	sort.Strings(diff.Added)
+	sort.Strings(diff.Removed)
+	sort.Slice(diff.Modified, func(i, j int) bool {
+		return diff.Modified[i].Key < diff.Modified[j].Key
+	})
+	return diff
+}
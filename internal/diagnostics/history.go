@@ -0,0 +1,94 @@
+package diagnostics
+
+import (
+	"time"
+
+	"github.com/kong/go-database-reconciler/pkg/file"
+
+	"github.com/kong/kubernetes-ingress-controller/v3/internal/util"
+)
+
+// configDumpHistoryDepth is the number of past config dumps kept by the diagnostics server. Every dump holds a full
+// Kong configuration, so it is kept relatively small to bound memory usage in large clusters.
+const configDumpHistoryDepth = 16
+
+// ConfigDumpMeta describes a config dump stored in the history without the configuration itself.
+type ConfigDumpMeta struct {
+	// ID is a monotonically increasing identifier of the config dump.
+	ID uint64 `json:"id"`
+	// Timestamp is the time the diagnostics server received the config dump.
+	Timestamp time.Time `json:"timestamp"`
+	// Hash is the hex-encoded SHA256 checksum of the configuration.
+	Hash string `json:"hash,omitempty"`
+	// Failed is true if the configuration apply failed.
+	Failed bool `json:"failed"`
+	// DryRun is true if the configuration was only validated against Kong without being applied.
+	DryRun bool `json:"dryRun,omitempty"`
+}
+
+// ConfigDumpEntry is a config dump stored in the history.
+type ConfigDumpEntry struct {
+	ConfigDumpMeta
+
+	// Config is the configuration KIC applied or attempted to apply.
+	Config file.Content `json:"config"`
+	// RawResponseBody is the raw Kong Admin API response body from a failed config apply.
+	RawResponseBody string `json:"rawResponseBody,omitempty"`
+}
+
+// configDumpHistory is a bounded ring buffer of config dumps. It is not safe for concurrent use.
+type configDumpHistory struct {
+	entries []ConfigDumpEntry
+	// next is the index in entries the next config dump will be written to.
+	next int
+	// lastID is the ID assigned to the most recently added config dump.
+	lastID uint64
+}
+
+func newConfigDumpHistory(depth int) *configDumpHistory {
+	return &configDumpHistory{
+		entries: make([]ConfigDumpEntry, 0, depth),
+	}
+}
+
+// add stores the dump in the history, evicting the oldest entry if the history is full.
+func (h *configDumpHistory) add(dump util.ConfigDump, ts time.Time) {
+	h.lastID++
+	entry := ConfigDumpEntry{
+		ConfigDumpMeta: ConfigDumpMeta{
+			ID:        h.lastID,
+			Timestamp: ts,
+			Hash:      dump.Hash,
+			Failed:    dump.Failed,
+			DryRun:    dump.DryRun,
+		},
+		Config:          dump.Config,
+		RawResponseBody: string(dump.RawResponseBody),
+	}
+	if len(h.entries) < cap(h.entries) {
+		h.entries = append(h.entries, entry)
+	} else {
+		h.entries[h.next] = entry
+	}
+	h.next = (h.next + 1) % cap(h.entries)
+}
+
+// list returns metadata of all stored config dumps, from the newest to the oldest.
+func (h *configDumpHistory) list() []ConfigDumpMeta {
+	metas := make([]ConfigDumpMeta, 0, len(h.entries))
+	for i := 1; i <= len(h.entries); i++ {
+		idx := (h.next - i + cap(h.entries)) % cap(h.entries)
+		metas = append(metas, h.entries[idx].ConfigDumpMeta)
+	}
+	return metas
+}
+
+// get returns the config dump with the given ID if it is still stored in the history.
+func (h *configDumpHistory) get(id uint64) (ConfigDumpEntry, bool) {
+	for _, e := range h.entries {
+		if e.ID == id {
+			return e, true
+		}
+	}
+	return ConfigDumpEntry{}, false
+}
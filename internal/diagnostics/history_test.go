@@ -0,0 +1,112 @@
+package diagnostics
+
+import (
+	"testing"
+	"time"
+
+	"github.com/kong/go-database-reconciler/pkg/file"
+	"github.com/kong/go-kong/kong"
+	"github.com/samber/lo"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/kong/kubernetes-ingress-controller/v3/internal/util"
+)
+
+func TestConfigDumpHistory(t *testing.T) {
+	h := newConfigDumpHistory(3)
+	require.Empty(t, h.list())
+
+	now := time.Now()
+	for i := 0; i < 5; i++ {
+		h.add(util.ConfigDump{
+			Hash:   string(rune('a' + i)),
+			Failed: i%2 == 1,
+		}, now.Add(time.Duration(i)*time.Second))
+	}
+
+	metas := h.list()
+	require.Len(t, metas, 3, "history should be bounded by its depth")
+	assert.Equal(t, []uint64{5, 4, 3}, lo.Map(metas, func(m ConfigDumpMeta, _ int) uint64 { return m.ID }),
+		"entries should be listed from the newest to the oldest")
+	assert.Equal(t, "e", metas[0].Hash)
+	assert.False(t, metas[0].Failed)
+	assert.True(t, metas[1].Failed)
+	assert.Equal(t, now.Add(4*time.Second), metas[0].Timestamp)
+
+	_, ok := h.get(2)
+	assert.False(t, ok, "evicted entry should not be returned")
+	entry, ok := h.get(4)
+	require.True(t, ok)
+	assert.Equal(t, "d", entry.Hash)
+}
+
+func TestDiffConfigDumps(t *testing.T) {
+	from := ConfigDumpEntry{
+		ConfigDumpMeta: ConfigDumpMeta{ID: 1},
+		Config: file.Content{
+			Services: []file.FService{
+				{
+					Service: kong.Service{Name: kong.String("svc-a"), Host: kong.String("a.default.80.svc")},
+					Routes: []*file.FRoute{
+						{
+							Route: kong.Route{Name: kong.String("route-a"), Paths: kong.StringSlice("/a")},
+							Plugins: []*file.FPlugin{
+								{Plugin: kong.Plugin{Name: kong.String("key-auth")}},
+							},
+						},
+					},
+				},
+				{
+					Service: kong.Service{Name: kong.String("svc-removed")},
+				},
+			},
+			Upstreams: []file.FUpstream{
+				{Upstream: kong.Upstream{Name: kong.String("a.default.80.svc")}},
+			},
+			Certificates: []file.FCertificate{
+				{ID: kong.String("cert-1"), Cert: kong.String("cert")},
+			},
+		},
+	}
+	to := ConfigDumpEntry{
+		ConfigDumpMeta: ConfigDumpMeta{ID: 2},
+		Config: file.Content{
+			Services: []file.FService{
+				{
+					Service: kong.Service{Name: kong.String("svc-a"), Host: kong.String("a.default.80.svc")},
+					Routes: []*file.FRoute{
+						{
+							Route: kong.Route{Name: kong.String("route-a"), Paths: kong.StringSlice("/a", "/b")},
+						},
+					},
+				},
+			},
+			Upstreams: []file.FUpstream{
+				{Upstream: kong.Upstream{Name: kong.String("a.default.80.svc")}},
+			},
+			Plugins: []file.FPlugin{
+				{Plugin: kong.Plugin{Name: kong.String("prometheus")}},
+			},
+			Certificates: []file.FCertificate{
+				{ID: kong.String("cert-1"), Cert: kong.String("rotated-cert")},
+			},
+		},
+	}
+
+	diff, err := diffConfigDumps(from, to)
+	require.NoError(t, err)
+
+	assert.Equal(t, uint64(1), diff.From)
+	assert.Equal(t, uint64(2), diff.To)
+	assert.Equal(t, EntityDiff{Removed: []string{"svc-removed"}}, diff.Services)
+	assert.Empty(t, diff.Routes.Added)
+	assert.Empty(t, diff.Routes.Removed)
+	require.Len(t, diff.Routes.Modified, 1)
+	assert.Equal(t, "route-a", diff.Routes.Modified[0].Key)
+	assert.Equal(t, []string{"global::prometheus"}, diff.Plugins.Added)
+	assert.Equal(t, []string{"route:route-a:key-auth"}, diff.Plugins.Removed)
+	assert.Equal(t, EntityDiff{}, diff.Upstreams)
+	require.Len(t, diff.Certificates.Modified, 1)
+	assert.Equal(t, "cert-1", diff.Certificates.Modified[0].Key)
+}
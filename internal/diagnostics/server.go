@@ -7,6 +7,7 @@ import (
 	"fmt"
 	"net/http"
 	"net/http/pprof"
+	"strconv"
 	"sync"
 	"time"
 
@@ -36,6 +37,7 @@ type Server struct {
 	successfulConfigDump file.Content
 	failedConfigDump     file.Content
 	rawErrBody           []byte
+	configHistory        *configDumpHistory
 	configLock           *sync.RWMutex
 }
 
@@ -56,6 +58,7 @@ func NewServer(logger logr.Logger, cfg ServerConfig) Server {
 	s := Server{
 		logger:           logger,
 		profilingEnabled: cfg.ProfilingEnabled,
+		configHistory:    newConfigDumpHistory(configDumpHistoryDepth),
 		configLock:       &sync.RWMutex{},
 	}
 
@@ -124,9 +127,11 @@ func (s *Server) receiveConfig(ctx context.Context) {
 			if dump.Failed {
 				s.failedConfigDump = dump.Config
 				s.rawErrBody = dump.RawResponseBody
-			} else {
+			} else if !dump.DryRun {
+				// Dry-run configurations are only validated, so they're not what the gateways are running.
 				s.successfulConfigDump = dump.Config
 			}
+			s.configHistory.add(dump, time.Now())
 			s.configLock.Unlock()
 		case <-ctx.Done():
 			if err := ctx.Err(); err != nil && !errors.Is(err, context.Canceled) {
@@ -159,6 +164,9 @@ func (s *Server) installDumpHandlers(mux *http.ServeMux) {
 	mux.HandleFunc("/debug/config/successful", s.handleLastValidConfig)
 	mux.HandleFunc("/debug/config/failed", s.handleLastFailedConfig)
 	mux.HandleFunc("/debug/config/raw-error", s.handleLastErrBody)
+	mux.HandleFunc("/debug/config/history", s.handleConfigHistory)
+	mux.HandleFunc("/debug/config/history/{id}", s.handleConfigHistoryEntry)
+	mux.HandleFunc("/debug/config/diff", s.handleConfigDiff)
 }
 
 // redirectTo redirects request to a certain destination.
@@ -198,3 +206,71 @@ func (s *Server) handleLastErrBody(rw http.ResponseWriter, _ *http.Request) {
 		rw.WriteHeader(http.StatusInternalServerError)
 	}
 }
+
+func (s *Server) handleConfigHistory(rw http.ResponseWriter, _ *http.Request) {
+	rw.Header().Set("Content-Type", "application/json")
+	s.configLock.RLock()
+	defer s.configLock.RUnlock()
+	if err := json.NewEncoder(rw).Encode(s.configHistory.list()); err != nil {
+		rw.WriteHeader(http.StatusInternalServerError)
+	}
+}
+
+func (s *Server) handleConfigHistoryEntry(rw http.ResponseWriter, req *http.Request) {
+	id, err := strconv.ParseUint(req.PathValue("id"), 10, 64)
+	if err != nil {
+		http.Error(rw, fmt.Sprintf("invalid config dump ID: %s", err), http.StatusBadRequest)
+		return
+	}
+
+	s.configLock.RLock()
+	defer s.configLock.RUnlock()
+	entry, ok := s.configHistory.get(id)
+	if !ok {
+		http.Error(rw, fmt.Sprintf("config dump %d not found", id), http.StatusNotFound)
+		return
+	}
+	rw.Header().Set("Content-Type", "application/json")
+	if err := json.NewEncoder(rw).Encode(entry); err != nil {
+		rw.WriteHeader(http.StatusInternalServerError)
+	}
+}
+
+// handleConfigDiff serves an entity-level diff between two config dumps from the history identified
+// by the "from" and "to" query parameters.
+func (s *Server) handleConfigDiff(rw http.ResponseWriter, req *http.Request) {
+	query := req.URL.Query()
+	fromID, err := strconv.ParseUint(query.Get("from"), 10, 64)
+	if err != nil {
+		http.Error(rw, fmt.Sprintf("invalid 'from' config dump ID: %s", err), http.StatusBadRequest)
+		return
+	}
+	toID, err := strconv.ParseUint(query.Get("to"), 10, 64)
+	if err != nil {
+		http.Error(rw, fmt.Sprintf("invalid 'to' config dump ID: %s", err), http.StatusBadRequest)
+		return
+	}
+
+	s.configLock.RLock()
+	from, fromOK := s.configHistory.get(fromID)
+	to, toOK := s.configHistory.get(toID)
+	s.configLock.RUnlock()
+	if !fromOK {
+		http.Error(rw, fmt.Sprintf("config dump %d not found", fromID), http.StatusNotFound)
+		return
+	}
+	if !toOK {
+		http.Error(rw, fmt.Sprintf("config dump %d not found", toID), http.StatusNotFound)
+		return
+	}
+
+	diff, err := diffConfigDumps(from, to)
+	if err != nil {
+		http.Error(rw, err.Error(), http.StatusInternalServerError)
+		return
+	}
+	rw.Header().Set("Content-Type", "application/json")
+	if err := json.NewEncoder(rw).Encode(diff); err != nil {
+		rw.WriteHeader(http.StatusInternalServerError)
+	}
+}
@@ -6,9 +6,11 @@ import (
 	"net/http"
 	"sync"
 	"testing"
+	"time"
 
 	"github.com/go-logr/logr"
 	"github.com/kong/go-database-reconciler/pkg/file"
+	"github.com/kong/go-kong/kong"
 	"github.com/stretchr/testify/require"
 
 	"github.com/kong/kubernetes-ingress-controller/v3/internal/util"
@@ -86,3 +88,27 @@ func TestDiagnosticsServer_ConfigDumps(t *testing.T) {
 
 	<-ctx.Done()
 }
+
+func TestDiagnosticsServer_DryRunConfigDumpsAreNotSuccessful(t *testing.T) {
+	s := NewServer(logr.Discard(), ServerConfig{
+		ConfigDumpsEnabled: true,
+	})
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+	go s.receiveConfig(ctx)
+
+	applied := file.Content{Services: []file.FService{{Service: kong.Service{Name: kong.String("applied")}}}}
+	dryRun := file.Content{Services: []file.FService{{Service: kong.Service{Name: kong.String("dry-run")}}}}
+	s.configDumps.Configs <- util.ConfigDump{Config: applied, Hash: "applied"}
+	s.configDumps.Configs <- util.ConfigDump{Config: dryRun, Hash: "dry-run", DryRun: true}
+
+	require.Eventually(t, func() bool {
+		s.configLock.RLock()
+		defer s.configLock.RUnlock()
+		return len(s.configHistory.list()) == 2
+	}, time.Second, 10*time.Millisecond)
+
+	s.configLock.RLock()
+	defer s.configLock.RUnlock()
+	require.Equal(t, applied, s.successfulConfigDump, "dry-run config dumps should not replace the successful config")
+}
@@ -3,6 +3,7 @@ package gatewayapi
 import (
 	gatewayv1 "sigs.k8s.io/gateway-api/apis/v1"
 	gatewayv1alpha2 "sigs.k8s.io/gateway-api/apis/v1alpha2"
+	gatewayv1alpha3 "sigs.k8s.io/gateway-api/apis/v1alpha3"
 	gatewayv1beta1 "sigs.k8s.io/gateway-api/apis/v1beta1"
 )
 
@@ -41,6 +42,7 @@ type (
 	HTTPMethod                = gatewayv1.HTTPMethod
 	HTTPPathMatch             = gatewayv1.HTTPPathMatch
 	HTTPQueryParamMatch       = gatewayv1.HTTPQueryParamMatch
+	HTTPRequestMirrorFilter   = gatewayv1.HTTPRequestMirrorFilter
 	HTTPRequestRedirectFilter = gatewayv1.HTTPRequestRedirectFilter
 	HTTPRoute                 = gatewayv1.HTTPRoute
 	HTTPRouteFilter           = gatewayv1.HTTPRouteFilter
@@ -85,12 +87,22 @@ type (
 	GRPCMethodMatch           = gatewayv1.GRPCMethodMatch
 	GRPCMethodMatchType       = gatewayv1.GRPCMethodMatchType
 	GRPCRoute                 = gatewayv1.GRPCRoute
+	GRPCRouteFilter           = gatewayv1.GRPCRouteFilter
+	GRPCRouteFilterType       = gatewayv1.GRPCRouteFilterType
 	GRPCRouteList             = gatewayv1.GRPCRouteList
 	GRPCRouteMatch            = gatewayv1.GRPCRouteMatch
 	GRPCRouteRule             = gatewayv1.GRPCRouteRule
 	GRPCRouteSpec             = gatewayv1.GRPCRouteSpec
 	GRPCRouteStatus           = gatewayv1.GRPCRouteStatus
 
+	CookieConfig           = gatewayv1.CookieConfig
+	CookieLifetimeType     = gatewayv1.CookieLifetimeType
+	SessionPersistence     = gatewayv1.SessionPersistence
+	SessionPersistenceType = gatewayv1.SessionPersistenceType
+
+	BackendLBPolicy      = gatewayv1alpha2.BackendLBPolicy
+	BackendLBPolicyList  = gatewayv1alpha2.BackendLBPolicyList
+	BackendLBPolicySpec  = gatewayv1alpha2.BackendLBPolicySpec
 	PolicyAncestorStatus = gatewayv1alpha2.PolicyAncestorStatus
 	PolicyStatus         = gatewayv1alpha2.PolicyStatus
 	TCPRoute             = gatewayv1alpha2.TCPRoute
@@ -108,6 +120,15 @@ type (
 	UDPRouteRule         = gatewayv1alpha2.UDPRouteRule
 	UDPRouteSpec         = gatewayv1alpha2.UDPRouteSpec
 	UDPRouteStatus       = gatewayv1alpha2.UDPRouteStatus
+
+	LocalPolicyTargetReference                = gatewayv1alpha2.LocalPolicyTargetReference
+	LocalPolicyTargetReferenceWithSectionName = gatewayv1alpha2.LocalPolicyTargetReferenceWithSectionName
+
+	BackendTLSPolicy            = gatewayv1alpha3.BackendTLSPolicy
+	BackendTLSPolicyList        = gatewayv1alpha3.BackendTLSPolicyList
+	BackendTLSPolicySpec        = gatewayv1alpha3.BackendTLSPolicySpec
+	BackendTLSPolicyValidation  = gatewayv1alpha3.BackendTLSPolicyValidation
+	WellKnownCACertificatesType = gatewayv1alpha3.WellKnownCACertificatesType
 )
 
 const (
@@ -168,16 +189,28 @@ const (
 	RouteReasonNotAllowedByListeners      = gatewayv1.RouteReasonNotAllowedByListeners
 	RouteReasonRefNotPermitted            = gatewayv1.RouteReasonRefNotPermitted
 	RouteReasonResolvedRefs               = gatewayv1.RouteReasonResolvedRefs
+	RouteReasonUnsupportedProtocol        = gatewayv1.RouteReasonUnsupportedProtocol
 	TCPProtocolType                       = gatewayv1.TCPProtocolType
 	TLSModePassthrough                    = gatewayv1.TLSModePassthrough
 	TLSModeTerminate                      = gatewayv1.TLSModeTerminate
 	TLSProtocolType                       = gatewayv1.TLSProtocolType
 	UDPProtocolType                       = gatewayv1.UDPProtocolType
 
-	GRPCMethodMatchExact             = gatewayv1.GRPCMethodMatchExact
-	GRPCMethodMatchRegularExpression = gatewayv1.GRPCMethodMatchRegularExpression
+	GRPCMethodMatchExact                  = gatewayv1.GRPCMethodMatchExact
+	GRPCMethodMatchRegularExpression      = gatewayv1.GRPCMethodMatchRegularExpression
+	GRPCRouteFilterExtensionRef           = gatewayv1.GRPCRouteFilterExtensionRef
+	GRPCRouteFilterRequestHeaderModifier  = gatewayv1.GRPCRouteFilterRequestHeaderModifier
+	GRPCRouteFilterRequestMirror          = gatewayv1.GRPCRouteFilterRequestMirror
+	GRPCRouteFilterResponseHeaderModifier = gatewayv1.GRPCRouteFilterResponseHeaderModifier
+
+	CookieBasedSessionPersistence = gatewayv1.CookieBasedSessionPersistence
+	HeaderBasedSessionPersistence = gatewayv1.HeaderBasedSessionPersistence
+	PermanentCookieLifetimeType   = gatewayv1.PermanentCookieLifetimeType
 
 	PolicyConditionAccepted = gatewayv1alpha2.PolicyConditionAccepted
 	PolicyReasonAccepted    = gatewayv1alpha2.PolicyReasonAccepted
 	PolicyReasonConflicted  = gatewayv1alpha2.PolicyReasonConflicted
+	PolicyReasonInvalid     = gatewayv1alpha2.PolicyReasonInvalid
+
+	WellKnownCACertificatesSystem = gatewayv1alpha3.WellKnownCACertificatesSystem
 )
@@ -0,0 +1,185 @@
+// Package gatewaysync keeps KongGatewaySync objects up to date, so that the state of the configuration sync
+// with every Kong gateway the controller configures can be inspected with kubectl.
+package gatewaysync
+
+import (
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/go-logr/logr"
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/api/equality"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	k8stypes "k8s.io/apimachinery/pkg/types"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	"github.com/kong/kubernetes-ingress-controller/v3/internal/adminapi"
+	"github.com/kong/kubernetes-ingress-controller/v3/internal/util"
+	configurationv1alpha1 "github.com/kong/kubernetes-ingress-controller/v3/pkg/apis/configuration/v1alpha1"
+)
+
+// DefaultUpdatePeriod is the default period of updating KongGatewaySyncs.
+const DefaultUpdatePeriod = 10 * time.Second
+
+// GatewayClientsProvider provides the clients of the Kong gateways the controller configures.
+type GatewayClientsProvider interface {
+	GatewayClients() []*adminapi.Client
+}
+
+// Updater periodically creates or updates a KongGatewaySync for every Kong gateway with a recorded configuration
+// sync. KongGatewaySyncs of gateways running in Pods are named after and owned by the Pods, so they are garbage
+// collected along with them.
+type Updater struct {
+	logger logr.Logger
+
+	// reader is used to read objects directly from the API server, as KongGatewaySyncs and Pods of gateways are not
+	// necessarily in the namespaces watched by the controller.
+	reader client.Reader
+	writer client.Writer
+
+	clientsProvider GatewayClientsProvider
+	namespace       string
+	period          time.Duration
+}
+
+// NewUpdater creates a new Updater. KongGatewaySyncs of gateways that don't run in Pods known to the controller
+// (e.g. gateways configured with --kong-admin-url) are created in the given namespace.
+func NewUpdater(
+	logger logr.Logger,
+	reader client.Reader,
+	writer client.Writer,
+	clientsProvider GatewayClientsProvider,
+	namespace string,
+	period time.Duration,
+) *Updater {
+	return &Updater{
+		logger:          logger.WithName("gateway-sync-updater"),
+		reader:          reader,
+		writer:          writer,
+		clientsProvider: clientsProvider,
+		namespace:       namespace,
+		period:          period,
+	}
+}
+
+// Start runs the loop updating KongGatewaySyncs until the context is done.
+func (u *Updater) Start(ctx context.Context) error {
+	u.logger.Info("Starting KongGatewaySync updater")
+
+	ticker := time.NewTicker(u.period)
+	defer ticker.Stop()
+	for {
+		select {
+		case <-ctx.Done():
+			u.logger.Info("KongGatewaySync updater stopped", "message", ctx.Err().Error())
+			return nil
+		case <-ticker.C:
+			u.logger.V(util.DebugLevel).Info("Updating KongGatewaySyncs on tick")
+			u.update(ctx)
+		}
+	}
+}
+
+// NeedLeaderElection implements LeaderElectionRunnable interface to ensure that only the leader updates
+// KongGatewaySyncs, as only the leader configures the gateways.
+func (u *Updater) NeedLeaderElection() bool {
+	return true
+}
+
+// update creates or updates KongGatewaySyncs of all the gateway clients with a recorded sync.
+func (u *Updater) update(ctx context.Context) {
+	for _, cl := range u.clientsProvider.GatewayClients() {
+		syncStatus, ok := cl.SyncStatus()
+		if !ok {
+			// In DB-backed mode, only one of the gateways is configured directly, the rest have no syncs recorded.
+			continue
+		}
+		if err := u.updateGatewaySync(ctx, cl, syncStatus); err != nil {
+			u.logger.Error(err, "Failed to update KongGatewaySync", "url", cl.BaseRootURL())
+		}
+	}
+}
+
+func (u *Updater) updateGatewaySync(ctx context.Context, cl *adminapi.Client, syncStatus adminapi.SyncStatus) error {
+	status := configurationv1alpha1.KongGatewaySyncStatus{
+		Address:    cl.BaseRootURL(),
+		ConfigHash: syncStatus.ConfigHash,
+		Fallback:   syncStatus.Fallback,
+		// The time is serialized with a precision of seconds, truncate it to compare it with the stored one.
+		LastSyncTime: &metav1.Time{Time: syncStatus.Time.Truncate(time.Second)},
+	}
+	if syncStatus.Err != nil {
+		status.LastError = syncStatus.Err.Error()
+	}
+
+	podNN, hasPod := cl.PodReference()
+	nn := podNN
+	if !hasPod {
+		if u.namespace == "" {
+			return errors.New("namespace for KongGatewaySyncs of gateways not running in Pods is unknown")
+		}
+		nn = k8stypes.NamespacedName{Namespace: u.namespace, Name: nameForAddress(cl.BaseRootURL())}
+	}
+
+	gatewaySync := &configurationv1alpha1.KongGatewaySync{}
+	err := u.reader.Get(ctx, nn, gatewaySync)
+	if apierrors.IsNotFound(err) {
+		gatewaySync = &configurationv1alpha1.KongGatewaySync{
+			ObjectMeta: metav1.ObjectMeta{
+				Namespace: nn.Namespace,
+				Name:      nn.Name,
+			},
+			Status: status,
+		}
+		if hasPod {
+			ownerRef, err := u.podOwnerReference(ctx, podNN)
+			if err != nil {
+				return err
+			}
+			gatewaySync.OwnerReferences = []metav1.OwnerReference{ownerRef}
+		}
+		if err := u.writer.Create(ctx, gatewaySync); err != nil {
+			return fmt.Errorf("failed to create KongGatewaySync %s: %w", nn, err)
+		}
+		return nil
+	}
+	if err != nil {
+		return fmt.Errorf("failed to get KongGatewaySync %s: %w", nn, err)
+	}
+
+	if equality.Semantic.DeepEqual(gatewaySync.Status, status) {
+		return nil
+	}
+	gatewaySync.Status = status
+	if err := u.writer.Update(ctx, gatewaySync); err != nil {
+		return fmt.Errorf("failed to update KongGatewaySync %s: %w", nn, err)
+	}
+	return nil
+}
+
+func (u *Updater) podOwnerReference(ctx context.Context, podNN k8stypes.NamespacedName) (metav1.OwnerReference, error) {
+	pod := &corev1.Pod{}
+	if err := u.reader.Get(ctx, podNN, pod); err != nil {
+		return metav1.OwnerReference{}, fmt.Errorf("failed to get Pod %s: %w", podNN, err)
+	}
+	return metav1.OwnerReference{
+		APIVersion: corev1.SchemeGroupVersion.String(),
+		Kind:       "Pod",
+		Name:       pod.Name,
+		UID:        pod.UID,
+	}, nil
+}
+
+// nameForAddress returns a stable name of the KongGatewaySync of a gateway not running in a Pod.
+func nameForAddress(address string) string {
+	sum := sha256.Sum256([]byte(address))
+	return "gateway-" + hex.EncodeToString(sum[:])[:10]
+}
+
+// +kubebuilder:rbac:groups=configuration.konghq.com,resources=konggatewaysyncs,verbs=get;create;update
+// +kubebuilder:rbac:groups="",resources=pods,verbs=get
@@ -0,0 +1,178 @@
+package gatewaysync
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/go-logr/logr"
+	"github.com/samber/lo"
+	"github.com/stretchr/testify/require"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	k8stypes "k8s.io/apimachinery/pkg/types"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	fakectrlruntimeclient "sigs.k8s.io/controller-runtime/pkg/client/fake"
+
+	"github.com/kong/kubernetes-ingress-controller/v3/internal/adminapi"
+	"github.com/kong/kubernetes-ingress-controller/v3/internal/manager/scheme"
+	configurationv1alpha1 "github.com/kong/kubernetes-ingress-controller/v3/pkg/apis/configuration/v1alpha1"
+)
+
+type fakeGatewayClientsProvider struct {
+	clients []*adminapi.Client
+}
+
+func (p fakeGatewayClientsProvider) GatewayClients() []*adminapi.Client {
+	return p.clients
+}
+
+func TestUpdater_Update(t *testing.T) {
+	ctx := context.Background()
+	syncTime := time.Date(2024, 5, 1, 12, 0, 0, 0, time.UTC)
+	newClient := func(t *testing.T, address string, podNN *k8stypes.NamespacedName, sha []byte, syncErr error) *adminapi.Client {
+		cl, err := adminapi.NewTestClient(address)
+		require.NoError(t, err)
+		if podNN != nil {
+			cl.AttachPodReference(*podNN)
+		}
+		if sha != nil || syncErr != nil {
+			cl.SetLastConfigSHA(sha)
+			cl.RecordSync(syncTime, false, syncErr)
+		}
+		return cl
+	}
+	gatewayPod := &corev1.Pod{
+		ObjectMeta: metav1.ObjectMeta{
+			Namespace: "kong",
+			Name:      "gateway-1",
+			UID:       "gateway-1-uid",
+		},
+	}
+	gatewayPodNN := k8stypes.NamespacedName{Namespace: "kong", Name: "gateway-1"}
+
+	testCases := []struct {
+		name                 string
+		objects              []client.Object
+		clients              []*adminapi.Client
+		expectedGatewaySyncs []configurationv1alpha1.KongGatewaySync
+	}{
+		{
+			name:    "KongGatewaySync of a gateway running in a Pod is created and owned by the Pod",
+			objects: []client.Object{gatewayPod},
+			clients: []*adminapi.Client{
+				newClient(t, "https://10.0.0.1:8444", &gatewayPodNN, []byte{0x01, 0x02}, nil),
+			},
+			expectedGatewaySyncs: []configurationv1alpha1.KongGatewaySync{
+				{
+					ObjectMeta: metav1.ObjectMeta{
+						Namespace: "kong",
+						Name:      "gateway-1",
+						OwnerReferences: []metav1.OwnerReference{
+							{APIVersion: "v1", Kind: "Pod", Name: "gateway-1", UID: "gateway-1-uid"},
+						},
+					},
+					Status: configurationv1alpha1.KongGatewaySyncStatus{
+						Address:      "https://10.0.0.1:8444",
+						ConfigHash:   "0102",
+						LastSyncTime: &metav1.Time{Time: syncTime},
+					},
+				},
+			},
+		},
+		{
+			name: "existing KongGatewaySync is updated with the last sync error",
+			objects: []client.Object{
+				gatewayPod,
+				&configurationv1alpha1.KongGatewaySync{
+					ObjectMeta: metav1.ObjectMeta{
+						Namespace: "kong",
+						Name:      "gateway-1",
+					},
+					Status: configurationv1alpha1.KongGatewaySyncStatus{
+						Address:      "https://10.0.0.1:8444",
+						ConfigHash:   "0102",
+						LastSyncTime: &metav1.Time{Time: syncTime.Add(-time.Minute)},
+					},
+				},
+			},
+			clients: []*adminapi.Client{
+				newClient(t, "https://10.0.0.1:8444", &gatewayPodNN, nil, errors.New("failed to apply configuration")),
+			},
+			expectedGatewaySyncs: []configurationv1alpha1.KongGatewaySync{
+				{
+					ObjectMeta: metav1.ObjectMeta{
+						Namespace: "kong",
+						Name:      "gateway-1",
+					},
+					Status: configurationv1alpha1.KongGatewaySyncStatus{
+						Address:      "https://10.0.0.1:8444",
+						LastSyncTime: &metav1.Time{Time: syncTime},
+						LastError:    "failed to apply configuration",
+					},
+				},
+			},
+		},
+		{
+			name: "KongGatewaySync of a gateway not running in a Pod is created in the controller's namespace",
+			clients: []*adminapi.Client{
+				newClient(t, "http://kong-admin:8001", nil, []byte{0x03}, nil),
+			},
+			expectedGatewaySyncs: []configurationv1alpha1.KongGatewaySync{
+				{
+					ObjectMeta: metav1.ObjectMeta{
+						Namespace: "controller-namespace",
+						Name:      nameForAddress("http://kong-admin:8001"),
+					},
+					Status: configurationv1alpha1.KongGatewaySyncStatus{
+						Address:      "http://kong-admin:8001",
+						ConfigHash:   "03",
+						LastSyncTime: &metav1.Time{Time: syncTime},
+					},
+				},
+			},
+		},
+		{
+			name:    "KongGatewaySync is not created for a gateway with no sync recorded",
+			objects: []client.Object{gatewayPod},
+			clients: []*adminapi.Client{
+				newClient(t, "https://10.0.0.1:8444", &gatewayPodNN, nil, nil),
+			},
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			fakeClient := fakectrlruntimeclient.
+				NewClientBuilder().
+				WithScheme(lo.Must(scheme.Get())).
+				WithObjects(tc.objects...).
+				Build()
+
+			updater := NewUpdater(
+				logr.Discard(),
+				fakeClient,
+				fakeClient,
+				fakeGatewayClientsProvider{clients: tc.clients},
+				"controller-namespace",
+				DefaultUpdatePeriod,
+			)
+			updater.update(ctx)
+
+			var gatewaySyncs configurationv1alpha1.KongGatewaySyncList
+			require.NoError(t, fakeClient.List(ctx, &gatewaySyncs))
+			require.Len(t, gatewaySyncs.Items, len(tc.expectedGatewaySyncs))
+			for i, expected := range tc.expectedGatewaySyncs {
+				actual := gatewaySyncs.Items[i]
+				require.Equal(t, expected.Namespace, actual.Namespace)
+				require.Equal(t, expected.Name, actual.Name)
+				require.Equal(t, expected.OwnerReferences, actual.OwnerReferences)
+				require.Equal(t, expected.Status.Address, actual.Status.Address)
+				require.Equal(t, expected.Status.ConfigHash, actual.Status.ConfigHash)
+				require.Equal(t, expected.Status.LastError, actual.Status.LastError)
+				require.True(t, expected.Status.LastSyncTime.Equal(actual.Status.LastSyncTime))
+			}
+		})
+	}
+}
@@ -0,0 +1,123 @@
+// Package hostownership determines which namespace owns a hostname routed by objects in several namespaces,
+// so that objects in one namespace cannot take over traffic for hostnames already routed by another namespace.
+package hostownership
+
+import (
+	"errors"
+	"fmt"
+	"slices"
+	"strings"
+
+	"github.com/samber/lo"
+	netv1 "k8s.io/api/networking/v1"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	"github.com/kong/kubernetes-ingress-controller/v3/internal/gatewayapi"
+	"github.com/kong/kubernetes-ingress-controller/v3/internal/tenantpolicy"
+)
+
+// Storer is the subset of store.Storer required to determine the owners of hostnames.
+type Storer interface {
+	tenantpolicy.Storer
+	ListIngressesV1() []*netv1.Ingress
+	ListHTTPRoutes() ([]*gatewayapi.HTTPRoute, error)
+}
+
+// Owners maps hostnames to the objects that claimed them first.
+type Owners struct {
+	owners map[string]client.Object
+}
+
+// NewOwners determines the owners of the hostnames routed by objs. A hostname is owned by the object routing it
+// that has the oldest creation timestamp. Ties are broken by the namespace and the name of the objects.
+// Hostnames are compared literally, so a wildcard hostname is owned independently of the hostnames it matches.
+func NewOwners(objs []client.Object) Owners {
+	owners := make(map[string]client.Object)
+	for _, obj := range objs {
+		for _, host := range Hostnames(obj) {
+			if owner, ok := owners[host]; !ok || claimedBefore(obj, owner) {
+				owners[host] = obj
+			}
+		}
+	}
+	return Owners{owners: owners}
+}
+
+// NewOwnersFromStore determines the owners of the hostnames routed by the Ingresses and HTTPRoutes in the store.
+// Objects violating the KongTenantPolicies selecting their namespaces are not translated, so they don't claim
+// hostnames and cannot block other namespaces from routing them.
+func NewOwnersFromStore(s Storer) (Owners, error) {
+	httpRoutes, err := s.ListHTTPRoutes()
+	if err != nil {
+		return Owners{}, fmt.Errorf("failed to list HTTPRoutes: %w", err)
+	}
+	ingresses := s.ListIngressesV1()
+	claimers := make([]client.Object, 0, len(ingresses)+len(httpRoutes))
+	for _, ingress := range ingresses {
+		claimers = append(claimers, ingress)
+	}
+	for _, httpRoute := range httpRoutes {
+		claimers = append(claimers, httpRoute)
+	}
+	tenantPolicies := tenantpolicy.NewEvaluator(s)
+	claimers = lo.Filter(claimers, func(obj client.Object, _ int) bool {
+		return tenantPolicies.ValidateObject(obj) == nil
+	})
+	return NewOwners(claimers), nil
+}
+
+// Validate returns an error for every hostname routed by obj that is owned by an object in another namespace.
+func (o Owners) Validate(obj client.Object) error {
+	var errs []error
+	for _, host := range Hostnames(obj) {
+		owner, ok := o.owners[host]
+		if !ok || owner.GetNamespace() == obj.GetNamespace() {
+			continue
+		}
+		errs = append(errs, fmt.Errorf("host %q is owned by namespace %q where it was first claimed by %s %s",
+			host, owner.GetNamespace(), kindOf(owner), owner.GetName()))
+	}
+	return errors.Join(errs...)
+}
+
+// Hostnames returns the sorted, lowercase hostnames routed by obj. Only Ingresses and HTTPRoutes claim hostnames.
+func Hostnames(obj client.Object) []string {
+	var hosts []string
+	switch obj := obj.(type) {
+	case *netv1.Ingress:
+		for _, rule := range obj.Spec.Rules {
+			if rule.Host != "" {
+				hosts = append(hosts, strings.ToLower(rule.Host))
+			}
+		}
+	case *gatewayapi.HTTPRoute:
+		for _, hostname := range obj.Spec.Hostnames {
+			hosts = append(hosts, strings.ToLower(string(hostname)))
+		}
+	}
+	hosts = lo.Uniq(hosts)
+	slices.Sort(hosts)
+	return hosts
+}
+
+func kindOf(obj client.Object) string {
+	switch obj.(type) {
+	case *netv1.Ingress:
+		return "Ingress"
+	case *gatewayapi.HTTPRoute:
+		return "HTTPRoute"
+	}
+	return obj.GetObjectKind().GroupVersionKind().Kind
+}
+
+// claimedBefore returns true when a claimed its hostnames before b.
+func claimedBefore(a, b client.Object) bool {
+	aCreated, bCreated := a.GetCreationTimestamp(), b.GetCreationTimestamp()
+	if !aCreated.Equal(&bCreated) {
+		return aCreated.Before(&bCreated)
+	}
+	if a.GetNamespace() != b.GetNamespace() {
+		return a.GetNamespace() < b.GetNamespace()
+	}
+	return a.GetName() < b.GetName()
+}
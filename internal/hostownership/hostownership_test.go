@@ -0,0 +1,161 @@
+package hostownership_test
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+	corev1 "k8s.io/api/core/v1"
+	netv1 "k8s.io/api/networking/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	"github.com/kong/kubernetes-ingress-controller/v3/internal/annotations"
+	"github.com/kong/kubernetes-ingress-controller/v3/internal/gatewayapi"
+	"github.com/kong/kubernetes-ingress-controller/v3/internal/hostownership"
+	"github.com/kong/kubernetes-ingress-controller/v3/internal/store"
+	kongv1alpha1 "github.com/kong/kubernetes-ingress-controller/v3/pkg/apis/configuration/v1alpha1"
+)
+
+func TestOwners_Validate(t *testing.T) {
+	now := time.Now()
+	newIngress := func(namespace, name string, created time.Time, hosts ...string) *netv1.Ingress {
+		ingress := &netv1.Ingress{
+			ObjectMeta: metav1.ObjectMeta{
+				Namespace:         namespace,
+				Name:              name,
+				CreationTimestamp: metav1.NewTime(created),
+			},
+		}
+		for _, host := range hosts {
+			ingress.Spec.Rules = append(ingress.Spec.Rules, netv1.IngressRule{Host: host})
+		}
+		return ingress
+	}
+	newHTTPRoute := func(namespace, name string, created time.Time, hostnames ...gatewayapi.Hostname) *gatewayapi.HTTPRoute {
+		return &gatewayapi.HTTPRoute{
+			ObjectMeta: metav1.ObjectMeta{
+				Namespace:         namespace,
+				Name:              name,
+				CreationTimestamp: metav1.NewTime(created),
+			},
+			Spec: gatewayapi.HTTPRouteSpec{
+				Hostnames: hostnames,
+			},
+		}
+	}
+
+	testCases := []struct {
+		name        string
+		claimers    []client.Object
+		obj         client.Object
+		expectedErr string
+	}{
+		{
+			name:     "hostname claimed only by the object is accepted",
+			claimers: []client.Object{newIngress("team-a", "api", now, "api.example.com")},
+			obj:      newIngress("team-a", "api", now, "api.example.com"),
+		},
+		{
+			name: "hostname claimed first in the same namespace is accepted",
+			claimers: []client.Object{
+				newIngress("team-a", "api", now, "api.example.com"),
+				newHTTPRoute("team-a", "api-v2", now.Add(time.Minute), "api.example.com"),
+			},
+			obj: newHTTPRoute("team-a", "api-v2", now.Add(time.Minute), "api.example.com"),
+		},
+		{
+			name: "hostname claimed first in another namespace is rejected",
+			claimers: []client.Object{
+				newIngress("team-a", "api", now, "api.example.com"),
+				newHTTPRoute("team-b", "api", now.Add(time.Minute), "API.example.com", "b.example.com"),
+			},
+			obj:         newHTTPRoute("team-b", "api", now.Add(time.Minute), "API.example.com", "b.example.com"),
+			expectedErr: `host "api.example.com" is owned by namespace "team-a" where it was first claimed by Ingress api`,
+		},
+		{
+			name: "older claimer owns the hostname regardless of the order of claimers",
+			claimers: []client.Object{
+				newHTTPRoute("team-b", "api", now.Add(time.Minute), "api.example.com"),
+				newIngress("team-a", "api", now, "api.example.com"),
+			},
+			obj: newIngress("team-a", "api", now, "api.example.com"),
+		},
+		{
+			name: "claimers created at the same time are ordered by namespace",
+			claimers: []client.Object{
+				newIngress("team-b", "api", now, "api.example.com"),
+				newIngress("team-a", "api", now, "api.example.com"),
+			},
+			obj:         newIngress("team-b", "api", now, "api.example.com"),
+			expectedErr: `host "api.example.com" is owned by namespace "team-a" where it was first claimed by Ingress api`,
+		},
+		{
+			name: "wildcard hostname does not conflict with hostnames it matches",
+			claimers: []client.Object{
+				newIngress("team-a", "api", now, "api.example.com"),
+			},
+			obj: newIngress("team-b", "wildcard", now.Add(time.Minute), "*.example.com"),
+		},
+		{
+			name:        "new object routing a hostname owned by another namespace is rejected",
+			claimers:    []client.Object{newIngress("team-a", "api", now, "api.example.com")},
+			obj:         newIngress("team-b", "api", time.Time{}, "api.example.com", "other.example.com"),
+			expectedErr: `host "api.example.com" is owned by namespace "team-a" where it was first claimed by Ingress api`,
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			err := hostownership.NewOwners(tc.claimers).Validate(tc.obj)
+			if tc.expectedErr == "" {
+				require.NoError(t, err)
+				return
+			}
+			require.EqualError(t, err, tc.expectedErr)
+		})
+	}
+}
+
+func TestNewOwnersFromStore_TenantPolicyViolatorsDoNotClaimHostnames(t *testing.T) {
+	now := time.Now()
+	newIngress := func(namespace string, created time.Time) *netv1.Ingress {
+		return &netv1.Ingress{
+			ObjectMeta: metav1.ObjectMeta{
+				Namespace:         namespace,
+				Name:              "api",
+				CreationTimestamp: metav1.NewTime(created),
+				Annotations: map[string]string{
+					annotations.IngressClassKey: annotations.DefaultIngressClass,
+				},
+			},
+			Spec: netv1.IngressSpec{
+				Rules: []netv1.IngressRule{{Host: "api.example.com"}},
+			},
+		}
+	}
+	violator := newIngress("team-a", now)
+	platform := newIngress("platform", now.Add(time.Minute))
+	s, err := store.NewFakeStore(store.FakeObjects{
+		IngressesV1: []*netv1.Ingress{violator, platform},
+		Namespaces: []*corev1.Namespace{
+			{ObjectMeta: metav1.ObjectMeta{Name: "team-a", Labels: map[string]string{"tenant": "true"}}},
+			{ObjectMeta: metav1.ObjectMeta{Name: "platform"}},
+		},
+		KongTenantPolicies: []*kongv1alpha1.KongTenantPolicy{
+			{
+				ObjectMeta: metav1.ObjectMeta{Name: "tenants"},
+				Spec: kongv1alpha1.KongTenantPolicySpec{
+					NamespaceSelector: metav1.LabelSelector{MatchLabels: map[string]string{"tenant": "true"}},
+					Hosts:             &kongv1alpha1.KongTenantPolicyRule{Allow: []string{"*.tenants.example.com"}},
+				},
+			},
+		},
+	})
+	require.NoError(t, err)
+
+	owners, err := hostownership.NewOwnersFromStore(s)
+	require.NoError(t, err)
+	require.NoError(t, owners.Validate(platform),
+		"hostname claimed first by an object violating tenant policies should be owned by the next claimer")
+}
@@ -0,0 +1,445 @@
+// Package ingressmigration converts Ingresses into equivalent Gateway API HTTPRoutes, taking into account
+// the konghq.com annotations that change how the controller translates Ingresses.
+package ingressmigration
+
+import (
+	"fmt"
+	"maps"
+	"slices"
+	"strings"
+
+	"github.com/samber/lo"
+	corev1 "k8s.io/api/core/v1"
+	netv1 "k8s.io/api/networking/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	k8stypes "k8s.io/apimachinery/pkg/types"
+
+	"github.com/kong/kubernetes-ingress-controller/v3/internal/annotations"
+	"github.com/kong/kubernetes-ingress-controller/v3/internal/gatewayapi"
+	kongv1 "github.com/kong/kubernetes-ingress-controller/v3/pkg/apis/configuration/v1"
+)
+
+// defaultRegexPrefix is the prefix of ImplementationSpecific Ingress paths that are regular expressions
+// when the konghq.com/regex-prefix annotation is not set.
+const defaultRegexPrefix = "/~"
+
+// carriedOverAnnotations are the annotations that the controller honors the same way on Ingresses
+// and HTTPRoutes. They are copied from the Ingress to the generated HTTPRoutes.
+var carriedOverAnnotations = []string{
+	annotations.AnnotationPrefix + annotations.StripPathKey,
+	annotations.AnnotationPrefix + annotations.HTTPSRedirectCodeKey,
+	annotations.AnnotationPrefix + annotations.PreserveHostKey,
+	annotations.AnnotationPrefix + annotations.RegexPriorityKey,
+	annotations.AnnotationPrefix + annotations.RoutePriorityKey,
+	annotations.AnnotationPrefix + annotations.SNIsKey,
+	annotations.AnnotationPrefix + annotations.RequestBuffering,
+	annotations.AnnotationPrefix + annotations.ResponseBuffering,
+	annotations.AnnotationPrefix + annotations.ProtocolsKey,
+	annotations.AnnotationPrefix + annotations.PathHandlingKey,
+	annotations.AnnotationPrefix + annotations.UserTagKey,
+}
+
+// gatewayAPIMethods are the HTTP methods HTTPRoute matches accept.
+var gatewayAPIMethods = []string{"GET", "HEAD", "POST", "PUT", "DELETE", "CONNECT", "OPTIONS", "TRACE", "PATCH"}
+
+// Options configures the conversion of Ingresses into HTTPRoutes.
+type Options struct {
+	// GatewayName is the name of the Gateway the generated HTTPRoutes are attached to.
+	GatewayName string
+	// GatewayNamespace is the namespace of the Gateway the generated HTTPRoutes are attached to.
+	// When empty, HTTPRoutes are attached to a Gateway in their own namespace.
+	GatewayNamespace string
+	// Services are used to resolve the ports of Ingress backends referencing Service ports by name.
+	Services []*corev1.Service
+}
+
+// Warning describes a part of an Ingress that couldn't be converted into an equivalent HTTPRoute.
+type Warning struct {
+	Ingress k8stypes.NamespacedName
+	Message string
+}
+
+func (w Warning) String() string {
+	return fmt.Sprintf("Ingress %s: %s", w.Ingress, w.Message)
+}
+
+// Result is the result of the conversion.
+type Result struct {
+	// HTTPRoutes are the HTTPRoutes generated from the Ingresses.
+	HTTPRoutes []*gatewayapi.HTTPRoute
+	// Warnings list the parts of the Ingresses that have no HTTPRoute equivalent.
+	Warnings []Warning
+}
+
+// Convert generates HTTPRoutes equivalent to the Ingresses. One HTTPRoute is generated per distinct host of
+// an Ingress' rules, as hostnames apply to all rules of an HTTPRoute. Annotations are converted into HTTPRoute
+// matches and filters when possible, or copied to the HTTPRoutes when the controller honors them on HTTPRoutes
+// too. Everything else is reported as a warning.
+func Convert(ingresses []*netv1.Ingress, opts Options) Result {
+	var result Result
+	for _, ingress := range ingresses {
+		c := converter{
+			ingress: ingress,
+			opts:    opts,
+		}
+		result.HTTPRoutes = append(result.HTTPRoutes, c.convert()...)
+		result.Warnings = append(result.Warnings, c.warnings...)
+	}
+	return result
+}
+
+// converter converts a single Ingress, collecting warnings along the way.
+type converter struct {
+	ingress  *netv1.Ingress
+	opts     Options
+	warnings []Warning
+}
+
+func (c *converter) warn(format string, args ...any) {
+	c.warnings = append(c.warnings, Warning{
+		Ingress: k8stypes.NamespacedName{Namespace: c.ingress.Namespace, Name: c.ingress.Name},
+		Message: fmt.Sprintf(format, args...),
+	})
+}
+
+// hostRules groups the rules of HTTPRoutes by the host of the Ingress rules they were converted from.
+type hostRules struct {
+	host  string
+	rules []gatewayapi.HTTPRouteRule
+}
+
+func (c *converter) convert() []*gatewayapi.HTTPRoute {
+	anns := c.ingress.Annotations
+	routeAnnotations := c.convertAnnotations()
+	matches := c.matchesTemplate()
+	filters := c.filters()
+
+	if len(c.ingress.Spec.TLS) > 0 {
+		c.warn("TLS configuration has no HTTPRoute equivalent, certificates have to be configured on the Gateway listeners")
+	}
+
+	regexPrefix := defaultRegexPrefix
+	if prefix := annotations.ExtractRegexPrefix(anns); prefix != "" {
+		regexPrefix = prefix
+	}
+
+	var groups []*hostRules
+	groupFor := func(host string) *hostRules {
+		for _, g := range groups {
+			if g.host == host {
+				return g
+			}
+		}
+		g := &hostRules{host: host}
+		groups = append(groups, g)
+		return g
+	}
+
+	for _, rule := range c.ingress.Spec.Rules {
+		if rule.HTTP == nil {
+			continue
+		}
+		group := groupFor(rule.Host)
+		for _, path := range rule.HTTP.Paths {
+			backendRef, ok := c.backendRef(path.Backend)
+			if !ok {
+				continue
+			}
+			pathMatch := c.pathMatch(path, regexPrefix)
+			group.rules = append(group.rules, gatewayapi.HTTPRouteRule{
+				Matches: lo.Map(matches, func(m gatewayapi.HTTPRouteMatch, _ int) gatewayapi.HTTPRouteMatch {
+					m.Path = pathMatch.DeepCopy()
+					return m
+				}),
+				Filters:     slices.Clone(filters),
+				BackendRefs: []gatewayapi.HTTPBackendRef{backendRef},
+			})
+		}
+	}
+	if defaultBackend := c.ingress.Spec.DefaultBackend; defaultBackend != nil {
+		if backendRef, ok := c.backendRef(*defaultBackend); ok {
+			group := groupFor("")
+			group.rules = append(group.rules, gatewayapi.HTTPRouteRule{
+				Filters:     slices.Clone(filters),
+				BackendRefs: []gatewayapi.HTTPBackendRef{backendRef},
+			})
+		}
+	}
+
+	groups = lo.Filter(groups, func(g *hostRules, _ int) bool { return len(g.rules) > 0 })
+	hostAliases, _ := annotations.ExtractHostAliases(anns)
+	httpRoutes := make([]*gatewayapi.HTTPRoute, 0, len(groups))
+	for _, group := range groups {
+		var hostnames []gatewayapi.Hostname
+		if group.host != "" {
+			hostnames = append(hostnames, gatewayapi.Hostname(group.host))
+		}
+		for _, alias := range hostAliases {
+			if alias = strings.TrimSpace(alias); alias != "" && !lo.Contains(hostnames, gatewayapi.Hostname(alias)) {
+				hostnames = append(hostnames, gatewayapi.Hostname(alias))
+			}
+		}
+		httpRoutes = append(httpRoutes, &gatewayapi.HTTPRoute{
+			TypeMeta: gatewayapi.V1HTTPRouteTypeMeta,
+			ObjectMeta: metav1.ObjectMeta{
+				Name:        c.httpRouteName(group.host, len(groups)),
+				Namespace:   c.ingress.Namespace,
+				Labels:      maps.Clone(c.ingress.Labels),
+				Annotations: maps.Clone(routeAnnotations),
+			},
+			Spec: gatewayapi.HTTPRouteSpec{
+				CommonRouteSpec: gatewayapi.CommonRouteSpec{
+					ParentRefs: []gatewayapi.ParentReference{c.parentRef()},
+				},
+				Hostnames: hostnames,
+				Rules:     group.rules,
+			},
+		})
+	}
+	return httpRoutes
+}
+
+// httpRouteName returns the name of the HTTPRoute generated for the host. When the Ingress is converted
+// into a single HTTPRoute, it keeps the name of the Ingress.
+func (c *converter) httpRouteName(host string, routesCount int) string {
+	if routesCount == 1 {
+		return c.ingress.Name
+	}
+	if host == "" {
+		return c.ingress.Name + "-default"
+	}
+	return c.ingress.Name + "-" + strings.ReplaceAll(host, "*", "wildcard")
+}
+
+func (c *converter) parentRef() gatewayapi.ParentReference {
+	parentRef := gatewayapi.ParentReference{
+		Name: gatewayapi.ObjectName(c.opts.GatewayName),
+	}
+	if c.opts.GatewayNamespace != "" && c.opts.GatewayNamespace != c.ingress.Namespace {
+		parentRef.Namespace = lo.ToPtr(gatewayapi.Namespace(c.opts.GatewayNamespace))
+	}
+	return parentRef
+}
+
+// convertAnnotations returns the annotations of the generated HTTPRoutes and reports the Ingress annotations
+// that are not converted into matches or filters and are not honored on HTTPRoutes.
+func (c *converter) convertAnnotations() map[string]string {
+	routeAnnotations := make(map[string]string)
+	for _, key := range carriedOverAnnotations {
+		if value, ok := c.ingress.Annotations[key]; ok {
+			routeAnnotations[key] = value
+		}
+	}
+
+	const (
+		pluginsKey          = annotations.AnnotationPrefix + annotations.PluginsKey
+		methodsKey          = annotations.AnnotationPrefix + annotations.MethodsKey
+		headersPrefix       = annotations.AnnotationPrefix + annotations.HeadersKey + "."
+		headersSeparatorKey = annotations.AnnotationPrefix + annotations.HeadersSeparatorKey
+		overrideKey         = annotations.AnnotationPrefix + annotations.ConfigurationKey
+	)
+	// Plugins from other namespaces can't be referenced by ExtensionRef filters, keep them in the annotation.
+	crossNamespacePlugins := lo.FilterMap(annotations.ExtractNamespacedKongPluginsFromAnnotations(c.ingress.Annotations),
+		func(p annotations.NamespacedKongPlugin, _ int) (string, bool) {
+			if p.Namespace == "" || p.Namespace == c.ingress.Namespace {
+				return "", false
+			}
+			return p.Namespace + ":" + p.Name, true
+		},
+	)
+	if len(crossNamespacePlugins) > 0 {
+		routeAnnotations[pluginsKey] = strings.Join(crossNamespacePlugins, ",")
+		c.warn("plugins %s from other namespaces are kept in the %s annotation, their ReferenceGrants have to allow HTTPRoutes",
+			strings.Join(crossNamespacePlugins, ", "), pluginsKey)
+	}
+	if _, ok := c.gatewayAPIMethods(); !ok {
+		routeAnnotations[methodsKey] = c.ingress.Annotations[methodsKey]
+	}
+	if _, ok := c.gatewayAPIHeaders(); !ok {
+		for key, value := range c.ingress.Annotations {
+			if strings.HasPrefix(key, headersPrefix) || key == headersSeparatorKey {
+				routeAnnotations[key] = value
+			}
+		}
+	}
+
+	handled := append(slices.Clone(carriedOverAnnotations),
+		pluginsKey,
+		methodsKey,
+		headersSeparatorKey,
+		annotations.AnnotationPrefix+annotations.HostAliasesKey,
+		annotations.AnnotationPrefix+annotations.RewriteURIKey,
+		annotations.AnnotationPrefix+annotations.RegexPrefixKey,
+	)
+	keys := lo.Keys(c.ingress.Annotations)
+	slices.Sort(keys)
+	for _, key := range keys {
+		switch {
+		case !strings.HasPrefix(key, annotations.AnnotationPrefix+"/"):
+		case lo.Contains(handled, key), strings.HasPrefix(key, headersPrefix):
+		case key == overrideKey:
+			c.warn("annotation %s references a KongIngress which is not supported with HTTPRoutes, "+
+				"use the annotations it replaces instead", key)
+		default:
+			c.warn("annotation %s has no HTTPRoute equivalent", key)
+		}
+	}
+
+	if len(routeAnnotations) == 0 {
+		return nil
+	}
+	return routeAnnotations
+}
+
+// gatewayAPIMethods returns the methods of the konghq.com/methods annotation if all of them can be matched
+// by HTTPRoute matches.
+func (c *converter) gatewayAPIMethods() ([]gatewayapi.HTTPMethod, bool) {
+	methods := annotations.ExtractMethods(c.ingress.Annotations)
+	if !lo.Every(gatewayAPIMethods, methods) {
+		return nil, false
+	}
+	return lo.Map(methods, func(m string, _ int) gatewayapi.HTTPMethod { return gatewayapi.HTTPMethod(m) }), true
+}
+
+// gatewayAPIHeaders returns the headers of the konghq.com/headers.* annotations if all of them can be matched
+// by HTTPRoute header matches, i.e. all of them have a single value.
+func (c *converter) gatewayAPIHeaders() ([]gatewayapi.HTTPHeaderMatch, bool) {
+	headers, _ := annotations.ExtractHeaders(c.ingress.Annotations)
+	names := lo.Keys(headers)
+	slices.Sort(names)
+	var headerMatches []gatewayapi.HTTPHeaderMatch
+	for _, name := range names {
+		if len(headers[name]) != 1 {
+			return nil, false
+		}
+		headerMatches = append(headerMatches, gatewayapi.HTTPHeaderMatch{
+			Type:  lo.ToPtr(gatewayapi.HeaderMatchExact),
+			Name:  gatewayapi.HTTPHeaderName(name),
+			Value: headers[name][0],
+		})
+	}
+	return headerMatches, true
+}
+
+// matchesTemplate returns the matches every Ingress path is converted into, without their path match.
+// There is one match per method of the konghq.com/methods annotation, all of them matching the headers
+// of the konghq.com/headers.* annotations.
+func (c *converter) matchesTemplate() []gatewayapi.HTTPRouteMatch {
+	headers, _ := c.gatewayAPIHeaders()
+	methods, _ := c.gatewayAPIMethods()
+	if len(methods) == 0 {
+		return []gatewayapi.HTTPRouteMatch{{Headers: headers}}
+	}
+	return lo.Map(methods, func(method gatewayapi.HTTPMethod, _ int) gatewayapi.HTTPRouteMatch {
+		return gatewayapi.HTTPRouteMatch{
+			Method:  lo.ToPtr(method),
+			Headers: slices.Clone(headers),
+		}
+	})
+}
+
+// filters returns the filters applied to every rule converted from the Ingress: ExtensionRef filters
+// for the KongPlugins from the Ingress' namespace and a URLRewrite filter for the konghq.com/rewrite annotation.
+func (c *converter) filters() []gatewayapi.HTTPRouteFilter {
+	var filters []gatewayapi.HTTPRouteFilter
+	for _, p := range annotations.ExtractNamespacedKongPluginsFromAnnotations(c.ingress.Annotations) {
+		if p.Namespace != "" && p.Namespace != c.ingress.Namespace {
+			continue
+		}
+		filters = append(filters, gatewayapi.HTTPRouteFilter{
+			Type: gatewayapi.HTTPRouteFilterExtensionRef,
+			ExtensionRef: &gatewayapi.LocalObjectReference{
+				Group: gatewayapi.Group(kongv1.GroupVersion.Group),
+				Kind:  "KongPlugin",
+				Name:  gatewayapi.ObjectName(p.Name),
+			},
+		})
+	}
+
+	if rewrite, ok := annotations.ExtractRewriteURI(c.ingress.Annotations); ok {
+		if strings.Contains(rewrite, "$") {
+			c.warn("annotation %s%s uses capture groups which have no HTTPRoute equivalent",
+				annotations.AnnotationPrefix, annotations.RewriteURIKey)
+		} else {
+			filters = append(filters, gatewayapi.HTTPRouteFilter{
+				Type: gatewayapi.HTTPRouteFilterURLRewrite,
+				URLRewrite: &gatewayapi.HTTPURLRewriteFilter{
+					Path: &gatewayapi.HTTPPathModifier{
+						Type:            gatewayapi.FullPathHTTPPathModifier,
+						ReplaceFullPath: lo.ToPtr(rewrite),
+					},
+				},
+			})
+		}
+	}
+	return filters
+}
+
+// pathMatch converts an Ingress path into an HTTPRoute path match.
+func (c *converter) pathMatch(path netv1.HTTPIngressPath, regexPrefix string) gatewayapi.HTTPPathMatch {
+	pathType := lo.FromPtrOr(path.PathType, netv1.PathTypeImplementationSpecific)
+	switch pathType {
+	case netv1.PathTypeExact:
+		return gatewayapi.HTTPPathMatch{
+			Type:  lo.ToPtr(gatewayapi.PathMatchExact),
+			Value: lo.ToPtr(path.Path),
+		}
+	case netv1.PathTypePrefix:
+		return prefixPathMatch(path.Path)
+	default:
+		if strings.HasPrefix(path.Path, regexPrefix) {
+			return gatewayapi.HTTPPathMatch{
+				Type:  lo.ToPtr(gatewayapi.PathMatchRegularExpression),
+				Value: lo.ToPtr(strings.TrimPrefix(path.Path, regexPrefix)),
+			}
+		}
+		if strings.Trim(path.Path, "/") != "" {
+			c.warn("ImplementationSpecific path %q matches all paths starting with it, "+
+				"it was converted into a PathPrefix match which matches only whole path segments", path.Path)
+		}
+		return prefixPathMatch(path.Path)
+	}
+}
+
+func prefixPathMatch(path string) gatewayapi.HTTPPathMatch {
+	return gatewayapi.HTTPPathMatch{
+		Type:  lo.ToPtr(gatewayapi.PathMatchPathPrefix),
+		Value: lo.ToPtr("/" + strings.Trim(path, "/")),
+	}
+}
+
+// backendRef converts an Ingress backend into an HTTPRoute backendRef.
+func (c *converter) backendRef(backend netv1.IngressBackend) (gatewayapi.HTTPBackendRef, bool) {
+	if backend.Service == nil {
+		c.warn("backends other than Services are not supported, the path was skipped")
+		return gatewayapi.HTTPBackendRef{}, false
+	}
+
+	port := backend.Service.Port.Number
+	if name := backend.Service.Port.Name; name != "" {
+		service, ok := lo.Find(c.opts.Services, func(s *corev1.Service) bool {
+			return s.Namespace == c.ingress.Namespace && s.Name == backend.Service.Name
+		})
+		if !ok {
+			c.warn("port %q of Service %s can't be resolved as the Service was not found, the path was skipped",
+				name, backend.Service.Name)
+			return gatewayapi.HTTPBackendRef{}, false
+		}
+		servicePort, ok := lo.Find(service.Spec.Ports, func(p corev1.ServicePort) bool { return p.Name == name })
+		if !ok {
+			c.warn("Service %s has no port %q, the path was skipped", backend.Service.Name, name)
+			return gatewayapi.HTTPBackendRef{}, false
+		}
+		port = servicePort.Port
+	}
+
+	return gatewayapi.HTTPBackendRef{
+		BackendRef: gatewayapi.BackendRef{
+			BackendObjectReference: gatewayapi.BackendObjectReference{
+				Kind: lo.ToPtr(gatewayapi.Kind("Service")),
+				Name: gatewayapi.ObjectName(backend.Service.Name),
+				Port: lo.ToPtr(gatewayapi.PortNumber(port)),
+			},
+		},
+	}, true
+}
@@ -0,0 +1,267 @@
+package ingressmigration_test
+
+import (
+	"testing"
+
+	"github.com/samber/lo"
+	"github.com/stretchr/testify/require"
+	corev1 "k8s.io/api/core/v1"
+	netv1 "k8s.io/api/networking/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	k8stypes "k8s.io/apimachinery/pkg/types"
+
+	"github.com/kong/kubernetes-ingress-controller/v3/internal/gatewayapi"
+	"github.com/kong/kubernetes-ingress-controller/v3/internal/ingressmigration"
+)
+
+func TestConvert(t *testing.T) {
+	echoBackend := func(port netv1.ServiceBackendPort) netv1.IngressBackend {
+		return netv1.IngressBackend{Service: &netv1.IngressServiceBackend{Name: "echo", Port: port}}
+	}
+	echoBackendRef := gatewayapi.HTTPBackendRef{
+		BackendRef: gatewayapi.BackendRef{
+			BackendObjectReference: gatewayapi.BackendObjectReference{
+				Kind: lo.ToPtr(gatewayapi.Kind("Service")),
+				Name: "echo",
+				Port: lo.ToPtr(gatewayapi.PortNumber(80)),
+			},
+		},
+	}
+	services := []*corev1.Service{{
+		ObjectMeta: metav1.ObjectMeta{Namespace: "default", Name: "echo"},
+		Spec:       corev1.ServiceSpec{Ports: []corev1.ServicePort{{Name: "http", Port: 80}}},
+	}}
+	ingressName := k8stypes.NamespacedName{Namespace: "default", Name: "echo"}
+
+	testCases := []struct {
+		name               string
+		ingress            *netv1.Ingress
+		expectedHTTPRoutes []*gatewayapi.HTTPRoute
+		expectedWarnings   []ingressmigration.Warning
+	}{
+		{
+			name: "annotations converted into matches and filters",
+			ingress: &netv1.Ingress{
+				ObjectMeta: metav1.ObjectMeta{
+					Namespace: "default",
+					Name:      "echo",
+					Labels:    map[string]string{"app": "echo"},
+					Annotations: map[string]string{
+						"konghq.com/strip-path":       "true",
+						"konghq.com/plugins":          "auth,shared:rate-limit",
+						"konghq.com/methods":          "GET",
+						"konghq.com/headers.x-tenant": "a",
+						"konghq.com/rewrite":          "/v1",
+						"konghq.com/host-aliases":     "alias.example.com",
+						"konghq.com/override":         "legacy",
+					},
+				},
+				Spec: netv1.IngressSpec{
+					Rules: []netv1.IngressRule{{
+						Host: "example.com",
+						IngressRuleValue: netv1.IngressRuleValue{HTTP: &netv1.HTTPIngressRuleValue{
+							Paths: []netv1.HTTPIngressPath{
+								{
+									Path:     "/api/",
+									PathType: lo.ToPtr(netv1.PathTypePrefix),
+									Backend:  echoBackend(netv1.ServiceBackendPort{Name: "http"}),
+								},
+								{
+									Path:     "/~/v[0-9]+",
+									PathType: lo.ToPtr(netv1.PathTypeImplementationSpecific),
+									Backend:  echoBackend(netv1.ServiceBackendPort{Number: 80}),
+								},
+							},
+						}},
+					}},
+				},
+			},
+			expectedHTTPRoutes: []*gatewayapi.HTTPRoute{{
+				TypeMeta: gatewayapi.V1HTTPRouteTypeMeta,
+				ObjectMeta: metav1.ObjectMeta{
+					Namespace: "default",
+					Name:      "echo",
+					Labels:    map[string]string{"app": "echo"},
+					Annotations: map[string]string{
+						"konghq.com/strip-path": "true",
+						"konghq.com/plugins":    "shared:rate-limit",
+					},
+				},
+				Spec: gatewayapi.HTTPRouteSpec{
+					CommonRouteSpec: gatewayapi.CommonRouteSpec{
+						ParentRefs: []gatewayapi.ParentReference{{
+							Name:      "kong",
+							Namespace: lo.ToPtr(gatewayapi.Namespace("kong")),
+						}},
+					},
+					Hostnames: []gatewayapi.Hostname{"example.com", "alias.example.com"},
+					Rules: lo.Map([]gatewayapi.HTTPPathMatch{
+						{Type: lo.ToPtr(gatewayapi.PathMatchPathPrefix), Value: lo.ToPtr("/api")},
+						{Type: lo.ToPtr(gatewayapi.PathMatchRegularExpression), Value: lo.ToPtr("/v[0-9]+")},
+					}, func(path gatewayapi.HTTPPathMatch, _ int) gatewayapi.HTTPRouteRule {
+						return gatewayapi.HTTPRouteRule{
+							Matches: []gatewayapi.HTTPRouteMatch{{
+								Path:   lo.ToPtr(path),
+								Method: lo.ToPtr(gatewayapi.HTTPMethod("GET")),
+								Headers: []gatewayapi.HTTPHeaderMatch{{
+									Type:  lo.ToPtr(gatewayapi.HeaderMatchExact),
+									Name:  "x-tenant",
+									Value: "a",
+								}},
+							}},
+							Filters: []gatewayapi.HTTPRouteFilter{
+								{
+									Type: gatewayapi.HTTPRouteFilterExtensionRef,
+									ExtensionRef: &gatewayapi.LocalObjectReference{
+										Group: "configuration.konghq.com",
+										Kind:  "KongPlugin",
+										Name:  "auth",
+									},
+								},
+								{
+									Type: gatewayapi.HTTPRouteFilterURLRewrite,
+									URLRewrite: &gatewayapi.HTTPURLRewriteFilter{
+										Path: &gatewayapi.HTTPPathModifier{
+											Type:            gatewayapi.FullPathHTTPPathModifier,
+											ReplaceFullPath: lo.ToPtr("/v1"),
+										},
+									},
+								},
+							},
+							BackendRefs: []gatewayapi.HTTPBackendRef{echoBackendRef},
+						}
+					}),
+				},
+			}},
+			expectedWarnings: []ingressmigration.Warning{
+				{
+					Ingress: ingressName,
+					Message: "plugins shared:rate-limit from other namespaces are kept in the konghq.com/plugins annotation, " +
+						"their ReferenceGrants have to allow HTTPRoutes",
+				},
+				{
+					Ingress: ingressName,
+					Message: "annotation konghq.com/override references a KongIngress which is not supported with HTTPRoutes, " +
+						"use the annotations it replaces instead",
+				},
+			},
+		},
+		{
+			name: "rules with different hosts converted into separate HTTPRoutes",
+			ingress: &netv1.Ingress{
+				ObjectMeta: metav1.ObjectMeta{
+					Namespace:   "default",
+					Name:        "echo",
+					Annotations: map[string]string{"konghq.com/methods": "GET,PURGE"},
+				},
+				Spec: netv1.IngressSpec{
+					DefaultBackend: lo.ToPtr(echoBackend(netv1.ServiceBackendPort{Number: 80})),
+					TLS:            []netv1.IngressTLS{{Hosts: []string{"example.com"}, SecretName: "cert"}},
+					Rules: []netv1.IngressRule{
+						{
+							Host: "example.com",
+							IngressRuleValue: netv1.IngressRuleValue{HTTP: &netv1.HTTPIngressRuleValue{
+								Paths: []netv1.HTTPIngressPath{{
+									Path:     "/api",
+									PathType: lo.ToPtr(netv1.PathTypeImplementationSpecific),
+									Backend:  echoBackend(netv1.ServiceBackendPort{Number: 80}),
+								}},
+							}},
+						},
+						{
+							Host: "*.example.com",
+							IngressRuleValue: netv1.IngressRuleValue{HTTP: &netv1.HTTPIngressRuleValue{
+								Paths: []netv1.HTTPIngressPath{
+									{
+										Path:     "/health",
+										PathType: lo.ToPtr(netv1.PathTypeExact),
+										Backend:  echoBackend(netv1.ServiceBackendPort{Number: 80}),
+									},
+									{
+										Path:     "/missing",
+										PathType: lo.ToPtr(netv1.PathTypeExact),
+										Backend:  echoBackend(netv1.ServiceBackendPort{Name: "missing"}),
+									},
+								},
+							}},
+						},
+					},
+				},
+			},
+			expectedHTTPRoutes: lo.Map([]struct {
+				name      string
+				hostnames []gatewayapi.Hostname
+				path      *gatewayapi.HTTPPathMatch
+			}{
+				{
+					name:      "echo-example.com",
+					hostnames: []gatewayapi.Hostname{"example.com"},
+					path:      &gatewayapi.HTTPPathMatch{Type: lo.ToPtr(gatewayapi.PathMatchPathPrefix), Value: lo.ToPtr("/api")},
+				},
+				{
+					name:      "echo-wildcard.example.com",
+					hostnames: []gatewayapi.Hostname{"*.example.com"},
+					path:      &gatewayapi.HTTPPathMatch{Type: lo.ToPtr(gatewayapi.PathMatchExact), Value: lo.ToPtr("/health")},
+				},
+				{
+					name: "echo-default",
+				},
+			}, func(r struct {
+				name      string
+				hostnames []gatewayapi.Hostname
+				path      *gatewayapi.HTTPPathMatch
+			}, _ int,
+			) *gatewayapi.HTTPRoute {
+				rule := gatewayapi.HTTPRouteRule{BackendRefs: []gatewayapi.HTTPBackendRef{echoBackendRef}}
+				if r.path != nil {
+					rule.Matches = []gatewayapi.HTTPRouteMatch{{Path: r.path}}
+				}
+				return &gatewayapi.HTTPRoute{
+					TypeMeta: gatewayapi.V1HTTPRouteTypeMeta,
+					ObjectMeta: metav1.ObjectMeta{
+						Namespace:   "default",
+						Name:        r.name,
+						Annotations: map[string]string{"konghq.com/methods": "GET,PURGE"},
+					},
+					Spec: gatewayapi.HTTPRouteSpec{
+						CommonRouteSpec: gatewayapi.CommonRouteSpec{
+							ParentRefs: []gatewayapi.ParentReference{{
+								Name:      "kong",
+								Namespace: lo.ToPtr(gatewayapi.Namespace("kong")),
+							}},
+						},
+						Hostnames: r.hostnames,
+						Rules:     []gatewayapi.HTTPRouteRule{rule},
+					},
+				}
+			}),
+			expectedWarnings: []ingressmigration.Warning{
+				{
+					Ingress: ingressName,
+					Message: "TLS configuration has no HTTPRoute equivalent, certificates have to be configured on the Gateway listeners",
+				},
+				{
+					Ingress: ingressName,
+					Message: `ImplementationSpecific path "/api" matches all paths starting with it, ` +
+						"it was converted into a PathPrefix match which matches only whole path segments",
+				},
+				{
+					Ingress: ingressName,
+					Message: `Service echo has no port "missing", the path was skipped`,
+				},
+			},
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			result := ingressmigration.Convert([]*netv1.Ingress{tc.ingress}, ingressmigration.Options{
+				GatewayName:      "kong",
+				GatewayNamespace: "kong",
+				Services:         services,
+			})
+			require.Equal(t, tc.expectedHTTPRoutes, result.HTTPRoutes)
+			require.Equal(t, tc.expectedWarnings, result.Warnings)
+		})
+	}
+}
@@ -0,0 +1,193 @@
+package ingressmigration
+
+import (
+	"encoding/json"
+	"fmt"
+	"slices"
+	"strings"
+
+	"github.com/go-logr/logr"
+	"github.com/samber/lo"
+	corev1 "k8s.io/api/core/v1"
+	netv1 "k8s.io/api/networking/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"sigs.k8s.io/yaml"
+
+	"github.com/kong/kubernetes-ingress-controller/v3/internal/annotations"
+	"github.com/kong/kubernetes-ingress-controller/v3/internal/dataplane/kongstate"
+	"github.com/kong/kubernetes-ingress-controller/v3/internal/dataplane/offline"
+	"github.com/kong/kubernetes-ingress-controller/v3/internal/gatewayapi"
+)
+
+// Difference is a Kong route match generated from only one of the Ingresses and the HTTPRoutes.
+type Difference struct {
+	// Source is the kind of objects the match was generated from, Ingress or HTTPRoute.
+	Source string
+	// Match describes the match and the attributes of the Kong route.
+	Match string
+}
+
+func (d Difference) String() string {
+	return fmt.Sprintf("route match generated only from %s objects: %s", d.Source, d.Match)
+}
+
+// Verify translates the objects along with the Ingresses and, separately, along with the HTTPRoutes generated
+// from them, and compares the generated Kong routes. Routes are compared by the combinations of hosts, paths
+// and methods they match, so that routes split or merged differently by the translator are still equivalent.
+// It returns the matches generated from only one of the Ingresses and the HTTPRoutes.
+func Verify(
+	logger logr.Logger,
+	objects [][]byte,
+	ingresses []*netv1.Ingress,
+	httpRoutes []*gatewayapi.HTTPRoute,
+	services []*corev1.Service,
+	cfg offline.Config,
+) ([]Difference, error) {
+	ingresses = lo.Map(ingresses, func(ingress *netv1.Ingress, _ int) *netv1.Ingress {
+		ingress = ingress.DeepCopy()
+		ingress.TypeMeta = metav1.TypeMeta{Kind: "Ingress", APIVersion: netv1.SchemeGroupVersion.String()}
+		return ingress
+	})
+	ingressMatches, err := translateRouteMatches(logger, objects, ingresses, services, cfg)
+	if err != nil {
+		return nil, fmt.Errorf("failed to translate Ingresses: %w", err)
+	}
+	httpRouteMatches, err := translateRouteMatches(logger, objects, httpRoutes, services, cfg)
+	if err != nil {
+		return nil, fmt.Errorf("failed to translate HTTPRoutes: %w", err)
+	}
+
+	onlyIngress, onlyHTTPRoute := lo.Difference(ingressMatches, httpRouteMatches)
+	differences := make([]Difference, 0, len(onlyIngress)+len(onlyHTTPRoute))
+	for _, m := range onlyIngress {
+		differences = append(differences, Difference{Source: "Ingress", Match: m})
+	}
+	for _, m := range onlyHTTPRoute {
+		differences = append(differences, Difference{Source: "HTTPRoute", Match: m})
+	}
+	return differences, nil
+}
+
+func translateRouteMatches[T any](
+	logger logr.Logger, objects [][]byte, routes []T, services []*corev1.Service, cfg offline.Config,
+) ([]string, error) {
+	objects = slices.Clone(objects)
+	for _, route := range routes {
+		b, err := yaml.Marshal(route)
+		if err != nil {
+			return nil, err
+		}
+		objects = append(objects, b)
+	}
+	result, err := offline.TranslateToKongState(logger, objects, cfg)
+	if err != nil {
+		return nil, err
+	}
+
+	var matches []string
+	for _, service := range result.KongState.Services {
+		backends := serviceBackendsString(service.Backends, services)
+		for _, route := range service.Routes {
+			matches = append(matches, routeMatches(route, backends)...)
+		}
+	}
+	slices.Sort(matches)
+	return lo.Uniq(matches), nil
+}
+
+// routeMatches returns a description of every combination of host, path and method matched by the route,
+// along with the route's attributes affecting how matching requests are proxied.
+func routeMatches(route kongstate.Route, backends string) []string {
+	hosts := lo.Ternary(len(route.Hosts) > 0, fromPtrs(route.Hosts), []string{"*"})
+	paths := lo.Ternary(len(route.Paths) > 0, normalizePaths(fromPtrs(route.Paths)), []string{"*"})
+	methods := lo.Ternary(len(route.Methods) > 0, fromPtrs(route.Methods), []string{"*"})
+
+	headerNames := lo.Keys(route.Headers)
+	slices.Sort(headerNames)
+	headers := lo.Map(headerNames, func(name string, _ int) string {
+		return strings.ToLower(name) + "=" + strings.Join(route.Headers[name], "|")
+	})
+
+	plugins := annotations.ExtractKongPluginsFromAnnotations(route.Ingress.Annotations)
+	for _, plugin := range route.Plugins {
+		config, _ := json.Marshal(plugin.Config)
+		plugins = append(plugins, fmt.Sprintf("%s%s", lo.FromPtr(plugin.Name), config))
+	}
+	slices.Sort(plugins)
+
+	protocols := fromPtrs(route.Protocols)
+	slices.Sort(protocols)
+	snis := fromPtrs(route.SNIs)
+	slices.Sort(snis)
+
+	attributes := fmt.Sprintf(
+		"headers=[%s] protocols=[%s] snis=[%s] strip_path=%t preserve_host=%t https_redirect_status_code=%d "+
+			"request_buffering=%t response_buffering=%t path_handling=%s plugins=[%s] backends=[%s]",
+		strings.Join(headers, ","),
+		strings.Join(protocols, ","),
+		strings.Join(snis, ","),
+		lo.FromPtr(route.StripPath),
+		lo.FromPtr(route.PreserveHost),
+		lo.FromPtr(route.HTTPSRedirectStatusCode),
+		lo.FromPtrOr(route.RequestBuffering, true),
+		lo.FromPtrOr(route.ResponseBuffering, true),
+		lo.FromPtrOr(route.PathHandling, "v0"),
+		strings.Join(plugins, ","),
+		backends,
+	)
+
+	var matches []string
+	for _, host := range hosts {
+		for _, path := range paths {
+			for _, method := range methods {
+				matches = append(matches, fmt.Sprintf("host=%s path=%s method=%s %s", host, path, method, attributes))
+			}
+		}
+	}
+	return matches
+}
+
+// normalizePaths drops the regular expression paths matching exactly the paths for which a path prefix
+// ending with a slash is also present. This is how both Prefix Ingress paths and PathPrefix HTTPRoute
+// matches are translated, but the prefix / is translated differently.
+func normalizePaths(paths []string) []string {
+	return lo.Filter(paths, func(path string, _ int) bool {
+		exact, ok := strings.CutPrefix(path, "~")
+		if !ok {
+			return true
+		}
+		exact, ok = strings.CutSuffix(exact, "$")
+		if !ok {
+			return true
+		}
+		return !lo.Contains(paths, strings.TrimSuffix(exact, "/")+"/")
+	})
+}
+
+// serviceBackendsString describes the backends of a Kong service, resolving ports referenced by name
+// to port numbers using the Services.
+func serviceBackendsString(backends kongstate.ServiceBackends, services []*corev1.Service) string {
+	descriptions := lo.Map(backends, func(backend kongstate.ServiceBackend, _ int) string {
+		port := backend.PortDef()
+		portString := port.CanonicalString()
+		if port.Mode == kongstate.PortModeByName {
+			service, ok := lo.Find(services, func(s *corev1.Service) bool {
+				return s.Namespace == backend.Namespace() && s.Name == backend.Name()
+			})
+			if ok {
+				if servicePort, ok := lo.Find(service.Spec.Ports, func(p corev1.ServicePort) bool {
+					return p.Name == port.Name
+				}); ok {
+					portString = fmt.Sprint(servicePort.Port)
+				}
+			}
+		}
+		return fmt.Sprintf("%s/%s:%s", backend.Namespace(), backend.Name(), portString)
+	})
+	slices.Sort(descriptions)
+	return strings.Join(descriptions, ",")
+}
+
+func fromPtrs(values []*string) []string {
+	return lo.Map(values, func(v *string, _ int) string { return lo.FromPtr(v) })
+}
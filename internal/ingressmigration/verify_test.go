@@ -0,0 +1,134 @@
+package ingressmigration_test
+
+import (
+	"testing"
+
+	"github.com/go-logr/logr"
+	"github.com/samber/lo"
+	"github.com/stretchr/testify/require"
+	corev1 "k8s.io/api/core/v1"
+	netv1 "k8s.io/api/networking/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	dpconf "github.com/kong/kubernetes-ingress-controller/v3/internal/dataplane/config"
+	"github.com/kong/kubernetes-ingress-controller/v3/internal/dataplane/offline"
+	"github.com/kong/kubernetes-ingress-controller/v3/internal/ingressmigration"
+	"github.com/kong/kubernetes-ingress-controller/v3/internal/manager/featuregates"
+)
+
+var verifyTestObjects = [][]byte{
+	[]byte(`
+apiVersion: networking.k8s.io/v1
+kind: IngressClass
+metadata:
+  name: kong
+spec:
+  controller: ingress-controllers.konghq.com/kong
+`),
+	[]byte(`
+apiVersion: v1
+kind: Service
+metadata:
+  name: echo
+  namespace: default
+spec:
+  ports:
+  - name: http
+    port: 80
+    protocol: TCP
+`),
+	[]byte(`
+apiVersion: configuration.konghq.com/v1
+kind: KongPlugin
+metadata:
+  name: auth
+  namespace: default
+plugin: key-auth
+`),
+}
+
+func TestVerify(t *testing.T) {
+	services := []*corev1.Service{{
+		ObjectMeta: metav1.ObjectMeta{Namespace: "default", Name: "echo"},
+		Spec:       corev1.ServiceSpec{Ports: []corev1.ServicePort{{Name: "http", Port: 80}}},
+	}}
+	newIngress := func(anns map[string]string, paths ...netv1.HTTPIngressPath) *netv1.Ingress {
+		return &netv1.Ingress{
+			ObjectMeta: metav1.ObjectMeta{Namespace: "default", Name: "echo", Annotations: anns},
+			Spec: netv1.IngressSpec{
+				IngressClassName: lo.ToPtr("kong"),
+				Rules: []netv1.IngressRule{{
+					Host:             "example.com",
+					IngressRuleValue: netv1.IngressRuleValue{HTTP: &netv1.HTTPIngressRuleValue{Paths: paths}},
+				}},
+			},
+		}
+	}
+	newPath := func(path string, pathType netv1.PathType) netv1.HTTPIngressPath {
+		return netv1.HTTPIngressPath{
+			Path:     path,
+			PathType: lo.ToPtr(pathType),
+			Backend: netv1.IngressBackend{Service: &netv1.IngressServiceBackend{
+				Name: "echo",
+				Port: netv1.ServiceBackendPort{Name: "http"},
+			}},
+		}
+	}
+
+	testCases := []struct {
+		name                string
+		ingress             *netv1.Ingress
+		featureGates        map[string]bool
+		expectedDifferences int
+	}{
+		{
+			name: "equivalent HTTPRoute",
+			ingress: newIngress(
+				map[string]string{
+					"konghq.com/strip-path":       "true",
+					"konghq.com/plugins":          "auth",
+					"konghq.com/methods":          "GET,POST",
+					"konghq.com/headers.x-tenant": "a",
+				},
+				newPath("/", netv1.PathTypePrefix),
+				newPath("/api", netv1.PathTypePrefix),
+				newPath("/health", netv1.PathTypeExact),
+				newPath("/~/v[0-9]+", netv1.PathTypeImplementationSpecific),
+			),
+		},
+		{
+			name: "rewrite translated into the same plugin",
+			ingress: newIngress(
+				map[string]string{"konghq.com/rewrite": "/v1/api"},
+				newPath("/api", netv1.PathTypePrefix),
+			),
+			featureGates: map[string]bool{featuregates.RewriteURIsFeature: true},
+		},
+		{
+			name:                "ImplementationSpecific prefix matching partial path segments",
+			ingress:             newIngress(nil, newPath("/api", netv1.PathTypeImplementationSpecific)),
+			expectedDifferences: 2,
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			ingresses := []*netv1.Ingress{tc.ingress}
+			result := ingressmigration.Convert(ingresses, ingressmigration.Options{GatewayName: "kong", Services: services})
+			differences, err := ingressmigration.Verify(
+				logr.Discard(),
+				verifyTestObjects,
+				ingresses,
+				result.HTTPRoutes,
+				services,
+				offline.Config{
+					IngressClassName: "kong",
+					FeatureGates:     tc.featureGates,
+					RouterFlavor:     dpconf.RouterFlavorTraditionalCompatible,
+				},
+			)
+			require.NoError(t, err)
+			require.Len(t, differences, tc.expectedDifferences, "differences: %v", differences)
+		})
+	}
+}
@@ -57,6 +57,11 @@ type Config struct {
 	AnonymousReports                  bool
 	EnableReverseSync                 bool
 	UseLastValidConfigForFallback     bool
+	LastValidConfigSecret             OptionalNamespacedName
+	CanaryRolloutGateways             int
+	CanaryRolloutHealthCheckDelay     time.Duration
+	CanaryRolloutMaxErrorRate         float64
+	DryRun                            bool
 	SyncPeriod                        time.Duration
 	SkipCACertificates                bool
 	CacheSyncTimeout                  time.Duration
@@ -116,6 +121,8 @@ type Config struct {
 	KongServiceFacadeEnabled      bool
 	KongVaultEnabled              bool
 	KongLicenseEnabled            bool
+	KongCustomEntityEnabled       bool
+	KongTenantPolicyEnabled       bool
 
 	// Gateway API toggling.
 	GatewayAPIGatewayController        bool
@@ -181,6 +188,17 @@ func (c *Config) FlagSet() *pflag.FlagSet {
 	flagSet.BoolVar(&c.AnonymousReports, "anonymous-reports", true, `Send anonymized usage data to help improve Kong.`)
 	flagSet.BoolVar(&c.EnableReverseSync, "enable-reverse-sync", false, `Send configuration to Kong even if the configuration checksum has not changed since previous update.`)
 	flagSet.BoolVar(&c.UseLastValidConfigForFallback, "use-last-valid-config-for-fallback", false, `When recovering from config push failures, use the last valid configuration cache to backfill broken objects.`)
+	flagSet.Var(flags.NewValidatedValue(&c.LastValidConfigSecret, namespacedNameFromFlagValue, nnTypeNameOverride), "last-valid-config-secret",
+		`Secret in "namespace/name" format to persist the last valid configuration in, so it can be used for recovery after `+
+			`the controller and DB-less gateways restart. It's applied as a whole and not used by the fallback configuration generator. `+
+			`The controller needs permissions to get, create and update it. Persistence is disabled when empty.`)
+	flagSet.IntVar(&c.CanaryRolloutGateways, "canary-rollout-gateways", 0, `Number of DB-less gateways to push configuration to first, before pushing it to the rest of them `+
+		`once these are found healthy. Staged rollout is disabled when set to 0.`)
+	flagSet.DurationVar(&c.CanaryRolloutHealthCheckDelay, "canary-rollout-health-check-delay", 10*time.Second, `Time to wait after pushing configuration to canary gateways before checking their health.`)
+	flagSet.Float64Var(&c.CanaryRolloutMaxErrorRate, "canary-rollout-max-error-rate", 0.05, `Maximum ratio of requests responded with 5xx status codes to all requests served by a canary gateway `+
+		`while waiting for its health check. Only checked when the gateway exposes metrics with Prometheus plugin's status_code_metrics enabled.`)
+	flagSet.BoolVar(&c.DryRun, "dry-run", false, `Translate configuration and validate it against Kong without applying it. `+
+		`Meant for running a shadow controller alongside another one, in which case it should be combined with --update-status=false.`)
 	// Default has to be explicitly passed to generate the proper docs. See https://github.com/kubernetes-sigs/controller-runtime/blob/f1c5dd3851ce3df8b4b7830d9b6eae6271f6932d/pkg/cache/cache.go#L146-L151.
 	flagSet.DurationVar(&c.SyncPeriod, "sync-period", 10*time.Hour, `Determine the minimum frequency at which watched resources are reconciled. Set to 0 to use default from controller-runtime.`)
 	flagSet.BoolVar(&c.SkipCACertificates, "skip-ca-certificates", false, `Disable syncing CA certificate syncing (for use with multi-workspace environments).`)
@@ -268,6 +286,8 @@ func (c *Config) FlagSet() *pflag.FlagSet {
 	flagSet.BoolVar(&c.KongServiceFacadeEnabled, "enable-controller-kong-service-facade", true, "Enable the KongServiceFacade controller.")
 	flagSet.BoolVar(&c.KongVaultEnabled, "enable-controller-kong-vault", true, "Enable the KongVault controller.")
 	flagSet.BoolVar(&c.KongLicenseEnabled, "enable-controller-kong-license", true, "Enable the KongLicense controller.")
+	flagSet.BoolVar(&c.KongCustomEntityEnabled, "enable-controller-kong-custom-entity", true, "Enable the KongCustomEntity controller.")
+	flagSet.BoolVar(&c.KongTenantPolicyEnabled, "enable-controller-kong-tenant-policy", true, "Enable the KongTenantPolicy and Namespace controllers.")
 
 	// Admission Webhook server config
 	flagSet.StringVar(&c.AdmissionServer.ListenAddr, "admission-webhook-listen", "off",
@@ -70,7 +70,23 @@ func (c *Config) Validate() error {
 	if err := c.validateKongAdminAPI(); err != nil {
 		return fmt.Errorf("invalid kong admin api configuration: %w", err)
 	}
+	if err := c.validateCanaryRollout(); err != nil {
+		return fmt.Errorf("invalid canary rollout configuration: %w", err)
+	}
+
+	return nil
+}
 
+func (c *Config) validateCanaryRollout() error {
+	if c.CanaryRolloutGateways < 0 {
+		return errors.New("--canary-rollout-gateways cannot be negative")
+	}
+	if c.CanaryRolloutHealthCheckDelay < 0 {
+		return errors.New("--canary-rollout-health-check-delay cannot be negative")
+	}
+	if c.CanaryRolloutMaxErrorRate < 0 || c.CanaryRolloutMaxErrorRate > 1 {
+		return errors.New("--canary-rollout-max-error-rate has to be between 0 and 1")
+	}
 	return nil
 }
 
@@ -4,6 +4,7 @@ import (
 	"bytes"
 	"fmt"
 	"testing"
+	"time"
 
 	"github.com/samber/mo"
 	"github.com/stretchr/testify/require"
@@ -275,6 +276,33 @@ func TestConfigValidate(t *testing.T) {
 		})
 	})
 
+	t.Run("canary rollout", func(t *testing.T) {
+		validCanaryRollout := func() manager.Config {
+			return manager.Config{
+				CanaryRolloutGateways:         1,
+				CanaryRolloutHealthCheckDelay: 10 * time.Second,
+				CanaryRolloutMaxErrorRate:     0.05,
+			}
+		}
+
+		t.Run("valid configuration is accepted", func(t *testing.T) {
+			c := validCanaryRollout()
+			require.NoError(t, c.Validate())
+		})
+
+		t.Run("negative number of gateways is rejected", func(t *testing.T) {
+			c := validCanaryRollout()
+			c.CanaryRolloutGateways = -1
+			require.ErrorContains(t, c.Validate(), "--canary-rollout-gateways cannot be negative")
+		})
+
+		t.Run("error rate greater than 1 is rejected", func(t *testing.T) {
+			c := validCanaryRollout()
+			c.CanaryRolloutMaxErrorRate = 1.5
+			require.ErrorContains(t, c.Validate(), "--canary-rollout-max-error-rate has to be between 0 and 1")
+		})
+	})
+
 	t.Run("Admin Token", func(t *testing.T) {
 		validWithToken := func() manager.Config {
 			return manager.Config{
@@ -9,6 +9,7 @@ import (
 	"sigs.k8s.io/controller-runtime/pkg/manager"
 	gatewayv1 "sigs.k8s.io/gateway-api/apis/v1"
 	gatewayv1alpha2 "sigs.k8s.io/gateway-api/apis/v1alpha2"
+	gatewayv1alpha3 "sigs.k8s.io/gateway-api/apis/v1alpha3"
 	gatewayv1beta1 "sigs.k8s.io/gateway-api/apis/v1beta1"
 
 	"github.com/kong/kubernetes-ingress-controller/v3/internal/controllers"
@@ -18,7 +19,10 @@ import (
 	ctrlref "github.com/kong/kubernetes-ingress-controller/v3/internal/controllers/reference"
 	"github.com/kong/kubernetes-ingress-controller/v3/internal/controllers/utils"
 	"github.com/kong/kubernetes-ingress-controller/v3/internal/dataplane"
+	"github.com/kong/kubernetes-ingress-controller/v3/internal/hostownership"
 	"github.com/kong/kubernetes-ingress-controller/v3/internal/manager/featuregates"
+	mcsv1alpha1 "github.com/kong/kubernetes-ingress-controller/v3/internal/mcsapi/v1alpha1"
+	"github.com/kong/kubernetes-ingress-controller/v3/internal/store"
 	"github.com/kong/kubernetes-ingress-controller/v3/internal/util/kubernetes/object/status"
 )
 
@@ -67,7 +71,15 @@ func setupControllers(
 	featureGates featuregates.FeatureGates,
 	kongAdminAPIEndpointsNotifier configuration.EndpointsNotifier,
 	adminAPIsDiscoverer configuration.AdminAPIsDiscoverer,
+	storer store.Storer,
 ) []ControllerDef {
+	// Hostname owners are determined from the same store as in translation, so that HTTPRoutes rejected
+	// for routing hostnames owned by other namespaces can be reported in their status.
+	var hostnameOwnershipStorer hostownership.Storer
+	if featureGates.Enabled(featuregates.HostnameOwnership) {
+		hostnameOwnershipStorer = storer
+	}
+
 	controllers := []ControllerDef{
 		// ---------------------------------------------------------------------------
 		// Kong Gateway Admin API Service discovery
@@ -143,6 +155,17 @@ func setupControllers(
 				ReferenceIndexers: referenceIndexers,
 			},
 		},
+		{
+			Enabled: true,
+			Controller: &configuration.CoreV1ConfigMapReconciler{
+				Client:            mgr.GetClient(),
+				Log:               ctrl.LoggerFrom(ctx).WithName("controllers").WithName("ConfigMaps"),
+				Scheme:            mgr.GetScheme(),
+				DataplaneClient:   dataplaneClient,
+				CacheSyncTimeout:  c.CacheSyncTimeout,
+				ReferenceIndexers: referenceIndexers,
+			},
+		},
 		// ---------------------------------------------------------------------------
 		// Kong API Controllers
 		// ---------------------------------------------------------------------------
@@ -253,6 +276,7 @@ func setupControllers(
 		},
 		// KongUpstreamPolicy controller.
 		// When HTTPRoute exists, the controller is enabled to watch HTTPRoutes to set ancestor status of KongUpstreamPolicies.
+		// The same applies to BackendLBPolicies when the Gateway API alpha features are enabled.
 		{
 			Enabled: c.KongUpstreamPolicyEnabled,
 			Controller: &configuration.KongUpstreamPolicyReconciler{
@@ -268,6 +292,12 @@ func setupControllers(
 					Version:  gatewayv1.GroupVersion.Version,
 					Resource: "httproutes",
 				}),
+				BackendLBPolicyEnabled: featureGates.Enabled(featuregates.GatewayAlphaFeature) &&
+					utils.CRDExists(mgr.GetRESTMapper(), schema.GroupVersionResource{
+						Group:    gatewayv1alpha2.GroupVersion.Group,
+						Version:  gatewayv1alpha2.GroupVersion.Version,
+						Resource: "backendlbpolicies",
+					}),
 			},
 		},
 		{
@@ -296,6 +326,40 @@ func setupControllers(
 				StatusQueue:                kubernetesStatusQueue,
 			},
 		},
+		{
+			Enabled: c.KongCustomEntityEnabled,
+			Controller: &configuration.KongV1Alpha1KongCustomEntityReconciler{
+				Client:                     mgr.GetClient(),
+				Log:                        ctrl.LoggerFrom(ctx).WithName("controllers").WithName("KongCustomEntity"),
+				Scheme:                     mgr.GetScheme(),
+				DataplaneClient:            dataplaneClient,
+				CacheSyncTimeout:           c.CacheSyncTimeout,
+				IngressClassName:           c.IngressClassName,
+				DisableIngressClassLookups: !c.IngressClassNetV1Enabled,
+				StatusQueue:                kubernetesStatusQueue,
+			},
+		},
+		{
+			Enabled: c.KongTenantPolicyEnabled,
+			Controller: &configuration.KongV1Alpha1KongTenantPolicyReconciler{
+				Client:           mgr.GetClient(),
+				Log:              ctrl.LoggerFrom(ctx).WithName("controllers").WithName("KongTenantPolicy"),
+				Scheme:           mgr.GetScheme(),
+				DataplaneClient:  dataplaneClient,
+				CacheSyncTimeout: c.CacheSyncTimeout,
+			},
+		},
+		{
+			// Namespaces are needed only to match their labels against KongTenantPolicies' selectors.
+			Enabled: c.KongTenantPolicyEnabled,
+			Controller: &configuration.CoreV1NamespaceReconciler{
+				Client:           mgr.GetClient(),
+				Log:              ctrl.LoggerFrom(ctx).WithName("controllers").WithName("Namespace"),
+				Scheme:           mgr.GetScheme(),
+				DataplaneClient:  dataplaneClient,
+				CacheSyncTimeout: c.CacheSyncTimeout,
+			},
+		},
 		// ---------------------------------------------------------------------------
 		// Gateway API Controllers
 		// ---------------------------------------------------------------------------
@@ -334,13 +398,16 @@ func setupControllers(
 					Resource: "httproutes",
 				}),
 				Controller: &gateway.HTTPRouteReconciler{
-					Client:           mgr.GetClient(),
-					Log:              ctrl.LoggerFrom(ctx).WithName("controllers").WithName("HTTPRoute"),
-					Scheme:           mgr.GetScheme(),
-					DataplaneClient:  dataplaneClient,
-					CacheSyncTimeout: c.CacheSyncTimeout,
-					StatusQueue:      kubernetesStatusQueue,
-					GatewayNN:        controllers.NewOptionalNamespacedName(c.GatewayToReconcile),
+					Client:                   mgr.GetClient(),
+					Log:                      ctrl.LoggerFrom(ctx).WithName("controllers").WithName("HTTPRoute"),
+					Scheme:                   mgr.GetScheme(),
+					DataplaneClient:          dataplaneClient,
+					CacheSyncTimeout:         c.CacheSyncTimeout,
+					StatusQueue:              kubernetesStatusQueue,
+					GatewayNN:                controllers.NewOptionalNamespacedName(c.GatewayToReconcile),
+					KongServiceFacadeEnabled: featureGates.Enabled(featuregates.KongServiceFacade) && c.KongServiceFacadeEnabled,
+					ServiceImportEnabled:     featureGates.Enabled(featuregates.MultiClusterServices),
+					HostnameOwnershipStorer:  hostnameOwnershipStorer,
 				},
 			},
 		},
@@ -455,6 +522,69 @@ func setupControllers(
 				},
 			},
 		},
+		{
+			Enabled: featureGates.Enabled(featuregates.GatewayAlphaFeature),
+			Controller: &crds.DynamicCRDController{
+				Manager:          mgr,
+				Log:              ctrl.LoggerFrom(ctx).WithName("controllers").WithName("Dynamic/BackendTLSPolicy"),
+				CacheSyncTimeout: c.CacheSyncTimeout,
+				RequiredCRDs: append(baseGatewayCRDs(), schema.GroupVersionResource{
+					Group:    gatewayv1alpha3.GroupVersion.Group,
+					Version:  gatewayv1alpha3.GroupVersion.Version,
+					Resource: "backendtlspolicies",
+				}),
+				Controller: &configuration.BackendTLSPolicyReconciler{
+					Client:            mgr.GetClient(),
+					Log:               ctrl.LoggerFrom(ctx).WithName("controllers").WithName("BackendTLSPolicy"),
+					Scheme:            mgr.GetScheme(),
+					DataplaneClient:   dataplaneClient,
+					CacheSyncTimeout:  c.CacheSyncTimeout,
+					StatusQueue:       kubernetesStatusQueue,
+					ReferenceIndexers: referenceIndexers,
+				},
+			},
+		},
+		{
+			Enabled: featureGates.Enabled(featuregates.GatewayAlphaFeature),
+			Controller: &crds.DynamicCRDController{
+				Manager:          mgr,
+				Log:              ctrl.LoggerFrom(ctx).WithName("controllers").WithName("Dynamic/BackendLBPolicy"),
+				CacheSyncTimeout: c.CacheSyncTimeout,
+				RequiredCRDs: append(baseGatewayCRDs(), schema.GroupVersionResource{
+					Group:    gatewayv1alpha2.GroupVersion.Group,
+					Version:  gatewayv1alpha2.GroupVersion.Version,
+					Resource: "backendlbpolicies",
+				}),
+				Controller: &configuration.GatewayV1Alpha2BackendLBPolicyReconciler{
+					Client:           mgr.GetClient(),
+					Log:              ctrl.LoggerFrom(ctx).WithName("controllers").WithName("BackendLBPolicy"),
+					Scheme:           mgr.GetScheme(),
+					DataplaneClient:  dataplaneClient,
+					CacheSyncTimeout: c.CacheSyncTimeout,
+				},
+			},
+		},
+		// ---------------------------------------------------------------------------
+		// Multi-Cluster Services API Controllers
+		// ---------------------------------------------------------------------------
+		{
+			Enabled: featureGates.Enabled(featuregates.MultiClusterServices),
+			Controller: &crds.DynamicCRDController{
+				Manager:          mgr,
+				Log:              ctrl.LoggerFrom(ctx).WithName("controllers").WithName("Dynamic/ServiceImport"),
+				CacheSyncTimeout: c.CacheSyncTimeout,
+				RequiredCRDs: []schema.GroupVersionResource{
+					mcsv1alpha1.GroupVersion.WithResource("serviceimports"),
+				},
+				Controller: &configuration.MulticlusterV1Alpha1ServiceImportReconciler{
+					Client:           mgr.GetClient(),
+					Log:              ctrl.LoggerFrom(ctx).WithName("controllers").WithName("ServiceImport"),
+					Scheme:           mgr.GetScheme(),
+					DataplaneClient:  dataplaneClient,
+					CacheSyncTimeout: c.CacheSyncTimeout,
+				},
+			},
+		},
 	}
 
 	return controllers
@@ -32,6 +32,22 @@ const (
 	// of entity errors returned by the Kong Admin API.
 	FallbackConfiguration = "FallbackConfiguration"
 
+	// HostnameOwnership is the name of the feature-gate that makes the first namespace routing a hostname its owner
+	// and rejects routes for that hostname from other namespaces.
+	HostnameOwnership = "HostnameOwnership"
+
+	// KongGatewaySync is the name of the feature-gate that makes the controller report the state of the configuration
+	// sync with every Kong gateway in a KongGatewaySync object.
+	KongGatewaySync = "KongGatewaySync"
+
+	// RequestMirror is the name of the feature-gate that enables translating HTTPRoute RequestMirror filters to
+	// post-function plugins. It requires Kong to allow serverless functions to require the resty.http module.
+	RequestMirror = "RequestMirror"
+
+	// MultiClusterServices is the name of the feature-gate that enables Multi-Cluster Services API ServiceImports
+	// as Gateway API routes' backendRefs.
+	MultiClusterServices = "MultiClusterServices"
+
 	// DocsURL provides a link to the documentation for feature gates in the KIC repository.
 	DocsURL = "https://github.com/Kong/kubernetes-ingress-controller/blob/main/FEATURE_GATES.md"
 )
@@ -73,5 +89,9 @@ func GetFeatureGatesDefaults() FeatureGates {
 		KongServiceFacade:          false,
 		SanitizeKonnectConfigDumps: true,
 		FallbackConfiguration:      false,
+		HostnameOwnership:          false,
+		KongGatewaySync:            false,
+		RequestMirror:              false,
+		MultiClusterServices:       false,
 	}
 }
@@ -20,6 +20,7 @@ import (
 	"sigs.k8s.io/controller-runtime/pkg/manager"
 
 	"github.com/kong/kubernetes-ingress-controller/v3/internal/adminapi"
+	"github.com/kong/kubernetes-ingress-controller/v3/internal/admission"
 	"github.com/kong/kubernetes-ingress-controller/v3/internal/clients"
 	"github.com/kong/kubernetes-ingress-controller/v3/internal/controllers/gateway"
 	ctrlref "github.com/kong/kubernetes-ingress-controller/v3/internal/controllers/reference"
@@ -30,6 +31,7 @@ import (
 	"github.com/kong/kubernetes-ingress-controller/v3/internal/dataplane/sendconfig"
 	"github.com/kong/kubernetes-ingress-controller/v3/internal/dataplane/translator"
 	"github.com/kong/kubernetes-ingress-controller/v3/internal/gatewayapi"
+	"github.com/kong/kubernetes-ingress-controller/v3/internal/gatewaysync"
 	"github.com/kong/kubernetes-ingress-controller/v3/internal/konnect"
 	"github.com/kong/kubernetes-ingress-controller/v3/internal/konnect/nodes"
 	"github.com/kong/kubernetes-ingress-controller/v3/internal/manager/featuregates"
@@ -124,6 +126,12 @@ func Run(
 		ExpressionRoutes:           dpconf.ShouldEnableExpressionRoutes(routerFlavor),
 		SanitizeKonnectConfigDumps: featureGates.Enabled(featuregates.SanitizeKonnectConfigDumps),
 		FallbackConfiguration:      featureGates.Enabled(featuregates.FallbackConfiguration),
+		DryRun:                     c.DryRun,
+		CanaryRollout: sendconfig.CanaryRolloutConfig{
+			Gateways:         c.CanaryRolloutGateways,
+			HealthCheckDelay: c.CanaryRolloutHealthCheckDelay,
+			MaxErrorRate:     c.CanaryRolloutMaxErrorRate,
+		},
 	}
 
 	setupLog.Info("Configuring and building the controller manager")
@@ -172,6 +180,7 @@ func Run(
 	translatorFeatureFlags := translator.NewFeatureFlags(
 		featureGates,
 		routerFlavor,
+		dbMode,
 		c.UpdateStatus,
 		kongStartUpConfig.Version.IsKongGatewayEnterprise(),
 	)
@@ -192,6 +201,10 @@ func Run(
 	updateStrategyResolver := sendconfig.NewDefaultUpdateStrategyResolver(kongConfig, logger)
 	configurationChangeDetector := sendconfig.NewDefaultConfigurationChangeDetector(logger)
 	kongConfigFetcher := configfetcher.NewDefaultKongLastGoodConfigFetcher(translatorFeatureFlags.FillIDs, c.KongWorkspace)
+	if secretNN, ok := c.LastValidConfigSecret.Get(); ok {
+		setupLog.Info("Persisting last valid configuration in Secret", "secret", secretNN)
+		kongConfigFetcher.InjectStorage(configfetcher.NewSecretLastValidConfigStorage(mgr.GetAPIReader(), mgr.GetClient(), secretNN))
+	}
 	fallbackConfigGenerator := fallback.NewGenerator(fallback.NewDefaultCacheGraphProvider(), logger)
 	dataplaneClient, err := dataplane.NewKongClient(
 		logger,
@@ -246,6 +259,7 @@ func Run(
 		featureGates,
 		clientsManager,
 		adminAPIsDiscoverer,
+		storer,
 	)
 	for _, c := range controllers {
 		if err := c.MaybeSetupWithManager(mgr); err != nil {
@@ -287,6 +301,12 @@ func Run(
 		}
 	}
 
+	if featureGates.Enabled(featuregates.KongGatewaySync) {
+		if err := setupGatewaySyncUpdaterWithMgr(mgr, clientsManager, setupLog); err != nil {
+			return err
+		}
+	}
+
 	// Setup and inject license getter.
 	licenseGetter, err := setupLicenseGetter(
 		ctx,
@@ -305,6 +325,7 @@ func Run(
 		configTranslator.InjectLicenseGetter(licenseGetter)
 		kongConfigFetcher.InjectLicenseGetter(licenseGetter)
 	}
+	configTranslator.InjectSchemaServiceProvider(admission.NewDefaultAdminAPIServicesProvider(clientsManager))
 
 	if c.AnonymousReports {
 		stopAnonymousReports, err := telemetry.SetupAnonymousReports(
@@ -424,6 +445,34 @@ func setupKonnectNodeAgentWithMgr(
 	return nil
 }
 
+// setupGatewaySyncUpdaterWithMgr creates and adds the KongGatewaySync updater as the manager's Runnable.
+func setupGatewaySyncUpdaterWithMgr(
+	mgr manager.Manager,
+	clientsManager *clients.AdminAPIClientsManager,
+	logger logr.Logger,
+) error {
+	// KongGatewaySyncs of gateways not running in Pods are created in the controller's namespace.
+	var namespace string
+	if nn, err := util.GetPodNN(); err != nil {
+		logger.Error(err, "Failed getting pod namespace, KongGatewaySyncs of gateways not running in Pods will not be created")
+	} else {
+		namespace = nn.Namespace
+	}
+
+	updater := gatewaysync.NewUpdater(
+		logger,
+		mgr.GetAPIReader(),
+		mgr.GetClient(),
+		clientsManager,
+		namespace,
+		gatewaysync.DefaultUpdatePeriod,
+	)
+	if err := mgr.Add(updater); err != nil {
+		return fmt.Errorf("failed adding gatewaysync.Updater runnable to the manager: %w", err)
+	}
+	return nil
+}
+
 // setupKonnectAdminAPIClientWithClientsMgr initializes Konnect Admin API client and sets it to clientsManager.
 // If it fails to initialize the client, it logs the error and returns.
 func setupKonnectAdminAPIClientWithClientsMgr(
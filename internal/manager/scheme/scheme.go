@@ -6,8 +6,10 @@ import (
 	clientgoscheme "k8s.io/client-go/kubernetes/scheme"
 	gatewayv1 "sigs.k8s.io/gateway-api/apis/v1"
 	gatewayv1alpha2 "sigs.k8s.io/gateway-api/apis/v1alpha2"
+	gatewayv1alpha3 "sigs.k8s.io/gateway-api/apis/v1alpha3"
 	gatewayv1beta1 "sigs.k8s.io/gateway-api/apis/v1beta1"
 
+	mcsv1alpha1 "github.com/kong/kubernetes-ingress-controller/v3/internal/mcsapi/v1alpha1"
 	kongv1 "github.com/kong/kubernetes-ingress-controller/v3/pkg/apis/configuration/v1"
 	kongv1alpha1 "github.com/kong/kubernetes-ingress-controller/v3/pkg/apis/configuration/v1alpha1"
 	kongv1beta1 "github.com/kong/kubernetes-ingress-controller/v3/pkg/apis/configuration/v1beta1"
@@ -39,10 +41,18 @@ func Get() (*runtime.Scheme, error) {
 		return nil, err
 	}
 
+	if err := mcsv1alpha1.AddToScheme(scheme); err != nil {
+		return nil, err
+	}
+
 	if err := gatewayv1alpha2.Install(scheme); err != nil {
 		return nil, err
 	}
 
+	if err := gatewayv1alpha3.Install(scheme); err != nil {
+		return nil, err
+	}
+
 	if err := gatewayv1beta1.Install(scheme); err != nil {
 		return nil, err
 	}
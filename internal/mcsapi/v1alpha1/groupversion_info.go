@@ -0,0 +1,24 @@
+// Package v1alpha1 contains the subset of the Kubernetes Multi-Cluster Services API
+// (https://github.com/kubernetes-sigs/mcs-api) types the controller reads: ServiceImports,
+// which can be used as Gateway API routes' backendRefs. The types mirror sigs.k8s.io/mcs-api/pkg/apis/v1alpha1
+// and have the same wire format.
+package v1alpha1
+
+import (
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	"sigs.k8s.io/controller-runtime/pkg/scheme"
+)
+
+var (
+	// GroupVersion is group version used to register these objects.
+	GroupVersion = schema.GroupVersion{Group: "multicluster.x-k8s.io", Version: "v1alpha1"}
+
+	// SchemeGroupVersion is a convenience var for consistency with other API packages.
+	SchemeGroupVersion = GroupVersion
+
+	// SchemeBuilder is used to add go types to the GroupVersionKind scheme.
+	SchemeBuilder = &scheme.Builder{GroupVersion: GroupVersion}
+
+	// AddToScheme adds the types in this group-version to the given scheme.
+	AddToScheme = SchemeBuilder.AddToScheme
+)
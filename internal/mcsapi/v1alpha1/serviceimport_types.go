@@ -0,0 +1,89 @@
+package v1alpha1
+
+import (
+	"fmt"
+
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+func init() {
+	SchemeBuilder.Register(&ServiceImport{}, &ServiceImportList{})
+}
+
+const (
+	// ServiceImportKind is the kind of the ServiceImport resource.
+	ServiceImportKind = "ServiceImport"
+
+	// LabelServiceName is the label set on EndpointSlices by MCS implementations to tie them to the ServiceImport
+	// they provide endpoints for.
+	LabelServiceName = "multicluster.kubernetes.io/service-name"
+
+	// ClusterSetDomain is the DNS domain in which ServiceImports are resolvable.
+	ClusterSetDomain = "clusterset.local"
+)
+
+// ServiceImportType designates the type of a ServiceImport.
+type ServiceImportType string
+
+const (
+	// ClusterSetIP are only accessible via the ClusterSet IP.
+	ClusterSetIP ServiceImportType = "ClusterSetIP"
+	// Headless services allow backend pods to be addressed directly.
+	Headless ServiceImportType = "Headless"
+)
+
+// ServiceImport describes a service imported from clusters in a ClusterSet.
+type ServiceImport struct {
+	metav1.TypeMeta   `json:",inline"`
+	metav1.ObjectMeta `json:"metadata,omitempty"`
+
+	Spec   ServiceImportSpec   `json:"spec,omitempty"`
+	Status ServiceImportStatus `json:"status,omitempty"`
+}
+
+// ServiceImportSpec describes an imported service and the information necessary to consume it.
+type ServiceImportSpec struct {
+	Ports []ServicePort `json:"ports"`
+	// IPs are the ClusterSet IPs of the imported service. They're empty for headless services.
+	IPs []string `json:"ips,omitempty"`
+	// Type defines the type of this service.
+	Type ServiceImportType `json:"type"`
+}
+
+// ServicePort represents the port on which the service is exposed.
+type ServicePort struct {
+	// Name is the name of this port within the service. It's matched against the names of the EndpointSlices' ports.
+	Name string `json:"name,omitempty"`
+	// Protocol is the IP protocol for this port. Defaults to TCP.
+	Protocol corev1.Protocol `json:"protocol,omitempty"`
+	// AppProtocol is the application protocol for this port.
+	AppProtocol *string `json:"appProtocol,omitempty"`
+	// Port is the port that will be exposed by this service.
+	Port int32 `json:"port"`
+}
+
+// ServiceImportStatus describes derived state of an imported service.
+type ServiceImportStatus struct {
+	// Clusters is the list of exporting clusters from which this service was derived.
+	Clusters []ClusterStatus `json:"clusters,omitempty"`
+}
+
+// ClusterStatus contains service configuration mapped to a specific source cluster.
+type ClusterStatus struct {
+	// Cluster is the name of the exporting cluster.
+	Cluster string `json:"cluster"`
+}
+
+// ServiceImportList represents a list of ServiceImports.
+type ServiceImportList struct {
+	metav1.TypeMeta `json:",inline"`
+	metav1.ListMeta `json:"metadata,omitempty"`
+
+	Items []ServiceImport `json:"items"`
+}
+
+// ClusterSetHostname returns the hostname a ServiceImport is resolvable at in the ClusterSet.
+func ClusterSetHostname(namespace, name string) string {
+	return fmt.Sprintf("%s.%s.svc.%s", name, namespace, ClusterSetDomain)
+}
@@ -11,6 +11,7 @@ import (
 	"sigs.k8s.io/controller-runtime/pkg/metrics"
 
 	"github.com/kong/kubernetes-ingress-controller/v3/internal/dataplane/deckerrors"
+	"github.com/kong/kubernetes-ingress-controller/v3/internal/dataplane/failures"
 )
 
 // descriptions of these metrics are found below, where their help text is set in NewCtrlFuncMetrics()
@@ -20,13 +21,21 @@ type CtrlFuncMetrics struct {
 
 	ConfigPushBrokenResources *prometheus.GaugeVec
 
+	ConfigPushBrokenObjects *prometheus.GaugeVec
+
 	TranslationCount *prometheus.CounterVec
 
 	TranslationBrokenResources prometheus.Gauge
 
+	TranslationBrokenObjects *prometheus.GaugeVec
+
+	TranslationDuration *prometheus.HistogramVec
+
 	ConfigPushDuration *prometheus.HistogramVec
 
 	ConfigPushSuccessTime *prometheus.GaugeVec
+
+	ConfigEntities *prometheus.GaugeVec
 }
 
 const (
@@ -46,6 +55,12 @@ const (
 	ProtocolDBLess Protocol = "db-less"
 	// ProtocolDeck indicates that configuration was sent to Kong using the DB mode protocol (deck sync).
 	ProtocolDeck Protocol = "deck"
+	// ProtocolDBLessDryRun indicates that configuration was validated against Kong running in DB-less mode without
+	// being applied (--dry-run).
+	ProtocolDBLessDryRun Protocol = "db-less-dry-run"
+	// ProtocolDeckDryRun indicates that configuration was diffed against Kong running in DB mode without being
+	// applied (--dry-run).
+	ProtocolDeckDryRun Protocol = "deck-dry-run"
 
 	// ProtocolKey defines the key of the metric label indicating which protocol KIC used to configure Kong.
 	ProtocolKey string = "protocol"
@@ -70,6 +85,44 @@ const (
 	DataplaneKey string = "dataplane"
 )
 
+const (
+	// KindKey defines the name of the metric label indicating the kind of a Kubernetes object.
+	KindKey string = "kind"
+	// NamespaceKey defines the name of the metric label indicating the namespace of a Kubernetes object.
+	NamespaceKey string = "namespace"
+	// NameKey defines the name of the metric label indicating the name of a Kubernetes object.
+	NameKey string = "name"
+)
+
+// TranslationStage is a stage of the translation from Kubernetes state to Kong state.
+type TranslationStage string
+
+const (
+	// TranslationStageIngress is the stage translating Ingresses, TCPIngresses and UDPIngresses.
+	TranslationStageIngress TranslationStage = "ingress"
+	// TranslationStageHTTPRoute is the stage translating HTTPRoutes.
+	TranslationStageHTTPRoute TranslationStage = "httproute"
+	// TranslationStageOtherRoutes is the stage translating GRPCRoutes, TCPRoutes, TLSRoutes and UDPRoutes.
+	TranslationStageOtherRoutes TranslationStage = "other_routes"
+	// TranslationStageServices is the stage resolving Kubernetes Services into Kong services, upstreams and targets
+	// and applying their overrides.
+	TranslationStageServices TranslationStage = "services"
+	// TranslationStageConsumers is the stage translating consumers, their credentials, consumer groups and vaults.
+	TranslationStageConsumers TranslationStage = "consumers"
+	// TranslationStagePlugins is the stage translating plugins.
+	TranslationStagePlugins TranslationStage = "plugins"
+	// TranslationStageCertificates is the stage translating certificates, CA certificates and BackendTLSPolicies.
+	TranslationStageCertificates TranslationStage = "certificates"
+
+	// TranslationStageKey defines the name of the metric label indicating the translation stage.
+	TranslationStageKey string = "stage"
+)
+
+const (
+	// EntityTypeKey defines the name of the metric label indicating the type of a Kong entity.
+	EntityTypeKey string = "entity_type"
+)
+
 const (
 	MetricNameConfigPushCount            = "ingress_controller_configuration_push_count"
 	MetricNameConfigPushBrokenResources  = "ingress_controller_configuration_push_broken_resource_count"
@@ -77,6 +130,10 @@ const (
 	MetricNameTranslationCount           = "ingress_controller_translation_count"
 	MetricNameTranslationBrokenResources = "ingress_controller_translation_broken_resource_count"
 	MetricNameConfigPushDuration         = "ingress_controller_configuration_push_duration_milliseconds"
+	MetricNameConfigPushBrokenObjects    = "ingress_controller_configuration_push_broken_resource"
+	MetricNameTranslationBrokenObjects   = "ingress_controller_translation_broken_resource"
+	MetricNameTranslationDuration        = "ingress_controller_translation_duration_milliseconds"
+	MetricNameConfigEntities             = "ingress_controller_configuration_entities"
 )
 
 var _lock sync.Mutex
@@ -93,12 +150,12 @@ func NewCtrlFuncMetrics() *CtrlFuncMetrics {
 			Help: fmt.Sprintf(
 				"Count of successful/failed configuration pushes to Kong. "+
 					"`%s` describes the dataplane that was the target of configuration push. "+
-					"`%s` describes the configuration protocol (`%s` or `%s`) in use. "+
+					"`%s` describes the configuration protocol (`%s`, `%s`, `%s` or `%s`) in use. "+
 					"`%s` describes whether there were unrecoverable errors (`%s`) or not (`%s`). "+
 					"`%s` is populated in case of `%s=\"%s\"` and describes the reason of failure "+
 					"(one of `%s`, `%s`, `%s`).",
 				DataplaneKey,
-				ProtocolKey, ProtocolDBLess, ProtocolDeck,
+				ProtocolKey, ProtocolDBLess, ProtocolDeck, ProtocolDBLessDryRun, ProtocolDeckDryRun,
 				SuccessKey, SuccessFalse, SuccessTrue,
 				FailureReasonKey, SuccessKey, SuccessFalse,
 				FailureReasonConflict, FailureReasonNetwork, FailureReasonOther,
@@ -118,6 +175,19 @@ func NewCtrlFuncMetrics() *CtrlFuncMetrics {
 		[]string{DataplaneKey},
 	)
 
+	controllerMetrics.ConfigPushBrokenObjects = prometheus.NewGaugeVec(
+		prometheus.GaugeOpts{
+			Name: MetricNameConfigPushBrokenObjects,
+			Help: fmt.Sprintf("Kubernetes objects whose configuration was not accepted by Kong when attempting to push "+
+				"configuration, set to the number of failures the object caused. "+
+				"`%s` describes the dataplane that was the target of the configuration push. "+
+				"`%s`, `%s` and `%s` identify the Kubernetes object.",
+				DataplaneKey, KindKey, NamespaceKey, NameKey,
+			),
+		},
+		[]string{DataplaneKey, KindKey, NamespaceKey, NameKey},
+	)
+
 	controllerMetrics.TranslationCount = prometheus.NewCounterVec(
 		prometheus.CounterOpts{
 			Name: MetricNameTranslationCount,
@@ -140,16 +210,44 @@ func NewCtrlFuncMetrics() *CtrlFuncMetrics {
 		},
 	)
 
+	controllerMetrics.TranslationBrokenObjects = prometheus.NewGaugeVec(
+		prometheus.GaugeOpts{
+			Name: MetricNameTranslationBrokenObjects,
+			Help: fmt.Sprintf("Kubernetes objects that the controller cannot successfully translate to Kong "+
+				"configuration, set to the number of failures the object caused. "+
+				"`%s`, `%s` and `%s` identify the Kubernetes object.",
+				KindKey, NamespaceKey, NameKey,
+			),
+		},
+		[]string{KindKey, NamespaceKey, NameKey},
+	)
+
+	controllerMetrics.TranslationDuration = prometheus.NewHistogramVec(
+		prometheus.HistogramOpts{
+			Name: MetricNameTranslationDuration,
+			Help: fmt.Sprintf(
+				"How long it took to translate Kubernetes state to Kong state, in milliseconds. "+
+					"`%s` describes the translation stage (`%s`, `%s`, `%s`, `%s`, `%s`, `%s` or `%s`).",
+				TranslationStageKey,
+				TranslationStageIngress, TranslationStageHTTPRoute, TranslationStageOtherRoutes,
+				TranslationStageServices, TranslationStageConsumers, TranslationStagePlugins,
+				TranslationStageCertificates,
+			),
+			Buckets: prometheus.ExponentialBuckets(0.1, 2, 18),
+		},
+		[]string{TranslationStageKey},
+	)
+
 	controllerMetrics.ConfigPushDuration = prometheus.NewHistogramVec(
 		prometheus.HistogramOpts{
 			Name: MetricNameConfigPushDuration,
 			Help: fmt.Sprintf(
 				"How long it took to push the configuration to Kong, in milliseconds. "+
 					"`%s` describes the dataplane that was the target of configuration push. "+
-					"`%s` describes the configuration protocol (`%s` or `%s`) in use. "+
+					"`%s` describes the configuration protocol (`%s`, `%s`, `%s` or `%s`) in use. "+
 					"`%s` describes whether there were unrecoverable errors (`%s`) or not (`%s`).",
 				DataplaneKey,
-				ProtocolKey, ProtocolDBLess, ProtocolDeck,
+				ProtocolKey, ProtocolDBLess, ProtocolDeck, ProtocolDBLessDryRun, ProtocolDeckDryRun,
 				SuccessKey, SuccessFalse, SuccessTrue,
 			),
 			Buckets: prometheus.ExponentialBuckets(100, 1.33, 30),
@@ -168,20 +266,39 @@ func NewCtrlFuncMetrics() *CtrlFuncMetrics {
 		[]string{DataplaneKey},
 	)
 
+	controllerMetrics.ConfigEntities = prometheus.NewGaugeVec(
+		prometheus.GaugeOpts{
+			Name: MetricNameConfigEntities,
+			Help: fmt.Sprintf("The number of Kong entities in the last configuration successfully pushed to Kong. "+
+				"`%s` describes the type of the entities (e.g. `services`, `routes`, `plugins`).",
+				EntityTypeKey,
+			),
+		},
+		[]string{EntityTypeKey},
+	)
+
 	metrics.Registry.Unregister(controllerMetrics.ConfigPushCount)
 	metrics.Registry.Unregister(controllerMetrics.ConfigPushBrokenResources)
+	metrics.Registry.Unregister(controllerMetrics.ConfigPushBrokenObjects)
 	metrics.Registry.Unregister(controllerMetrics.TranslationCount)
 	metrics.Registry.Unregister(controllerMetrics.TranslationBrokenResources)
+	metrics.Registry.Unregister(controllerMetrics.TranslationBrokenObjects)
+	metrics.Registry.Unregister(controllerMetrics.TranslationDuration)
 	metrics.Registry.Unregister(controllerMetrics.ConfigPushDuration)
 	metrics.Registry.Unregister(controllerMetrics.ConfigPushSuccessTime)
+	metrics.Registry.Unregister(controllerMetrics.ConfigEntities)
 
 	metrics.Registry.MustRegister(
 		controllerMetrics.ConfigPushCount,
 		controllerMetrics.ConfigPushBrokenResources,
+		controllerMetrics.ConfigPushBrokenObjects,
 		controllerMetrics.TranslationCount,
 		controllerMetrics.TranslationBrokenResources,
+		controllerMetrics.TranslationBrokenObjects,
+		controllerMetrics.TranslationDuration,
 		controllerMetrics.ConfigPushDuration,
 		controllerMetrics.ConfigPushSuccessTime,
+		controllerMetrics.ConfigEntities,
 	)
 
 	return controllerMetrics
@@ -193,15 +310,17 @@ func (c *CtrlFuncMetrics) RecordPushSuccess(p Protocol, d time.Duration, datapla
 	c.recordPushCount(p, dpOpt)
 	c.recordPushDuration(p, d, dpOpt)
 	c.recordPushSuccessTime(dpOpt)
-	c.recordPushBrokenResources(0, dpOpt)
+	c.recordPushBrokenResources(nil, dpOpt)
 }
 
 // RecordPushFailure records a failed configuration push.
-func (c *CtrlFuncMetrics) RecordPushFailure(p Protocol, d time.Duration, dataplane string, count int, err error) {
+func (c *CtrlFuncMetrics) RecordPushFailure(
+	p Protocol, d time.Duration, dataplane string, resourceFailures []failures.ResourceFailure, err error,
+) {
 	dpOpt := withDataplane(dataplane)
 	c.recordPushCount(p, dpOpt, withError(err))
 	c.recordPushDuration(p, d, dpOpt, withFailure())
-	c.recordPushBrokenResources(count, dpOpt)
+	c.recordPushBrokenResources(resourceFailures, dpOpt)
 }
 
 // RecordTranslationSuccess records a successful configuration translation.
@@ -218,9 +337,66 @@ func (c *CtrlFuncMetrics) RecordTranslationFailure() {
 	}).Inc()
 }
 
-// RecordTranslationBrokenResources records the number of resources failing translation.
-func (c *CtrlFuncMetrics) RecordTranslationBrokenResources(count int) {
-	c.TranslationBrokenResources.Set(float64(count))
+// RecordTranslationBrokenResources records the number of resources failing translation along with
+// the Kubernetes objects causing the failures.
+func (c *CtrlFuncMetrics) RecordTranslationBrokenResources(resourceFailures []failures.ResourceFailure) {
+	c.TranslationBrokenResources.Set(float64(len(resourceFailures)))
+
+	// Objects that are no longer broken must not be reported anymore.
+	c.TranslationBrokenObjects.Reset()
+	for labels, count := range countFailuresByObject(resourceFailures) {
+		c.TranslationBrokenObjects.With(labels.toPrometheusLabels()).Set(float64(count))
+	}
+}
+
+// RecordTranslationDurations records durations of the translation stages.
+func (c *CtrlFuncMetrics) RecordTranslationDurations(durations map[TranslationStage]time.Duration) {
+	for stage, d := range durations {
+		c.TranslationDuration.With(prometheus.Labels{
+			TranslationStageKey: string(stage),
+		}).Observe(float64(d) / float64(time.Millisecond))
+	}
+}
+
+// RecordConfigEntities records the number of Kong entities per entity type in the configuration
+// successfully pushed to Kong.
+func (c *CtrlFuncMetrics) RecordConfigEntities(counts map[string]int) {
+	c.ConfigEntities.Reset()
+	for entityType, count := range counts {
+		c.ConfigEntities.With(prometheus.Labels{
+			EntityTypeKey: entityType,
+		}).Set(float64(count))
+	}
+}
+
+// objectLabels are the labels identifying a Kubernetes object in the metrics.
+type objectLabels struct {
+	kind      string
+	namespace string
+	name      string
+}
+
+func (l objectLabels) toPrometheusLabels() prometheus.Labels {
+	return prometheus.Labels{
+		KindKey:      l.kind,
+		NamespaceKey: l.namespace,
+		NameKey:      l.name,
+	}
+}
+
+// countFailuresByObject returns the number of failures each of the causing objects is involved in.
+func countFailuresByObject(resourceFailures []failures.ResourceFailure) map[objectLabels]int {
+	counts := make(map[objectLabels]int)
+	for _, failure := range resourceFailures {
+		for _, obj := range failure.CausingObjects() {
+			counts[objectLabels{
+				kind:      obj.GetObjectKind().GroupVersionKind().Kind,
+				namespace: obj.GetNamespace(),
+				name:      obj.GetName(),
+			}]++
+		}
+	}
+	return counts
 }
 
 type recordOption func(prometheus.Labels) prometheus.Labels
@@ -276,14 +452,24 @@ func (c *CtrlFuncMetrics) recordPushDuration(p Protocol, d time.Duration, opts .
 	c.ConfigPushDuration.With(labels).Observe(float64(d.Milliseconds()))
 }
 
-func (c *CtrlFuncMetrics) recordPushBrokenResources(count int, opts ...recordOption) {
+func (c *CtrlFuncMetrics) recordPushBrokenResources(resourceFailures []failures.ResourceFailure, opts ...recordOption) {
 	labels := prometheus.Labels{}
 
 	for _, opt := range opts {
 		labels = opt(labels)
 	}
 
-	c.ConfigPushBrokenResources.With(labels).Set(float64(count))
+	c.ConfigPushBrokenResources.With(labels).Set(float64(len(resourceFailures)))
+
+	// Objects that are no longer broken must not be reported anymore for the dataplane.
+	c.ConfigPushBrokenObjects.DeletePartialMatch(labels)
+	for objLabels, count := range countFailuresByObject(resourceFailures) {
+		objPromLabels := objLabels.toPrometheusLabels()
+		for k, v := range labels {
+			objPromLabels[k] = v
+		}
+		c.ConfigPushBrokenObjects.With(objPromLabels).Set(float64(count))
+	}
 }
 
 func (c *CtrlFuncMetrics) recordPushSuccessTime(opts ...recordOption) {
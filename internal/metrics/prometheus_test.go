@@ -10,9 +10,13 @@ import (
 
 	deckutils "github.com/kong/go-database-reconciler/pkg/utils"
 	"github.com/kong/go-kong/kong"
+	"github.com/prometheus/client_golang/prometheus/testutil"
 	"github.com/stretchr/testify/require"
+	netv1 "k8s.io/api/networking/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 
 	"github.com/kong/kubernetes-ingress-controller/v3/internal/dataplane/deckerrors"
+	"github.com/kong/kubernetes-ingress-controller/v3/internal/dataplane/failures"
 )
 
 func TestNewCtrlFuncMetricsDoesNotPanicWhenCalledTwice(t *testing.T) {
@@ -33,7 +37,7 @@ func TestRecordPush(t *testing.T) {
 	})
 	t.Run("recording push failure works", func(t *testing.T) {
 		require.NotPanics(t, func() {
-			m.RecordPushFailure(ProtocolDBLess, time.Millisecond, "https://10.0.0.1:8080", 5,
+			m.RecordPushFailure(ProtocolDBLess, time.Millisecond, "https://10.0.0.1:8080", newTestResourceFailures(t),
 				fmt.Errorf("custom error"))
 		})
 	})
@@ -44,15 +48,76 @@ func TestRecordTranslation(t *testing.T) {
 	t.Run("recording translation success works", func(t *testing.T) {
 		require.NotPanics(t, func() {
 			m.RecordTranslationSuccess()
-			m.RecordTranslationBrokenResources(0)
+			m.RecordTranslationBrokenResources(nil)
 		})
 	})
 	t.Run("recording translation failure works", func(t *testing.T) {
 		require.NotPanics(t, func() {
 			m.RecordTranslationFailure()
-			m.RecordTranslationBrokenResources(9)
+			m.RecordTranslationBrokenResources(newTestResourceFailures(t))
 		})
 	})
+	t.Run("recording translation durations works", func(t *testing.T) {
+		require.NotPanics(t, func() {
+			m.RecordTranslationDurations(map[TranslationStage]time.Duration{
+				TranslationStageIngress:   time.Millisecond,
+				TranslationStageHTTPRoute: time.Microsecond,
+			})
+		})
+	})
+}
+
+func TestRecordBrokenObjects(t *testing.T) {
+	m := NewCtrlFuncMetrics()
+	const dataplane = "https://10.0.0.1:8080"
+
+	m.RecordTranslationBrokenResources(newTestResourceFailures(t))
+	require.Equal(t, 2, testutil.CollectAndCount(m.TranslationBrokenObjects))
+	require.Equal(t, float64(2), testutil.ToFloat64(m.TranslationBrokenObjects.With(map[string]string{
+		KindKey: "Ingress", NamespaceKey: "team-a", NameKey: "ingress-1",
+	})))
+	m.RecordTranslationBrokenResources(nil)
+	require.Equal(t, 0, testutil.CollectAndCount(m.TranslationBrokenObjects), "fixed objects should not be reported")
+
+	m.RecordPushFailure(ProtocolDBLess, time.Millisecond, dataplane, newTestResourceFailures(t), errors.New("error"))
+	require.Equal(t, 2, testutil.CollectAndCount(m.ConfigPushBrokenObjects))
+	require.Equal(t, float64(1), testutil.ToFloat64(m.ConfigPushBrokenObjects.With(map[string]string{
+		DataplaneKey: dataplane, KindKey: "Ingress", NamespaceKey: "team-b", NameKey: "ingress-2",
+	})))
+	m.RecordPushSuccess(ProtocolDBLess, time.Millisecond, dataplane)
+	require.Equal(t, 0, testutil.CollectAndCount(m.ConfigPushBrokenObjects), "fixed objects should not be reported")
+}
+
+func TestRecordConfigEntities(t *testing.T) {
+	m := NewCtrlFuncMetrics()
+	m.RecordConfigEntities(map[string]int{"services": 3, "routes": 5})
+	require.Equal(t, float64(5), testutil.ToFloat64(m.ConfigEntities.With(map[string]string{EntityTypeKey: "routes"})))
+	m.RecordConfigEntities(map[string]int{"services": 1})
+	require.Equal(t, 1, testutil.CollectAndCount(m.ConfigEntities))
+}
+
+// newTestResourceFailures returns resource failures caused by two Ingresses, one of them causing two failures.
+func newTestResourceFailures(t *testing.T) []failures.ResourceFailure {
+	newIngress := func(namespace, name string) *netv1.Ingress {
+		return &netv1.Ingress{
+			TypeMeta: metav1.TypeMeta{
+				APIVersion: netv1.SchemeGroupVersion.String(),
+				Kind:       "Ingress",
+			},
+			ObjectMeta: metav1.ObjectMeta{
+				Namespace: namespace,
+				Name:      name,
+			},
+		}
+	}
+	ingress1 := newIngress("team-a", "ingress-1")
+	ingress2 := newIngress("team-b", "ingress-2")
+
+	failure1, err := failures.NewResourceFailure("failure 1", ingress1)
+	require.NoError(t, err)
+	failure2, err := failures.NewResourceFailure("failure 2", ingress1, ingress2)
+	require.NoError(t, err)
+	return []failures.ResourceFailure{failure1, failure2}
 }
 
 func TestPushFailureReason(t *testing.T) {
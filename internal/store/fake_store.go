@@ -17,11 +17,13 @@ import (
 	"k8s.io/client-go/tools/cache"
 	gatewayv1 "sigs.k8s.io/gateway-api/apis/v1"
 	gatewayv1alpha2 "sigs.k8s.io/gateway-api/apis/v1alpha2"
+	gatewayv1alpha3 "sigs.k8s.io/gateway-api/apis/v1alpha3"
 	gatewayv1beta1 "sigs.k8s.io/gateway-api/apis/v1beta1"
 	"sigs.k8s.io/yaml"
 
 	"github.com/kong/kubernetes-ingress-controller/v3/internal/annotations"
 	"github.com/kong/kubernetes-ingress-controller/v3/internal/gatewayapi"
+	mcsv1alpha1 "github.com/kong/kubernetes-ingress-controller/v3/internal/mcsapi/v1alpha1"
 	kongv1 "github.com/kong/kubernetes-ingress-controller/v3/pkg/apis/configuration/v1"
 	kongv1alpha1 "github.com/kong/kubernetes-ingress-controller/v3/pkg/apis/configuration/v1alpha1"
 	kongv1beta1 "github.com/kong/kubernetes-ingress-controller/v3/pkg/apis/configuration/v1beta1"
@@ -39,12 +41,17 @@ type FakeObjects struct {
 	GRPCRoutes                     []*gatewayapi.GRPCRoute
 	ReferenceGrants                []*gatewayapi.ReferenceGrant
 	Gateways                       []*gatewayapi.Gateway
+	BackendTLSPolicies             []*gatewayapi.BackendTLSPolicy
+	BackendLBPolicies              []*gatewayapi.BackendLBPolicy
 	TCPIngresses                   []*kongv1beta1.TCPIngress
 	UDPIngresses                   []*kongv1beta1.UDPIngress
 	IngressClassParametersV1alpha1 []*kongv1alpha1.IngressClassParameters
 	Services                       []*corev1.Service
 	EndpointSlices                 []*discoveryv1.EndpointSlice
+	ServiceImports                 []*mcsv1alpha1.ServiceImport
 	Secrets                        []*corev1.Secret
+	ConfigMaps                     []*corev1.ConfigMap
+	Namespaces                     []*corev1.Namespace
 	KongPlugins                    []*kongv1.KongPlugin
 	KongClusterPlugins             []*kongv1.KongClusterPlugin
 	KongIngresses                  []*kongv1.KongIngress
@@ -53,6 +60,8 @@ type FakeObjects struct {
 	KongUpstreamPolicies           []*kongv1beta1.KongUpstreamPolicy
 	KongServiceFacades             []*incubatorv1alpha1.KongServiceFacade
 	KongVaults                     []*kongv1alpha1.KongVault
+	KongCustomEntities             []*kongv1alpha1.KongCustomEntity
+	KongTenantPolicies             []*kongv1alpha1.KongTenantPolicy
 }
 
 // NewFakeStore creates a store backed by the objects passed in as arguments.
@@ -124,6 +133,18 @@ func NewFakeStore(
 			return nil, err
 		}
 	}
+	backendTLSPolicyStore := cache.NewStore(namespacedKeyFunc)
+	for _, p := range objects.BackendTLSPolicies {
+		if err := backendTLSPolicyStore.Add(p); err != nil {
+			return nil, err
+		}
+	}
+	backendLBPolicyStore := cache.NewStore(namespacedKeyFunc)
+	for _, p := range objects.BackendLBPolicies {
+		if err := backendLBPolicyStore.Add(p); err != nil {
+			return nil, err
+		}
+	}
 	tcpIngressStore := cache.NewStore(namespacedKeyFunc)
 	for _, ingress := range objects.TCPIngresses {
 		err := tcpIngressStore.Add(ingress)
@@ -151,6 +172,13 @@ func NewFakeStore(
 			return nil, err
 		}
 	}
+	configMapsStore := cache.NewStore(namespacedKeyFunc)
+	for _, c := range objects.ConfigMaps {
+		err := configMapsStore.Add(c)
+		if err != nil {
+			return nil, err
+		}
+	}
 	endpointSliceStore := cache.NewStore(namespacedKeyFunc)
 	for _, e := range objects.EndpointSlices {
 		err := endpointSliceStore.Add(e)
@@ -158,6 +186,13 @@ func NewFakeStore(
 			return nil, err
 		}
 	}
+	serviceImportStore := cache.NewStore(namespacedKeyFunc)
+	for _, s := range objects.ServiceImports {
+		err := serviceImportStore.Add(s)
+		if err != nil {
+			return nil, err
+		}
+	}
 	kongIngressStore := cache.NewStore(namespacedKeyFunc)
 	for _, k := range objects.KongIngresses {
 		err := kongIngressStore.Add(k)
@@ -215,6 +250,30 @@ func NewFakeStore(
 		}
 	}
 
+	kongCustomEntityStore := cache.NewStore(namespacedKeyFunc)
+	for _, e := range objects.KongCustomEntities {
+		err := kongCustomEntityStore.Add(e)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	namespaceStore := cache.NewStore(clusterWideKeyFunc)
+	for _, n := range objects.Namespaces {
+		err := namespaceStore.Add(n)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	kongTenantPolicyStore := cache.NewStore(clusterWideKeyFunc)
+	for _, p := range objects.KongTenantPolicies {
+		err := kongTenantPolicyStore.Add(p)
+		if err != nil {
+			return nil, err
+		}
+	}
+
 	s = &Store{
 		stores: CacheStores{
 			IngressV1:                      ingressV1Store,
@@ -226,11 +285,16 @@ func NewFakeStore(
 			GRPCRoute:                      grpcrouteStore,
 			ReferenceGrant:                 referencegrantStore,
 			Gateway:                        gatewayStore,
+			BackendTLSPolicy:               backendTLSPolicyStore,
+			BackendLBPolicy:                backendLBPolicyStore,
 			TCPIngress:                     tcpIngressStore,
 			UDPIngress:                     udpIngressStore,
 			Service:                        serviceStore,
 			EndpointSlice:                  endpointSliceStore,
+			ServiceImport:                  serviceImportStore,
 			Secret:                         secretsStore,
+			ConfigMap:                      configMapsStore,
+			Namespace:                      namespaceStore,
 			Plugin:                         kongPluginsStore,
 			ClusterPlugin:                  kongClusterPluginsStore,
 			Consumer:                       consumerStore,
@@ -240,6 +304,8 @@ func NewFakeStore(
 			KongUpstreamPolicy:             kongUpstreamPolicyStore,
 			KongServiceFacade:              kongServiceFacade,
 			KongVault:                      kongVaultStore,
+			KongCustomEntity:               kongCustomEntityStore,
+			KongTenantPolicy:               kongTenantPolicyStore,
 		},
 		ingressClass:          annotations.DefaultIngressClass,
 		isValidIngressClass:   annotations.IngressClassValidatorFuncFromObjectMeta(annotations.DefaultIngressClass),
@@ -265,18 +331,25 @@ func (objects FakeObjects) MarshalToYAML() ([]byte, error) {
 		reflect.TypeOf(&gatewayapi.GRPCRoute{}):                gatewayv1.SchemeGroupVersion.WithKind("GRPCRoute"),
 		reflect.TypeOf(&gatewayapi.ReferenceGrant{}):           gatewayv1beta1.SchemeGroupVersion.WithKind("ReferenceGrant"),
 		reflect.TypeOf(&gatewayapi.Gateway{}):                  gatewayv1.SchemeGroupVersion.WithKind("Gateway"),
+		reflect.TypeOf(&gatewayapi.BackendTLSPolicy{}):         gatewayv1alpha3.SchemeGroupVersion.WithKind("BackendTLSPolicy"),
+		reflect.TypeOf(&gatewayapi.BackendLBPolicy{}):          gatewayv1alpha2.SchemeGroupVersion.WithKind("BackendLBPolicy"),
 		reflect.TypeOf(&kongv1beta1.TCPIngress{}):              kongv1beta1.SchemeGroupVersion.WithKind("TCPIngress"),
 		reflect.TypeOf(&kongv1beta1.UDPIngress{}):              kongv1beta1.SchemeGroupVersion.WithKind("UDPIngress"),
 		reflect.TypeOf(&kongv1alpha1.IngressClassParameters{}): kongv1alpha1.SchemeGroupVersion.WithKind("IngressClassParameters"),
 		reflect.TypeOf(&corev1.Service{}):                      corev1.SchemeGroupVersion.WithKind("Service"),
 		reflect.TypeOf(&discoveryv1.EndpointSlice{}):           discoveryv1.SchemeGroupVersion.WithKind("EndpointSlice"),
+		reflect.TypeOf(&mcsv1alpha1.ServiceImport{}):           mcsv1alpha1.SchemeGroupVersion.WithKind(mcsv1alpha1.ServiceImportKind),
 		reflect.TypeOf(&corev1.Secret{}):                       corev1.SchemeGroupVersion.WithKind("Secret"),
+		reflect.TypeOf(&corev1.ConfigMap{}):                    corev1.SchemeGroupVersion.WithKind("ConfigMap"),
+		reflect.TypeOf(&corev1.Namespace{}):                    corev1.SchemeGroupVersion.WithKind("Namespace"),
 		reflect.TypeOf(&kongv1.KongPlugin{}):                   kongv1.SchemeGroupVersion.WithKind("KongPlugin"),
 		reflect.TypeOf(&kongv1.KongClusterPlugin{}):            kongv1.SchemeGroupVersion.WithKind("KongClusterPlugin"),
 		reflect.TypeOf(&kongv1.KongIngress{}):                  kongv1.SchemeGroupVersion.WithKind("KongIngress"),
 		reflect.TypeOf(&kongv1.KongConsumer{}):                 kongv1.SchemeGroupVersion.WithKind("KongConsumer"),
 		reflect.TypeOf(&kongv1beta1.KongConsumerGroup{}):       kongv1beta1.SchemeGroupVersion.WithKind("KongConsumerGroup"),
 		reflect.TypeOf(&kongv1alpha1.KongVault{}):              kongv1alpha1.SchemeGroupVersion.WithKind(kongv1alpha1.KongVaultKind),
+		reflect.TypeOf(&kongv1alpha1.KongCustomEntity{}):       kongv1alpha1.SchemeGroupVersion.WithKind(kongv1alpha1.KongCustomEntityKind),
+		reflect.TypeOf(&kongv1alpha1.KongTenantPolicy{}):       kongv1alpha1.SchemeGroupVersion.WithKind(kongv1alpha1.KongTenantPolicyKind),
 	}
 
 	out := &bytes.Buffer{}
@@ -308,18 +381,25 @@ func (objects FakeObjects) MarshalToYAML() ([]byte, error) {
 	allObjects = append(allObjects, lo.ToAnySlice(objects.GRPCRoutes)...)
 	allObjects = append(allObjects, lo.ToAnySlice(objects.ReferenceGrants)...)
 	allObjects = append(allObjects, lo.ToAnySlice(objects.Gateways)...)
+	allObjects = append(allObjects, lo.ToAnySlice(objects.BackendTLSPolicies)...)
+	allObjects = append(allObjects, lo.ToAnySlice(objects.BackendLBPolicies)...)
 	allObjects = append(allObjects, lo.ToAnySlice(objects.TCPIngresses)...)
 	allObjects = append(allObjects, lo.ToAnySlice(objects.UDPIngresses)...)
 	allObjects = append(allObjects, lo.ToAnySlice(objects.IngressClassParametersV1alpha1)...)
 	allObjects = append(allObjects, lo.ToAnySlice(objects.Services)...)
 	allObjects = append(allObjects, lo.ToAnySlice(objects.EndpointSlices)...)
+	allObjects = append(allObjects, lo.ToAnySlice(objects.ServiceImports)...)
 	allObjects = append(allObjects, lo.ToAnySlice(objects.Secrets)...)
+	allObjects = append(allObjects, lo.ToAnySlice(objects.ConfigMaps)...)
+	allObjects = append(allObjects, lo.ToAnySlice(objects.Namespaces)...)
 	allObjects = append(allObjects, lo.ToAnySlice(objects.KongPlugins)...)
 	allObjects = append(allObjects, lo.ToAnySlice(objects.KongClusterPlugins)...)
 	allObjects = append(allObjects, lo.ToAnySlice(objects.KongIngresses)...)
 	allObjects = append(allObjects, lo.ToAnySlice(objects.KongConsumers)...)
 	allObjects = append(allObjects, lo.ToAnySlice(objects.KongConsumerGroups)...)
 	allObjects = append(allObjects, lo.ToAnySlice(objects.KongVaults)...)
+	allObjects = append(allObjects, lo.ToAnySlice(objects.KongCustomEntities)...)
+	allObjects = append(allObjects, lo.ToAnySlice(objects.KongTenantPolicies)...)
 
 	for _, obj := range allObjects {
 		if err := fillGVKAndAppendToBuffer(obj.(runtime.Object)); err != nil {
@@ -15,6 +15,7 @@ import (
 	"github.com/kong/kubernetes-ingress-controller/v3/internal/annotations"
 	"github.com/kong/kubernetes-ingress-controller/v3/internal/gatewayapi"
 	kongv1 "github.com/kong/kubernetes-ingress-controller/v3/pkg/apis/configuration/v1"
+	kongv1alpha1 "github.com/kong/kubernetes-ingress-controller/v3/pkg/apis/configuration/v1alpha1"
 	kongv1beta1 "github.com/kong/kubernetes-ingress-controller/v3/pkg/apis/configuration/v1beta1"
 	incubatorv1alpha1 "github.com/kong/kubernetes-ingress-controller/v3/pkg/apis/incubator/v1alpha1"
 )
@@ -261,6 +262,8 @@ func TestFakeStoreService(t *testing.T) {
 	assert.NotNil(err)
 	assert.True(errors.As(err, &NotFoundError{}))
 	assert.Nil(service)
+
+	assert.Equal(services, store.ListServices())
 }
 
 func TestFakeStoreEndpointSlice(t *testing.T) {
@@ -779,3 +782,88 @@ func TestFakeStore_KongServiceFacade(t *testing.T) {
 	require.NoError(t, err)
 	require.Equal(t, fakeObjects.KongServiceFacades[0], storedFacade)
 }
+
+func TestFakeStore_KongCustomEntity(t *testing.T) {
+	newEntity := func(name string, annotation string, controllerName string) *kongv1alpha1.KongCustomEntity {
+		entity := &kongv1alpha1.KongCustomEntity{
+			ObjectMeta: metav1.ObjectMeta{
+				Name:      name,
+				Namespace: "default",
+			},
+			Spec: kongv1alpha1.KongCustomEntitySpec{
+				EntityType:     "degraphql_routes",
+				ControllerName: controllerName,
+			},
+		}
+		if annotation != "" {
+			entity.Annotations = map[string]string{annotations.IngressClassKey: annotation}
+		}
+		return entity
+	}
+	fakeObjects := FakeObjects{
+		KongCustomEntities: []*kongv1alpha1.KongCustomEntity{
+			newEntity("by-annotation", annotations.DefaultIngressClass, ""),
+			newEntity("by-controller-name", "", annotations.DefaultIngressClass),
+			newEntity("other-class", "other", "other"),
+			newEntity("no-class", "", ""),
+		},
+	}
+
+	store, err := NewFakeStore(fakeObjects)
+	require.NoError(t, err)
+
+	entities := store.ListKongCustomEntities()
+	require.ElementsMatch(t, []string{"by-annotation", "by-controller-name"},
+		lo.Map(entities, func(e *kongv1alpha1.KongCustomEntity, _ int) string { return e.Name }),
+	)
+}
+
+func TestFakeStore_KongTenantPolicy(t *testing.T) {
+	fakeObjects := FakeObjects{
+		KongTenantPolicies: []*kongv1alpha1.KongTenantPolicy{
+			{
+				ObjectMeta: metav1.ObjectMeta{
+					Name: "tenants",
+				},
+			},
+			{
+				// KongTenantPolicies are not filtered by ingress class.
+				ObjectMeta: metav1.ObjectMeta{
+					Name:        "other-class",
+					Annotations: map[string]string{annotations.IngressClassKey: "other"},
+				},
+			},
+		},
+	}
+
+	store, err := NewFakeStore(fakeObjects)
+	require.NoError(t, err)
+
+	policies := store.ListKongTenantPolicies()
+	require.ElementsMatch(t, []string{"tenants", "other-class"},
+		lo.Map(policies, func(p *kongv1alpha1.KongTenantPolicy, _ int) string { return p.Name }),
+	)
+}
+
+func TestFakeStore_Namespace(t *testing.T) {
+	fakeObjects := FakeObjects{
+		Namespaces: []*corev1.Namespace{
+			{
+				ObjectMeta: metav1.ObjectMeta{
+					Name:   "team-a",
+					Labels: map[string]string{"tenant": "true"},
+				},
+			},
+		},
+	}
+
+	store, err := NewFakeStore(fakeObjects)
+	require.NoError(t, err)
+
+	namespace, err := store.GetNamespace("team-a")
+	require.NoError(t, err)
+	require.Equal(t, "true", namespace.Labels["tenant"])
+
+	_, err = store.GetNamespace("team-b")
+	require.Error(t, err)
+}
@@ -34,12 +34,14 @@ import (
 	"sigs.k8s.io/controller-runtime/pkg/client"
 	gatewayv1 "sigs.k8s.io/gateway-api/apis/v1"
 	gatewayv1alpha2 "sigs.k8s.io/gateway-api/apis/v1alpha2"
+	gatewayv1alpha3 "sigs.k8s.io/gateway-api/apis/v1alpha3"
 	gatewayv1beta1 "sigs.k8s.io/gateway-api/apis/v1beta1"
 	"sigs.k8s.io/yaml"
 
 	"github.com/kong/kubernetes-ingress-controller/v3/internal/annotations"
 	ctrlutils "github.com/kong/kubernetes-ingress-controller/v3/internal/controllers/utils"
 	"github.com/kong/kubernetes-ingress-controller/v3/internal/gatewayapi"
+	mcsv1alpha1 "github.com/kong/kubernetes-ingress-controller/v3/internal/mcsapi/v1alpha1"
 	"github.com/kong/kubernetes-ingress-controller/v3/internal/util"
 	kongv1 "github.com/kong/kubernetes-ingress-controller/v3/pkg/apis/configuration/v1"
 	kongv1alpha1 "github.com/kong/kubernetes-ingress-controller/v3/pkg/apis/configuration/v1alpha1"
@@ -59,8 +61,11 @@ type Storer interface {
 	UpdateCache(cs CacheStores)
 
 	GetSecret(namespace, name string) (*corev1.Secret, error)
+	GetConfigMap(namespace, name string) (*corev1.ConfigMap, error)
 	GetService(namespace, name string) (*corev1.Service, error)
 	GetEndpointSlicesForService(namespace, name string) ([]*discoveryv1.EndpointSlice, error)
+	GetServiceImport(namespace, name string) (*mcsv1alpha1.ServiceImport, error)
+	GetEndpointSlicesForServiceImport(namespace, name string) ([]*discoveryv1.EndpointSlice, error)
 	GetKongIngress(namespace, name string) (*kongv1.KongIngress, error)
 	GetKongPlugin(namespace, name string) (*kongv1.KongPlugin, error)
 	GetKongClusterPlugin(name string) (*kongv1.KongClusterPlugin, error)
@@ -73,7 +78,9 @@ type Storer interface {
 	GetKongUpstreamPolicy(namespace, name string) (*kongv1beta1.KongUpstreamPolicy, error)
 	GetKongServiceFacade(namespace, name string) (*incubatorv1alpha1.KongServiceFacade, error)
 	GetKongVault(name string) (*kongv1alpha1.KongVault, error)
+	GetNamespace(name string) (*corev1.Namespace, error)
 
+	ListServices() []*corev1.Service
 	ListIngressesV1() []*netv1.Ingress
 	ListIngressClassesV1() []*netv1.IngressClass
 	ListIngressClassParametersV1Alpha1() []*kongv1alpha1.IngressClassParameters
@@ -84,6 +91,8 @@ type Storer interface {
 	ListGRPCRoutes() ([]*gatewayapi.GRPCRoute, error)
 	ListReferenceGrants() ([]*gatewayapi.ReferenceGrant, error)
 	ListGateways() ([]*gatewayapi.Gateway, error)
+	ListBackendTLSPolicies() ([]*gatewayapi.BackendTLSPolicy, error)
+	ListBackendLBPolicies() ([]*gatewayapi.BackendLBPolicy, error)
 	ListTCPIngresses() ([]*kongv1beta1.TCPIngress, error)
 	ListUDPIngresses() ([]*kongv1beta1.UDPIngress, error)
 	ListGlobalKongClusterPlugins() ([]*kongv1.KongClusterPlugin, error)
@@ -93,6 +102,8 @@ type Storer interface {
 	ListKongConsumerGroups() []*kongv1beta1.KongConsumerGroup
 	ListCACerts() ([]*corev1.Secret, error)
 	ListKongVaults() []*kongv1alpha1.KongVault
+	ListKongCustomEntities() []*kongv1alpha1.KongCustomEntity
+	ListKongTenantPolicies() []*kongv1alpha1.KongTenantPolicy
 }
 
 // Store implements Storer and can be used to list Ingress, Services
@@ -143,6 +154,19 @@ func (s Store) GetSecret(namespace, name string) (*corev1.Secret, error) {
 	return secret.(*corev1.Secret), nil
 }
 
+// GetConfigMap returns a ConfigMap using the namespace and name as key.
+func (s Store) GetConfigMap(namespace, name string) (*corev1.ConfigMap, error) {
+	key := fmt.Sprintf("%v/%v", namespace, name)
+	configMap, exists, err := s.stores.ConfigMap.GetByKey(key)
+	if err != nil {
+		return nil, err
+	}
+	if !exists {
+		return nil, NotFoundError{fmt.Sprintf("ConfigMap %v not found", key)}
+	}
+	return configMap.(*corev1.ConfigMap), nil
+}
+
 // GetService returns a Service using the namespace and name as key.
 func (s Store) GetService(namespace, name string) (*corev1.Service, error) {
 	key := fmt.Sprintf("%v/%v", namespace, name)
@@ -156,6 +180,20 @@ func (s Store) GetService(namespace, name string) (*corev1.Service, error) {
 	return service.(*corev1.Service), nil
 }
 
+// ListServices returns the list of Services in the Service store.
+func (s Store) ListServices() []*corev1.Service {
+	var services []*corev1.Service
+	for _, item := range s.stores.Service.List() {
+		service, ok := item.(*corev1.Service)
+		if !ok {
+			s.logger.Error(nil, "ListServices: dropping object of unexpected type", "type", fmt.Sprintf("%T", item))
+			continue
+		}
+		services = append(services, service)
+	}
+	return services
+}
+
 // ListIngressesV1 returns the list of Ingresses in the Ingress v1 store.
 func (s Store) ListIngressesV1() []*netv1.Ingress {
 	// filter ingress rules
@@ -279,6 +317,10 @@ func GetTypedStore[T client.Object](cs CacheStores) (cache.Store, error) {
 		return cs.ReferenceGrant, nil
 	case *gatewayapi.Gateway:
 		return cs.Gateway, nil
+	case *gatewayapi.BackendTLSPolicy:
+		return cs.BackendTLSPolicy, nil
+	case *gatewayapi.BackendLBPolicy:
+		return cs.BackendLBPolicy, nil
 	case *kongv1.KongPlugin:
 		return cs.Plugin, nil
 	default:
@@ -321,6 +363,16 @@ func (s Store) ListGateways() ([]*gatewayapi.Gateway, error) {
 	return List[*gatewayapi.Gateway](s.stores)
 }
 
+// ListBackendTLSPolicies returns the list of BackendTLSPolicies in the BackendTLSPolicy cache store.
+func (s Store) ListBackendTLSPolicies() ([]*gatewayapi.BackendTLSPolicy, error) {
+	return List[*gatewayapi.BackendTLSPolicy](s.stores)
+}
+
+// ListBackendLBPolicies returns the list of BackendLBPolicies in the BackendLBPolicy cache store.
+func (s Store) ListBackendLBPolicies() ([]*gatewayapi.BackendLBPolicy, error) {
+	return List[*gatewayapi.BackendLBPolicy](s.stores)
+}
+
 // ListTCPIngresses returns the list of TCP Ingresses from
 // configuration.konghq.com group.
 func (s Store) ListTCPIngresses() ([]*kongv1beta1.TCPIngress, error) {
@@ -368,7 +420,46 @@ func (s Store) ListUDPIngresses() ([]*kongv1beta1.UDPIngress, error) {
 // 'namespace/name' inside K8s.
 func (s Store) GetEndpointSlicesForService(namespace, name string) ([]*discoveryv1.EndpointSlice, error) {
 	// EndpointSlices are tied to a Service via a label.
-	req, err := labels.NewRequirement(discoveryv1.LabelServiceName, selection.Equals, []string{name})
+	endpointSlices, err := s.listEndpointSlicesWithLabel(namespace, discoveryv1.LabelServiceName, name)
+	if err != nil {
+		return nil, err
+	}
+	if len(endpointSlices) == 0 {
+		return nil, NotFoundError{fmt.Sprintf("EndpointSlices for Service %s/%s not found", namespace, name)}
+	}
+	return endpointSlices, nil
+}
+
+// GetServiceImport returns the 'name' ServiceImport resource in namespace.
+func (s Store) GetServiceImport(namespace, name string) (*mcsv1alpha1.ServiceImport, error) {
+	key := fmt.Sprintf("%v/%v", namespace, name)
+	p, exists, err := s.stores.ServiceImport.GetByKey(key)
+	if err != nil {
+		return nil, err
+	}
+	if !exists {
+		return nil, NotFoundError{fmt.Sprintf("ServiceImport %v not found", key)}
+	}
+	return p.(*mcsv1alpha1.ServiceImport), nil
+}
+
+// GetEndpointSlicesForServiceImport returns all EndpointSlices for ServiceImport
+// 'namespace/name' inside K8s.
+func (s Store) GetEndpointSlicesForServiceImport(namespace, name string) ([]*discoveryv1.EndpointSlice, error) {
+	// EndpointSlices imported from other clusters are tied to a ServiceImport via a label.
+	endpointSlices, err := s.listEndpointSlicesWithLabel(namespace, mcsv1alpha1.LabelServiceName, name)
+	if err != nil {
+		return nil, err
+	}
+	if len(endpointSlices) == 0 {
+		return nil, NotFoundError{fmt.Sprintf("EndpointSlices for ServiceImport %s/%s not found", namespace, name)}
+	}
+	return endpointSlices, nil
+}
+
+// listEndpointSlicesWithLabel returns all EndpointSlices from namespace that have the label set to value.
+func (s Store) listEndpointSlicesWithLabel(namespace, label, value string) ([]*discoveryv1.EndpointSlice, error) {
+	req, err := labels.NewRequirement(label, selection.Equals, []string{value})
 	if err != nil {
 		return nil, err
 	}
@@ -376,7 +467,7 @@ func (s Store) GetEndpointSlicesForService(namespace, name string) ([]*discovery
 	if err := cache.ListAll(
 		s.stores.EndpointSlice, labels.NewSelector().Add(*req),
 		func(obj interface{}) {
-			// Ensure the EndpointSlice is for the Service from the requested namespace.
+			// Ensure the EndpointSlice is from the requested namespace.
 			if eps, ok := obj.(*discoveryv1.EndpointSlice); ok && eps.Namespace == namespace {
 				endpointSlices = append(endpointSlices, eps)
 			}
@@ -384,9 +475,6 @@ func (s Store) GetEndpointSlicesForService(namespace, name string) ([]*discovery
 	); err != nil {
 		return nil, err
 	}
-	if len(endpointSlices) == 0 {
-		return nil, NotFoundError{fmt.Sprintf("EndpointSlices for Service %s/%s not found", namespace, name)}
-	}
 	return endpointSlices, nil
 }
 
@@ -562,6 +650,18 @@ func (s Store) GetKongVault(name string) (*kongv1alpha1.KongVault, error) {
 	return p.(*kongv1alpha1.KongVault), nil
 }
 
+// GetNamespace returns the Namespace resource having specified name.
+func (s Store) GetNamespace(name string) (*corev1.Namespace, error) {
+	p, exists, err := s.stores.Namespace.GetByKey(name)
+	if err != nil {
+		return nil, err
+	}
+	if !exists {
+		return nil, NotFoundError{fmt.Sprintf("Namespace %v not found", name)}
+	}
+	return p.(*corev1.Namespace), nil
+}
+
 // ListKongConsumers returns all KongConsumers filtered by the ingress.class
 // annotation.
 func (s Store) ListKongConsumers() []*kongv1.KongConsumer {
@@ -670,6 +770,45 @@ func (s Store) ListKongVaults() []*kongv1alpha1.KongVault {
 	return kongVaults
 }
 
+// ListKongCustomEntities lists all KongCustomEntity resources filtered by the ingress.class annotation
+// or their spec.controllerName.
+func (s Store) ListKongCustomEntities() []*kongv1alpha1.KongCustomEntity {
+	var entities []*kongv1alpha1.KongCustomEntity
+	handling := s.getIngressClassHandling()
+	for _, obj := range s.stores.KongCustomEntity.List() {
+		entity, ok := obj.(*kongv1alpha1.KongCustomEntity)
+		if !ok {
+			continue
+		}
+		// The controller name in the spec is treated the same way as the ingress.class annotation,
+		// which takes precedence when both are set.
+		objectMeta := &entity.ObjectMeta
+		if _, ok := entity.Annotations[annotations.IngressClassKey]; !ok && entity.Spec.ControllerName != "" {
+			objectMeta = &metav1.ObjectMeta{
+				Annotations: map[string]string{
+					annotations.IngressClassKey: entity.Spec.ControllerName,
+				},
+			}
+		}
+		if s.isValidIngressClass(objectMeta, annotations.IngressClassKey, handling) {
+			entities = append(entities, entity)
+		}
+	}
+	return entities
+}
+
+// ListKongTenantPolicies lists all KongTenantPolicies. Policies are not filtered by the ingress.class
+// annotation as they restrict objects regardless of the controller reconciling them.
+func (s Store) ListKongTenantPolicies() []*kongv1alpha1.KongTenantPolicy {
+	var policies []*kongv1alpha1.KongTenantPolicy
+	for _, obj := range s.stores.KongTenantPolicy.List() {
+		if policy, ok := obj.(*kongv1alpha1.KongTenantPolicy); ok {
+			policies = append(policies, policy)
+		}
+	}
+	return policies
+}
+
 // getIngressClassHandling returns annotations.ExactOrEmptyClassMatch if an IngressClass is the default class, or
 // annotations.ExactClassMatch if the IngressClass is not default or does not exist.
 func (s Store) getIngressClassHandling() annotations.ClassMatching {
@@ -704,6 +843,12 @@ func convUnstructuredObj(from, to runtime.Object) error {
 // mkObjFromGVK is a factory function that returns a concrete implementation runtime.Object
 // for the given GVK. Callers can then use `convert()` to convert an unstructured
 // runtime.Object into a concrete one.
+// IsSupportedGVK returns true if objects of the given GroupVersionKind can be stored in CacheStores.
+func IsSupportedGVK(gvk schema.GroupVersionKind) bool {
+	_, err := mkObjFromGVK(gvk)
+	return err == nil
+}
+
 func mkObjFromGVK(gvk schema.GroupVersionKind) (runtime.Object, error) {
 	switch gvk {
 	// ----------------------------------------------------------------------------
@@ -717,12 +862,21 @@ func mkObjFromGVK(gvk schema.GroupVersionKind) (runtime.Object, error) {
 		return &corev1.Service{}, nil
 	case corev1.SchemeGroupVersion.WithKind("Secret"):
 		return &corev1.Secret{}, nil
+	case corev1.SchemeGroupVersion.WithKind("ConfigMap"):
+		return &corev1.ConfigMap{}, nil
+	case corev1.SchemeGroupVersion.WithKind("Namespace"):
+		return &corev1.Namespace{}, nil
 	// ----------------------------------------------------------------------------
 	// Kubernetes Discovery APIs
 	// ----------------------------------------------------------------------------
 	case discoveryv1.SchemeGroupVersion.WithKind("EndpointSlice"):
 		return &discoveryv1.EndpointSlice{}, nil
 	// ----------------------------------------------------------------------------
+	// Kubernetes Multi-Cluster Services APIs
+	// ----------------------------------------------------------------------------
+	case mcsv1alpha1.SchemeGroupVersion.WithKind(mcsv1alpha1.ServiceImportKind):
+		return &mcsv1alpha1.ServiceImport{}, nil
+	// ----------------------------------------------------------------------------
 	// Kubernetes Gateway APIs
 	// ----------------------------------------------------------------------------
 	case gatewayv1.SchemeGroupVersion.WithKind("HTTPRoute"):
@@ -737,6 +891,12 @@ func mkObjFromGVK(gvk schema.GroupVersionKind) (runtime.Object, error) {
 		return &gatewayapi.TLSRoute{}, nil
 	case gatewayv1beta1.SchemeGroupVersion.WithKind("ReferenceGrant"):
 		return &gatewayapi.ReferenceGrant{}, nil
+	case gatewayv1.SchemeGroupVersion.WithKind("Gateway"):
+		return &gatewayapi.Gateway{}, nil
+	case gatewayv1alpha3.SchemeGroupVersion.WithKind("BackendTLSPolicy"):
+		return &gatewayapi.BackendTLSPolicy{}, nil
+	case gatewayv1alpha2.SchemeGroupVersion.WithKind("BackendLBPolicy"):
+		return &gatewayapi.BackendLBPolicy{}, nil
 	// ----------------------------------------------------------------------------
 	// Kong APIs
 	// ----------------------------------------------------------------------------
@@ -758,6 +918,12 @@ func mkObjFromGVK(gvk schema.GroupVersionKind) (runtime.Object, error) {
 		return &kongv1alpha1.IngressClassParameters{}, nil
 	case kongv1beta1.SchemeGroupVersion.WithKind("KongUpstreamPolicy"):
 		return &kongv1beta1.KongUpstreamPolicy{}, nil
+	case kongv1alpha1.SchemeGroupVersion.WithKind("KongVault"):
+		return &kongv1alpha1.KongVault{}, nil
+	case kongv1alpha1.SchemeGroupVersion.WithKind(kongv1alpha1.KongCustomEntityKind):
+		return &kongv1alpha1.KongCustomEntity{}, nil
+	case kongv1alpha1.SchemeGroupVersion.WithKind(kongv1alpha1.KongTenantPolicyKind):
+		return &kongv1alpha1.KongTenantPolicy{}, nil
 	case incubatorv1alpha1.SchemeGroupVersion.WithKind("KongServiceFacade"):
 		return &incubatorv1alpha1.KongServiceFacade{}, nil
 	default:
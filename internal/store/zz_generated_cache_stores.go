@@ -14,6 +14,7 @@ import (
 	"sigs.k8s.io/controller-runtime/pkg/client"
 
 	"github.com/kong/kubernetes-ingress-controller/v3/internal/gatewayapi"
+	mcsv1alpha1 "github.com/kong/kubernetes-ingress-controller/v3/internal/mcsapi/v1alpha1"
 	kongv1 "github.com/kong/kubernetes-ingress-controller/v3/pkg/apis/configuration/v1"
 	kongv1alpha1 "github.com/kong/kubernetes-ingress-controller/v3/pkg/apis/configuration/v1alpha1"
 	kongv1beta1 "github.com/kong/kubernetes-ingress-controller/v3/pkg/apis/configuration/v1beta1"
@@ -28,6 +29,8 @@ type CacheStores struct {
 	Service                        cache.Store
 	Secret                         cache.Store
 	EndpointSlice                  cache.Store
+	ConfigMap                      cache.Store
+	Namespace                      cache.Store
 	HTTPRoute                      cache.Store
 	UDPRoute                       cache.Store
 	TCPRoute                       cache.Store
@@ -35,6 +38,9 @@ type CacheStores struct {
 	GRPCRoute                      cache.Store
 	ReferenceGrant                 cache.Store
 	Gateway                        cache.Store
+	BackendTLSPolicy               cache.Store
+	BackendLBPolicy                cache.Store
+	ServiceImport                  cache.Store
 	Plugin                         cache.Store
 	ClusterPlugin                  cache.Store
 	Consumer                       cache.Store
@@ -46,6 +52,8 @@ type CacheStores struct {
 	IngressClassParametersV1alpha1 cache.Store
 	KongServiceFacade              cache.Store
 	KongVault                      cache.Store
+	KongCustomEntity               cache.Store
+	KongTenantPolicy               cache.Store
 
 	l *sync.RWMutex
 }
@@ -58,6 +66,8 @@ func NewCacheStores() CacheStores {
 		Service:                        cache.NewStore(namespacedKeyFunc),
 		Secret:                         cache.NewStore(namespacedKeyFunc),
 		EndpointSlice:                  cache.NewStore(namespacedKeyFunc),
+		ConfigMap:                      cache.NewStore(namespacedKeyFunc),
+		Namespace:                      cache.NewStore(clusterWideKeyFunc),
 		HTTPRoute:                      cache.NewStore(namespacedKeyFunc),
 		UDPRoute:                       cache.NewStore(namespacedKeyFunc),
 		TCPRoute:                       cache.NewStore(namespacedKeyFunc),
@@ -65,6 +75,9 @@ func NewCacheStores() CacheStores {
 		GRPCRoute:                      cache.NewStore(namespacedKeyFunc),
 		ReferenceGrant:                 cache.NewStore(namespacedKeyFunc),
 		Gateway:                        cache.NewStore(namespacedKeyFunc),
+		BackendTLSPolicy:               cache.NewStore(namespacedKeyFunc),
+		BackendLBPolicy:                cache.NewStore(namespacedKeyFunc),
+		ServiceImport:                  cache.NewStore(namespacedKeyFunc),
 		Plugin:                         cache.NewStore(namespacedKeyFunc),
 		ClusterPlugin:                  cache.NewStore(clusterWideKeyFunc),
 		Consumer:                       cache.NewStore(namespacedKeyFunc),
@@ -76,6 +89,8 @@ func NewCacheStores() CacheStores {
 		IngressClassParametersV1alpha1: cache.NewStore(namespacedKeyFunc),
 		KongServiceFacade:              cache.NewStore(namespacedKeyFunc),
 		KongVault:                      cache.NewStore(clusterWideKeyFunc),
+		KongCustomEntity:               cache.NewStore(namespacedKeyFunc),
+		KongTenantPolicy:               cache.NewStore(clusterWideKeyFunc),
 
 		l: &sync.RWMutex{},
 	}
@@ -97,6 +112,10 @@ func (c CacheStores) Get(obj runtime.Object) (item interface{}, exists bool, err
 		return c.Secret.Get(obj)
 	case *discoveryv1.EndpointSlice:
 		return c.EndpointSlice.Get(obj)
+	case *corev1.ConfigMap:
+		return c.ConfigMap.Get(obj)
+	case *corev1.Namespace:
+		return c.Namespace.Get(obj)
 	case *gatewayapi.HTTPRoute:
 		return c.HTTPRoute.Get(obj)
 	case *gatewayapi.UDPRoute:
@@ -111,6 +130,12 @@ func (c CacheStores) Get(obj runtime.Object) (item interface{}, exists bool, err
 		return c.ReferenceGrant.Get(obj)
 	case *gatewayapi.Gateway:
 		return c.Gateway.Get(obj)
+	case *gatewayapi.BackendTLSPolicy:
+		return c.BackendTLSPolicy.Get(obj)
+	case *gatewayapi.BackendLBPolicy:
+		return c.BackendLBPolicy.Get(obj)
+	case *mcsv1alpha1.ServiceImport:
+		return c.ServiceImport.Get(obj)
 	case *kongv1.KongPlugin:
 		return c.Plugin.Get(obj)
 	case *kongv1.KongClusterPlugin:
@@ -133,6 +158,10 @@ func (c CacheStores) Get(obj runtime.Object) (item interface{}, exists bool, err
 		return c.KongServiceFacade.Get(obj)
 	case *kongv1alpha1.KongVault:
 		return c.KongVault.Get(obj)
+	case *kongv1alpha1.KongCustomEntity:
+		return c.KongCustomEntity.Get(obj)
+	case *kongv1alpha1.KongTenantPolicy:
+		return c.KongTenantPolicy.Get(obj)
 	}
 	return nil, false, fmt.Errorf("%T is not a supported cache object type", obj)
 }
@@ -154,6 +183,10 @@ func (c CacheStores) Add(obj runtime.Object) error {
 		return c.Secret.Add(obj)
 	case *discoveryv1.EndpointSlice:
 		return c.EndpointSlice.Add(obj)
+	case *corev1.ConfigMap:
+		return c.ConfigMap.Add(obj)
+	case *corev1.Namespace:
+		return c.Namespace.Add(obj)
 	case *gatewayapi.HTTPRoute:
 		return c.HTTPRoute.Add(obj)
 	case *gatewayapi.UDPRoute:
@@ -168,6 +201,12 @@ func (c CacheStores) Add(obj runtime.Object) error {
 		return c.ReferenceGrant.Add(obj)
 	case *gatewayapi.Gateway:
 		return c.Gateway.Add(obj)
+	case *gatewayapi.BackendTLSPolicy:
+		return c.BackendTLSPolicy.Add(obj)
+	case *gatewayapi.BackendLBPolicy:
+		return c.BackendLBPolicy.Add(obj)
+	case *mcsv1alpha1.ServiceImport:
+		return c.ServiceImport.Add(obj)
 	case *kongv1.KongPlugin:
 		return c.Plugin.Add(obj)
 	case *kongv1.KongClusterPlugin:
@@ -190,6 +229,10 @@ func (c CacheStores) Add(obj runtime.Object) error {
 		return c.KongServiceFacade.Add(obj)
 	case *kongv1alpha1.KongVault:
 		return c.KongVault.Add(obj)
+	case *kongv1alpha1.KongCustomEntity:
+		return c.KongCustomEntity.Add(obj)
+	case *kongv1alpha1.KongTenantPolicy:
+		return c.KongTenantPolicy.Add(obj)
 	}
 	return fmt.Errorf("cannot add unsupported kind %q to the store", obj.GetObjectKind().GroupVersionKind())
 }
@@ -211,6 +254,10 @@ func (c CacheStores) Delete(obj runtime.Object) error {
 		return c.Secret.Delete(obj)
 	case *discoveryv1.EndpointSlice:
 		return c.EndpointSlice.Delete(obj)
+	case *corev1.ConfigMap:
+		return c.ConfigMap.Delete(obj)
+	case *corev1.Namespace:
+		return c.Namespace.Delete(obj)
 	case *gatewayapi.HTTPRoute:
 		return c.HTTPRoute.Delete(obj)
 	case *gatewayapi.UDPRoute:
@@ -225,6 +272,12 @@ func (c CacheStores) Delete(obj runtime.Object) error {
 		return c.ReferenceGrant.Delete(obj)
 	case *gatewayapi.Gateway:
 		return c.Gateway.Delete(obj)
+	case *gatewayapi.BackendTLSPolicy:
+		return c.BackendTLSPolicy.Delete(obj)
+	case *gatewayapi.BackendLBPolicy:
+		return c.BackendLBPolicy.Delete(obj)
+	case *mcsv1alpha1.ServiceImport:
+		return c.ServiceImport.Delete(obj)
 	case *kongv1.KongPlugin:
 		return c.Plugin.Delete(obj)
 	case *kongv1.KongClusterPlugin:
@@ -247,6 +300,10 @@ func (c CacheStores) Delete(obj runtime.Object) error {
 		return c.KongServiceFacade.Delete(obj)
 	case *kongv1alpha1.KongVault:
 		return c.KongVault.Delete(obj)
+	case *kongv1alpha1.KongCustomEntity:
+		return c.KongCustomEntity.Delete(obj)
+	case *kongv1alpha1.KongTenantPolicy:
+		return c.KongTenantPolicy.Delete(obj)
 	}
 	return fmt.Errorf("cannot delete unsupported kind %q from the store", obj.GetObjectKind().GroupVersionKind())
 }
@@ -259,6 +316,8 @@ func (c CacheStores) ListAllStores() []cache.Store {
 		c.Service,
 		c.Secret,
 		c.EndpointSlice,
+		c.ConfigMap,
+		c.Namespace,
 		c.HTTPRoute,
 		c.UDPRoute,
 		c.TCPRoute,
@@ -266,6 +325,9 @@ func (c CacheStores) ListAllStores() []cache.Store {
 		c.GRPCRoute,
 		c.ReferenceGrant,
 		c.Gateway,
+		c.BackendTLSPolicy,
+		c.BackendLBPolicy,
+		c.ServiceImport,
 		c.Plugin,
 		c.ClusterPlugin,
 		c.Consumer,
@@ -277,6 +339,8 @@ func (c CacheStores) ListAllStores() []cache.Store {
 		c.IngressClassParametersV1alpha1,
 		c.KongServiceFacade,
 		c.KongVault,
+		c.KongCustomEntity,
+		c.KongTenantPolicy,
 	}
 }
 
@@ -288,6 +352,8 @@ func (c CacheStores) SupportedTypes() []client.Object {
 		&corev1.Service{},
 		&corev1.Secret{},
 		&discoveryv1.EndpointSlice{},
+		&corev1.ConfigMap{},
+		&corev1.Namespace{},
 		&gatewayapi.HTTPRoute{},
 		&gatewayapi.UDPRoute{},
 		&gatewayapi.TCPRoute{},
@@ -295,6 +361,9 @@ func (c CacheStores) SupportedTypes() []client.Object {
 		&gatewayapi.GRPCRoute{},
 		&gatewayapi.ReferenceGrant{},
 		&gatewayapi.Gateway{},
+		&gatewayapi.BackendTLSPolicy{},
+		&gatewayapi.BackendLBPolicy{},
+		&mcsv1alpha1.ServiceImport{},
 		&kongv1.KongPlugin{},
 		&kongv1.KongClusterPlugin{},
 		&kongv1.KongConsumer{},
@@ -306,5 +375,7 @@ func (c CacheStores) SupportedTypes() []client.Object {
 		&kongv1alpha1.IngressClassParameters{},
 		&incubatorv1alpha1.KongServiceFacade{},
 		&kongv1alpha1.KongVault{},
+		&kongv1alpha1.KongCustomEntity{},
+		&kongv1alpha1.KongTenantPolicy{},
 	}
 }
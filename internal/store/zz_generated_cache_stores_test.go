@@ -6,6 +6,7 @@ import (
 	"testing"
 
 	"github.com/kong/kubernetes-ingress-controller/v3/internal/gatewayapi"
+	mcsv1alpha1 "github.com/kong/kubernetes-ingress-controller/v3/internal/mcsapi/v1alpha1"
 	kongv1 "github.com/kong/kubernetes-ingress-controller/v3/pkg/apis/configuration/v1"
 	kongv1alpha1 "github.com/kong/kubernetes-ingress-controller/v3/pkg/apis/configuration/v1alpha1"
 	"github.com/stretchr/testify/require"
@@ -50,6 +51,16 @@ func TestCacheStores(t *testing.T) {
 			objectToStore: &discoveryv1.EndpointSlice{},
 		},
 
+		{
+			name:          "ConfigMap",
+			objectToStore: &corev1.ConfigMap{},
+		},
+
+		{
+			name:          "Namespace",
+			objectToStore: &corev1.Namespace{},
+		},
+
 		{
 			name:          "HTTPRoute",
 			objectToStore: &gatewayapi.HTTPRoute{},
@@ -85,6 +96,21 @@ func TestCacheStores(t *testing.T) {
 			objectToStore: &gatewayapi.Gateway{},
 		},
 
+		{
+			name:          "BackendTLSPolicy",
+			objectToStore: &gatewayapi.BackendTLSPolicy{},
+		},
+
+		{
+			name:          "BackendLBPolicy",
+			objectToStore: &gatewayapi.BackendLBPolicy{},
+		},
+
+		{
+			name:          "ServiceImport",
+			objectToStore: &mcsv1alpha1.ServiceImport{},
+		},
+
 		{
 			name:          "KongPlugin",
 			objectToStore: &kongv1.KongPlugin{},
@@ -139,6 +165,16 @@ func TestCacheStores(t *testing.T) {
 			name:          "KongVault",
 			objectToStore: &kongv1alpha1.KongVault{},
 		},
+
+		{
+			name:          "KongCustomEntity",
+			objectToStore: &kongv1alpha1.KongCustomEntity{},
+		},
+
+		{
+			name:          "KongTenantPolicy",
+			objectToStore: &kongv1alpha1.KongTenantPolicy{},
+		},
 	}
 
 	for _, tc := range testCases {
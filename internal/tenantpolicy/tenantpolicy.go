@@ -0,0 +1,198 @@
+// Package tenantpolicy evaluates KongTenantPolicies restricting what objects in the selected namespaces
+// can configure in Kong.
+package tenantpolicy
+
+import (
+	"errors"
+	"fmt"
+	"regexp"
+	"slices"
+	"strings"
+
+	"github.com/samber/lo"
+	corev1 "k8s.io/api/core/v1"
+	netv1 "k8s.io/api/networking/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	k8slabels "k8s.io/apimachinery/pkg/labels"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	"github.com/kong/kubernetes-ingress-controller/v3/internal/annotations"
+	"github.com/kong/kubernetes-ingress-controller/v3/internal/gatewayapi"
+	kongv1alpha1 "github.com/kong/kubernetes-ingress-controller/v3/pkg/apis/configuration/v1alpha1"
+	kongv1beta1 "github.com/kong/kubernetes-ingress-controller/v3/pkg/apis/configuration/v1beta1"
+)
+
+// Storer is the subset of store.Storer required to evaluate KongTenantPolicies.
+type Storer interface {
+	ListKongTenantPolicies() []*kongv1alpha1.KongTenantPolicy
+	GetNamespace(name string) (*corev1.Namespace, error)
+}
+
+// Evaluator checks objects against the KongTenantPolicies selecting their namespaces.
+// It caches the policies selecting each namespace, so it should be created anew
+// for every translation or validation to reflect the current state of the cluster.
+type Evaluator struct {
+	storer            Storer
+	policies          []*kongv1alpha1.KongTenantPolicy
+	namespacePolicies map[string][]*kongv1alpha1.KongTenantPolicy
+}
+
+// NewEvaluator creates an Evaluator using the KongTenantPolicies and Namespaces from the provided store.
+func NewEvaluator(s Storer) *Evaluator {
+	return &Evaluator{
+		storer:            s,
+		policies:          s.ListKongTenantPolicies(),
+		namespacePolicies: make(map[string][]*kongv1alpha1.KongTenantPolicy),
+	}
+}
+
+// ValidatePlugin returns an error when a plugin named pluginName is not allowed to be attached
+// to objects in the namespace.
+func (e *Evaluator) ValidatePlugin(namespace, pluginName string) error {
+	for _, policy := range e.policiesForNamespace(namespace) {
+		if !ruleAllows(policy.Spec.Plugins, pluginName, globMatches) {
+			return violationError(policy, "plugin", pluginName, namespace)
+		}
+	}
+	return nil
+}
+
+// ValidateObject returns an error when the konghq.com annotations of obj or the hosts and paths
+// its routes match are not allowed in its namespace.
+func (e *Evaluator) ValidateObject(obj client.Object) error {
+	policies := e.policiesForNamespace(obj.GetNamespace())
+	if len(policies) == 0 {
+		return nil
+	}
+
+	var errs []error
+	annotationKeys := lo.Filter(lo.Keys(obj.GetAnnotations()), func(key string, _ int) bool {
+		return strings.HasPrefix(key, annotations.AnnotationPrefix+"/")
+	})
+	slices.Sort(annotationKeys)
+	hosts, paths := hostsAndPaths(obj)
+	for _, policy := range policies {
+		for _, key := range annotationKeys {
+			if !ruleAllows(policy.Spec.Annotations, key, globMatches) {
+				errs = append(errs, violationError(policy, "annotation", key, obj.GetNamespace()))
+			}
+		}
+		for _, host := range hosts {
+			if !ruleAllows(policy.Spec.Hosts, host, globMatches) {
+				errs = append(errs, violationError(policy, "host", host, obj.GetNamespace()))
+			}
+		}
+		for _, path := range paths {
+			if !ruleAllows(policy.Spec.PathPrefixes, path, strings.HasPrefix) {
+				errs = append(errs, violationError(policy, "path", path, obj.GetNamespace()))
+			}
+		}
+	}
+	return errors.Join(errs...)
+}
+
+// policiesForNamespace returns the KongTenantPolicies selecting the namespace.
+func (e *Evaluator) policiesForNamespace(namespace string) []*kongv1alpha1.KongTenantPolicy {
+	if len(e.policies) == 0 {
+		return nil
+	}
+	if policies, ok := e.namespacePolicies[namespace]; ok {
+		return policies
+	}
+
+	// When the Namespace is not known yet, fall back to the label set on every Namespace
+	// by Kubernetes, so that policies selecting Namespaces by name still apply.
+	namespaceLabels := k8slabels.Set{corev1.LabelMetadataName: namespace}
+	if ns, err := e.storer.GetNamespace(namespace); err == nil {
+		namespaceLabels = ns.Labels
+	}
+
+	var policies []*kongv1alpha1.KongTenantPolicy
+	for _, policy := range e.policies {
+		selector, err := metav1.LabelSelectorAsSelector(&policy.Spec.NamespaceSelector)
+		if err != nil {
+			// The selector is invalid and the API server should have rejected it already.
+			// Treat it as selecting every namespace to fail closed.
+			policies = append(policies, policy)
+			continue
+		}
+		if selector.Matches(namespaceLabels) {
+			policies = append(policies, policy)
+		}
+	}
+	e.namespacePolicies[namespace] = policies
+	return policies
+}
+
+// ruleAllows returns true when value is not denied by the rule and is allowed by it, if the rule
+// has an allow list. A nil rule allows everything.
+func ruleAllows(rule *kongv1alpha1.KongTenantPolicyRule, value string, matches func(value, entry string) bool) bool {
+	if rule == nil {
+		return true
+	}
+	matchesValue := func(entry string) bool { return matches(value, entry) }
+	if lo.ContainsBy(rule.Deny, matchesValue) {
+		return false
+	}
+	return len(rule.Allow) == 0 || lo.ContainsBy(rule.Allow, matchesValue)
+}
+
+// globMatches returns true when value matches pattern in which * matches any sequence of characters.
+func globMatches(value, pattern string) bool {
+	if !strings.Contains(pattern, "*") {
+		return value == pattern
+	}
+	parts := strings.Split(pattern, "*")
+	for i, part := range parts {
+		parts[i] = regexp.QuoteMeta(part)
+	}
+	return regexp.MustCompile("^" + strings.Join(parts, ".*") + "$").MatchString(value)
+}
+
+func violationError(policy *kongv1alpha1.KongTenantPolicy, field, value, namespace string) error {
+	return fmt.Errorf("%s %q is not allowed in namespace %q by KongTenantPolicy %s", field, value, namespace, policy.Name)
+}
+
+// hostsAndPaths returns the hosts and paths matched by routes translated from obj.
+func hostsAndPaths(obj client.Object) (hosts []string, paths []string) {
+	switch obj := obj.(type) {
+	case *netv1.Ingress:
+		for _, rule := range obj.Spec.Rules {
+			if rule.Host != "" {
+				hosts = append(hosts, rule.Host)
+			}
+			if rule.HTTP == nil {
+				continue
+			}
+			for _, path := range rule.HTTP.Paths {
+				if path.Path != "" {
+					paths = append(paths, path.Path)
+				}
+			}
+		}
+	case *gatewayapi.HTTPRoute:
+		hosts = hostnamesToStrings(obj.Spec.Hostnames)
+		for _, rule := range obj.Spec.Rules {
+			for _, match := range rule.Matches {
+				if match.Path != nil && match.Path.Value != nil {
+					paths = append(paths, *match.Path.Value)
+				}
+			}
+		}
+	case *gatewayapi.GRPCRoute:
+		hosts = hostnamesToStrings(obj.Spec.Hostnames)
+	case *gatewayapi.TLSRoute:
+		hosts = hostnamesToStrings(obj.Spec.Hostnames)
+	case *kongv1beta1.TCPIngress:
+		for _, rule := range obj.Spec.Rules {
+			if rule.Host != "" {
+				hosts = append(hosts, rule.Host)
+			}
+		}
+	}
+	return lo.Uniq(hosts), lo.Uniq(paths)
+}
+
+func hostnamesToStrings(hostnames []gatewayapi.Hostname) []string {
+	return lo.Map(hostnames, func(h gatewayapi.Hostname, _ int) string { return string(h) })
+}
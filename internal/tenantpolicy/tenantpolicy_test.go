@@ -0,0 +1,242 @@
+package tenantpolicy_test
+
+import (
+	"testing"
+
+	"github.com/samber/lo"
+	"github.com/stretchr/testify/require"
+	corev1 "k8s.io/api/core/v1"
+	netv1 "k8s.io/api/networking/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	"github.com/kong/kubernetes-ingress-controller/v3/internal/gatewayapi"
+	"github.com/kong/kubernetes-ingress-controller/v3/internal/store"
+	"github.com/kong/kubernetes-ingress-controller/v3/internal/tenantpolicy"
+	kongv1alpha1 "github.com/kong/kubernetes-ingress-controller/v3/pkg/apis/configuration/v1alpha1"
+)
+
+func tenantPoliciesTestStore(t *testing.T, policies ...*kongv1alpha1.KongTenantPolicy) store.Storer {
+	t.Helper()
+	s, err := store.NewFakeStore(store.FakeObjects{
+		Namespaces: []*corev1.Namespace{
+			{
+				ObjectMeta: metav1.ObjectMeta{
+					Name:   "team-a",
+					Labels: map[string]string{"tenant": "true"},
+				},
+			},
+			{
+				ObjectMeta: metav1.ObjectMeta{
+					Name: "platform",
+				},
+			},
+		},
+		KongTenantPolicies: policies,
+	})
+	require.NoError(t, err)
+	return s
+}
+
+func tenantsPolicy(spec kongv1alpha1.KongTenantPolicySpec) *kongv1alpha1.KongTenantPolicy {
+	spec.NamespaceSelector = metav1.LabelSelector{
+		MatchLabels: map[string]string{"tenant": "true"},
+	}
+	return &kongv1alpha1.KongTenantPolicy{
+		ObjectMeta: metav1.ObjectMeta{
+			Name: "tenants",
+		},
+		Spec: spec,
+	}
+}
+
+func TestEvaluator_ValidatePlugin(t *testing.T) {
+	testCases := []struct {
+		name        string
+		policies    []*kongv1alpha1.KongTenantPolicy
+		namespace   string
+		pluginName  string
+		expectedErr string
+	}{
+		{
+			name:       "no policies allow everything",
+			namespace:  "team-a",
+			pluginName: "pre-function",
+		},
+		{
+			name: "denied plugin is rejected in a selected namespace",
+			policies: []*kongv1alpha1.KongTenantPolicy{tenantsPolicy(kongv1alpha1.KongTenantPolicySpec{
+				Plugins: &kongv1alpha1.KongTenantPolicyRule{Deny: []string{"*-function"}},
+			})},
+			namespace:   "team-a",
+			pluginName:  "pre-function",
+			expectedErr: `plugin "pre-function" is not allowed in namespace "team-a" by KongTenantPolicy tenants`,
+		},
+		{
+			name: "denied plugin is accepted in a namespace not selected by the policy",
+			policies: []*kongv1alpha1.KongTenantPolicy{tenantsPolicy(kongv1alpha1.KongTenantPolicySpec{
+				Plugins: &kongv1alpha1.KongTenantPolicyRule{Deny: []string{"*-function"}},
+			})},
+			namespace:  "platform",
+			pluginName: "pre-function",
+		},
+		{
+			name: "plugin missing from the allow list is rejected",
+			policies: []*kongv1alpha1.KongTenantPolicy{tenantsPolicy(kongv1alpha1.KongTenantPolicySpec{
+				Plugins: &kongv1alpha1.KongTenantPolicyRule{Allow: []string{"rate-limiting", "cors"}},
+			})},
+			namespace:   "team-a",
+			pluginName:  "key-auth",
+			expectedErr: `plugin "key-auth" is not allowed in namespace "team-a" by KongTenantPolicy tenants`,
+		},
+		{
+			name: "deny takes precedence over allow",
+			policies: []*kongv1alpha1.KongTenantPolicy{tenantsPolicy(kongv1alpha1.KongTenantPolicySpec{
+				Plugins: &kongv1alpha1.KongTenantPolicyRule{Allow: []string{"*"}, Deny: []string{"pre-function"}},
+			})},
+			namespace:   "team-a",
+			pluginName:  "pre-function",
+			expectedErr: `plugin "pre-function" is not allowed in namespace "team-a" by KongTenantPolicy tenants`,
+		},
+		{
+			name: "policy selecting a namespace by name applies to a namespace not known yet",
+			policies: []*kongv1alpha1.KongTenantPolicy{{
+				ObjectMeta: metav1.ObjectMeta{Name: "team-b"},
+				Spec: kongv1alpha1.KongTenantPolicySpec{
+					NamespaceSelector: metav1.LabelSelector{
+						MatchLabels: map[string]string{corev1.LabelMetadataName: "team-b"},
+					},
+					Plugins: &kongv1alpha1.KongTenantPolicyRule{Deny: []string{"pre-function"}},
+				},
+			}},
+			namespace:   "team-b",
+			pluginName:  "pre-function",
+			expectedErr: `plugin "pre-function" is not allowed in namespace "team-b" by KongTenantPolicy team-b`,
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			evaluator := tenantpolicy.NewEvaluator(tenantPoliciesTestStore(t, tc.policies...))
+			err := evaluator.ValidatePlugin(tc.namespace, tc.pluginName)
+			if tc.expectedErr == "" {
+				require.NoError(t, err)
+				return
+			}
+			require.EqualError(t, err, tc.expectedErr)
+		})
+	}
+}
+
+func TestEvaluator_ValidateObject(t *testing.T) {
+	policy := tenantsPolicy(kongv1alpha1.KongTenantPolicySpec{
+		Annotations:  &kongv1alpha1.KongTenantPolicyRule{Deny: []string{"konghq.com/override", "konghq.com/host-*"}},
+		Hosts:        &kongv1alpha1.KongTenantPolicyRule{Allow: []string{"*.team-a.example.com"}},
+		PathPrefixes: &kongv1alpha1.KongTenantPolicyRule{Allow: []string{"/team-a"}},
+	})
+
+	testCases := []struct {
+		name        string
+		obj         client.Object
+		expectedErr string
+	}{
+		{
+			name: "compliant Ingress is accepted",
+			obj: &netv1.Ingress{
+				ObjectMeta: metav1.ObjectMeta{
+					Namespace:   "team-a",
+					Name:        "ingress",
+					Annotations: map[string]string{"konghq.com/strip-path": "true"},
+				},
+				Spec: netv1.IngressSpec{
+					Rules: []netv1.IngressRule{{
+						Host: "api.team-a.example.com",
+						IngressRuleValue: netv1.IngressRuleValue{HTTP: &netv1.HTTPIngressRuleValue{
+							Paths: []netv1.HTTPIngressPath{{Path: "/team-a/v1"}},
+						}},
+					}},
+				},
+			},
+		},
+		{
+			name: "Ingress with denied annotations, host and path is rejected",
+			obj: &netv1.Ingress{
+				ObjectMeta: metav1.ObjectMeta{
+					Namespace: "team-a",
+					Name:      "ingress",
+					Annotations: map[string]string{
+						"konghq.com/override":    "shared",
+						"konghq.com/host-header": "example.com",
+						"other.io/annotation":    "value",
+					},
+				},
+				Spec: netv1.IngressSpec{
+					Rules: []netv1.IngressRule{{
+						Host: "shared.example.com",
+						IngressRuleValue: netv1.IngressRuleValue{HTTP: &netv1.HTTPIngressRuleValue{
+							Paths: []netv1.HTTPIngressPath{{Path: "/"}},
+						}},
+					}},
+				},
+			},
+			expectedErr: `annotation "konghq.com/host-header" is not allowed in namespace "team-a" by KongTenantPolicy tenants` + "\n" +
+				`annotation "konghq.com/override" is not allowed in namespace "team-a" by KongTenantPolicy tenants` + "\n" +
+				`host "shared.example.com" is not allowed in namespace "team-a" by KongTenantPolicy tenants` + "\n" +
+				`path "/" is not allowed in namespace "team-a" by KongTenantPolicy tenants`,
+		},
+		{
+			name: "HTTPRoute with a path outside of allowed prefixes is rejected",
+			obj: &gatewayapi.HTTPRoute{
+				ObjectMeta: metav1.ObjectMeta{
+					Namespace: "team-a",
+					Name:      "httproute",
+				},
+				Spec: gatewayapi.HTTPRouteSpec{
+					Hostnames: []gatewayapi.Hostname{"api.team-a.example.com"},
+					Rules: []gatewayapi.HTTPRouteRule{{
+						Matches: []gatewayapi.HTTPRouteMatch{
+							{Path: &gatewayapi.HTTPPathMatch{Value: lo.ToPtr("/team-a")}},
+							{Path: &gatewayapi.HTTPPathMatch{Value: lo.ToPtr("/admin")}},
+						},
+					}},
+				},
+			},
+			expectedErr: `path "/admin" is not allowed in namespace "team-a" by KongTenantPolicy tenants`,
+		},
+		{
+			name: "GRPCRoute with a host not allowed is rejected",
+			obj: &gatewayapi.GRPCRoute{
+				ObjectMeta: metav1.ObjectMeta{
+					Namespace: "team-a",
+					Name:      "grpcroute",
+				},
+				Spec: gatewayapi.GRPCRouteSpec{
+					Hostnames: []gatewayapi.Hostname{"grpc.example.com"},
+				},
+			},
+			expectedErr: `host "grpc.example.com" is not allowed in namespace "team-a" by KongTenantPolicy tenants`,
+		},
+		{
+			name: "object in a namespace not selected by the policy is accepted",
+			obj: &netv1.Ingress{
+				ObjectMeta: metav1.ObjectMeta{
+					Namespace:   "platform",
+					Name:        "ingress",
+					Annotations: map[string]string{"konghq.com/override": "shared"},
+				},
+			},
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			evaluator := tenantpolicy.NewEvaluator(tenantPoliciesTestStore(t, policy))
+			err := evaluator.ValidateObject(tc.obj)
+			if tc.expectedErr == "" {
+				require.NoError(t, err)
+				return
+			}
+			require.EqualError(t, err, tc.expectedErr)
+		})
+	}
+}
@@ -8,6 +8,10 @@ type ConfigDump struct {
 	Config file.Content
 	// Failed is true if the configuration apply failed.
 	Failed bool
+	// Hash is the hex-encoded SHA256 checksum of the configuration KIC applied or attempted to apply.
+	Hash string
+	// DryRun is true if the configuration was only validated against Kong without being applied.
+	DryRun bool
 	// RawResponseBody is the raw Kong Admin API response body from a config apply. It is only available in DB-less mode.
 	RawResponseBody []byte
 }
@@ -2,9 +2,12 @@ package util
 
 import (
 	"fmt"
+	"strings"
+	"time"
 
 	corev1 "k8s.io/api/core/v1"
 
+	"github.com/kong/kubernetes-ingress-controller/v3/internal/annotations"
 	"github.com/kong/kubernetes-ingress-controller/v3/internal/labels"
 )
 
@@ -17,3 +20,99 @@ func ExtractKongCredentialType(secret *corev1.Secret) (string, error) {
 	}
 	return credType, nil
 }
+
+// CredentialVersion is a version of the credential held by a Secret.
+type CredentialVersion struct {
+	// Name is the name of the version, empty for Secrets that don't list versions.
+	Name string
+	// NotBefore is the time the version is valid from. Zero value means it's valid since forever.
+	NotBefore time.Time
+	// NotAfter is the time the version is valid until. Zero value means it's valid forever.
+	NotAfter time.Time
+	// Data holds the fields of the version, including the fields shared by all versions.
+	Data map[string][]byte
+}
+
+// IsValidAt returns true if the version is valid at the given time.
+func (v CredentialVersion) IsValidAt(t time.Time) bool {
+	return (v.NotBefore.IsZero() || !t.Before(v.NotBefore)) && (v.NotAfter.IsZero() || t.Before(v.NotAfter))
+}
+
+// ExtractKongCredentialVersions returns the versions of the credential held by a Secret.
+//
+// A Secret without the konghq.com/credential-versions annotation holds a single, always valid version.
+// Otherwise, the annotation lists the comma separated names of the versions. Fields of a version are held
+// in keys suffixed with .<version> (e.g. key.v2), while keys without a version suffix are shared by all
+// versions. The optional konghq.com/credential-not-before.<version> and konghq.com/credential-not-after.<version>
+// annotations hold RFC 3339 timestamps bounding the time the version is valid in. Several versions may be valid
+// at the same time, it's up to the caller to pick the ones that can be provisioned together.
+func ExtractKongCredentialVersions(secret *corev1.Secret) ([]CredentialVersion, error) {
+	versionsAnnotation, ok := secret.Annotations[annotations.AnnotationPrefix+annotations.CredentialVersionsKey]
+	if !ok {
+		return []CredentialVersion{{Data: secret.Data}}, nil
+	}
+
+	var versions []CredentialVersion
+	for _, name := range strings.Split(versionsAnnotation, ",") {
+		name = strings.TrimSpace(name)
+		if name == "" {
+			return nil, fmt.Errorf("%s annotation contains an empty version", annotations.CredentialVersionsKey)
+		}
+		for _, v := range versions {
+			if v.Name == name {
+				return nil, fmt.Errorf("version %q is listed more than once", name)
+			}
+		}
+		version := CredentialVersion{Name: name, Data: make(map[string][]byte)}
+		var err error
+		if version.NotBefore, err = credentialVersionTime(secret, annotations.CredentialNotBeforeKey, name); err != nil {
+			return nil, err
+		}
+		if version.NotAfter, err = credentialVersionTime(secret, annotations.CredentialNotAfterKey, name); err != nil {
+			return nil, err
+		}
+		if !version.NotBefore.IsZero() && !version.NotAfter.IsZero() && !version.NotBefore.Before(version.NotAfter) {
+			return nil, fmt.Errorf("version %q is never valid, its not-after time is not after its not-before time", name)
+		}
+		versions = append(versions, version)
+	}
+
+	for k, v := range secret.Data {
+		field, versionName, found := cutVersionSuffix(k, versions)
+		if !found {
+			for i := range versions {
+				versions[i].Data[k] = v
+			}
+			continue
+		}
+		for i := range versions {
+			if versions[i].Name == versionName {
+				versions[i].Data[field] = v
+			}
+		}
+	}
+	return versions, nil
+}
+
+// cutVersionSuffix returns the field and the version of a Secret key suffixed with the name of one of the versions.
+func cutVersionSuffix(key string, versions []CredentialVersion) (field, version string, found bool) {
+	for _, v := range versions {
+		if field, ok := strings.CutSuffix(key, "."+v.Name); ok && field != "" {
+			return field, v.Name, true
+		}
+	}
+	return "", "", false
+}
+
+func credentialVersionTime(secret *corev1.Secret, key, version string) (time.Time, error) {
+	annotation := annotations.AnnotationPrefix + key + "." + version
+	value, ok := secret.Annotations[annotation]
+	if !ok {
+		return time.Time{}, nil
+	}
+	t, err := time.Parse(time.RFC3339, value)
+	if err != nil {
+		return time.Time{}, fmt.Errorf("invalid %s annotation: %w", annotation, err)
+	}
+	return t, nil
+}
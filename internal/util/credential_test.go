@@ -3,6 +3,7 @@ package util
 import (
 	"fmt"
 	"testing"
+	"time"
 
 	"github.com/stretchr/testify/require"
 	corev1 "k8s.io/api/core/v1"
@@ -58,3 +59,102 @@ func TestExtractKongCredentialType(t *testing.T) {
 		})
 	}
 }
+
+func TestExtractKongCredentialVersions(t *testing.T) {
+	newSecret := func(anns map[string]string, data map[string][]byte) *corev1.Secret {
+		return &corev1.Secret{
+			ObjectMeta: metav1.ObjectMeta{
+				Name:        "secret",
+				Namespace:   "default",
+				Annotations: anns,
+			},
+			Data: data,
+		}
+	}
+	notBefore := time.Date(2024, 5, 1, 0, 0, 0, 0, time.UTC)
+	notAfter := time.Date(2024, 6, 1, 0, 0, 0, 0, time.UTC)
+
+	tests := []struct {
+		name     string
+		secret   *corev1.Secret
+		versions []CredentialVersion
+		wantErr  string
+	}{
+		{
+			name:   "secret without versions",
+			secret: newSecret(nil, map[string][]byte{"key": []byte("key")}),
+			versions: []CredentialVersion{
+				{Data: map[string][]byte{"key": []byte("key")}},
+			},
+		},
+		{
+			name: "secret with versions",
+			secret: newSecret(map[string]string{
+				"konghq.com/credential-versions":      "v1, v2",
+				"konghq.com/credential-not-after.v1":  notAfter.Format(time.RFC3339),
+				"konghq.com/credential-not-before.v2": notBefore.Format(time.RFC3339),
+			}, map[string][]byte{
+				"username":    []byte("batman"),
+				"password.v1": []byte("password-1"),
+				"password.v2": []byte("password-2"),
+			}),
+			versions: []CredentialVersion{
+				{
+					Name:     "v1",
+					NotAfter: notAfter,
+					Data: map[string][]byte{
+						"username": []byte("batman"),
+						"password": []byte("password-1"),
+					},
+				},
+				{
+					Name:      "v2",
+					NotBefore: notBefore,
+					Data: map[string][]byte{
+						"username": []byte("batman"),
+						"password": []byte("password-2"),
+					},
+				},
+			},
+		},
+		{
+			name: "duplicate version",
+			secret: newSecret(map[string]string{
+				"konghq.com/credential-versions": "v1,v1",
+			}, nil),
+			wantErr: `version "v1" is listed more than once`,
+		},
+		{
+			name: "version never valid",
+			secret: newSecret(map[string]string{
+				"konghq.com/credential-versions":      "v1",
+				"konghq.com/credential-not-before.v1": notAfter.Format(time.RFC3339),
+				"konghq.com/credential-not-after.v1":  notBefore.Format(time.RFC3339),
+			}, nil),
+			wantErr: `version "v1" is never valid, its not-after time is not after its not-before time`,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			versions, err := ExtractKongCredentialVersions(tt.secret)
+			if tt.wantErr != "" {
+				require.EqualError(t, err, tt.wantErr)
+				return
+			}
+			require.NoError(t, err)
+			require.Equal(t, tt.versions, versions)
+		})
+	}
+}
+
+func TestCredentialVersion_IsValidAt(t *testing.T) {
+	notBefore := time.Date(2024, 5, 1, 0, 0, 0, 0, time.UTC)
+	notAfter := time.Date(2024, 6, 1, 0, 0, 0, 0, time.UTC)
+	version := CredentialVersion{NotBefore: notBefore, NotAfter: notAfter}
+
+	require.False(t, version.IsValidAt(notBefore.Add(-time.Second)))
+	require.True(t, version.IsValidAt(notBefore))
+	require.True(t, version.IsValidAt(notAfter.Add(-time.Second)))
+	require.False(t, version.IsValidAt(notAfter))
+	require.True(t, CredentialVersion{}.IsValidAt(notAfter), "version without bounds should always be valid")
+}
@@ -18,6 +18,7 @@ package util
 
 import (
 	"context"
+	"errors"
 	"fmt"
 	"os"
 	"strings"
@@ -32,6 +33,8 @@ import (
 
 	"github.com/kong/kubernetes-ingress-controller/v3/internal/annotations"
 	"github.com/kong/kubernetes-ingress-controller/v3/internal/gatewayapi"
+	mcsv1alpha1 "github.com/kong/kubernetes-ingress-controller/v3/internal/mcsapi/v1alpha1"
+	incubatorv1alpha1 "github.com/kong/kubernetes-ingress-controller/v3/pkg/apis/incubator/v1alpha1"
 )
 
 // ParseNameNS parses a string searching a namespace and name.
@@ -147,6 +150,40 @@ func IsBackendRefGroupKindSupported(gatewayAPIGroup *gatewayapi.Group, gatewayAP
 	return ok
 }
 
+// IsBackendRefKongServiceFacade checks if the object used as BackendRef is a KongServiceFacade.
+func IsBackendRefKongServiceFacade(gatewayAPIGroup *gatewayapi.Group, gatewayAPIKind *gatewayapi.Kind) bool {
+	return gatewayAPIGroup != nil && string(*gatewayAPIGroup) == incubatorv1alpha1.GroupVersion.Group &&
+		gatewayAPIKind != nil && string(*gatewayAPIKind) == incubatorv1alpha1.KongServiceFacadeKind
+}
+
+// IsBackendRefServiceImport checks if the object used as BackendRef is a Multi-Cluster Services API ServiceImport.
+func IsBackendRefServiceImport(gatewayAPIGroup *gatewayapi.Group, gatewayAPIKind *gatewayapi.Kind) bool {
+	return gatewayAPIGroup != nil && string(*gatewayAPIGroup) == mcsv1alpha1.GroupVersion.Group &&
+		gatewayAPIKind != nil && string(*gatewayAPIKind) == mcsv1alpha1.ServiceImportKind
+}
+
+// ValidateServicePortServesHTTP returns an error if the Service's port does not serve plain HTTP, i.e. the Service's
+// protocol is set to something else with the konghq.com/protocol annotation or the port's appProtocol.
+func ValidateServicePortServesHTTP(service *corev1.Service, port *gatewayapi.PortNumber) error {
+	if protocol := annotations.ExtractProtocolName(service.Annotations); protocol != "" && protocol != "http" {
+		return fmt.Errorf("%s annotation is set to %q, only http is supported",
+			annotations.AnnotationPrefix+annotations.ProtocolKey, protocol)
+	}
+	if port == nil {
+		return errors.New("port is not specified")
+	}
+	servicePort, ok := lo.Find(service.Spec.Ports, func(p corev1.ServicePort) bool {
+		return p.Port == int32(*port)
+	})
+	if !ok {
+		return fmt.Errorf("port %d is not exposed by the Service", *port)
+	}
+	if servicePort.AppProtocol != nil && !strings.EqualFold(*servicePort.AppProtocol, "http") {
+		return fmt.Errorf("port %d has appProtocol %q, only http is supported", *port, *servicePort.AppProtocol)
+	}
+	return nil
+}
+
 const (
 	K8sNamespaceTagPrefix = "k8s-namespace:"
 	K8sNameTagPrefix      = "k8s-name:"
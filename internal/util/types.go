@@ -28,6 +28,10 @@ type Endpoint struct {
 	Address string `json:"address"`
 	// Port number of the TCP port
 	Port string `json:"port"`
+	// Zones are the zones of the clients the endpoint is local to. They're set only with topology aware routing.
+	Zones []string `json:"zones,omitempty"`
+	// Terminating indicates the endpoint is terminating but still serving. It's set only with topology aware routing.
+	Terminating bool `json:"terminating,omitempty"`
 }
 
 // TypeMeta is stripped after unmarshaling into Go struct due to the issue described in
@@ -1,37 +1,49 @@
 package v1
 
-// ConfigSource is a wrapper around SecretValueFromSource.
+// ConfigSource is a wrapper around SecretValueFromSource and ConfigMapValueFromSource.
 // +kubebuilder:object:generate=true
+// +kubebuilder:validation:XValidation:rule="has(self.configMapKeyRef) ? !has(self.secretKeyRef) || size(self.secretKeyRef.name) == 0 : has(self.secretKeyRef)", message="Exactly one of secretKeyRef or configMapKeyRef must be set."
 type ConfigSource struct {
 	// Specifies a name and a key of a secret to refer to. The namespace is implicitly set to the one of referring object.
-	SecretValue SecretValueFromSource `json:"secretKeyRef"`
+	// +optional
+	SecretValue SecretValueFromSource `json:"secretKeyRef,omitempty"`
+	// Specifies a name and a key of a ConfigMap to refer to. The namespace is implicitly set to the one of referring object.
+	// It should be used instead of secretKeyRef for values that are not sensitive.
+	// +optional
+	ConfigMapValue *ConfigMapValueFromSource `json:"configMapKeyRef,omitempty"`
 }
 
-// ConfigPatch is a JSON patch (RFC6902) to add values from Secret to the generated configuration.
+// ConfigPatch is a JSON patch (RFC6902) to add values from Secret or ConfigMap to the generated configuration.
 // It is an equivalent of the following patch:
 // `{"op": "add", "path": {.Path}, "value": {.ComputedValueFrom}}`.
 // +kubebuilder:object:generate=true
 type ConfigPatch struct {
 	// Path is the JSON-Pointer value (RFC6901) that references a location within the target configuration.
 	Path string `json:"path"`
-	// ValueFrom is the reference to a key of a secret where the patched value comes from.
+	// ValueFrom is the reference to a key of a secret or a ConfigMap where the patched value comes from.
 	ValueFrom ConfigSource `json:"valueFrom"`
 }
 
-// NamespacedConfigSource is a wrapper around NamespacedSecretValueFromSource.
+// NamespacedConfigSource is a wrapper around NamespacedSecretValueFromSource and NamespacedConfigMapValueFromSource.
 // +kubebuilder:object:generate=true
+// +kubebuilder:validation:XValidation:rule="has(self.configMapKeyRef) ? !has(self.secretKeyRef) || size(self.secretKeyRef.name) == 0 : has(self.secretKeyRef)", message="Exactly one of secretKeyRef or configMapKeyRef must be set."
 type NamespacedConfigSource struct {
 	// Specifies a name, a namespace, and a key of a secret to refer to.
-	SecretValue NamespacedSecretValueFromSource `json:"secretKeyRef"`
+	// +optional
+	SecretValue NamespacedSecretValueFromSource `json:"secretKeyRef,omitempty"`
+	// Specifies a name, a namespace, and a key of a ConfigMap to refer to.
+	// It should be used instead of secretKeyRef for values that are not sensitive.
+	// +optional
+	ConfigMapValue *NamespacedConfigMapValueFromSource `json:"configMapKeyRef,omitempty"`
 }
 
-// NamespacedConfigPatch is a JSON patch to add values from secrets to KongClusterPlugin
+// NamespacedConfigPatch is a JSON patch to add values from secrets or ConfigMaps to KongClusterPlugin
 // to the generated configuration of plugin in Kong.
 // +kubebuilder:object:generate=true
 type NamespacedConfigPatch struct {
 	// Path is the JSON path to add the patch.
 	Path string `json:"path"`
-	// ValueFrom is the reference to a key of a secret where the patched value comes from.
+	// ValueFrom is the reference to a key of a secret or a ConfigMap where the patched value comes from.
 	ValueFrom NamespacedConfigSource `json:"valueFrom"`
 }
 
@@ -54,3 +66,23 @@ type NamespacedSecretValueFromSource struct {
 	// The key containing the value.
 	Key string `json:"key"`
 }
+
+// ConfigMapValueFromSource represents the source of a ConfigMap value.
+// +kubebuilder:object:generate=true
+type ConfigMapValueFromSource struct {
+	// The ConfigMap containing the key.
+	ConfigMap string `json:"name"`
+	// The key containing the value.
+	Key string `json:"key"`
+}
+
+// NamespacedConfigMapValueFromSource represents the source of a ConfigMap value specifying the ConfigMap namespace.
+// +kubebuilder:object:generate=true
+type NamespacedConfigMapValueFromSource struct {
+	// The namespace containing the ConfigMap.
+	Namespace string `json:"namespace"`
+	// The ConfigMap containing the key.
+	ConfigMap string `json:"name"`
+	// The key containing the value.
+	Key string `json:"key"`
+}
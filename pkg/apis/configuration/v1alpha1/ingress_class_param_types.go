@@ -61,6 +61,12 @@ type IngressClassParametersSpec struct {
 	// 3.0 or higher.
 	// +kubebuilder:default:=false
 	EnableLegacyRegexDetection bool `json:"enableLegacyRegexDetection,omitempty"`
+
+	// TopologyAwareRouting makes gateways prefer upstream targets in their own zone, using the zone hints
+	// or the zones of the EndpointSlice endpoints. It also keeps terminating endpoints that are still serving
+	// as targets with a reduced weight, so that they are drained gracefully. It's not applied in DB mode.
+	// +kubebuilder:default:=false
+	TopologyAwareRouting bool `json:"topologyAwareRouting,omitempty"`
 }
 
 func init() {
@@ -0,0 +1,66 @@
+package v1alpha1
+
+import (
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+// +genclient
+// +genclient:noStatus
+// +k8s:deepcopy-gen:interfaces=k8s.io/apimachinery/pkg/runtime.Object
+// +kubebuilder:object:root=true
+// +kubebuilder:resource:shortName=kgs,categories=kong-ingress-controller,path=konggatewaysyncs
+// +kubebuilder:storageversion
+// +kubebuilder:printcolumn:name="Address",type=string,JSONPath=`.status.address`,description="Address of the Kong gateway's Admin API"
+// +kubebuilder:printcolumn:name="Config Hash",type=string,JSONPath=`.status.configHash`,description="Hash of the last configuration applied to the Kong gateway"
+// +kubebuilder:printcolumn:name="Fallback",type=boolean,JSONPath=`.status.fallback`,description="Whether the last configuration applied to the Kong gateway is the fallback configuration"
+// +kubebuilder:printcolumn:name="Last Sync",type=date,JSONPath=`.status.lastSyncTime`,description="Time of the last configuration sync with the Kong gateway"
+// +kubebuilder:printcolumn:name="Error",type=string,JSONPath=`.status.lastError`,description="Error of the last configuration sync with the Kong gateway",priority=1
+
+// KongGatewaySync reports the state of the configuration sync between the controller and a single Kong gateway
+// instance the controller configures. It is created and kept up to date by the controller and is not meant to be
+// edited by users. When the Kong gateway runs in a Pod, the KongGatewaySync is named after the Pod, placed in its
+// namespace and owned by it.
+type KongGatewaySync struct {
+	metav1.TypeMeta   `json:",inline"`
+	metav1.ObjectMeta `json:"metadata,omitempty"`
+
+	// Status is the state of the configuration sync with the Kong gateway.
+	Status KongGatewaySyncStatus `json:"status,omitempty"`
+}
+
+// KongGatewaySyncStatus is the state of the configuration sync with a Kong gateway.
+type KongGatewaySyncStatus struct {
+	// Address is the address of the Kong gateway's Admin API.
+	Address string `json:"address"`
+
+	// ConfigHash is the hash of the last configuration successfully applied to the Kong gateway.
+	// +optional
+	ConfigHash string `json:"configHash,omitempty"`
+
+	// Fallback is true when the last configuration successfully applied to the Kong gateway
+	// is the fallback configuration, generated without the objects the Kong gateway rejected.
+	// +optional
+	Fallback bool `json:"fallback,omitempty"`
+
+	// LastSyncTime is the time of the last attempt to sync the configuration with the Kong gateway.
+	// +optional
+	LastSyncTime *metav1.Time `json:"lastSyncTime,omitempty"`
+
+	// LastError is the error returned by the last attempt to sync the configuration with the Kong gateway.
+	// It is empty when the last attempt succeeded.
+	// +optional
+	LastError string `json:"lastError,omitempty"`
+}
+
+// +kubebuilder:object:root=true
+
+// KongGatewaySyncList contains a list of KongGatewaySync.
+type KongGatewaySyncList struct {
+	metav1.TypeMeta `json:",inline"`
+	metav1.ListMeta `json:"metadata,omitempty"`
+	Items           []KongGatewaySync `json:"items"`
+}
+
+func init() {
+	SchemeBuilder.Register(&KongGatewaySync{}, &KongGatewaySyncList{})
+}
@@ -0,0 +1,79 @@
+/*
+Copyright 2024 Kong, Inc.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package v1alpha1
+
+import (
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+const (
+	KongTenantPolicyKind = "KongTenantPolicy"
+)
+
+// +genclient
+// +genclient:nonNamespaced
+// +k8s:deepcopy-gen:interfaces=k8s.io/apimachinery/pkg/runtime.Object
+// +kubebuilder:object:root=true
+// +kubebuilder:resource:scope=Cluster,shortName=ktp,categories=kong-ingress-controller,path=kongtenantpolicies
+// +kubebuilder:storageversion
+// +kubebuilder:printcolumn:name="Age",type=date,JSONPath=`.metadata.creationTimestamp`,description="Age"
+
+// KongTenantPolicy restricts the Kong plugins, annotations, hosts and paths that objects in the
+// namespaces selected by the policy may use. Objects violating any of the policies selecting their
+// namespace are rejected by the admission webhook and excluded from the Kong configuration.
+type KongTenantPolicy struct {
+	metav1.TypeMeta   `json:",inline"`
+	metav1.ObjectMeta `json:"metadata,omitempty"`
+	Spec              KongTenantPolicySpec `json:"spec"`
+}
+
+// KongTenantPolicySpec defines the restrictions of a KongTenantPolicy.
+type KongTenantPolicySpec struct {
+	// NamespaceSelector selects the namespaces the policy applies to.
+	// An empty selector selects all namespaces.
+	NamespaceSelector metav1.LabelSelector `json:"namespaceSelector,omitempty"`
+	// Plugins restricts the names of Kong plugins (e.g. pre-function) that can be attached to objects.
+	Plugins *KongTenantPolicyRule `json:"plugins,omitempty"`
+	// Annotations restricts the keys of the konghq.com/ annotations objects can use.
+	Annotations *KongTenantPolicyRule `json:"annotations,omitempty"`
+	// Hosts restricts the hostnames routes can match.
+	Hosts *KongTenantPolicyRule `json:"hosts,omitempty"`
+	// PathPrefixes restricts the paths routes can match. A path matches an entry when it starts with it.
+	PathPrefixes *KongTenantPolicyRule `json:"pathPrefixes,omitempty"`
+}
+
+// KongTenantPolicyRule lists the allowed and denied values of a restricted field.
+// Except for path prefixes, entries may use the * wildcard matching any sequence of characters.
+type KongTenantPolicyRule struct {
+	// Allow lists the values that are allowed. When empty, all values that are not denied are allowed.
+	Allow []string `json:"allow,omitempty"`
+	// Deny lists the values that are denied. Deny takes precedence over Allow.
+	Deny []string `json:"deny,omitempty"`
+}
+
+// +kubebuilder:object:root=true
+
+// KongTenantPolicyList contains a list of KongTenantPolicy.
+type KongTenantPolicyList struct {
+	metav1.TypeMeta `json:",inline"`
+	metav1.ListMeta `json:"metadata,omitempty"`
+	Items           []KongTenantPolicy `json:"items"`
+}
+
+func init() {
+	SchemeBuilder.Register(&KongTenantPolicy{}, &KongTenantPolicyList{})
+}
@@ -15,7 +15,7 @@ func init() {
 
 // KongServiceFacade allows creating separate Kong Services for a single Kubernetes
 // Service. It can be used as Kubernetes Ingress' backend (via its path's `backend.resource`
-// field). It's designed to enable creating two "virtual" Services in Kong that will point
+// field) or as HTTPRoute's and GRPCRoute's backendRef. It's designed to enable creating two "virtual" Services in Kong that will point
 // to the same Kubernetes Service, but will have different configuration (e.g. different
 // set of plugins, different load balancing algorithm, etc.).
 //
@@ -30,7 +30,9 @@ type ConfigurationV1alpha1Interface interface {
 	RESTClient() rest.Interface
 	IngressClassParametersesGetter
 	KongCustomEntitiesGetter
+	KongGatewaySyncsGetter
 	KongLicensesGetter
+	KongTenantPoliciesGetter
 	KongVaultsGetter
 }
 
@@ -47,10 +49,18 @@ func (c *ConfigurationV1alpha1Client) KongCustomEntities(namespace string) KongC
 	return newKongCustomEntities(c, namespace)
 }
 
+func (c *ConfigurationV1alpha1Client) KongGatewaySyncs(namespace string) KongGatewaySyncInterface {
+	return newKongGatewaySyncs(c, namespace)
+}
+
 func (c *ConfigurationV1alpha1Client) KongLicenses() KongLicenseInterface {
 	return newKongLicenses(c)
 }
 
+func (c *ConfigurationV1alpha1Client) KongTenantPolicies() KongTenantPolicyInterface {
+	return newKongTenantPolicies(c)
+}
+
 func (c *ConfigurationV1alpha1Client) KongVaults() KongVaultInterface {
 	return newKongVaults(c)
 }
@@ -36,10 +36,18 @@ func (c *FakeConfigurationV1alpha1) KongCustomEntities(namespace string) v1alpha
 	return &FakeKongCustomEntities{c, namespace}
 }
 
+func (c *FakeConfigurationV1alpha1) KongGatewaySyncs(namespace string) v1alpha1.KongGatewaySyncInterface {
+	return &FakeKongGatewaySyncs{c, namespace}
+}
+
 func (c *FakeConfigurationV1alpha1) KongLicenses() v1alpha1.KongLicenseInterface {
 	return &FakeKongLicenses{c}
 }
 
+func (c *FakeConfigurationV1alpha1) KongTenantPolicies() v1alpha1.KongTenantPolicyInterface {
+	return &FakeKongTenantPolicies{c}
+}
+
 func (c *FakeConfigurationV1alpha1) KongVaults() v1alpha1.KongVaultInterface {
 	return &FakeKongVaults{c}
 }
@@ -22,6 +22,10 @@ type IngressClassParametersExpansion interface{}
 
 type KongCustomEntityExpansion interface{}
 
+type KongGatewaySyncExpansion interface{}
+
 type KongLicenseExpansion interface{}
 
+type KongTenantPolicyExpansion interface{}
+
 type KongVaultExpansion interface{}
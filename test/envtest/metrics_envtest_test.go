@@ -42,6 +42,7 @@ func TestMetricsAreServed(t *testing.T) {
 		metrics.MetricNameTranslationBrokenResources,
 		metrics.MetricNameConfigPushDuration,
 		metrics.MetricNameConfigPushSuccessTime,
+		metrics.MetricNameTranslationDuration,
 	}
 
 	metricsURL := fmt.Sprintf("http://%s/metrics", cfg.MetricsAddr)
@@ -8,6 +8,7 @@ import (
 	k8sscheme "k8s.io/client-go/kubernetes/scheme"
 	gatewayv1 "sigs.k8s.io/gateway-api/apis/v1"
 	gatewayv1alpha2 "sigs.k8s.io/gateway-api/apis/v1alpha2"
+	gatewayv1alpha3 "sigs.k8s.io/gateway-api/apis/v1alpha3"
 	gatewayv1beta1 "sigs.k8s.io/gateway-api/apis/v1beta1"
 
 	kongv1 "github.com/kong/kubernetes-ingress-controller/v3/pkg/apis/configuration/v1"
@@ -23,6 +24,7 @@ func WithGatewayAPI(t *testing.T, s *k8sruntime.Scheme) {
 	require.NoError(t, gatewayv1.Install(s))
 	require.NoError(t, gatewayv1beta1.Install(s))
 	require.NoError(t, gatewayv1alpha2.Install(s))
+	require.NoError(t, gatewayv1alpha3.Install(s))
 }
 
 // WithKong registers the Kong types with the scheme.
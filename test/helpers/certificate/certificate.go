@@ -1,6 +1,9 @@
 package certificate
 
 import (
+	"crypto"
+	"crypto/ecdsa"
+	"crypto/elliptic"
 	"crypto/rand"
 	"crypto/rsa"
 	"crypto/tls"
@@ -19,6 +22,7 @@ type selfSignedCertificateOptions struct {
 	DNSNames   []string
 	CATrue     bool
 	Expired    bool
+	ECDSAKey   bool
 }
 
 type SelfSignedCertificateOption func(selfSignedCertificateOptions) selfSignedCertificateOptions
@@ -52,15 +56,17 @@ func WithAlreadyExpired() SelfSignedCertificateOption {
 	}
 }
 
+// WithECDSAKey makes the certificate use an ECDSA (P-256) key instead of an RSA one.
+func WithECDSAKey() SelfSignedCertificateOption {
+	return func(opts selfSignedCertificateOptions) selfSignedCertificateOptions {
+		opts.ECDSAKey = true
+		return opts
+	}
+}
+
 // MustGenerateSelfSignedCert generates a tls.Certificate struct to be used in TLS client/listener configurations.
 // Certificate is self-signed thus returned cert can be used as CA for it.
 func MustGenerateSelfSignedCert(decorators ...SelfSignedCertificateOption) tls.Certificate {
-	// Generate a new RSA private key.
-	privateKey, err := rsa.GenerateKey(rand.Reader, 2048)
-	if err != nil {
-		panic(fmt.Sprintf("Failed to generate RSA key: %s", err))
-	}
-
 	options := selfSignedCertificateOptions{
 		CommonName: "",
 		DNSNames:   []string{},
@@ -70,6 +76,20 @@ func MustGenerateSelfSignedCert(decorators ...SelfSignedCertificateOption) tls.C
 		options = decorator(options)
 	}
 
+	// Generate a new RSA or ECDSA private key.
+	var (
+		privateKey crypto.Signer
+		err        error
+	)
+	if options.ECDSAKey {
+		privateKey, err = ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+	} else {
+		privateKey, err = rsa.GenerateKey(rand.Reader, 2048)
+	}
+	if err != nil {
+		panic(fmt.Sprintf("Failed to generate private key: %s", err))
+	}
+
 	notBefore := time.Now()
 	notAfter := notBefore.AddDate(1, 0, 0)
 	if options.Expired {
@@ -95,7 +115,7 @@ func MustGenerateSelfSignedCert(decorators ...SelfSignedCertificateOption) tls.C
 		BasicConstraintsValid: true,
 		IsCA:                  options.CATrue,
 	}
-	derBytes, err := x509.CreateCertificate(rand.Reader, template, template, &privateKey.PublicKey, privateKey)
+	derBytes, err := x509.CreateCertificate(rand.Reader, template, template, privateKey.Public(), privateKey)
 	if err != nil {
 		panic(fmt.Sprintf("Failed to create x509 certificate: %s", err))
 	}
@@ -120,13 +140,24 @@ func MustGenerateSelfSignedCertPEMFormat(decorators ...SelfSignedCertificateOpti
 		Bytes: tlsCert.Certificate[0],
 	}
 
-	privateKey, ok := tlsCert.PrivateKey.(*rsa.PrivateKey)
-	if !ok {
-		panic("Private Key should be convertible to *rsa.PrivateKey")
-	}
-	keyBlock := &pem.Block{
-		Type:  "RSA PRIVATE KEY",
-		Bytes: x509.MarshalPKCS1PrivateKey(privateKey),
+	var keyBlock *pem.Block
+	switch privateKey := tlsCert.PrivateKey.(type) {
+	case *rsa.PrivateKey:
+		keyBlock = &pem.Block{
+			Type:  "RSA PRIVATE KEY",
+			Bytes: x509.MarshalPKCS1PrivateKey(privateKey),
+		}
+	case *ecdsa.PrivateKey:
+		keyBytes, err := x509.MarshalECPrivateKey(privateKey)
+		if err != nil {
+			panic(fmt.Sprintf("Failed to marshal ECDSA key: %s", err))
+		}
+		keyBlock = &pem.Block{
+			Type:  "EC PRIVATE KEY",
+			Bytes: keyBytes,
+		}
+	default:
+		panic("Private Key should be convertible to *rsa.PrivateKey or *ecdsa.PrivateKey")
 	}
 
 	return pem.EncodeToMemory(certBlock), pem.EncodeToMemory(keyBlock)